@@ -31,34 +31,43 @@ type DotPhysicals struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                        string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`                                                //
-	OrganizationId            string                 `protobuf:"bytes,2,opt,name=OrganizationId,proto3" json:"OrganizationId,omitempty"`                        //
-	UserId                    string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                                        //
-	Status                    string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`                                        //
-	ScheduledDate             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ScheduledDate,proto3" json:"ScheduledDate,omitempty"`                          //
-	ScheduledBy               string                 `protobuf:"bytes,6,opt,name=ScheduledBy,proto3" json:"ScheduledBy,omitempty"`                              //
-	ExaminerId                string                 `protobuf:"bytes,7,opt,name=ExaminerId,proto3" json:"ExaminerId,omitempty"`                                //
-	ExaminerName              string                 `protobuf:"bytes,8,opt,name=ExaminerName,proto3" json:"ExaminerName,omitempty"`                            //
-	ExaminerLicenseNumber     string                 `protobuf:"bytes,9,opt,name=ExaminerLicenseNumber,proto3" json:"ExaminerLicenseNumber,omitempty"`          //
-	ExaminerRegistryNumber    string                 `protobuf:"bytes,10,opt,name=ExaminerRegistryNumber,proto3" json:"ExaminerRegistryNumber,omitempty"`       //
-	ClinicName                string                 `protobuf:"bytes,11,opt,name=ClinicName,proto3" json:"ClinicName,omitempty"`                               //
-	ClinicAddress             string                 `protobuf:"bytes,12,opt,name=ClinicAddress,proto3" json:"ClinicAddress,omitempty"`                         //
-	ClinicPhone               string                 `protobuf:"bytes,13,opt,name=ClinicPhone,proto3" json:"ClinicPhone,omitempty"`                             //
-	ExaminationDate           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=ExaminationDate,proto3" json:"ExaminationDate,omitempty"`                     //
-	CertificateNumber         string                 `protobuf:"bytes,15,opt,name=CertificateNumber,proto3" json:"CertificateNumber,omitempty"`                 //
-	CertificateIssueDate      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=CertificateIssueDate,proto3" json:"CertificateIssueDate,omitempty"`           //
-	CertificateExpirationDate *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=CertificateExpirationDate,proto3" json:"CertificateExpirationDate,omitempty"` //
-	MedicalQualification      string                 `protobuf:"bytes,18,opt,name=MedicalQualification,proto3" json:"MedicalQualification,omitempty"`           //
-	Restrictions              string                 `protobuf:"bytes,19,opt,name=Restrictions,proto3" json:"Restrictions,omitempty"`                           //
-	Exemptions                string                 `protobuf:"bytes,20,opt,name=Exemptions,proto3" json:"Exemptions,omitempty"`                               //
-	RequiresMonitoring        bool                   `protobuf:"varint,21,opt,name=RequiresMonitoring,proto3" json:"RequiresMonitoring,omitempty"`              //
-	MonitoringRequirements    string                 `protobuf:"bytes,22,opt,name=MonitoringRequirements,proto3" json:"MonitoringRequirements,omitempty"`       //
-	NextRequiredDate          *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=NextRequiredDate,proto3" json:"NextRequiredDate,omitempty"`                   //
-	CertificateUrl            string                 `protobuf:"bytes,24,opt,name=CertificateUrl,proto3" json:"CertificateUrl,omitempty"`                       //
-	CertificateUploadedAt     *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=CertificateUploadedAt,proto3" json:"CertificateUploadedAt,omitempty"`         //
-	Notes                     string                 `protobuf:"bytes,26,opt,name=Notes,proto3" json:"Notes,omitempty"`                                         //
-	CreatedAt                 *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`                                 //
-	UpdatedAt                 *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`                                 //
+	Id                        string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`                                                 //
+	OrganizationId            string                 `protobuf:"bytes,2,opt,name=OrganizationId,proto3" json:"OrganizationId,omitempty"`                         //
+	UserId                    string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`                                         //
+	Status                    string                 `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`                                         //
+	ScheduledDate             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ScheduledDate,proto3" json:"ScheduledDate,omitempty"`                           //
+	ScheduledBy               string                 `protobuf:"bytes,6,opt,name=ScheduledBy,proto3" json:"ScheduledBy,omitempty"`                               //
+	ExaminerId                string                 `protobuf:"bytes,7,opt,name=ExaminerId,proto3" json:"ExaminerId,omitempty"`                                 //
+	ExaminerName              string                 `protobuf:"bytes,8,opt,name=ExaminerName,proto3" json:"ExaminerName,omitempty"`                             //
+	ExaminerLicenseNumber     string                 `protobuf:"bytes,9,opt,name=ExaminerLicenseNumber,proto3" json:"ExaminerLicenseNumber,omitempty"`           //
+	ExaminerRegistryNumber    string                 `protobuf:"bytes,10,opt,name=ExaminerRegistryNumber,proto3" json:"ExaminerRegistryNumber,omitempty"`        //
+	ClinicName                string                 `protobuf:"bytes,11,opt,name=ClinicName,proto3" json:"ClinicName,omitempty"`                                //
+	ClinicAddress             string                 `protobuf:"bytes,12,opt,name=ClinicAddress,proto3" json:"ClinicAddress,omitempty"`                          //
+	ClinicPhone               string                 `protobuf:"bytes,13,opt,name=ClinicPhone,proto3" json:"ClinicPhone,omitempty"`                              //
+	ExaminationDate           *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=ExaminationDate,proto3" json:"ExaminationDate,omitempty"`                      //
+	CertificateNumber         string                 `protobuf:"bytes,15,opt,name=CertificateNumber,proto3" json:"CertificateNumber,omitempty"`                  //
+	CertificateIssueDate      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=CertificateIssueDate,proto3" json:"CertificateIssueDate,omitempty"`            //
+	CertificateExpirationDate *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=CertificateExpirationDate,proto3" json:"CertificateExpirationDate,omitempty"`  //
+	MedicalQualification      string                 `protobuf:"bytes,18,opt,name=MedicalQualification,proto3" json:"MedicalQualification,omitempty"`            //
+	Restrictions              string                 `protobuf:"bytes,19,opt,name=Restrictions,proto3" json:"Restrictions,omitempty"`                            //
+	Exemptions                string                 `protobuf:"bytes,20,opt,name=Exemptions,proto3" json:"Exemptions,omitempty"`                                //
+	RequiresMonitoring        bool                   `protobuf:"varint,21,opt,name=RequiresMonitoring,proto3" json:"RequiresMonitoring,omitempty"`               //
+	MonitoringRequirements    string                 `protobuf:"bytes,22,opt,name=MonitoringRequirements,proto3" json:"MonitoringRequirements,omitempty"`        //
+	NextRequiredDate          *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=NextRequiredDate,proto3" json:"NextRequiredDate,omitempty"`                    //
+	CertificateUrl            string                 `protobuf:"bytes,24,opt,name=CertificateUrl,proto3" json:"CertificateUrl,omitempty"`                        //
+	CertificateUploadedAt     *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=CertificateUploadedAt,proto3" json:"CertificateUploadedAt,omitempty"`          //
+	Notes                     string                 `protobuf:"bytes,26,opt,name=Notes,proto3" json:"Notes,omitempty"`                                          //
+	CreatedAt                 *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`                                  //
+	UpdatedAt                 *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`                                  //
+	ClinicId                  string                 `protobuf:"bytes,29,opt,name=ClinicId,proto3" json:"ClinicId,omitempty"`                                    //
+	MedicalExaminerId         string                 `protobuf:"bytes,30,opt,name=MedicalExaminerId,proto3" json:"MedicalExaminerId,omitempty"`                  //
+	ExaminationType           string                 `protobuf:"bytes,31,opt,name=ExaminationType,proto3" json:"ExaminationType,omitempty"`                      //
+	SpecialRequirements       string                 `protobuf:"bytes,32,opt,name=SpecialRequirements,proto3" json:"SpecialRequirements,omitempty"`              //
+	AppointmentSequence       int32                  `protobuf:"varint,33,opt,name=AppointmentSequence,proto3" json:"AppointmentSequence,omitempty"`             //
+	CancelledAt               *timestamppb.Timestamp `protobuf:"bytes,34,opt,name=CancelledAt,proto3" json:"CancelledAt,omitempty"`                              //
+	CancellationReason        string                 `protobuf:"bytes,35,opt,name=CancellationReason,proto3" json:"CancellationReason,omitempty"`                //
+	NoShowAt                  *timestamppb.Timestamp `protobuf:"bytes,36,opt,name=NoShowAt,proto3" json:"NoShowAt,omitempty"`                                    //
+	CertificationPeriodMonths int32                  `protobuf:"varint,37,opt,name=CertificationPeriodMonths,proto3" json:"CertificationPeriodMonths,omitempty"` //
 }
 
 func (x *DotPhysicals) Reset() {
@@ -289,6 +298,69 @@ func (x *DotPhysicals) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DotPhysicals) GetClinicId() string {
+	if x != nil {
+		return x.ClinicId
+	}
+	return ""
+}
+
+func (x *DotPhysicals) GetMedicalExaminerId() string {
+	if x != nil {
+		return x.MedicalExaminerId
+	}
+	return ""
+}
+
+func (x *DotPhysicals) GetExaminationType() string {
+	if x != nil {
+		return x.ExaminationType
+	}
+	return ""
+}
+
+func (x *DotPhysicals) GetSpecialRequirements() string {
+	if x != nil {
+		return x.SpecialRequirements
+	}
+	return ""
+}
+
+func (x *DotPhysicals) GetAppointmentSequence() int32 {
+	if x != nil {
+		return x.AppointmentSequence
+	}
+	return 0
+}
+
+func (x *DotPhysicals) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *DotPhysicals) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+func (x *DotPhysicals) GetNoShowAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NoShowAt
+	}
+	return nil
+}
+
+func (x *DotPhysicals) GetCertificationPeriodMonths() int32 {
+	if x != nil {
+		return x.CertificationPeriodMonths
+	}
+	return 0
+}
+
 var File_pbentity_dot_physicals_proto protoreflect.FileDescriptor
 
 var file_pbentity_dot_physicals_proto_rawDesc = []byte{
@@ -296,7 +368,7 @@ var file_pbentity_dot_physicals_proto_rawDesc = []byte{
 	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
 	0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x0a, 0x0a, 0x0c, 0x44, 0x6f,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x0d, 0x0a, 0x0c, 0x44, 0x6f,
 	0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x72,
 	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
@@ -380,9 +452,37 @@ var file_pbentity_dot_physicals_proto_rawDesc = []byte{
 	0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1c, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x1b, 0x5a, 0x19, 0x76, 0x31,
-	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c,
+	0x69, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c,
+	0x69, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x45,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30,
+	0x0a, 0x13, 0x53, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x30, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x41,
+	0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2e, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x08, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x41, 0x74, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x19, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x25, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x42, 0x1b, 0x5a, 0x19, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -403,19 +503,21 @@ var file_pbentity_dot_physicals_proto_goTypes = []interface{}{
 	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
 }
 var file_pbentity_dot_physicals_proto_depIdxs = []int32{
-	1, // 0: pbentity.DotPhysicals.ScheduledDate:type_name -> google.protobuf.Timestamp
-	1, // 1: pbentity.DotPhysicals.ExaminationDate:type_name -> google.protobuf.Timestamp
-	1, // 2: pbentity.DotPhysicals.CertificateIssueDate:type_name -> google.protobuf.Timestamp
-	1, // 3: pbentity.DotPhysicals.CertificateExpirationDate:type_name -> google.protobuf.Timestamp
-	1, // 4: pbentity.DotPhysicals.NextRequiredDate:type_name -> google.protobuf.Timestamp
-	1, // 5: pbentity.DotPhysicals.CertificateUploadedAt:type_name -> google.protobuf.Timestamp
-	1, // 6: pbentity.DotPhysicals.CreatedAt:type_name -> google.protobuf.Timestamp
-	1, // 7: pbentity.DotPhysicals.UpdatedAt:type_name -> google.protobuf.Timestamp
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: pbentity.DotPhysicals.ScheduledDate:type_name -> google.protobuf.Timestamp
+	1,  // 1: pbentity.DotPhysicals.ExaminationDate:type_name -> google.protobuf.Timestamp
+	1,  // 2: pbentity.DotPhysicals.CertificateIssueDate:type_name -> google.protobuf.Timestamp
+	1,  // 3: pbentity.DotPhysicals.CertificateExpirationDate:type_name -> google.protobuf.Timestamp
+	1,  // 4: pbentity.DotPhysicals.NextRequiredDate:type_name -> google.protobuf.Timestamp
+	1,  // 5: pbentity.DotPhysicals.CertificateUploadedAt:type_name -> google.protobuf.Timestamp
+	1,  // 6: pbentity.DotPhysicals.CreatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 7: pbentity.DotPhysicals.UpdatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 8: pbentity.DotPhysicals.CancelledAt:type_name -> google.protobuf.Timestamp
+	1,  // 9: pbentity.DotPhysicals.NoShowAt:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_pbentity_dot_physicals_proto_init() }
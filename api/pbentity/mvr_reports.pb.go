@@ -55,6 +55,8 @@ type MvrReports struct {
 	ActionNotes           string                 `protobuf:"bytes,22,opt,name=ActionNotes,proto3" json:"ActionNotes,omitempty"`                     //
 	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`                         //
 	UpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`                         //
+	LicenseClass          string                 `protobuf:"bytes,25,opt,name=LicenseClass,proto3" json:"LicenseClass,omitempty"`                   //
+	LicenseEndorsements   string                 `protobuf:"bytes,26,opt,name=LicenseEndorsements,proto3" json:"LicenseEndorsements,omitempty"`     //
 }
 
 func (x *MvrReports) Reset() {
@@ -257,6 +259,20 @@ func (x *MvrReports) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *MvrReports) GetLicenseClass() string {
+	if x != nil {
+		return x.LicenseClass
+	}
+	return ""
+}
+
+func (x *MvrReports) GetLicenseEndorsements() string {
+	if x != nil {
+		return x.LicenseEndorsements
+	}
+	return ""
+}
+
 var File_pbentity_mvr_reports_proto protoreflect.FileDescriptor
 
 var file_pbentity_mvr_reports_proto_rawDesc = []byte{
@@ -264,7 +280,7 @@ var file_pbentity_mvr_reports_proto_rawDesc = []byte{
 	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x62,
 	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x08, 0x0a, 0x0a, 0x4d, 0x76, 0x72, 0x52,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x09, 0x0a, 0x0a, 0x4d, 0x76, 0x72, 0x52,
 	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69,
 	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
@@ -331,9 +347,14 @@ var file_pbentity_mvr_reports_proto_rawDesc = []byte{
 	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x42, 0x1b, 0x5a, 0x19, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
-	0x74, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x4c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x1b, 0x5a, 0x19, 0x76, 0x31,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
+	0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -53,6 +53,15 @@ type Notifications struct {
 	DeliveryError     string                 `protobuf:"bytes,20,opt,name=DeliveryError,proto3" json:"DeliveryError,omitempty"`         //
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`                 //
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`                 //
+	Category          string                 `protobuf:"bytes,23,opt,name=Category,proto3" json:"Category,omitempty"`                   //
+	DeliveryStatus    string                 `protobuf:"bytes,24,opt,name=DeliveryStatus,proto3" json:"DeliveryStatus,omitempty"`       //
+	DigestAt          *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=DigestAt,proto3" json:"DigestAt,omitempty"`                   //
+	BouncedAt         *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=BouncedAt,proto3" json:"BouncedAt,omitempty"`                 //
+	BounceType        string                 `protobuf:"bytes,27,opt,name=BounceType,proto3" json:"BounceType,omitempty"`               //
+	ComplainedAt      *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=ComplainedAt,proto3" json:"ComplainedAt,omitempty"`           //
+	OpenedAt          *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=OpenedAt,proto3" json:"OpenedAt,omitempty"`                   //
+	ClickedAt         *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=ClickedAt,proto3" json:"ClickedAt,omitempty"`                 //
+	BulkRecipientId   string                 `protobuf:"bytes,31,opt,name=BulkRecipientId,proto3" json:"BulkRecipientId,omitempty"`     //
 }
 
 func (x *Notifications) Reset() {
@@ -241,6 +250,69 @@ func (x *Notifications) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Notifications) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Notifications) GetDeliveryStatus() string {
+	if x != nil {
+		return x.DeliveryStatus
+	}
+	return ""
+}
+
+func (x *Notifications) GetDigestAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DigestAt
+	}
+	return nil
+}
+
+func (x *Notifications) GetBouncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BouncedAt
+	}
+	return nil
+}
+
+func (x *Notifications) GetBounceType() string {
+	if x != nil {
+		return x.BounceType
+	}
+	return ""
+}
+
+func (x *Notifications) GetComplainedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComplainedAt
+	}
+	return nil
+}
+
+func (x *Notifications) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Notifications) GetClickedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClickedAt
+	}
+	return nil
+}
+
+func (x *Notifications) GetBulkRecipientId() string {
+	if x != nil {
+		return x.BulkRecipientId
+	}
+	return ""
+}
+
 var File_pbentity_notifications_proto protoreflect.FileDescriptor
 
 var file_pbentity_notifications_proto_rawDesc = []byte{
@@ -248,7 +320,7 @@ var file_pbentity_notifications_proto_rawDesc = []byte{
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
 	0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf3, 0x06, 0x0a, 0x0d, 0x4e, 0x6f,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x0a, 0x0a, 0x0d, 0x4e, 0x6f,
 	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x4f,
 	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
@@ -303,10 +375,37 @@ var file_pbentity_notifications_proto_rawDesc = []byte{
 	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
 	0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
-	0x1b, 0x5a, 0x19, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x41, 0x74, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x42,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x42, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x42, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x6c,
+	0x69, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x75, 0x6c, 0x6b, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x42, 0x75, 0x6c, 0x6b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x42, 0x1b, 0x5a, 0x19, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -327,17 +426,22 @@ var file_pbentity_notifications_proto_goTypes = []interface{}{
 	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
 }
 var file_pbentity_notifications_proto_depIdxs = []int32{
-	1, // 0: pbentity.Notifications.SentAt:type_name -> google.protobuf.Timestamp
-	1, // 1: pbentity.Notifications.DeliveredAt:type_name -> google.protobuf.Timestamp
-	1, // 2: pbentity.Notifications.ReadAt:type_name -> google.protobuf.Timestamp
-	1, // 3: pbentity.Notifications.LastAttemptAt:type_name -> google.protobuf.Timestamp
-	1, // 4: pbentity.Notifications.CreatedAt:type_name -> google.protobuf.Timestamp
-	1, // 5: pbentity.Notifications.UpdatedAt:type_name -> google.protobuf.Timestamp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: pbentity.Notifications.SentAt:type_name -> google.protobuf.Timestamp
+	1,  // 1: pbentity.Notifications.DeliveredAt:type_name -> google.protobuf.Timestamp
+	1,  // 2: pbentity.Notifications.ReadAt:type_name -> google.protobuf.Timestamp
+	1,  // 3: pbentity.Notifications.LastAttemptAt:type_name -> google.protobuf.Timestamp
+	1,  // 4: pbentity.Notifications.CreatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 5: pbentity.Notifications.UpdatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 6: pbentity.Notifications.DigestAt:type_name -> google.protobuf.Timestamp
+	1,  // 7: pbentity.Notifications.BouncedAt:type_name -> google.protobuf.Timestamp
+	1,  // 8: pbentity.Notifications.ComplainedAt:type_name -> google.protobuf.Timestamp
+	1,  // 9: pbentity.Notifications.OpenedAt:type_name -> google.protobuf.Timestamp
+	1,  // 10: pbentity.Notifications.ClickedAt:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_pbentity_notifications_proto_init() }
@@ -20,19 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DocumentService_UploadDocument_FullMethodName             = "/v1consortium.services.DocumentService/UploadDocument"
-	DocumentService_GetDocument_FullMethodName                = "/v1consortium.services.DocumentService/GetDocument"
-	DocumentService_ListDocuments_FullMethodName              = "/v1consortium.services.DocumentService/ListDocuments"
-	DocumentService_UpdateDocument_FullMethodName             = "/v1consortium.services.DocumentService/UpdateDocument"
-	DocumentService_DeleteDocument_FullMethodName             = "/v1consortium.services.DocumentService/DeleteDocument"
-	DocumentService_GetDocumentVersions_FullMethodName        = "/v1consortium.services.DocumentService/GetDocumentVersions"
-	DocumentService_ShareDocument_FullMethodName              = "/v1consortium.services.DocumentService/ShareDocument"
-	DocumentService_GetSharedDocuments_FullMethodName         = "/v1consortium.services.DocumentService/GetSharedDocuments"
-	DocumentService_RevokeDocumentShare_FullMethodName        = "/v1consortium.services.DocumentService/RevokeDocumentShare"
-	DocumentService_SearchDocuments_FullMethodName            = "/v1consortium.services.DocumentService/SearchDocuments"
-	DocumentService_GetDocumentAnalytics_FullMethodName       = "/v1consortium.services.DocumentService/GetDocumentAnalytics"
-	DocumentService_GetDocumentRetentionStatus_FullMethodName = "/v1consortium.services.DocumentService/GetDocumentRetentionStatus"
-	DocumentService_ApplyRetentionPolicy_FullMethodName       = "/v1consortium.services.DocumentService/ApplyRetentionPolicy"
+	DocumentService_UploadDocument_FullMethodName                  = "/v1consortium.services.DocumentService/UploadDocument"
+	DocumentService_StreamUploadDocument_FullMethodName            = "/v1consortium.services.DocumentService/StreamUploadDocument"
+	DocumentService_GetDocumentUploadSession_FullMethodName        = "/v1consortium.services.DocumentService/GetDocumentUploadSession"
+	DocumentService_GetDocumentUploadLimits_FullMethodName         = "/v1consortium.services.DocumentService/GetDocumentUploadLimits"
+	DocumentService_SetDocumentUploadLimits_FullMethodName         = "/v1consortium.services.DocumentService/SetDocumentUploadLimits"
+	DocumentService_GetDocument_FullMethodName                     = "/v1consortium.services.DocumentService/GetDocument"
+	DocumentService_ListDocuments_FullMethodName                   = "/v1consortium.services.DocumentService/ListDocuments"
+	DocumentService_UpdateDocument_FullMethodName                  = "/v1consortium.services.DocumentService/UpdateDocument"
+	DocumentService_DeleteDocument_FullMethodName                  = "/v1consortium.services.DocumentService/DeleteDocument"
+	DocumentService_GetDocumentVersions_FullMethodName             = "/v1consortium.services.DocumentService/GetDocumentVersions"
+	DocumentService_RollbackDocument_FullMethodName                = "/v1consortium.services.DocumentService/RollbackDocument"
+	DocumentService_ShareDocument_FullMethodName                   = "/v1consortium.services.DocumentService/ShareDocument"
+	DocumentService_GetSharedDocuments_FullMethodName              = "/v1consortium.services.DocumentService/GetSharedDocuments"
+	DocumentService_RevokeDocumentShare_FullMethodName             = "/v1consortium.services.DocumentService/RevokeDocumentShare"
+	DocumentService_SearchDocuments_FullMethodName                 = "/v1consortium.services.DocumentService/SearchDocuments"
+	DocumentService_GetDocumentAnalytics_FullMethodName            = "/v1consortium.services.DocumentService/GetDocumentAnalytics"
+	DocumentService_GetDocumentRetentionStatus_FullMethodName      = "/v1consortium.services.DocumentService/GetDocumentRetentionStatus"
+	DocumentService_ApplyRetentionPolicy_FullMethodName            = "/v1consortium.services.DocumentService/ApplyRetentionPolicy"
+	DocumentService_PlaceLegalHold_FullMethodName                  = "/v1consortium.services.DocumentService/PlaceLegalHold"
+	DocumentService_ReleaseLegalHold_FullMethodName                = "/v1consortium.services.DocumentService/ReleaseLegalHold"
+	DocumentService_ListLegalHolds_FullMethodName                  = "/v1consortium.services.DocumentService/ListLegalHolds"
+	DocumentService_AssembleDriverQualificationFile_FullMethodName = "/v1consortium.services.DocumentService/AssembleDriverQualificationFile"
 )
 
 // DocumentServiceClient is the client API for DocumentService service.
@@ -43,11 +52,19 @@ const (
 type DocumentServiceClient interface {
 	// Document Management
 	UploadDocument(ctx context.Context, in *UploadDocumentRequest, opts ...grpc.CallOption) (*UploadDocumentResponse, error)
+	// Streams a document in chunks after a header message. Interrupted uploads resume by sending
+	// the same upload session ID with the offset reported by GetDocumentUploadSession.
+	StreamUploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamUploadDocumentRequest, StreamUploadDocumentResponse], error)
+	GetDocumentUploadSession(ctx context.Context, in *GetDocumentUploadSessionRequest, opts ...grpc.CallOption) (*GetDocumentUploadSessionResponse, error)
+	GetDocumentUploadLimits(ctx context.Context, in *GetDocumentUploadLimitsRequest, opts ...grpc.CallOption) (*GetDocumentUploadLimitsResponse, error)
+	SetDocumentUploadLimits(ctx context.Context, in *SetDocumentUploadLimitsRequest, opts ...grpc.CallOption) (*SetDocumentUploadLimitsResponse, error)
 	GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error)
 	ListDocuments(ctx context.Context, in *ListDocumentsRequest, opts ...grpc.CallOption) (*ListDocumentsResponse, error)
 	UpdateDocument(ctx context.Context, in *UpdateDocumentRequest, opts ...grpc.CallOption) (*UpdateDocumentResponse, error)
 	DeleteDocument(ctx context.Context, in *DeleteDocumentRequest, opts ...grpc.CallOption) (*DeleteDocumentResponse, error)
 	GetDocumentVersions(ctx context.Context, in *GetDocumentVersionsRequest, opts ...grpc.CallOption) (*GetDocumentVersionsResponse, error)
+	// Restores a prior version's content and file details as a new current version
+	RollbackDocument(ctx context.Context, in *RollbackDocumentRequest, opts ...grpc.CallOption) (*RollbackDocumentResponse, error)
 	// Document Sharing
 	ShareDocument(ctx context.Context, in *ShareDocumentRequest, opts ...grpc.CallOption) (*ShareDocumentResponse, error)
 	GetSharedDocuments(ctx context.Context, in *GetSharedDocumentsRequest, opts ...grpc.CallOption) (*GetSharedDocumentsResponse, error)
@@ -59,6 +76,12 @@ type DocumentServiceClient interface {
 	// Document Compliance
 	GetDocumentRetentionStatus(ctx context.Context, in *GetDocumentRetentionStatusRequest, opts ...grpc.CallOption) (*GetDocumentRetentionStatusResponse, error)
 	ApplyRetentionPolicy(ctx context.Context, in *ApplyRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyRetentionPolicyResponse, error)
+	// Legal holds block deletion of an organization's, a user's or a single document's records
+	PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*PlaceLegalHoldResponse, error)
+	ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*ReleaseLegalHoldResponse, error)
+	ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error)
+	// Driver qualification file: every record FMCSA requires for a driver, bundled as one PDF or ZIP
+	AssembleDriverQualificationFile(ctx context.Context, in *AssembleDriverQualificationFileRequest, opts ...grpc.CallOption) (*AssembleDriverQualificationFileResponse, error)
 }
 
 type documentServiceClient struct {
@@ -79,6 +102,49 @@ func (c *documentServiceClient) UploadDocument(ctx context.Context, in *UploadDo
 	return out, nil
 }
 
+func (c *documentServiceClient) StreamUploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamUploadDocumentRequest, StreamUploadDocumentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DocumentService_ServiceDesc.Streams[0], DocumentService_StreamUploadDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamUploadDocumentRequest, StreamUploadDocumentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocumentService_StreamUploadDocumentClient = grpc.ClientStreamingClient[StreamUploadDocumentRequest, StreamUploadDocumentResponse]
+
+func (c *documentServiceClient) GetDocumentUploadSession(ctx context.Context, in *GetDocumentUploadSessionRequest, opts ...grpc.CallOption) (*GetDocumentUploadSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentUploadSessionResponse)
+	err := c.cc.Invoke(ctx, DocumentService_GetDocumentUploadSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) GetDocumentUploadLimits(ctx context.Context, in *GetDocumentUploadLimitsRequest, opts ...grpc.CallOption) (*GetDocumentUploadLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentUploadLimitsResponse)
+	err := c.cc.Invoke(ctx, DocumentService_GetDocumentUploadLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) SetDocumentUploadLimits(ctx context.Context, in *SetDocumentUploadLimitsRequest, opts ...grpc.CallOption) (*SetDocumentUploadLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDocumentUploadLimitsResponse)
+	err := c.cc.Invoke(ctx, DocumentService_SetDocumentUploadLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) GetDocument(ctx context.Context, in *GetDocumentRequest, opts ...grpc.CallOption) (*GetDocumentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocumentResponse)
@@ -129,6 +195,16 @@ func (c *documentServiceClient) GetDocumentVersions(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *documentServiceClient) RollbackDocument(ctx context.Context, in *RollbackDocumentRequest, opts ...grpc.CallOption) (*RollbackDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackDocumentResponse)
+	err := c.cc.Invoke(ctx, DocumentService_RollbackDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *documentServiceClient) ShareDocument(ctx context.Context, in *ShareDocumentRequest, opts ...grpc.CallOption) (*ShareDocumentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ShareDocumentResponse)
@@ -199,6 +275,46 @@ func (c *documentServiceClient) ApplyRetentionPolicy(ctx context.Context, in *Ap
 	return out, nil
 }
 
+func (c *documentServiceClient) PlaceLegalHold(ctx context.Context, in *PlaceLegalHoldRequest, opts ...grpc.CallOption) (*PlaceLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceLegalHoldResponse)
+	err := c.cc.Invoke(ctx, DocumentService_PlaceLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ReleaseLegalHold(ctx context.Context, in *ReleaseLegalHoldRequest, opts ...grpc.CallOption) (*ReleaseLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLegalHoldResponse)
+	err := c.cc.Invoke(ctx, DocumentService_ReleaseLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) ListLegalHolds(ctx context.Context, in *ListLegalHoldsRequest, opts ...grpc.CallOption) (*ListLegalHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLegalHoldsResponse)
+	err := c.cc.Invoke(ctx, DocumentService_ListLegalHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *documentServiceClient) AssembleDriverQualificationFile(ctx context.Context, in *AssembleDriverQualificationFileRequest, opts ...grpc.CallOption) (*AssembleDriverQualificationFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssembleDriverQualificationFileResponse)
+	err := c.cc.Invoke(ctx, DocumentService_AssembleDriverQualificationFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DocumentServiceServer is the server API for DocumentService service.
 // All implementations must embed UnimplementedDocumentServiceServer
 // for forward compatibility.
@@ -207,11 +323,19 @@ func (c *documentServiceClient) ApplyRetentionPolicy(ctx context.Context, in *Ap
 type DocumentServiceServer interface {
 	// Document Management
 	UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error)
+	// Streams a document in chunks after a header message. Interrupted uploads resume by sending
+	// the same upload session ID with the offset reported by GetDocumentUploadSession.
+	StreamUploadDocument(grpc.ClientStreamingServer[StreamUploadDocumentRequest, StreamUploadDocumentResponse]) error
+	GetDocumentUploadSession(context.Context, *GetDocumentUploadSessionRequest) (*GetDocumentUploadSessionResponse, error)
+	GetDocumentUploadLimits(context.Context, *GetDocumentUploadLimitsRequest) (*GetDocumentUploadLimitsResponse, error)
+	SetDocumentUploadLimits(context.Context, *SetDocumentUploadLimitsRequest) (*SetDocumentUploadLimitsResponse, error)
 	GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error)
 	ListDocuments(context.Context, *ListDocumentsRequest) (*ListDocumentsResponse, error)
 	UpdateDocument(context.Context, *UpdateDocumentRequest) (*UpdateDocumentResponse, error)
 	DeleteDocument(context.Context, *DeleteDocumentRequest) (*DeleteDocumentResponse, error)
 	GetDocumentVersions(context.Context, *GetDocumentVersionsRequest) (*GetDocumentVersionsResponse, error)
+	// Restores a prior version's content and file details as a new current version
+	RollbackDocument(context.Context, *RollbackDocumentRequest) (*RollbackDocumentResponse, error)
 	// Document Sharing
 	ShareDocument(context.Context, *ShareDocumentRequest) (*ShareDocumentResponse, error)
 	GetSharedDocuments(context.Context, *GetSharedDocumentsRequest) (*GetSharedDocumentsResponse, error)
@@ -223,6 +347,12 @@ type DocumentServiceServer interface {
 	// Document Compliance
 	GetDocumentRetentionStatus(context.Context, *GetDocumentRetentionStatusRequest) (*GetDocumentRetentionStatusResponse, error)
 	ApplyRetentionPolicy(context.Context, *ApplyRetentionPolicyRequest) (*ApplyRetentionPolicyResponse, error)
+	// Legal holds block deletion of an organization's, a user's or a single document's records
+	PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*PlaceLegalHoldResponse, error)
+	ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*ReleaseLegalHoldResponse, error)
+	ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error)
+	// Driver qualification file: every record FMCSA requires for a driver, bundled as one PDF or ZIP
+	AssembleDriverQualificationFile(context.Context, *AssembleDriverQualificationFileRequest) (*AssembleDriverQualificationFileResponse, error)
 	mustEmbedUnimplementedDocumentServiceServer()
 }
 
@@ -236,6 +366,18 @@ type UnimplementedDocumentServiceServer struct{}
 func (UnimplementedDocumentServiceServer) UploadDocument(context.Context, *UploadDocumentRequest) (*UploadDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
 }
+func (UnimplementedDocumentServiceServer) StreamUploadDocument(grpc.ClientStreamingServer[StreamUploadDocumentRequest, StreamUploadDocumentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamUploadDocument not implemented")
+}
+func (UnimplementedDocumentServiceServer) GetDocumentUploadSession(context.Context, *GetDocumentUploadSessionRequest) (*GetDocumentUploadSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentUploadSession not implemented")
+}
+func (UnimplementedDocumentServiceServer) GetDocumentUploadLimits(context.Context, *GetDocumentUploadLimitsRequest) (*GetDocumentUploadLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentUploadLimits not implemented")
+}
+func (UnimplementedDocumentServiceServer) SetDocumentUploadLimits(context.Context, *SetDocumentUploadLimitsRequest) (*SetDocumentUploadLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDocumentUploadLimits not implemented")
+}
 func (UnimplementedDocumentServiceServer) GetDocument(context.Context, *GetDocumentRequest) (*GetDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocument not implemented")
 }
@@ -251,6 +393,9 @@ func (UnimplementedDocumentServiceServer) DeleteDocument(context.Context, *Delet
 func (UnimplementedDocumentServiceServer) GetDocumentVersions(context.Context, *GetDocumentVersionsRequest) (*GetDocumentVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentVersions not implemented")
 }
+func (UnimplementedDocumentServiceServer) RollbackDocument(context.Context, *RollbackDocumentRequest) (*RollbackDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDocument not implemented")
+}
 func (UnimplementedDocumentServiceServer) ShareDocument(context.Context, *ShareDocumentRequest) (*ShareDocumentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareDocument not implemented")
 }
@@ -272,6 +417,18 @@ func (UnimplementedDocumentServiceServer) GetDocumentRetentionStatus(context.Con
 func (UnimplementedDocumentServiceServer) ApplyRetentionPolicy(context.Context, *ApplyRetentionPolicyRequest) (*ApplyRetentionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyRetentionPolicy not implemented")
 }
+func (UnimplementedDocumentServiceServer) PlaceLegalHold(context.Context, *PlaceLegalHoldRequest) (*PlaceLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLegalHold not implemented")
+}
+func (UnimplementedDocumentServiceServer) ReleaseLegalHold(context.Context, *ReleaseLegalHoldRequest) (*ReleaseLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLegalHold not implemented")
+}
+func (UnimplementedDocumentServiceServer) ListLegalHolds(context.Context, *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLegalHolds not implemented")
+}
+func (UnimplementedDocumentServiceServer) AssembleDriverQualificationFile(context.Context, *AssembleDriverQualificationFileRequest) (*AssembleDriverQualificationFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssembleDriverQualificationFile not implemented")
+}
 func (UnimplementedDocumentServiceServer) mustEmbedUnimplementedDocumentServiceServer() {}
 func (UnimplementedDocumentServiceServer) testEmbeddedByValue()                         {}
 
@@ -311,6 +468,67 @@ func _DocumentService_UploadDocument_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_StreamUploadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DocumentServiceServer).StreamUploadDocument(&grpc.GenericServerStream[StreamUploadDocumentRequest, StreamUploadDocumentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DocumentService_StreamUploadDocumentServer = grpc.ClientStreamingServer[StreamUploadDocumentRequest, StreamUploadDocumentResponse]
+
+func _DocumentService_GetDocumentUploadSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentUploadSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentUploadSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_GetDocumentUploadSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentUploadSession(ctx, req.(*GetDocumentUploadSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_GetDocumentUploadLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentUploadLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).GetDocumentUploadLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_GetDocumentUploadLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).GetDocumentUploadLimits(ctx, req.(*GetDocumentUploadLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_SetDocumentUploadLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDocumentUploadLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).SetDocumentUploadLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_SetDocumentUploadLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).SetDocumentUploadLimits(ctx, req.(*SetDocumentUploadLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDocumentRequest)
 	if err := dec(in); err != nil {
@@ -401,6 +619,24 @@ func _DocumentService_GetDocumentVersions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_RollbackDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).RollbackDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_RollbackDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).RollbackDocument(ctx, req.(*RollbackDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DocumentService_ShareDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShareDocumentRequest)
 	if err := dec(in); err != nil {
@@ -527,6 +763,78 @@ func _DocumentService_ApplyRetentionPolicy_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DocumentService_PlaceLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).PlaceLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_PlaceLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).PlaceLegalHold(ctx, req.(*PlaceLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ReleaseLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ReleaseLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_ReleaseLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ReleaseLegalHold(ctx, req.(*ReleaseLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_ListLegalHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLegalHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).ListLegalHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_ListLegalHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).ListLegalHolds(ctx, req.(*ListLegalHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DocumentService_AssembleDriverQualificationFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssembleDriverQualificationFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DocumentServiceServer).AssembleDriverQualificationFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DocumentService_AssembleDriverQualificationFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DocumentServiceServer).AssembleDriverQualificationFile(ctx, req.(*AssembleDriverQualificationFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DocumentService_ServiceDesc is the grpc.ServiceDesc for DocumentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -538,6 +846,18 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UploadDocument",
 			Handler:    _DocumentService_UploadDocument_Handler,
 		},
+		{
+			MethodName: "GetDocumentUploadSession",
+			Handler:    _DocumentService_GetDocumentUploadSession_Handler,
+		},
+		{
+			MethodName: "GetDocumentUploadLimits",
+			Handler:    _DocumentService_GetDocumentUploadLimits_Handler,
+		},
+		{
+			MethodName: "SetDocumentUploadLimits",
+			Handler:    _DocumentService_SetDocumentUploadLimits_Handler,
+		},
 		{
 			MethodName: "GetDocument",
 			Handler:    _DocumentService_GetDocument_Handler,
@@ -558,6 +878,10 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDocumentVersions",
 			Handler:    _DocumentService_GetDocumentVersions_Handler,
 		},
+		{
+			MethodName: "RollbackDocument",
+			Handler:    _DocumentService_RollbackDocument_Handler,
+		},
 		{
 			MethodName: "ShareDocument",
 			Handler:    _DocumentService_ShareDocument_Handler,
@@ -586,7 +910,29 @@ var DocumentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyRetentionPolicy",
 			Handler:    _DocumentService_ApplyRetentionPolicy_Handler,
 		},
+		{
+			MethodName: "PlaceLegalHold",
+			Handler:    _DocumentService_PlaceLegalHold_Handler,
+		},
+		{
+			MethodName: "ReleaseLegalHold",
+			Handler:    _DocumentService_ReleaseLegalHold_Handler,
+		},
+		{
+			MethodName: "ListLegalHolds",
+			Handler:    _DocumentService_ListLegalHolds_Handler,
+		},
+		{
+			MethodName: "AssembleDriverQualificationFile",
+			Handler:    _DocumentService_AssembleDriverQualificationFile_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamUploadDocument",
+			Handler:       _DocumentService_StreamUploadDocument_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/v1/document.proto",
 }
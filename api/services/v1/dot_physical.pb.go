@@ -37,7 +37,8 @@ type ScheduleDOTPhysicalRequest struct {
 	ExaminationType     string                 `protobuf:"bytes,5,opt,name=examination_type,json=examinationType,proto3" json:"examination_type,omitempty" dc:"'initial', 'renewal', 'follow_up'"` // "initial", "renewal", "follow_up"
 	ScheduledBy         string                 `protobuf:"bytes,6,opt,name=scheduled_by,json=scheduledBy,proto3" json:"scheduled_by,omitempty"`
 	FacilityPreference  string                 `protobuf:"bytes,7,opt,name=facility_preference,json=facilityPreference,proto3" json:"facility_preference,omitempty"`
-	SpecialRequirements string                 `protobuf:"bytes,8,opt,name=special_requirements,json=specialRequirements,proto3" json:"special_requirements,omitempty" dc:"Any special accommodations needed"` // Any special accommodations needed
+	SpecialRequirements string                 `protobuf:"bytes,8,opt,name=special_requirements,json=specialRequirements,proto3" json:"special_requirements,omitempty" dc:"Any special accommodations needed"`                // Any special accommodations needed
+	SlotId              string                 `protobuf:"bytes,9,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty" dc:"Availability slot to book; otherwise the examiner's first open slot from preferred_date"` // Availability slot to book; otherwise the examiner's first open slot from preferred_date
 }
 
 func (x *ScheduleDOTPhysicalRequest) Reset() {
@@ -128,16 +129,25 @@ func (x *ScheduleDOTPhysicalRequest) GetSpecialRequirements() string {
 	return ""
 }
 
+func (x *ScheduleDOTPhysicalRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
 type ScheduleDOTPhysicalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Physical                *pbentity.DotPhysicals `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
-	AppointmentConfirmation string                 `protobuf:"bytes,2,opt,name=appointment_confirmation,json=appointmentConfirmation,proto3" json:"appointment_confirmation,omitempty"`
-	ExaminerName            string                 `protobuf:"bytes,3,opt,name=examiner_name,json=examinerName,proto3" json:"examiner_name,omitempty"`
-	FacilityName            string                 `protobuf:"bytes,4,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
-	FacilityAddress         string                 `protobuf:"bytes,5,opt,name=facility_address,json=facilityAddress,proto3" json:"facility_address,omitempty"`
+	Physical                *pbentity.DotPhysicals  `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+	AppointmentConfirmation string                  `protobuf:"bytes,2,opt,name=appointment_confirmation,json=appointmentConfirmation,proto3" json:"appointment_confirmation,omitempty"`
+	ExaminerName            string                  `protobuf:"bytes,3,opt,name=examiner_name,json=examinerName,proto3" json:"examiner_name,omitempty"`
+	FacilityName            string                  `protobuf:"bytes,4,opt,name=facility_name,json=facilityName,proto3" json:"facility_name,omitempty"`
+	FacilityAddress         string                  `protobuf:"bytes,5,opt,name=facility_address,json=facilityAddress,proto3" json:"facility_address,omitempty"`
+	Slot                    *ClinicAvailabilitySlot `protobuf:"bytes,6,opt,name=slot,proto3" json:"slot,omitempty"`
+	CalendarInvite          string                  `protobuf:"bytes,7,opt,name=calendar_invite,json=calendarInvite,proto3" json:"calendar_invite,omitempty" dc:"iCalendar (ICS) invite sent to the driver"` // iCalendar (ICS) invite sent to the driver
 }
 
 func (x *ScheduleDOTPhysicalResponse) Reset() {
@@ -207,6 +217,20 @@ func (x *ScheduleDOTPhysicalResponse) GetFacilityAddress() string {
 	return ""
 }
 
+func (x *ScheduleDOTPhysicalResponse) GetSlot() *ClinicAvailabilitySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *ScheduleDOTPhysicalResponse) GetCalendarInvite() string {
+	if x != nil {
+		return x.CalendarInvite
+	}
+	return ""
+}
+
 type GetDOTPhysicalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -259,7 +283,11 @@ type GetDOTPhysicalResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Physical *pbentity.DotPhysicals `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+	Physical         *pbentity.DotPhysicals          `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+	RestrictionCodes []string                        `protobuf:"bytes,2,rep,name=restriction_codes,json=restrictionCodes,proto3" json:"restriction_codes,omitempty"`
+	Monitoring       []*MedicalMonitoringRequirement `protobuf:"bytes,3,rep,name=monitoring,proto3" json:"monitoring,omitempty"`
+	Exemptions       []*MedicalExemption             `protobuf:"bytes,4,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
+	FollowUpTasks    []*MedicalFollowUpTask          `protobuf:"bytes,5,rep,name=follow_up_tasks,json=followUpTasks,proto3" json:"follow_up_tasks,omitempty"`
 }
 
 func (x *GetDOTPhysicalResponse) Reset() {
@@ -301,24 +329,57 @@ func (x *GetDOTPhysicalResponse) GetPhysical() *pbentity.DotPhysicals {
 	return nil
 }
 
+func (x *GetDOTPhysicalResponse) GetRestrictionCodes() []string {
+	if x != nil {
+		return x.RestrictionCodes
+	}
+	return nil
+}
+
+func (x *GetDOTPhysicalResponse) GetMonitoring() []*MedicalMonitoringRequirement {
+	if x != nil {
+		return x.Monitoring
+	}
+	return nil
+}
+
+func (x *GetDOTPhysicalResponse) GetExemptions() []*MedicalExemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
+func (x *GetDOTPhysicalResponse) GetFollowUpTasks() []*MedicalFollowUpTask {
+	if x != nil {
+		return x.FollowUpTasks
+	}
+	return nil
+}
+
 type UpdateDOTPhysicalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PhysicalId                string                 `protobuf:"bytes,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
-	Status                    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty" dc:"'scheduled', 'completed', 'cancelled', 'no_show'"` // "scheduled", "completed", "cancelled", "no_show"
-	ExaminationDate           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=examination_date,json=examinationDate,proto3" json:"examination_date,omitempty"`
-	QualificationStatus       string                 `protobuf:"bytes,4,opt,name=qualification_status,json=qualificationStatus,proto3" json:"qualification_status,omitempty" dc:"'qualified', 'disqualified', 'qualified_with_restrictions'"` // "qualified", "disqualified", "qualified_with_restrictions"
-	CertificateIssueDate      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=certificate_issue_date,json=certificateIssueDate,proto3" json:"certificate_issue_date,omitempty"`
-	CertificateExpirationDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=certificate_expiration_date,json=certificateExpirationDate,proto3" json:"certificate_expiration_date,omitempty"`
-	CertificateNumber         string                 `protobuf:"bytes,7,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
-	Restrictions              string                 `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty" dc:"JSON string of any restrictions"` // JSON string of any restrictions
-	ExaminerNotes             string                 `protobuf:"bytes,9,opt,name=examiner_notes,json=examinerNotes,proto3" json:"examiner_notes,omitempty"`
-	RequiresMonitoring        bool                   `protobuf:"varint,10,opt,name=requires_monitoring,json=requiresMonitoring,proto3" json:"requires_monitoring,omitempty"`
-	MonitoringRequirements    string                 `protobuf:"bytes,11,opt,name=monitoring_requirements,json=monitoringRequirements,proto3" json:"monitoring_requirements,omitempty"`
-	ExemptionType             string                 `protobuf:"bytes,12,opt,name=exemption_type,json=exemptionType,proto3" json:"exemption_type,omitempty"`
-	ExemptionExpiration       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=exemption_expiration,json=exemptionExpiration,proto3" json:"exemption_expiration,omitempty"`
+	PhysicalId                string                          `protobuf:"bytes,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	Status                    string                          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty" dc:"'scheduled', 'completed', 'cancelled', 'no_show'"` // "scheduled", "completed", "cancelled", "no_show"
+	ExaminationDate           *timestamppb.Timestamp          `protobuf:"bytes,3,opt,name=examination_date,json=examinationDate,proto3" json:"examination_date,omitempty"`
+	QualificationStatus       string                          `protobuf:"bytes,4,opt,name=qualification_status,json=qualificationStatus,proto3" json:"qualification_status,omitempty" dc:"'qualified', 'disqualified', 'qualified_with_restrictions'"` // "qualified", "disqualified", "qualified_with_restrictions"
+	CertificateIssueDate      *timestamppb.Timestamp          `protobuf:"bytes,5,opt,name=certificate_issue_date,json=certificateIssueDate,proto3" json:"certificate_issue_date,omitempty"`
+	CertificateExpirationDate *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=certificate_expiration_date,json=certificateExpirationDate,proto3" json:"certificate_expiration_date,omitempty"`
+	CertificateNumber         string                          `protobuf:"bytes,7,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
+	Restrictions              string                          `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty" dc:"JSON string of any restrictions"` // JSON string of any restrictions
+	ExaminerNotes             string                          `protobuf:"bytes,9,opt,name=examiner_notes,json=examinerNotes,proto3" json:"examiner_notes,omitempty"`
+	RequiresMonitoring        bool                            `protobuf:"varint,10,opt,name=requires_monitoring,json=requiresMonitoring,proto3" json:"requires_monitoring,omitempty"`
+	MonitoringRequirements    string                          `protobuf:"bytes,11,opt,name=monitoring_requirements,json=monitoringRequirements,proto3" json:"monitoring_requirements,omitempty"`
+	ExemptionType             string                          `protobuf:"bytes,12,opt,name=exemption_type,json=exemptionType,proto3" json:"exemption_type,omitempty"`
+	ExemptionExpiration       *timestamppb.Timestamp          `protobuf:"bytes,13,opt,name=exemption_expiration,json=exemptionExpiration,proto3" json:"exemption_expiration,omitempty"`
+	ExaminerRegistryNumber    string                          `protobuf:"bytes,14,opt,name=examiner_registry_number,json=examinerRegistryNumber,proto3" json:"examiner_registry_number,omitempty" dc:"NRCME number of the certifying examiner"`                                                                                   // NRCME number of the certifying examiner
+	RestrictionCodes          []string                        `protobuf:"bytes,15,rep,name=restriction_codes,json=restrictionCodes,proto3" json:"restriction_codes,omitempty" dc:"'corrective_lenses', 'hearing_aid', 'waiver_exemption', 'spe_certificate', 'exempt_intracity_zone', 'grandfathered_391_64', 'intrastate_only'"` // "corrective_lenses", "hearing_aid", "waiver_exemption", "spe_certificate", "exempt_intracity_zone", "grandfathered_391_64", "intrastate_only"
+	Monitoring                []*MedicalMonitoringRequirement `protobuf:"bytes,16,rep,name=monitoring,proto3" json:"monitoring,omitempty"`
+	Exemptions                []*MedicalExemption             `protobuf:"bytes,17,rep,name=exemptions,proto3" json:"exemptions,omitempty"`
+	CertificationPeriodMonths int32                           `protobuf:"varint,18,opt,name=certification_period_months,json=certificationPeriodMonths,proto3" json:"certification_period_months,omitempty" dc:"Short-term certificate length; defaults to the shortest monitoring interval, or 24"` // Short-term certificate length; defaults to the shortest monitoring interval, or 24
 }
 
 func (x *UpdateDOTPhysicalRequest) Reset() {
@@ -444,6 +505,41 @@ func (x *UpdateDOTPhysicalRequest) GetExemptionExpiration() *timestamppb.Timesta
 	return nil
 }
 
+func (x *UpdateDOTPhysicalRequest) GetExaminerRegistryNumber() string {
+	if x != nil {
+		return x.ExaminerRegistryNumber
+	}
+	return ""
+}
+
+func (x *UpdateDOTPhysicalRequest) GetRestrictionCodes() []string {
+	if x != nil {
+		return x.RestrictionCodes
+	}
+	return nil
+}
+
+func (x *UpdateDOTPhysicalRequest) GetMonitoring() []*MedicalMonitoringRequirement {
+	if x != nil {
+		return x.Monitoring
+	}
+	return nil
+}
+
+func (x *UpdateDOTPhysicalRequest) GetExemptions() []*MedicalExemption {
+	if x != nil {
+		return x.Exemptions
+	}
+	return nil
+}
+
+func (x *UpdateDOTPhysicalRequest) GetCertificationPeriodMonths() int32 {
+	if x != nil {
+		return x.CertificationPeriodMonths
+	}
+	return 0
+}
+
 type UpdateDOTPhysicalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -491,24 +587,19 @@ func (x *UpdateDOTPhysicalResponse) GetPhysical() *pbentity.DotPhysicals {
 	return nil
 }
 
-type ListDOTPhysicalsRequest struct {
+// Medical Restrictions and Monitoring
+type MedicalMonitoringRequirement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrganizationId      string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" Optional:"filter by user"`                                                 // Optional: filter by user
-	MedicalExaminerId   string                 `protobuf:"bytes,3,opt,name=medical_examiner_id,json=medicalExaminerId,proto3" json:"medical_examiner_id,omitempty" Optional:"filter by examiner"`          // Optional: filter by examiner
-	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty" Optional:"filter by status"`                                                             // Optional: filter by status
-	QualificationStatus string                 `protobuf:"bytes,5,opt,name=qualification_status,json=qualificationStatus,proto3" json:"qualification_status,omitempty" Optional:"filter by qualification"` // Optional: filter by qualification
-	StartDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Page                int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize            int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Condition      string `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty" dc:"'hypertension', 'insulin_treated_diabetes', 'sleep_apnea', 'cardiovascular', 'vision', 'hearing', 'other'"` // "hypertension", "insulin_treated_diabetes", "sleep_apnea", "cardiovascular", "vision", "hearing", "other"
+	IntervalMonths int32  `protobuf:"varint,2,opt,name=interval_months,json=intervalMonths,proto3" json:"interval_months,omitempty" dc:"How often evidence of control is due; defaults per condition"`             // How often evidence of control is due; defaults per condition
+	Notes          string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *ListDOTPhysicalsRequest) Reset() {
-	*x = ListDOTPhysicalsRequest{}
+func (x *MedicalMonitoringRequirement) Reset() {
+	*x = MedicalMonitoringRequirement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_v1_dot_physical_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -516,13 +607,13 @@ func (x *ListDOTPhysicalsRequest) Reset() {
 	}
 }
 
-func (x *ListDOTPhysicalsRequest) String() string {
+func (x *MedicalMonitoringRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDOTPhysicalsRequest) ProtoMessage() {}
+func (*MedicalMonitoringRequirement) ProtoMessage() {}
 
-func (x *ListDOTPhysicalsRequest) ProtoReflect() protoreflect.Message {
+func (x *MedicalMonitoringRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_services_v1_dot_physical_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -534,87 +625,49 @@ func (x *ListDOTPhysicalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDOTPhysicalsRequest.ProtoReflect.Descriptor instead.
-func (*ListDOTPhysicalsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MedicalMonitoringRequirement.ProtoReflect.Descriptor instead.
+func (*MedicalMonitoringRequirement) Descriptor() ([]byte, []int) {
 	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListDOTPhysicalsRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
-}
-
-func (x *ListDOTPhysicalsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *ListDOTPhysicalsRequest) GetMedicalExaminerId() string {
-	if x != nil {
-		return x.MedicalExaminerId
-	}
-	return ""
-}
-
-func (x *ListDOTPhysicalsRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *ListDOTPhysicalsRequest) GetQualificationStatus() string {
+func (x *MedicalMonitoringRequirement) GetCondition() string {
 	if x != nil {
-		return x.QualificationStatus
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *ListDOTPhysicalsRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *ListDOTPhysicalsRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
-}
-
-func (x *ListDOTPhysicalsRequest) GetPage() int32 {
+func (x *MedicalMonitoringRequirement) GetIntervalMonths() int32 {
 	if x != nil {
-		return x.Page
+		return x.IntervalMonths
 	}
 	return 0
 }
 
-func (x *ListDOTPhysicalsRequest) GetPageSize() int32 {
+func (x *MedicalMonitoringRequirement) GetNotes() string {
 	if x != nil {
-		return x.PageSize
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-type ListDOTPhysicalsResponse struct {
+type MedicalExemption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Physicals  []*pbentity.DotPhysicals `protobuf:"bytes,1,rep,name=physicals,proto3" json:"physicals,omitempty"`
-	TotalCount int32                    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page       int32                    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize   int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ExemptionId      string                 `protobuf:"bytes,1,opt,name=exemption_id,json=exemptionId,proto3" json:"exemption_id,omitempty"`
+	ExemptionType    string                 `protobuf:"bytes,2,opt,name=exemption_type,json=exemptionType,proto3" json:"exemption_type,omitempty" dc:"'vision', 'hearing', 'seizure', 'skill_performance_evaluation', 'other'"` // "vision", "hearing", "seizure", "skill_performance_evaluation", "other"
+	ExemptionNumber  string                 `protobuf:"bytes,3,opt,name=exemption_number,json=exemptionNumber,proto3" json:"exemption_number,omitempty"`
+	IssuingAuthority string                 `protobuf:"bytes,4,opt,name=issuing_authority,json=issuingAuthority,proto3" json:"issuing_authority,omitempty"`
+	DocumentId       string                 `protobuf:"bytes,5,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty" dc:"Uploaded exemption document"` // Uploaded exemption document
+	IssuedDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=issued_date,json=issuedDate,proto3" json:"issued_date,omitempty"`
+	ExpirationDate   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	IsExpired        bool                   `protobuf:"varint,8,opt,name=is_expired,json=isExpired,proto3" json:"is_expired,omitempty"`
 }
 
-func (x *ListDOTPhysicalsResponse) Reset() {
-	*x = ListDOTPhysicalsResponse{}
+func (x *MedicalExemption) Reset() {
+	*x = MedicalExemption{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_v1_dot_physical_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -622,13 +675,13 @@ func (x *ListDOTPhysicalsResponse) Reset() {
 	}
 }
 
-func (x *ListDOTPhysicalsResponse) String() string {
+func (x *MedicalExemption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDOTPhysicalsResponse) ProtoMessage() {}
+func (*MedicalExemption) ProtoMessage() {}
 
-func (x *ListDOTPhysicalsResponse) ProtoReflect() protoreflect.Message {
+func (x *MedicalExemption) ProtoReflect() protoreflect.Message {
 	mi := &file_services_v1_dot_physical_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -640,66 +693,88 @@ func (x *ListDOTPhysicalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDOTPhysicalsResponse.ProtoReflect.Descriptor instead.
-func (*ListDOTPhysicalsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MedicalExemption.ProtoReflect.Descriptor instead.
+func (*MedicalExemption) Descriptor() ([]byte, []int) {
 	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListDOTPhysicalsResponse) GetPhysicals() []*pbentity.DotPhysicals {
+func (x *MedicalExemption) GetExemptionId() string {
 	if x != nil {
-		return x.Physicals
+		return x.ExemptionId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListDOTPhysicalsResponse) GetTotalCount() int32 {
+func (x *MedicalExemption) GetExemptionType() string {
 	if x != nil {
-		return x.TotalCount
+		return x.ExemptionType
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDOTPhysicalsResponse) GetPage() int32 {
+func (x *MedicalExemption) GetExemptionNumber() string {
 	if x != nil {
-		return x.Page
+		return x.ExemptionNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *ListDOTPhysicalsResponse) GetPageSize() int32 {
+func (x *MedicalExemption) GetIssuingAuthority() string {
 	if x != nil {
-		return x.PageSize
+		return x.IssuingAuthority
 	}
-	return 0
+	return ""
 }
 
-// Medical Examiner Management
-type RegisterMedicalExaminerRequest struct {
+func (x *MedicalExemption) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *MedicalExemption) GetIssuedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedDate
+	}
+	return nil
+}
+
+func (x *MedicalExemption) GetExpirationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return nil
+}
+
+func (x *MedicalExemption) GetIsExpired() bool {
+	if x != nil {
+		return x.IsExpired
+	}
+	return false
+}
+
+type MedicalFollowUpTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirstName               string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName                string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email                   string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Phone                   string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	LicenseNumber           string                 `protobuf:"bytes,5,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
-	LicenseState            string                 `protobuf:"bytes,6,opt,name=license_state,json=licenseState,proto3" json:"license_state,omitempty"`
-	LicenseExpiration       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=license_expiration,json=licenseExpiration,proto3" json:"license_expiration,omitempty"`
-	CertificationNumber     string                 `protobuf:"bytes,8,opt,name=certification_number,json=certificationNumber,proto3" json:"certification_number,omitempty"`
-	CertificationExpiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=certification_expiration,json=certificationExpiration,proto3" json:"certification_expiration,omitempty"`
-	PracticeName            string                 `protobuf:"bytes,10,opt,name=practice_name,json=practiceName,proto3" json:"practice_name,omitempty"`
-	AddressLine1            string                 `protobuf:"bytes,11,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
-	AddressLine2            string                 `protobuf:"bytes,12,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
-	City                    string                 `protobuf:"bytes,13,opt,name=city,proto3" json:"city,omitempty"`
-	State                   string                 `protobuf:"bytes,14,opt,name=state,proto3" json:"state,omitempty"`
-	ZipCode                 string                 `protobuf:"bytes,15,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
-	AcceptsNewPatients      bool                   `protobuf:"varint,16,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty"`
-	Specializations         []string               `protobuf:"bytes,17,rep,name=specializations,proto3" json:"specializations,omitempty"`
-}
-
-func (x *RegisterMedicalExaminerRequest) Reset() {
-	*x = RegisterMedicalExaminerRequest{}
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PhysicalId     string                 `protobuf:"bytes,4,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	TaskType       string                 `protobuf:"bytes,5,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty" dc:"'recertification', 'exemption_renewal', 'monitoring_check'"` // "recertification", "exemption_renewal", "monitoring_check"
+	Title          string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty" dc:"'open', 'completed', 'cancelled'"` // "open", "completed", "cancelled"
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CompletedBy    string                 `protobuf:"bytes,11,opt,name=completed_by,json=completedBy,proto3" json:"completed_by,omitempty"`
+	Notes          string                 `protobuf:"bytes,12,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *MedicalFollowUpTask) Reset() {
+	*x = MedicalFollowUpTask{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_services_v1_dot_physical_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,13 +782,13 @@ func (x *RegisterMedicalExaminerRequest) Reset() {
 	}
 }
 
-func (x *RegisterMedicalExaminerRequest) String() string {
+func (x *MedicalFollowUpTask) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterMedicalExaminerRequest) ProtoMessage() {}
+func (*MedicalFollowUpTask) ProtoMessage() {}
 
-func (x *RegisterMedicalExaminerRequest) ProtoReflect() protoreflect.Message {
+func (x *MedicalFollowUpTask) ProtoReflect() protoreflect.Message {
 	mi := &file_services_v1_dot_physical_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -725,132 +800,1664 @@ func (x *RegisterMedicalExaminerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterMedicalExaminerRequest.ProtoReflect.Descriptor instead.
-func (*RegisterMedicalExaminerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MedicalFollowUpTask.ProtoReflect.Descriptor instead.
+func (*MedicalFollowUpTask) Descriptor() ([]byte, []int) {
 	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RegisterMedicalExaminerRequest) GetFirstName() string {
+func (x *MedicalFollowUpTask) GetTaskId() string {
 	if x != nil {
-		return x.FirstName
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetLastName() string {
+func (x *MedicalFollowUpTask) GetOrganizationId() string {
 	if x != nil {
-		return x.LastName
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetEmail() string {
+func (x *MedicalFollowUpTask) GetUserId() string {
 	if x != nil {
-		return x.Email
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetPhone() string {
+func (x *MedicalFollowUpTask) GetPhysicalId() string {
 	if x != nil {
-		return x.Phone
+		return x.PhysicalId
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetLicenseNumber() string {
+func (x *MedicalFollowUpTask) GetTaskType() string {
 	if x != nil {
-		return x.LicenseNumber
+		return x.TaskType
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetLicenseState() string {
+func (x *MedicalFollowUpTask) GetTitle() string {
 	if x != nil {
-		return x.LicenseState
+		return x.Title
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetLicenseExpiration() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LicenseExpiration
-	}
-	return nil
-}
-
-func (x *RegisterMedicalExaminerRequest) GetCertificationNumber() string {
+func (x *MedicalFollowUpTask) GetDescription() string {
 	if x != nil {
-		return x.CertificationNumber
+		return x.Description
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetCertificationExpiration() *timestamppb.Timestamp {
+func (x *MedicalFollowUpTask) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CertificationExpiration
+		return x.DueDate
 	}
 	return nil
 }
 
-func (x *RegisterMedicalExaminerRequest) GetPracticeName() string {
+func (x *MedicalFollowUpTask) GetStatus() string {
 	if x != nil {
-		return x.PracticeName
+		return x.Status
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetAddressLine1() string {
+func (x *MedicalFollowUpTask) GetCompletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AddressLine1
+		return x.CompletedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterMedicalExaminerRequest) GetAddressLine2() string {
+func (x *MedicalFollowUpTask) GetCompletedBy() string {
 	if x != nil {
-		return x.AddressLine2
+		return x.CompletedBy
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetCity() string {
+func (x *MedicalFollowUpTask) GetNotes() string {
 	if x != nil {
-		return x.City
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *RegisterMedicalExaminerRequest) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
-}
+type ListMedicalFollowUpTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *RegisterMedicalExaminerRequest) GetZipCode() string {
-	if x != nil {
-		return x.ZipCode
-	}
-	return ""
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" Optional:"filter by driver"`                          // Optional: filter by driver
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty" Optional:"filter by status"`                                        // Optional: filter by status
+	TaskType       string                 `protobuf:"bytes,4,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty" Optional:"filter by task type"`                 // Optional: filter by task type
+	DueBefore      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty" Optional:"tasks due on or before this date"` // Optional: tasks due on or before this date
+	Page           int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize       int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (x *RegisterMedicalExaminerRequest) GetAcceptsNewPatients() bool {
-	if x != nil {
-		return x.AcceptsNewPatients
+func (x *ListMedicalFollowUpTasksRequest) Reset() {
+	*x = ListMedicalFollowUpTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *RegisterMedicalExaminerRequest) GetSpecializations() []string {
-	if x != nil {
-		return x.Specializations
-	}
-	return nil
+func (x *ListMedicalFollowUpTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type RegisterMedicalExaminerResponse struct {
-	state         protoimpl.MessageState
+func (*ListMedicalFollowUpTasksRequest) ProtoMessage() {}
+
+func (x *ListMedicalFollowUpTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalFollowUpTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalFollowUpTasksRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetTaskType() string {
+	if x != nil {
+		return x.TaskType
+	}
+	return ""
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMedicalFollowUpTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMedicalFollowUpTasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tasks      []*MedicalFollowUpTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	TotalCount int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListMedicalFollowUpTasksResponse) Reset() {
+	*x = ListMedicalFollowUpTasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalFollowUpTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalFollowUpTasksResponse) ProtoMessage() {}
+
+func (x *ListMedicalFollowUpTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalFollowUpTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalFollowUpTasksResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMedicalFollowUpTasksResponse) GetTasks() []*MedicalFollowUpTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListMedicalFollowUpTasksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListMedicalFollowUpTasksResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMedicalFollowUpTasksResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CompleteMedicalFollowUpTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Notes  string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *CompleteMedicalFollowUpTaskRequest) Reset() {
+	*x = CompleteMedicalFollowUpTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteMedicalFollowUpTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMedicalFollowUpTaskRequest) ProtoMessage() {}
+
+func (x *CompleteMedicalFollowUpTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMedicalFollowUpTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMedicalFollowUpTaskRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompleteMedicalFollowUpTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CompleteMedicalFollowUpTaskRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type CompleteMedicalFollowUpTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *MedicalFollowUpTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *CompleteMedicalFollowUpTaskResponse) Reset() {
+	*x = CompleteMedicalFollowUpTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteMedicalFollowUpTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMedicalFollowUpTaskResponse) ProtoMessage() {}
+
+func (x *CompleteMedicalFollowUpTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMedicalFollowUpTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMedicalFollowUpTaskResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CompleteMedicalFollowUpTaskResponse) GetTask() *MedicalFollowUpTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type RescheduleDOTPhysicalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhysicalId string `protobuf:"bytes,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	SlotId     string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty" dc:"New availability slot to book"` // New availability slot to book
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RescheduleDOTPhysicalRequest) Reset() {
+	*x = RescheduleDOTPhysicalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleDOTPhysicalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleDOTPhysicalRequest) ProtoMessage() {}
+
+func (x *RescheduleDOTPhysicalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleDOTPhysicalRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleDOTPhysicalRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RescheduleDOTPhysicalRequest) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
+func (x *RescheduleDOTPhysicalRequest) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *RescheduleDOTPhysicalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RescheduleDOTPhysicalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Physical       *pbentity.DotPhysicals  `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+	Slot           *ClinicAvailabilitySlot `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	CalendarInvite string                  `protobuf:"bytes,3,opt,name=calendar_invite,json=calendarInvite,proto3" json:"calendar_invite,omitempty" dc:"Updated iCalendar (ICS) invite sent to the driver"` // Updated iCalendar (ICS) invite sent to the driver
+}
+
+func (x *RescheduleDOTPhysicalResponse) Reset() {
+	*x = RescheduleDOTPhysicalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleDOTPhysicalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleDOTPhysicalResponse) ProtoMessage() {}
+
+func (x *RescheduleDOTPhysicalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleDOTPhysicalResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleDOTPhysicalResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RescheduleDOTPhysicalResponse) GetPhysical() *pbentity.DotPhysicals {
+	if x != nil {
+		return x.Physical
+	}
+	return nil
+}
+
+func (x *RescheduleDOTPhysicalResponse) GetSlot() *ClinicAvailabilitySlot {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *RescheduleDOTPhysicalResponse) GetCalendarInvite() string {
+	if x != nil {
+		return x.CalendarInvite
+	}
+	return ""
+}
+
+type CancelDOTPhysicalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhysicalId string `protobuf:"bytes,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelDOTPhysicalRequest) Reset() {
+	*x = CancelDOTPhysicalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDOTPhysicalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDOTPhysicalRequest) ProtoMessage() {}
+
+func (x *CancelDOTPhysicalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDOTPhysicalRequest.ProtoReflect.Descriptor instead.
+func (*CancelDOTPhysicalRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelDOTPhysicalRequest) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
+func (x *CancelDOTPhysicalRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDOTPhysicalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Physical       *pbentity.DotPhysicals `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+	CalendarInvite string                 `protobuf:"bytes,2,opt,name=calendar_invite,json=calendarInvite,proto3" json:"calendar_invite,omitempty" dc:"iCalendar (ICS) cancellation sent to the driver"` // iCalendar (ICS) cancellation sent to the driver
+}
+
+func (x *CancelDOTPhysicalResponse) Reset() {
+	*x = CancelDOTPhysicalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDOTPhysicalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDOTPhysicalResponse) ProtoMessage() {}
+
+func (x *CancelDOTPhysicalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDOTPhysicalResponse.ProtoReflect.Descriptor instead.
+func (*CancelDOTPhysicalResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelDOTPhysicalResponse) GetPhysical() *pbentity.DotPhysicals {
+	if x != nil {
+		return x.Physical
+	}
+	return nil
+}
+
+func (x *CancelDOTPhysicalResponse) GetCalendarInvite() string {
+	if x != nil {
+		return x.CalendarInvite
+	}
+	return ""
+}
+
+type MarkDOTPhysicalNoShowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhysicalId string `protobuf:"bytes,1,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	Notes      string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *MarkDOTPhysicalNoShowRequest) Reset() {
+	*x = MarkDOTPhysicalNoShowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkDOTPhysicalNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDOTPhysicalNoShowRequest) ProtoMessage() {}
+
+func (x *MarkDOTPhysicalNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDOTPhysicalNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkDOTPhysicalNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MarkDOTPhysicalNoShowRequest) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
+func (x *MarkDOTPhysicalNoShowRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type MarkDOTPhysicalNoShowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Physical *pbentity.DotPhysicals `protobuf:"bytes,1,opt,name=physical,proto3" json:"physical,omitempty"`
+}
+
+func (x *MarkDOTPhysicalNoShowResponse) Reset() {
+	*x = MarkDOTPhysicalNoShowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkDOTPhysicalNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkDOTPhysicalNoShowResponse) ProtoMessage() {}
+
+func (x *MarkDOTPhysicalNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkDOTPhysicalNoShowResponse.ProtoReflect.Descriptor instead.
+func (*MarkDOTPhysicalNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MarkDOTPhysicalNoShowResponse) GetPhysical() *pbentity.DotPhysicals {
+	if x != nil {
+		return x.Physical
+	}
+	return nil
+}
+
+type ListDOTPhysicalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationId      string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId              string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" Optional:"filter by user"`                                                 // Optional: filter by user
+	MedicalExaminerId   string                 `protobuf:"bytes,3,opt,name=medical_examiner_id,json=medicalExaminerId,proto3" json:"medical_examiner_id,omitempty" Optional:"filter by examiner"`          // Optional: filter by examiner
+	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty" Optional:"filter by status"`                                                             // Optional: filter by status
+	QualificationStatus string                 `protobuf:"bytes,5,opt,name=qualification_status,json=qualificationStatus,proto3" json:"qualification_status,omitempty" Optional:"filter by qualification"` // Optional: filter by qualification
+	StartDate           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate             *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page                int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize            int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListDOTPhysicalsRequest) Reset() {
+	*x = ListDOTPhysicalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDOTPhysicalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDOTPhysicalsRequest) ProtoMessage() {}
+
+func (x *ListDOTPhysicalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDOTPhysicalsRequest.ProtoReflect.Descriptor instead.
+func (*ListDOTPhysicalsRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDOTPhysicalsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListDOTPhysicalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDOTPhysicalsRequest) GetMedicalExaminerId() string {
+	if x != nil {
+		return x.MedicalExaminerId
+	}
+	return ""
+}
+
+func (x *ListDOTPhysicalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDOTPhysicalsRequest) GetQualificationStatus() string {
+	if x != nil {
+		return x.QualificationStatus
+	}
+	return ""
+}
+
+func (x *ListDOTPhysicalsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListDOTPhysicalsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListDOTPhysicalsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDOTPhysicalsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDOTPhysicalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Physicals  []*pbentity.DotPhysicals `protobuf:"bytes,1,rep,name=physicals,proto3" json:"physicals,omitempty"`
+	TotalCount int32                    `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32                    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListDOTPhysicalsResponse) Reset() {
+	*x = ListDOTPhysicalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDOTPhysicalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDOTPhysicalsResponse) ProtoMessage() {}
+
+func (x *ListDOTPhysicalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDOTPhysicalsResponse.ProtoReflect.Descriptor instead.
+func (*ListDOTPhysicalsResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDOTPhysicalsResponse) GetPhysicals() []*pbentity.DotPhysicals {
+	if x != nil {
+		return x.Physicals
+	}
+	return nil
+}
+
+func (x *ListDOTPhysicalsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListDOTPhysicalsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDOTPhysicalsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// Medical Examiner Management
+type MedicalExaminerClinic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClinicId     string `protobuf:"bytes,1,opt,name=clinic_id,json=clinicId,proto3" json:"clinic_id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AddressLine1 string `protobuf:"bytes,3,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
+	AddressLine2 string `protobuf:"bytes,4,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
+	City         string `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State        string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	ZipCode      string `protobuf:"bytes,7,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	Phone        string `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	Timezone     string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty" dc:"IANA timezone, e.g. 'America/Chicago'"` // IANA timezone, e.g. "America/Chicago"
+}
+
+func (x *MedicalExaminerClinic) Reset() {
+	*x = MedicalExaminerClinic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicalExaminerClinic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalExaminerClinic) ProtoMessage() {}
+
+func (x *MedicalExaminerClinic) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalExaminerClinic.ProtoReflect.Descriptor instead.
+func (*MedicalExaminerClinic) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MedicalExaminerClinic) GetClinicId() string {
+	if x != nil {
+		return x.ClinicId
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetAddressLine1() string {
+	if x != nil {
+		return x.AddressLine1
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetAddressLine2() string {
+	if x != nil {
+		return x.AddressLine2
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *MedicalExaminerClinic) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Clinic Availability
+type ClinicAvailabilitySlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	ClinicId      string                 `protobuf:"bytes,2,opt,name=clinic_id,json=clinicId,proto3" json:"clinic_id,omitempty"`
+	ExaminerId    string                 `protobuf:"bytes,3,opt,name=examiner_id,json=examinerId,proto3" json:"examiner_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsBooked      bool                   `protobuf:"varint,6,opt,name=is_booked,json=isBooked,proto3" json:"is_booked,omitempty"`
+	IsBlocked     bool                   `protobuf:"varint,7,opt,name=is_blocked,json=isBlocked,proto3" json:"is_blocked,omitempty"`
+	ClinicName    string                 `protobuf:"bytes,8,opt,name=clinic_name,json=clinicName,proto3" json:"clinic_name,omitempty"`
+	ClinicAddress string                 `protobuf:"bytes,9,opt,name=clinic_address,json=clinicAddress,proto3" json:"clinic_address,omitempty"`
+	ClinicPhone   string                 `protobuf:"bytes,10,opt,name=clinic_phone,json=clinicPhone,proto3" json:"clinic_phone,omitempty"`
+	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	ExaminerName  string                 `protobuf:"bytes,12,opt,name=examiner_name,json=examinerName,proto3" json:"examiner_name,omitempty"`
+}
+
+func (x *ClinicAvailabilitySlot) Reset() {
+	*x = ClinicAvailabilitySlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClinicAvailabilitySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClinicAvailabilitySlot) ProtoMessage() {}
+
+func (x *ClinicAvailabilitySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClinicAvailabilitySlot.ProtoReflect.Descriptor instead.
+func (*ClinicAvailabilitySlot) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClinicAvailabilitySlot) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetClinicId() string {
+	if x != nil {
+		return x.ClinicId
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetExaminerId() string {
+	if x != nil {
+		return x.ExaminerId
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ClinicAvailabilitySlot) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ClinicAvailabilitySlot) GetIsBooked() bool {
+	if x != nil {
+		return x.IsBooked
+	}
+	return false
+}
+
+func (x *ClinicAvailabilitySlot) GetIsBlocked() bool {
+	if x != nil {
+		return x.IsBlocked
+	}
+	return false
+}
+
+func (x *ClinicAvailabilitySlot) GetClinicName() string {
+	if x != nil {
+		return x.ClinicName
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetClinicAddress() string {
+	if x != nil {
+		return x.ClinicAddress
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetClinicPhone() string {
+	if x != nil {
+		return x.ClinicPhone
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ClinicAvailabilitySlot) GetExaminerName() string {
+	if x != nil {
+		return x.ExaminerName
+	}
+	return ""
+}
+
+type CreateClinicAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClinicId    string                 `protobuf:"bytes,1,opt,name=clinic_id,json=clinicId,proto3" json:"clinic_id,omitempty"`
+	StartDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Weekdays    []int32                `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty" dc:"0 = Sunday; defaults to Monday through Friday"` // 0 = Sunday; defaults to Monday through Friday
+	DayStart    string                 `protobuf:"bytes,5,opt,name=day_start,json=dayStart,proto3" json:"day_start,omitempty" dc:"Clinic local time, e.g. '08:00'"`       // Clinic local time, e.g. "08:00"
+	DayEnd      string                 `protobuf:"bytes,6,opt,name=day_end,json=dayEnd,proto3" json:"day_end,omitempty" dc:"Clinic local time, e.g. '17:00'"`             // Clinic local time, e.g. "17:00"
+	SlotMinutes int32                  `protobuf:"varint,7,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty" dc:"Defaults to 30"`              // Defaults to 30
+}
+
+func (x *CreateClinicAvailabilityRequest) Reset() {
+	*x = CreateClinicAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClinicAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClinicAvailabilityRequest) ProtoMessage() {}
+
+func (x *CreateClinicAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClinicAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*CreateClinicAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateClinicAvailabilityRequest) GetClinicId() string {
+	if x != nil {
+		return x.ClinicId
+	}
+	return ""
+}
+
+func (x *CreateClinicAvailabilityRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateClinicAvailabilityRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateClinicAvailabilityRequest) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CreateClinicAvailabilityRequest) GetDayStart() string {
+	if x != nil {
+		return x.DayStart
+	}
+	return ""
+}
+
+func (x *CreateClinicAvailabilityRequest) GetDayEnd() string {
+	if x != nil {
+		return x.DayEnd
+	}
+	return ""
+}
+
+func (x *CreateClinicAvailabilityRequest) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+type CreateClinicAvailabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotsCreated int32                     `protobuf:"varint,1,opt,name=slots_created,json=slotsCreated,proto3" json:"slots_created,omitempty"`
+	Slots        []*ClinicAvailabilitySlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *CreateClinicAvailabilityResponse) Reset() {
+	*x = CreateClinicAvailabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateClinicAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClinicAvailabilityResponse) ProtoMessage() {}
+
+func (x *CreateClinicAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClinicAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*CreateClinicAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateClinicAvailabilityResponse) GetSlotsCreated() int32 {
+	if x != nil {
+		return x.SlotsCreated
+	}
+	return 0
+}
+
+func (x *CreateClinicAvailabilityResponse) GetSlots() []*ClinicAvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type ListClinicAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClinicId          string                 `protobuf:"bytes,1,opt,name=clinic_id,json=clinicId,proto3" json:"clinic_id,omitempty" Optional:"filter by clinic"`                                // Optional: filter by clinic
+	MedicalExaminerId string                 `protobuf:"bytes,2,opt,name=medical_examiner_id,json=medicalExaminerId,proto3" json:"medical_examiner_id,omitempty" Optional:"filter by examiner"` // Optional: filter by examiner
+	State             string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City              string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode           string                 `protobuf:"bytes,5,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	StartTime         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IncludeBooked     bool                   `protobuf:"varint,8,opt,name=include_booked,json=includeBooked,proto3" json:"include_booked,omitempty"`
+	Page              int32                  `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListClinicAvailabilityRequest) Reset() {
+	*x = ListClinicAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClinicAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClinicAvailabilityRequest) ProtoMessage() {}
+
+func (x *ListClinicAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClinicAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*ListClinicAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListClinicAvailabilityRequest) GetClinicId() string {
+	if x != nil {
+		return x.ClinicId
+	}
+	return ""
+}
+
+func (x *ListClinicAvailabilityRequest) GetMedicalExaminerId() string {
+	if x != nil {
+		return x.MedicalExaminerId
+	}
+	return ""
+}
+
+func (x *ListClinicAvailabilityRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListClinicAvailabilityRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListClinicAvailabilityRequest) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *ListClinicAvailabilityRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListClinicAvailabilityRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListClinicAvailabilityRequest) GetIncludeBooked() bool {
+	if x != nil {
+		return x.IncludeBooked
+	}
+	return false
+}
+
+func (x *ListClinicAvailabilityRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListClinicAvailabilityRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListClinicAvailabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots      []*ClinicAvailabilitySlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	TotalCount int32                     `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page       int32                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize   int32                     `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *ListClinicAvailabilityResponse) Reset() {
+	*x = ListClinicAvailabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListClinicAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClinicAvailabilityResponse) ProtoMessage() {}
+
+func (x *ListClinicAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClinicAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*ListClinicAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListClinicAvailabilityResponse) GetSlots() []*ClinicAvailabilitySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *ListClinicAvailabilityResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListClinicAvailabilityResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListClinicAvailabilityResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RegisterMedicalExaminerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName               string                   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName                string                   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email                   string                   `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone                   string                   `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	LicenseNumber           string                   `protobuf:"bytes,5,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	LicenseState            string                   `protobuf:"bytes,6,opt,name=license_state,json=licenseState,proto3" json:"license_state,omitempty"`
+	LicenseExpiration       *timestamppb.Timestamp   `protobuf:"bytes,7,opt,name=license_expiration,json=licenseExpiration,proto3" json:"license_expiration,omitempty"`
+	CertificationNumber     string                   `protobuf:"bytes,8,opt,name=certification_number,json=certificationNumber,proto3" json:"certification_number,omitempty"`
+	CertificationExpiration *timestamppb.Timestamp   `protobuf:"bytes,9,opt,name=certification_expiration,json=certificationExpiration,proto3" json:"certification_expiration,omitempty"`
+	PracticeName            string                   `protobuf:"bytes,10,opt,name=practice_name,json=practiceName,proto3" json:"practice_name,omitempty"`
+	AddressLine1            string                   `protobuf:"bytes,11,opt,name=address_line1,json=addressLine1,proto3" json:"address_line1,omitempty"`
+	AddressLine2            string                   `protobuf:"bytes,12,opt,name=address_line2,json=addressLine2,proto3" json:"address_line2,omitempty"`
+	City                    string                   `protobuf:"bytes,13,opt,name=city,proto3" json:"city,omitempty"`
+	State                   string                   `protobuf:"bytes,14,opt,name=state,proto3" json:"state,omitempty"`
+	ZipCode                 string                   `protobuf:"bytes,15,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	AcceptsNewPatients      bool                     `protobuf:"varint,16,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty"`
+	Specializations         []string                 `protobuf:"bytes,17,rep,name=specializations,proto3" json:"specializations,omitempty"`
+	Clinics                 []*MedicalExaminerClinic `protobuf:"bytes,18,rep,name=clinics,proto3" json:"clinics,omitempty" dc:"Clinics the examiner serves"` // Clinics the examiner serves
+	CertificationDate       *timestamppb.Timestamp   `protobuf:"bytes,19,opt,name=certification_date,json=certificationDate,proto3" json:"certification_date,omitempty"`
+}
+
+func (x *RegisterMedicalExaminerRequest) Reset() {
+	*x = RegisterMedicalExaminerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterMedicalExaminerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterMedicalExaminerRequest) ProtoMessage() {}
+
+func (x *RegisterMedicalExaminerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterMedicalExaminerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterMedicalExaminerRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegisterMedicalExaminerRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetLicenseState() string {
+	if x != nil {
+		return x.LicenseState
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetLicenseExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenseExpiration
+	}
+	return nil
+}
+
+func (x *RegisterMedicalExaminerRequest) GetCertificationNumber() string {
+	if x != nil {
+		return x.CertificationNumber
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetCertificationExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertificationExpiration
+	}
+	return nil
+}
+
+func (x *RegisterMedicalExaminerRequest) GetPracticeName() string {
+	if x != nil {
+		return x.PracticeName
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetAddressLine1() string {
+	if x != nil {
+		return x.AddressLine1
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetAddressLine2() string {
+	if x != nil {
+		return x.AddressLine2
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *RegisterMedicalExaminerRequest) GetAcceptsNewPatients() bool {
+	if x != nil {
+		return x.AcceptsNewPatients
+	}
+	return false
+}
+
+func (x *RegisterMedicalExaminerRequest) GetSpecializations() []string {
+	if x != nil {
+		return x.Specializations
+	}
+	return nil
+}
+
+func (x *RegisterMedicalExaminerRequest) GetClinics() []*MedicalExaminerClinic {
+	if x != nil {
+		return x.Clinics
+	}
+	return nil
+}
+
+func (x *RegisterMedicalExaminerRequest) GetCertificationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertificationDate
+	}
+	return nil
+}
+
+type RegisterMedicalExaminerResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
@@ -861,7 +2468,7 @@ type RegisterMedicalExaminerResponse struct {
 func (x *RegisterMedicalExaminerResponse) Reset() {
 	*x = RegisterMedicalExaminerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[9]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -874,7 +2481,7 @@ func (x *RegisterMedicalExaminerResponse) String() string {
 func (*RegisterMedicalExaminerResponse) ProtoMessage() {}
 
 func (x *RegisterMedicalExaminerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[9]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,7 +2494,7 @@ func (x *RegisterMedicalExaminerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterMedicalExaminerResponse.ProtoReflect.Descriptor instead.
 func (*RegisterMedicalExaminerResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{9}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RegisterMedicalExaminerResponse) GetExaminerId() string {
@@ -915,7 +2522,7 @@ type GetMedicalExaminerRequest struct {
 func (x *GetMedicalExaminerRequest) Reset() {
 	*x = GetMedicalExaminerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[10]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -928,7 +2535,7 @@ func (x *GetMedicalExaminerRequest) String() string {
 func (*GetMedicalExaminerRequest) ProtoMessage() {}
 
 func (x *GetMedicalExaminerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[10]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +2548,7 @@ func (x *GetMedicalExaminerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMedicalExaminerRequest.ProtoReflect.Descriptor instead.
 func (*GetMedicalExaminerRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{10}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetMedicalExaminerRequest) GetExaminerId() string {
@@ -956,29 +2563,32 @@ type MedicalExaminerInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExaminerId              string                 `protobuf:"bytes,1,opt,name=examiner_id,json=examinerId,proto3" json:"examiner_id,omitempty"`
-	FirstName               string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName                string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email                   string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	Phone                   string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	LicenseNumber           string                 `protobuf:"bytes,6,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
-	LicenseState            string                 `protobuf:"bytes,7,opt,name=license_state,json=licenseState,proto3" json:"license_state,omitempty"`
-	LicenseExpiration       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=license_expiration,json=licenseExpiration,proto3" json:"license_expiration,omitempty"`
-	CertificationNumber     string                 `protobuf:"bytes,9,opt,name=certification_number,json=certificationNumber,proto3" json:"certification_number,omitempty"`
-	CertificationExpiration *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=certification_expiration,json=certificationExpiration,proto3" json:"certification_expiration,omitempty"`
-	PracticeName            string                 `protobuf:"bytes,11,opt,name=practice_name,json=practiceName,proto3" json:"practice_name,omitempty"`
-	FullAddress             string                 `protobuf:"bytes,12,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
-	AcceptsNewPatients      bool                   `protobuf:"varint,13,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty"`
-	Specializations         []string               `protobuf:"bytes,14,rep,name=specializations,proto3" json:"specializations,omitempty"`
-	IsActive                bool                   `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	Rating                  float32                `protobuf:"fixed32,16,opt,name=rating,proto3" json:"rating,omitempty"`
-	ExaminationsCompleted   int32                  `protobuf:"varint,17,opt,name=examinations_completed,json=examinationsCompleted,proto3" json:"examinations_completed,omitempty"`
+	ExaminerId              string                   `protobuf:"bytes,1,opt,name=examiner_id,json=examinerId,proto3" json:"examiner_id,omitempty"`
+	FirstName               string                   `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName                string                   `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email                   string                   `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone                   string                   `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	LicenseNumber           string                   `protobuf:"bytes,6,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	LicenseState            string                   `protobuf:"bytes,7,opt,name=license_state,json=licenseState,proto3" json:"license_state,omitempty"`
+	LicenseExpiration       *timestamppb.Timestamp   `protobuf:"bytes,8,opt,name=license_expiration,json=licenseExpiration,proto3" json:"license_expiration,omitempty"`
+	CertificationNumber     string                   `protobuf:"bytes,9,opt,name=certification_number,json=certificationNumber,proto3" json:"certification_number,omitempty"`
+	CertificationExpiration *timestamppb.Timestamp   `protobuf:"bytes,10,opt,name=certification_expiration,json=certificationExpiration,proto3" json:"certification_expiration,omitempty"`
+	PracticeName            string                   `protobuf:"bytes,11,opt,name=practice_name,json=practiceName,proto3" json:"practice_name,omitempty"`
+	FullAddress             string                   `protobuf:"bytes,12,opt,name=full_address,json=fullAddress,proto3" json:"full_address,omitempty"`
+	AcceptsNewPatients      bool                     `protobuf:"varint,13,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty"`
+	Specializations         []string                 `protobuf:"bytes,14,rep,name=specializations,proto3" json:"specializations,omitempty"`
+	IsActive                bool                     `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Rating                  float32                  `protobuf:"fixed32,16,opt,name=rating,proto3" json:"rating,omitempty"`
+	ExaminationsCompleted   int32                    `protobuf:"varint,17,opt,name=examinations_completed,json=examinationsCompleted,proto3" json:"examinations_completed,omitempty"`
+	Clinics                 []*MedicalExaminerClinic `protobuf:"bytes,18,rep,name=clinics,proto3" json:"clinics,omitempty"`
+	RegistryStatus          string                   `protobuf:"bytes,19,opt,name=registry_status,json=registryStatus,proto3" json:"registry_status,omitempty" dc:"'active', 'lapsed', 'suspended', 'revoked'"`                          // "active", "lapsed", "suspended", "revoked"
+	RegistrationCurrent     bool                     `protobuf:"varint,20,opt,name=registration_current,json=registrationCurrent,proto3" json:"registration_current,omitempty" dc:"Active registration with an unexpired certification"` // Active registration with an unexpired certification
 }
 
 func (x *MedicalExaminerInfo) Reset() {
 	*x = MedicalExaminerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[11]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -991,7 +2601,7 @@ func (x *MedicalExaminerInfo) String() string {
 func (*MedicalExaminerInfo) ProtoMessage() {}
 
 func (x *MedicalExaminerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[11]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +2614,7 @@ func (x *MedicalExaminerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MedicalExaminerInfo.ProtoReflect.Descriptor instead.
 func (*MedicalExaminerInfo) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{11}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MedicalExaminerInfo) GetExaminerId() string {
@@ -1112,45 +2722,247 @@ func (x *MedicalExaminerInfo) GetIsActive() bool {
 	return false
 }
 
-func (x *MedicalExaminerInfo) GetRating() float32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+func (x *MedicalExaminerInfo) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *MedicalExaminerInfo) GetExaminationsCompleted() int32 {
+	if x != nil {
+		return x.ExaminationsCompleted
+	}
+	return 0
+}
+
+func (x *MedicalExaminerInfo) GetClinics() []*MedicalExaminerClinic {
+	if x != nil {
+		return x.Clinics
+	}
+	return nil
+}
+
+func (x *MedicalExaminerInfo) GetRegistryStatus() string {
+	if x != nil {
+		return x.RegistryStatus
+	}
+	return ""
+}
+
+func (x *MedicalExaminerInfo) GetRegistrationCurrent() bool {
+	if x != nil {
+		return x.RegistrationCurrent
+	}
+	return false
+}
+
+type GetMedicalExaminerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Examiner *MedicalExaminerInfo `protobuf:"bytes,1,opt,name=examiner,proto3" json:"examiner,omitempty"`
+}
+
+func (x *GetMedicalExaminerResponse) Reset() {
+	*x = GetMedicalExaminerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMedicalExaminerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMedicalExaminerResponse) ProtoMessage() {}
+
+func (x *GetMedicalExaminerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMedicalExaminerResponse.ProtoReflect.Descriptor instead.
+func (*GetMedicalExaminerResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMedicalExaminerResponse) GetExaminer() *MedicalExaminerInfo {
+	if x != nil {
+		return x.Examiner
+	}
+	return nil
+}
+
+type ListMedicalExaminersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State              string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty" Optional:"filter by state"`                                                               // Optional: filter by state
+	City               string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" Optional:"filter by city"`                                                                  // Optional: filter by city
+	ZipCode            string `protobuf:"bytes,3,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty" Optional:"filter by zip code"`                                         // Optional: filter by zip code
+	AcceptsNewPatients bool   `protobuf:"varint,4,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty" Optional:"filter by availability"` // Optional: filter by availability
+	RadiusMiles        int32  `protobuf:"varint,5,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty" Optional:"search radius"`                                 // Optional: search radius
+	Location           string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty" Optional:"center point for radius search"`                                          // Optional: center point for radius search
+}
+
+func (x *ListMedicalExaminersRequest) Reset() {
+	*x = ListMedicalExaminersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalExaminersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalExaminersRequest) ProtoMessage() {}
+
+func (x *ListMedicalExaminersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalExaminersRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalExaminersRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMedicalExaminersRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ListMedicalExaminersRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListMedicalExaminersRequest) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *ListMedicalExaminersRequest) GetAcceptsNewPatients() bool {
+	if x != nil {
+		return x.AcceptsNewPatients
+	}
+	return false
+}
+
+func (x *ListMedicalExaminersRequest) GetRadiusMiles() int32 {
+	if x != nil {
+		return x.RadiusMiles
+	}
+	return 0
+}
+
+func (x *ListMedicalExaminersRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type ListMedicalExaminersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Examiners []*MedicalExaminerInfo `protobuf:"bytes,1,rep,name=examiners,proto3" json:"examiners,omitempty"`
+}
+
+func (x *ListMedicalExaminersResponse) Reset() {
+	*x = ListMedicalExaminersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_services_v1_dot_physical_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalExaminersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalExaminersResponse) ProtoMessage() {}
+
+func (x *ListMedicalExaminersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalExaminersResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalExaminersResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *MedicalExaminerInfo) GetExaminationsCompleted() int32 {
+func (x *ListMedicalExaminersResponse) GetExaminers() []*MedicalExaminerInfo {
 	if x != nil {
-		return x.ExaminationsCompleted
+		return x.Examiners
 	}
-	return 0
+	return nil
 }
 
-type GetMedicalExaminerResponse struct {
+type ImportMedicalExaminersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Examiner *MedicalExaminerInfo `protobuf:"bytes,1,opt,name=examiner,proto3" json:"examiner,omitempty"`
+	CsvData []byte `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty" dc:"National Registry CSV export with a header row"` // National Registry CSV export with a header row
 }
 
-func (x *GetMedicalExaminerResponse) Reset() {
-	*x = GetMedicalExaminerResponse{}
+func (x *ImportMedicalExaminersRequest) Reset() {
+	*x = ImportMedicalExaminersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[12]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetMedicalExaminerResponse) String() string {
+func (x *ImportMedicalExaminersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMedicalExaminerResponse) ProtoMessage() {}
+func (*ImportMedicalExaminersRequest) ProtoMessage() {}
 
-func (x *GetMedicalExaminerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[12]
+func (x *ImportMedicalExaminersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,48 +2973,45 @@ func (x *GetMedicalExaminerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMedicalExaminerResponse.ProtoReflect.Descriptor instead.
-func (*GetMedicalExaminerResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportMedicalExaminersRequest.ProtoReflect.Descriptor instead.
+func (*ImportMedicalExaminersRequest) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetMedicalExaminerResponse) GetExaminer() *MedicalExaminerInfo {
+func (x *ImportMedicalExaminersRequest) GetCsvData() []byte {
 	if x != nil {
-		return x.Examiner
+		return x.CsvData
 	}
 	return nil
 }
 
-type ListMedicalExaminersRequest struct {
+type MedicalExaminerImportError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State              string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty" Optional:"filter by state"`                                                               // Optional: filter by state
-	City               string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" Optional:"filter by city"`                                                                  // Optional: filter by city
-	ZipCode            string `protobuf:"bytes,3,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty" Optional:"filter by zip code"`                                         // Optional: filter by zip code
-	AcceptsNewPatients bool   `protobuf:"varint,4,opt,name=accepts_new_patients,json=acceptsNewPatients,proto3" json:"accepts_new_patients,omitempty" Optional:"filter by availability"` // Optional: filter by availability
-	RadiusMiles        int32  `protobuf:"varint,5,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty" Optional:"search radius"`                                 // Optional: search radius
-	Location           string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty" Optional:"center point for radius search"`                                          // Optional: center point for radius search
+	Line           int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	RegistryNumber string `protobuf:"bytes,2,opt,name=registry_number,json=registryNumber,proto3" json:"registry_number,omitempty"`
+	Message        string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ListMedicalExaminersRequest) Reset() {
-	*x = ListMedicalExaminersRequest{}
+func (x *MedicalExaminerImportError) Reset() {
+	*x = MedicalExaminerImportError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[13]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMedicalExaminersRequest) String() string {
+func (x *MedicalExaminerImportError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMedicalExaminersRequest) ProtoMessage() {}
+func (*MedicalExaminerImportError) ProtoMessage() {}
 
-func (x *ListMedicalExaminersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[13]
+func (x *MedicalExaminerImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,78 +3022,59 @@ func (x *ListMedicalExaminersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMedicalExaminersRequest.ProtoReflect.Descriptor instead.
-func (*ListMedicalExaminersRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListMedicalExaminersRequest) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+// Deprecated: Use MedicalExaminerImportError.ProtoReflect.Descriptor instead.
+func (*MedicalExaminerImportError) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ListMedicalExaminersRequest) GetCity() string {
+func (x *MedicalExaminerImportError) GetLine() int32 {
 	if x != nil {
-		return x.City
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *ListMedicalExaminersRequest) GetZipCode() string {
+func (x *MedicalExaminerImportError) GetRegistryNumber() string {
 	if x != nil {
-		return x.ZipCode
+		return x.RegistryNumber
 	}
 	return ""
 }
 
-func (x *ListMedicalExaminersRequest) GetAcceptsNewPatients() bool {
-	if x != nil {
-		return x.AcceptsNewPatients
-	}
-	return false
-}
-
-func (x *ListMedicalExaminersRequest) GetRadiusMiles() int32 {
-	if x != nil {
-		return x.RadiusMiles
-	}
-	return 0
-}
-
-func (x *ListMedicalExaminersRequest) GetLocation() string {
+func (x *MedicalExaminerImportError) GetMessage() string {
 	if x != nil {
-		return x.Location
+		return x.Message
 	}
 	return ""
 }
 
-type ListMedicalExaminersResponse struct {
+type ImportMedicalExaminersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Examiners []*MedicalExaminerInfo `protobuf:"bytes,1,rep,name=examiners,proto3" json:"examiners,omitempty"`
+	Created int32                         `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Updated int32                         `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Errors  []*MedicalExaminerImportError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *ListMedicalExaminersResponse) Reset() {
-	*x = ListMedicalExaminersResponse{}
+func (x *ImportMedicalExaminersResponse) Reset() {
+	*x = ImportMedicalExaminersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[14]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListMedicalExaminersResponse) String() string {
+func (x *ImportMedicalExaminersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMedicalExaminersResponse) ProtoMessage() {}
+func (*ImportMedicalExaminersResponse) ProtoMessage() {}
 
-func (x *ListMedicalExaminersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[14]
+func (x *ImportMedicalExaminersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_services_v1_dot_physical_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,14 +3085,28 @@ func (x *ListMedicalExaminersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMedicalExaminersResponse.ProtoReflect.Descriptor instead.
-func (*ListMedicalExaminersResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportMedicalExaminersResponse.ProtoReflect.Descriptor instead.
+func (*ImportMedicalExaminersResponse) Descriptor() ([]byte, []int) {
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ListMedicalExaminersResponse) GetExaminers() []*MedicalExaminerInfo {
+func (x *ImportMedicalExaminersResponse) GetCreated() int32 {
 	if x != nil {
-		return x.Examiners
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportMedicalExaminersResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportMedicalExaminersResponse) GetErrors() []*MedicalExaminerImportError {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
@@ -1321,7 +3125,7 @@ type GenerateCertificateRequest struct {
 func (x *GenerateCertificateRequest) Reset() {
 	*x = GenerateCertificateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[15]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1334,7 +3138,7 @@ func (x *GenerateCertificateRequest) String() string {
 func (*GenerateCertificateRequest) ProtoMessage() {}
 
 func (x *GenerateCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[15]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +3151,7 @@ func (x *GenerateCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateCertificateRequest.ProtoReflect.Descriptor instead.
 func (*GenerateCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{15}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GenerateCertificateRequest) GetPhysicalId() string {
@@ -1384,7 +3188,7 @@ type GenerateCertificateResponse struct {
 func (x *GenerateCertificateResponse) Reset() {
 	*x = GenerateCertificateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[16]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1397,7 +3201,7 @@ func (x *GenerateCertificateResponse) String() string {
 func (*GenerateCertificateResponse) ProtoMessage() {}
 
 func (x *GenerateCertificateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[16]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,7 +3214,7 @@ func (x *GenerateCertificateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateCertificateResponse.ProtoReflect.Descriptor instead.
 func (*GenerateCertificateResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{16}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GenerateCertificateResponse) GetCertificateUrl() string {
@@ -1440,13 +3244,22 @@ type ValidateCertificateRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	CertificateNumber string `protobuf:"bytes,1,opt,name=certificate_number,json=certificateNumber,proto3" json:"certificate_number,omitempty"`
-	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" Optional:"validate for specific user"` // Optional: validate for specific user
+	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" Optional:"validate for specific user; defaults to the caller for an uploaded certificate"` // Optional: validate for specific user; defaults to the caller for an uploaded certificate
+	// Fields captured from an uploaded certificate (MCSA-5876). When omitted the certificate on file is looked up.
+	DocumentId             string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty" dc:"Uploaded certificate document"` // Uploaded certificate document
+	ExaminerRegistryNumber string                 `protobuf:"bytes,4,opt,name=examiner_registry_number,json=examinerRegistryNumber,proto3" json:"examiner_registry_number,omitempty"`
+	IssueDate              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=issue_date,json=issueDate,proto3" json:"issue_date,omitempty"`
+	ExpirationDate         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	DriverLicenseNumber    string                 `protobuf:"bytes,7,opt,name=driver_license_number,json=driverLicenseNumber,proto3" json:"driver_license_number,omitempty"`
+	DriverLicenseState     string                 `protobuf:"bytes,8,opt,name=driver_license_state,json=driverLicenseState,proto3" json:"driver_license_state,omitempty"`
+	DriverDateOfBirth      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=driver_date_of_birth,json=driverDateOfBirth,proto3" json:"driver_date_of_birth,omitempty"`
+	RestrictionCodes       []string               `protobuf:"bytes,10,rep,name=restriction_codes,json=restrictionCodes,proto3" json:"restriction_codes,omitempty"`
 }
 
 func (x *ValidateCertificateRequest) Reset() {
 	*x = ValidateCertificateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[17]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1459,7 +3272,7 @@ func (x *ValidateCertificateRequest) String() string {
 func (*ValidateCertificateRequest) ProtoMessage() {}
 
 func (x *ValidateCertificateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[17]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1472,7 +3285,7 @@ func (x *ValidateCertificateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCertificateRequest.ProtoReflect.Descriptor instead.
 func (*ValidateCertificateRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{17}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ValidateCertificateRequest) GetCertificateNumber() string {
@@ -1489,23 +3302,82 @@ func (x *ValidateCertificateRequest) GetUserId() string {
 	return ""
 }
 
+func (x *ValidateCertificateRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *ValidateCertificateRequest) GetExaminerRegistryNumber() string {
+	if x != nil {
+		return x.ExaminerRegistryNumber
+	}
+	return ""
+}
+
+func (x *ValidateCertificateRequest) GetIssueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssueDate
+	}
+	return nil
+}
+
+func (x *ValidateCertificateRequest) GetExpirationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return nil
+}
+
+func (x *ValidateCertificateRequest) GetDriverLicenseNumber() string {
+	if x != nil {
+		return x.DriverLicenseNumber
+	}
+	return ""
+}
+
+func (x *ValidateCertificateRequest) GetDriverLicenseState() string {
+	if x != nil {
+		return x.DriverLicenseState
+	}
+	return ""
+}
+
+func (x *ValidateCertificateRequest) GetDriverDateOfBirth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DriverDateOfBirth
+	}
+	return nil
+}
+
+func (x *ValidateCertificateRequest) GetRestrictionCodes() []string {
+	if x != nil {
+		return x.RestrictionCodes
+	}
+	return nil
+}
+
 type ValidateCertificateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsValid         bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty" dc:"'valid', 'expired', 'revoked', 'not_found'"` // "valid", "expired", "revoked", "not_found"
-	ExpirationDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
-	HolderName      string                 `protobuf:"bytes,4,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
-	Restrictions    string                 `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
-	IssuingExaminer string                 `protobuf:"bytes,6,opt,name=issuing_examiner,json=issuingExaminer,proto3" json:"issuing_examiner,omitempty"`
+	IsValid               bool                   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Status                string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty" dc:"'valid', 'expired', 'invalid', 'not_found'"` // "valid", "expired", "invalid", "not_found"
+	ExpirationDate        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	HolderName            string                 `protobuf:"bytes,4,opt,name=holder_name,json=holderName,proto3" json:"holder_name,omitempty"`
+	Restrictions          string                 `protobuf:"bytes,5,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
+	IssuingExaminer       string                 `protobuf:"bytes,6,opt,name=issuing_examiner,json=issuingExaminer,proto3" json:"issuing_examiner,omitempty"`
+	Errors                []string               `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty" dc:"Failed checks when the status is 'invalid' or 'expired'"`           // Failed checks when the status is "invalid" or "expired"
+	PhysicalId            string                 `protobuf:"bytes,8,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty" dc:"Physical the certificate is recorded on"` // Physical the certificate is recorded on
+	CertificateUploadedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=certificate_uploaded_at,json=certificateUploadedAt,proto3" json:"certificate_uploaded_at,omitempty"`
 }
 
 func (x *ValidateCertificateResponse) Reset() {
 	*x = ValidateCertificateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[18]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1518,7 +3390,7 @@ func (x *ValidateCertificateResponse) String() string {
 func (*ValidateCertificateResponse) ProtoMessage() {}
 
 func (x *ValidateCertificateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[18]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +3403,7 @@ func (x *ValidateCertificateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateCertificateResponse.ProtoReflect.Descriptor instead.
 func (*ValidateCertificateResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{18}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ValidateCertificateResponse) GetIsValid() bool {
@@ -1576,6 +3448,27 @@ func (x *ValidateCertificateResponse) GetIssuingExaminer() string {
 	return ""
 }
 
+func (x *ValidateCertificateResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateCertificateResponse) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
+func (x *ValidateCertificateResponse) GetCertificateUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertificateUploadedAt
+	}
+	return nil
+}
+
 // Expiration Monitoring
 type GetExpiringCertificatesRequest struct {
 	state         protoimpl.MessageState
@@ -1589,7 +3482,7 @@ type GetExpiringCertificatesRequest struct {
 func (x *GetExpiringCertificatesRequest) Reset() {
 	*x = GetExpiringCertificatesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[19]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1602,7 +3495,7 @@ func (x *GetExpiringCertificatesRequest) String() string {
 func (*GetExpiringCertificatesRequest) ProtoMessage() {}
 
 func (x *GetExpiringCertificatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[19]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1615,7 +3508,7 @@ func (x *GetExpiringCertificatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExpiringCertificatesRequest.ProtoReflect.Descriptor instead.
 func (*GetExpiringCertificatesRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{19}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetExpiringCertificatesRequest) GetOrganizationId() string {
@@ -1643,12 +3536,13 @@ type ExpiringCertificate struct {
 	ExpirationDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
 	DaysUntilExpiration int32                  `protobuf:"varint,5,opt,name=days_until_expiration,json=daysUntilExpiration,proto3" json:"days_until_expiration,omitempty"`
 	QualificationStatus string                 `protobuf:"bytes,6,opt,name=qualification_status,json=qualificationStatus,proto3" json:"qualification_status,omitempty"`
+	PhysicalId          string                 `protobuf:"bytes,7,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty" dc:"Physical that issued the certificate"` // Physical that issued the certificate
 }
 
 func (x *ExpiringCertificate) Reset() {
 	*x = ExpiringCertificate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[20]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1661,7 +3555,7 @@ func (x *ExpiringCertificate) String() string {
 func (*ExpiringCertificate) ProtoMessage() {}
 
 func (x *ExpiringCertificate) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[20]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,7 +3568,7 @@ func (x *ExpiringCertificate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExpiringCertificate.ProtoReflect.Descriptor instead.
 func (*ExpiringCertificate) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{20}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ExpiringCertificate) GetUserId() string {
@@ -1719,6 +3613,13 @@ func (x *ExpiringCertificate) GetQualificationStatus() string {
 	return ""
 }
 
+func (x *ExpiringCertificate) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
 type GetExpiringCertificatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1730,7 +3631,7 @@ type GetExpiringCertificatesResponse struct {
 func (x *GetExpiringCertificatesResponse) Reset() {
 	*x = GetExpiringCertificatesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[21]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1743,7 +3644,7 @@ func (x *GetExpiringCertificatesResponse) String() string {
 func (*GetExpiringCertificatesResponse) ProtoMessage() {}
 
 func (x *GetExpiringCertificatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[21]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,7 +3657,7 @@ func (x *GetExpiringCertificatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExpiringCertificatesResponse.ProtoReflect.Descriptor instead.
 func (*GetExpiringCertificatesResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{21}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetExpiringCertificatesResponse) GetExpiringCertificates() []*ExpiringCertificate {
@@ -1781,7 +3682,7 @@ type SetExpirationReminderRequest struct {
 func (x *SetExpirationReminderRequest) Reset() {
 	*x = SetExpirationReminderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[22]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1794,7 +3695,7 @@ func (x *SetExpirationReminderRequest) String() string {
 func (*SetExpirationReminderRequest) ProtoMessage() {}
 
 func (x *SetExpirationReminderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[22]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,7 +3708,7 @@ func (x *SetExpirationReminderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExpirationReminderRequest.ProtoReflect.Descriptor instead.
 func (*SetExpirationReminderRequest) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{22}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SetExpirationReminderRequest) GetOrganizationId() string {
@@ -1856,7 +3757,7 @@ type SetExpirationReminderResponse struct {
 func (x *SetExpirationReminderResponse) Reset() {
 	*x = SetExpirationReminderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_services_v1_dot_physical_proto_msgTypes[23]
+		mi := &file_services_v1_dot_physical_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +3770,7 @@ func (x *SetExpirationReminderResponse) String() string {
 func (*SetExpirationReminderResponse) ProtoMessage() {}
 
 func (x *SetExpirationReminderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_services_v1_dot_physical_proto_msgTypes[23]
+	mi := &file_services_v1_dot_physical_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +3783,7 @@ func (x *SetExpirationReminderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExpirationReminderResponse.ProtoReflect.Descriptor instead.
 func (*SetExpirationReminderResponse) Descriptor() ([]byte, []int) {
-	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{23}
+	return file_services_v1_dot_physical_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SetExpirationReminderResponse) GetMessage() string {
@@ -1904,7 +3805,7 @@ var file_services_v1_dot_physical_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x2f, 0x64, 0x6f, 0x74, 0x5f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x03, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x03, 0x0a, 0x1a, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
 	0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
@@ -1928,467 +3829,939 @@ var file_services_v1_dot_physical_proto_rawDesc = []byte{
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x70, 0x65, 0x63, 0x69,
 	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
 	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x1b, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68,
-	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
-	0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x39,
-	0x0a, 0x18, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x17, 0x61, 0x70, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x61,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66,
-	0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68,
-	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44,
-	0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
-	0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x68,
-	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xd5, 0x05, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63,
-	0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x10,
-	0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a, 0x1b, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x19, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x61, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f,
-	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x72, 0x65, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x73, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x37, 0x0a, 0x17, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x16, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x4d, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c,
+	0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f,
+	0x74, 0x49, 0x64, 0x22, 0xed, 0x02, 0x0a, 0x1b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x18, 0x61, 0x70, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x61, 0x70, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x63, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x61, 0x63, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6c,
+	0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x22, 0x38, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xeb, 0x02,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x73, 0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e,
+	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x47,
+	0x0a, 0x0a, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x0f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x9a, 0x08, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x45, 0x0a, 0x10, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x49, 0x73, 0x73, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a,
+	0x1b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x19,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x17, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13,
+	0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0a, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x47, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78,
+	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x22, 0x4f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52,
+	0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x7b, 0x0a, 0x1c, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x10, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x73, 0x73,
+	0x75, 0x69, 0x6e, 0x67, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x0b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0xad, 0x03, 0x0a, 0x13, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x65, 0x78, 0x65, 0x6d, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f,
-	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70,
-	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x22,
-	0xf9, 0x02, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a,
-	0x13, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69,
-	0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0x84, 0x02, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x61, 0x73, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x75,
+	0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x75, 0x65, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x53, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x23, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x04, 0x74,
+	0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x70, 0x0a, 0x1c, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xbf, 0x01,
+	0x0a, 0x1d, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6c, 0x6f, 0x74,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22,
+	0x53, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x4f,
+	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44,
+	0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x08, 0x70, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x22, 0x55,
+	0x0a, 0x1c, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x4f, 0x54,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73,
+	0x52, 0x08, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x22, 0xf9, 0x02, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x09,
+	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x89, 0x02, 0x0a, 0x15,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69,
+	0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69,
+	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xc5, 0x03, 0x0a, 0x16, 0x43, 0x6c, 0x69, 0x6e,
+	0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6c,
+	0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x18,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x44, 0x6f, 0x74, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
-	0x61, 0x6c, 0x73, 0x52, 0x09, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x22, 0xb9, 0x05, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
-	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x6c, 0x69, 0x63, 0x65, 0x6e,
-	0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x11, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x6f,
+	0x6f, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c,
+	0x69, 0x6e, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0xa5, 0x02, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x61, 0x79, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61,
+	0x79, 0x45, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x6c, 0x6f, 0x74,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0xfb, 0x02, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x6e,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x6e, 0x69, 0x63, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x17, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e,
-	0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x5f,
-	0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x65,
-	0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x1f,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45,
-	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
-	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xcf, 0x05, 0x0a, 0x13, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x6f, 0x6f,
+	0x6b, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69,
+	0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xcc,
+	0x06, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x63,
-	0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
 	0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65,
-	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x6c,
 	0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x12, 0x31, 0x0a, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
 	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d,
 	0x62, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x52, 0x17, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0c, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x5f, 0x6e, 0x65,
-	0x77, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73,
-	0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x15, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x64, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63,
-	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x22, 0xd3, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69,
-	0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69,
-	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73,
-	0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x50,
-	0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x64, 0x69, 0x75,
-	0x73, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72,
-	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x6e, 0x65, 0x31, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x6c, 0x69, 0x6e, 0x65, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61,
+	0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x63, 0x6c,
+	0x69, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76, 0x31,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x6e, 0x69,
+	0x63, 0x73, 0x12, 0x49, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a,
+	0x1f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf3, 0x06, 0x0a, 0x13, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69,
+	0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x18, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x17, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x61, 0x63, 0x74, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x5f, 0x6e,
+	0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x63,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x76,
+	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x6e,
+	0x69, 0x63, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0x64, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x08, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x65, 0x78, 0x61,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
 	0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x6e,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12,
+	0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x73, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x65,
+	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x65, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x3a, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x73, 0x76, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x73, 0x0a, 0x1a, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x49, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x6e,
 	0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x72,
-	0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x64, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
-	0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x85, 0x02, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22,
-	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x78,
-	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x73,
-	0x73, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x68, 0x0a,
-	0x1e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x73,
-	0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x61,
-	0x79, 0x73, 0x41, 0x68, 0x65, 0x61, 0x64, 0x22, 0xa6, 0x02, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a,
+	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xb4, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9f, 0x04, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x18, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x61, 0x79,
-	0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x61, 0x79, 0x73, 0x55, 0x6e,
-	0x74, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
-	0x14, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x71, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x82, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
-	0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x69,
-	0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72,
-	0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6d, 0x73,
-	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x73, 0x6d, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x17, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xec,
-	0x0f, 0x0a, 0x12, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbe, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x31, 0x2e,
-	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f,
-	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x22,
-	0x35, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79,
-	0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x4f,
-	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x76, 0x31, 0x63, 0x6f,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
+	0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x14, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x72, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x4b, 0x0a, 0x14, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x66, 0x5f, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x64, 0x72, 0x69, 0x76, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x92, 0x03, 0x0a, 0x1b, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x43, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x73, 0x73, 0x75, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x69, 0x6e, 0x67, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x17, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x68,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x79,
+	0x73, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64,
+	0x61, 0x79, 0x73, 0x41, 0x68, 0x65, 0x61, 0x64, 0x22, 0xc7, 0x02, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x61,
+	0x79, 0x73, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x64, 0x61, 0x79, 0x73, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x14, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x49, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6d, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x6d, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x17,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x32, 0xfc, 0x1b, 0x0a, 0x12, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xbe, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12,
+	0x31, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01,
+	0x2a, 0x22, 0x35, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2c, 0x2e, 0x76, 0x31,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f,
 	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73,
-	0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0xa6, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa6, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2f, 0x2e, 0x76, 0x31,
+	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76,
+	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73,
+	0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb2,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x73, 0x12, 0x2e, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63,
+	0x61, 0x6c, 0x73, 0x12, 0xbd, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x33, 0x2e,
+	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33,
+	0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74,
+	0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0xad, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x4f,
 	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x2f, 0x2e, 0x76, 0x31, 0x63, 0x6f,
 	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69,
+	0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69,
 	0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x76, 0x31, 0x63,
 	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
 	0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b,
-	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb2, 0x01, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x73, 0x12, 0x2e, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f,
-	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x4f,
-	0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x73, 0x12, 0xae, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x35, 0x2e,
+	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0xba, 0x01, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x4f, 0x54, 0x50,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x12, 0x33, 0x2e,
 	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74,
-	0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x76, 0x31, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x31,
-	0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78,
-	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x44, 0x4f, 0x54, 0x50, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x44,
+	0x4f, 0x54, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x4e, 0x6f, 0x53, 0x68, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74,
+	0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x2d, 0x73, 0x68, 0x6f, 0x77,
+	0x12, 0xd4, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x36, 0x2e,
+	0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x2d, 0x75,
+	0x70, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0xd3, 0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x39, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x2d, 0x75, 0x70, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0xd1, 0x01,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x36, 0x2e, 0x76, 0x31, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x3e, 0x3a, 0x01, 0x2a, 0x22, 0x39, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
 	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0xa2, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45,
-	0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e,
-	0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76,
-	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0xb8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x76,
+	0x2d, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0xaa, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x34, 0x2e, 0x76,
 	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68, 0x79, 0x73,
-	0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0xaa, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73,
-	0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x31, 0x63,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6c, 0x69, 0x6e, 0x69, 0x63, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x69, 0x6e, 0x69,
+	0x63, 0x2d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0xae,
+	0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x76, 0x31, 0x63,
 	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0xd3, 0x01, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0xaa, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78,
+	0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f,
+	0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e,
 	0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x36, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12,
-	0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x63, 0x65, 0x72,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69,
-	0x6e, 0x67, 0x12, 0xd8, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x33, 0x2e, 0x76,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa2, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x76, 0x31, 0x63, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61,
+	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0xb2, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x34, 0x2e, 0x76,
+	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x35, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
+	0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x61, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x2f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xb8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x31,
+	0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a,
+	0x22, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0xaa, 0x01, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x31, 0x2e, 0x76, 0x31, 0x63, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76,
 	0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x34, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4e, 0x3a,
-	0x01, 0x2a, 0x22, 0x49, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d,
-	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2d, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x42, 0x1e, 0x5a,
-	0x1c, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0xd3,
+	0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x76, 0x31, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x43, 0x12, 0x41, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f, 0x74, 0x2d, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x12, 0xd8, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x33,
+	0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x75, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4e, 0x3a, 0x01, 0x2a, 0x22, 0x49, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x6f,
+	0x74, 0x2d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x2d, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x42,
+	0x1e, 0x5a, 0x1c, 0x76, 0x31, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2403,84 +4776,155 @@ func file_services_v1_dot_physical_proto_rawDescGZIP() []byte {
 	return file_services_v1_dot_physical_proto_rawDescData
 }
 
-var file_services_v1_dot_physical_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_services_v1_dot_physical_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_services_v1_dot_physical_proto_goTypes = []interface{}{
-	(*ScheduleDOTPhysicalRequest)(nil),      // 0: v1consortium.services.ScheduleDOTPhysicalRequest
-	(*ScheduleDOTPhysicalResponse)(nil),     // 1: v1consortium.services.ScheduleDOTPhysicalResponse
-	(*GetDOTPhysicalRequest)(nil),           // 2: v1consortium.services.GetDOTPhysicalRequest
-	(*GetDOTPhysicalResponse)(nil),          // 3: v1consortium.services.GetDOTPhysicalResponse
-	(*UpdateDOTPhysicalRequest)(nil),        // 4: v1consortium.services.UpdateDOTPhysicalRequest
-	(*UpdateDOTPhysicalResponse)(nil),       // 5: v1consortium.services.UpdateDOTPhysicalResponse
-	(*ListDOTPhysicalsRequest)(nil),         // 6: v1consortium.services.ListDOTPhysicalsRequest
-	(*ListDOTPhysicalsResponse)(nil),        // 7: v1consortium.services.ListDOTPhysicalsResponse
-	(*RegisterMedicalExaminerRequest)(nil),  // 8: v1consortium.services.RegisterMedicalExaminerRequest
-	(*RegisterMedicalExaminerResponse)(nil), // 9: v1consortium.services.RegisterMedicalExaminerResponse
-	(*GetMedicalExaminerRequest)(nil),       // 10: v1consortium.services.GetMedicalExaminerRequest
-	(*MedicalExaminerInfo)(nil),             // 11: v1consortium.services.MedicalExaminerInfo
-	(*GetMedicalExaminerResponse)(nil),      // 12: v1consortium.services.GetMedicalExaminerResponse
-	(*ListMedicalExaminersRequest)(nil),     // 13: v1consortium.services.ListMedicalExaminersRequest
-	(*ListMedicalExaminersResponse)(nil),    // 14: v1consortium.services.ListMedicalExaminersResponse
-	(*GenerateCertificateRequest)(nil),      // 15: v1consortium.services.GenerateCertificateRequest
-	(*GenerateCertificateResponse)(nil),     // 16: v1consortium.services.GenerateCertificateResponse
-	(*ValidateCertificateRequest)(nil),      // 17: v1consortium.services.ValidateCertificateRequest
-	(*ValidateCertificateResponse)(nil),     // 18: v1consortium.services.ValidateCertificateResponse
-	(*GetExpiringCertificatesRequest)(nil),  // 19: v1consortium.services.GetExpiringCertificatesRequest
-	(*ExpiringCertificate)(nil),             // 20: v1consortium.services.ExpiringCertificate
-	(*GetExpiringCertificatesResponse)(nil), // 21: v1consortium.services.GetExpiringCertificatesResponse
-	(*SetExpirationReminderRequest)(nil),    // 22: v1consortium.services.SetExpirationReminderRequest
-	(*SetExpirationReminderResponse)(nil),   // 23: v1consortium.services.SetExpirationReminderResponse
-	(*timestamppb.Timestamp)(nil),           // 24: google.protobuf.Timestamp
-	(*pbentity.DotPhysicals)(nil),           // 25: pbentity.DotPhysicals
+	(*ScheduleDOTPhysicalRequest)(nil),          // 0: v1consortium.services.ScheduleDOTPhysicalRequest
+	(*ScheduleDOTPhysicalResponse)(nil),         // 1: v1consortium.services.ScheduleDOTPhysicalResponse
+	(*GetDOTPhysicalRequest)(nil),               // 2: v1consortium.services.GetDOTPhysicalRequest
+	(*GetDOTPhysicalResponse)(nil),              // 3: v1consortium.services.GetDOTPhysicalResponse
+	(*UpdateDOTPhysicalRequest)(nil),            // 4: v1consortium.services.UpdateDOTPhysicalRequest
+	(*UpdateDOTPhysicalResponse)(nil),           // 5: v1consortium.services.UpdateDOTPhysicalResponse
+	(*MedicalMonitoringRequirement)(nil),        // 6: v1consortium.services.MedicalMonitoringRequirement
+	(*MedicalExemption)(nil),                    // 7: v1consortium.services.MedicalExemption
+	(*MedicalFollowUpTask)(nil),                 // 8: v1consortium.services.MedicalFollowUpTask
+	(*ListMedicalFollowUpTasksRequest)(nil),     // 9: v1consortium.services.ListMedicalFollowUpTasksRequest
+	(*ListMedicalFollowUpTasksResponse)(nil),    // 10: v1consortium.services.ListMedicalFollowUpTasksResponse
+	(*CompleteMedicalFollowUpTaskRequest)(nil),  // 11: v1consortium.services.CompleteMedicalFollowUpTaskRequest
+	(*CompleteMedicalFollowUpTaskResponse)(nil), // 12: v1consortium.services.CompleteMedicalFollowUpTaskResponse
+	(*RescheduleDOTPhysicalRequest)(nil),        // 13: v1consortium.services.RescheduleDOTPhysicalRequest
+	(*RescheduleDOTPhysicalResponse)(nil),       // 14: v1consortium.services.RescheduleDOTPhysicalResponse
+	(*CancelDOTPhysicalRequest)(nil),            // 15: v1consortium.services.CancelDOTPhysicalRequest
+	(*CancelDOTPhysicalResponse)(nil),           // 16: v1consortium.services.CancelDOTPhysicalResponse
+	(*MarkDOTPhysicalNoShowRequest)(nil),        // 17: v1consortium.services.MarkDOTPhysicalNoShowRequest
+	(*MarkDOTPhysicalNoShowResponse)(nil),       // 18: v1consortium.services.MarkDOTPhysicalNoShowResponse
+	(*ListDOTPhysicalsRequest)(nil),             // 19: v1consortium.services.ListDOTPhysicalsRequest
+	(*ListDOTPhysicalsResponse)(nil),            // 20: v1consortium.services.ListDOTPhysicalsResponse
+	(*MedicalExaminerClinic)(nil),               // 21: v1consortium.services.MedicalExaminerClinic
+	(*ClinicAvailabilitySlot)(nil),              // 22: v1consortium.services.ClinicAvailabilitySlot
+	(*CreateClinicAvailabilityRequest)(nil),     // 23: v1consortium.services.CreateClinicAvailabilityRequest
+	(*CreateClinicAvailabilityResponse)(nil),    // 24: v1consortium.services.CreateClinicAvailabilityResponse
+	(*ListClinicAvailabilityRequest)(nil),       // 25: v1consortium.services.ListClinicAvailabilityRequest
+	(*ListClinicAvailabilityResponse)(nil),      // 26: v1consortium.services.ListClinicAvailabilityResponse
+	(*RegisterMedicalExaminerRequest)(nil),      // 27: v1consortium.services.RegisterMedicalExaminerRequest
+	(*RegisterMedicalExaminerResponse)(nil),     // 28: v1consortium.services.RegisterMedicalExaminerResponse
+	(*GetMedicalExaminerRequest)(nil),           // 29: v1consortium.services.GetMedicalExaminerRequest
+	(*MedicalExaminerInfo)(nil),                 // 30: v1consortium.services.MedicalExaminerInfo
+	(*GetMedicalExaminerResponse)(nil),          // 31: v1consortium.services.GetMedicalExaminerResponse
+	(*ListMedicalExaminersRequest)(nil),         // 32: v1consortium.services.ListMedicalExaminersRequest
+	(*ListMedicalExaminersResponse)(nil),        // 33: v1consortium.services.ListMedicalExaminersResponse
+	(*ImportMedicalExaminersRequest)(nil),       // 34: v1consortium.services.ImportMedicalExaminersRequest
+	(*MedicalExaminerImportError)(nil),          // 35: v1consortium.services.MedicalExaminerImportError
+	(*ImportMedicalExaminersResponse)(nil),      // 36: v1consortium.services.ImportMedicalExaminersResponse
+	(*GenerateCertificateRequest)(nil),          // 37: v1consortium.services.GenerateCertificateRequest
+	(*GenerateCertificateResponse)(nil),         // 38: v1consortium.services.GenerateCertificateResponse
+	(*ValidateCertificateRequest)(nil),          // 39: v1consortium.services.ValidateCertificateRequest
+	(*ValidateCertificateResponse)(nil),         // 40: v1consortium.services.ValidateCertificateResponse
+	(*GetExpiringCertificatesRequest)(nil),      // 41: v1consortium.services.GetExpiringCertificatesRequest
+	(*ExpiringCertificate)(nil),                 // 42: v1consortium.services.ExpiringCertificate
+	(*GetExpiringCertificatesResponse)(nil),     // 43: v1consortium.services.GetExpiringCertificatesResponse
+	(*SetExpirationReminderRequest)(nil),        // 44: v1consortium.services.SetExpirationReminderRequest
+	(*SetExpirationReminderResponse)(nil),       // 45: v1consortium.services.SetExpirationReminderResponse
+	(*timestamppb.Timestamp)(nil),               // 46: google.protobuf.Timestamp
+	(*pbentity.DotPhysicals)(nil),               // 47: pbentity.DotPhysicals
 }
 var file_services_v1_dot_physical_proto_depIdxs = []int32{
-	24, // 0: v1consortium.services.ScheduleDOTPhysicalRequest.preferred_date:type_name -> google.protobuf.Timestamp
-	25, // 1: v1consortium.services.ScheduleDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
-	25, // 2: v1consortium.services.GetDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
-	24, // 3: v1consortium.services.UpdateDOTPhysicalRequest.examination_date:type_name -> google.protobuf.Timestamp
-	24, // 4: v1consortium.services.UpdateDOTPhysicalRequest.certificate_issue_date:type_name -> google.protobuf.Timestamp
-	24, // 5: v1consortium.services.UpdateDOTPhysicalRequest.certificate_expiration_date:type_name -> google.protobuf.Timestamp
-	24, // 6: v1consortium.services.UpdateDOTPhysicalRequest.exemption_expiration:type_name -> google.protobuf.Timestamp
-	25, // 7: v1consortium.services.UpdateDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
-	24, // 8: v1consortium.services.ListDOTPhysicalsRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 9: v1consortium.services.ListDOTPhysicalsRequest.end_date:type_name -> google.protobuf.Timestamp
-	25, // 10: v1consortium.services.ListDOTPhysicalsResponse.physicals:type_name -> pbentity.DotPhysicals
-	24, // 11: v1consortium.services.RegisterMedicalExaminerRequest.license_expiration:type_name -> google.protobuf.Timestamp
-	24, // 12: v1consortium.services.RegisterMedicalExaminerRequest.certification_expiration:type_name -> google.protobuf.Timestamp
-	24, // 13: v1consortium.services.MedicalExaminerInfo.license_expiration:type_name -> google.protobuf.Timestamp
-	24, // 14: v1consortium.services.MedicalExaminerInfo.certification_expiration:type_name -> google.protobuf.Timestamp
-	11, // 15: v1consortium.services.GetMedicalExaminerResponse.examiner:type_name -> v1consortium.services.MedicalExaminerInfo
-	11, // 16: v1consortium.services.ListMedicalExaminersResponse.examiners:type_name -> v1consortium.services.MedicalExaminerInfo
-	24, // 17: v1consortium.services.GenerateCertificateResponse.generated_at:type_name -> google.protobuf.Timestamp
-	24, // 18: v1consortium.services.ValidateCertificateResponse.expiration_date:type_name -> google.protobuf.Timestamp
-	24, // 19: v1consortium.services.ExpiringCertificate.expiration_date:type_name -> google.protobuf.Timestamp
-	20, // 20: v1consortium.services.GetExpiringCertificatesResponse.expiring_certificates:type_name -> v1consortium.services.ExpiringCertificate
-	0,  // 21: v1consortium.services.DOTPhysicalService.ScheduleDOTPhysical:input_type -> v1consortium.services.ScheduleDOTPhysicalRequest
-	2,  // 22: v1consortium.services.DOTPhysicalService.GetDOTPhysical:input_type -> v1consortium.services.GetDOTPhysicalRequest
-	4,  // 23: v1consortium.services.DOTPhysicalService.UpdateDOTPhysical:input_type -> v1consortium.services.UpdateDOTPhysicalRequest
-	6,  // 24: v1consortium.services.DOTPhysicalService.ListDOTPhysicals:input_type -> v1consortium.services.ListDOTPhysicalsRequest
-	8,  // 25: v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer:input_type -> v1consortium.services.RegisterMedicalExaminerRequest
-	10, // 26: v1consortium.services.DOTPhysicalService.GetMedicalExaminer:input_type -> v1consortium.services.GetMedicalExaminerRequest
-	13, // 27: v1consortium.services.DOTPhysicalService.ListMedicalExaminers:input_type -> v1consortium.services.ListMedicalExaminersRequest
-	15, // 28: v1consortium.services.DOTPhysicalService.GenerateCertificate:input_type -> v1consortium.services.GenerateCertificateRequest
-	17, // 29: v1consortium.services.DOTPhysicalService.ValidateCertificate:input_type -> v1consortium.services.ValidateCertificateRequest
-	19, // 30: v1consortium.services.DOTPhysicalService.GetExpiringCertificates:input_type -> v1consortium.services.GetExpiringCertificatesRequest
-	22, // 31: v1consortium.services.DOTPhysicalService.SetExpirationReminder:input_type -> v1consortium.services.SetExpirationReminderRequest
-	1,  // 32: v1consortium.services.DOTPhysicalService.ScheduleDOTPhysical:output_type -> v1consortium.services.ScheduleDOTPhysicalResponse
-	3,  // 33: v1consortium.services.DOTPhysicalService.GetDOTPhysical:output_type -> v1consortium.services.GetDOTPhysicalResponse
-	5,  // 34: v1consortium.services.DOTPhysicalService.UpdateDOTPhysical:output_type -> v1consortium.services.UpdateDOTPhysicalResponse
-	7,  // 35: v1consortium.services.DOTPhysicalService.ListDOTPhysicals:output_type -> v1consortium.services.ListDOTPhysicalsResponse
-	9,  // 36: v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer:output_type -> v1consortium.services.RegisterMedicalExaminerResponse
-	12, // 37: v1consortium.services.DOTPhysicalService.GetMedicalExaminer:output_type -> v1consortium.services.GetMedicalExaminerResponse
-	14, // 38: v1consortium.services.DOTPhysicalService.ListMedicalExaminers:output_type -> v1consortium.services.ListMedicalExaminersResponse
-	16, // 39: v1consortium.services.DOTPhysicalService.GenerateCertificate:output_type -> v1consortium.services.GenerateCertificateResponse
-	18, // 40: v1consortium.services.DOTPhysicalService.ValidateCertificate:output_type -> v1consortium.services.ValidateCertificateResponse
-	21, // 41: v1consortium.services.DOTPhysicalService.GetExpiringCertificates:output_type -> v1consortium.services.GetExpiringCertificatesResponse
-	23, // 42: v1consortium.services.DOTPhysicalService.SetExpirationReminder:output_type -> v1consortium.services.SetExpirationReminderResponse
-	32, // [32:43] is the sub-list for method output_type
-	21, // [21:32] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	46, // 0: v1consortium.services.ScheduleDOTPhysicalRequest.preferred_date:type_name -> google.protobuf.Timestamp
+	47, // 1: v1consortium.services.ScheduleDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
+	22, // 2: v1consortium.services.ScheduleDOTPhysicalResponse.slot:type_name -> v1consortium.services.ClinicAvailabilitySlot
+	47, // 3: v1consortium.services.GetDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
+	6,  // 4: v1consortium.services.GetDOTPhysicalResponse.monitoring:type_name -> v1consortium.services.MedicalMonitoringRequirement
+	7,  // 5: v1consortium.services.GetDOTPhysicalResponse.exemptions:type_name -> v1consortium.services.MedicalExemption
+	8,  // 6: v1consortium.services.GetDOTPhysicalResponse.follow_up_tasks:type_name -> v1consortium.services.MedicalFollowUpTask
+	46, // 7: v1consortium.services.UpdateDOTPhysicalRequest.examination_date:type_name -> google.protobuf.Timestamp
+	46, // 8: v1consortium.services.UpdateDOTPhysicalRequest.certificate_issue_date:type_name -> google.protobuf.Timestamp
+	46, // 9: v1consortium.services.UpdateDOTPhysicalRequest.certificate_expiration_date:type_name -> google.protobuf.Timestamp
+	46, // 10: v1consortium.services.UpdateDOTPhysicalRequest.exemption_expiration:type_name -> google.protobuf.Timestamp
+	6,  // 11: v1consortium.services.UpdateDOTPhysicalRequest.monitoring:type_name -> v1consortium.services.MedicalMonitoringRequirement
+	7,  // 12: v1consortium.services.UpdateDOTPhysicalRequest.exemptions:type_name -> v1consortium.services.MedicalExemption
+	47, // 13: v1consortium.services.UpdateDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
+	46, // 14: v1consortium.services.MedicalExemption.issued_date:type_name -> google.protobuf.Timestamp
+	46, // 15: v1consortium.services.MedicalExemption.expiration_date:type_name -> google.protobuf.Timestamp
+	46, // 16: v1consortium.services.MedicalFollowUpTask.due_date:type_name -> google.protobuf.Timestamp
+	46, // 17: v1consortium.services.MedicalFollowUpTask.completed_at:type_name -> google.protobuf.Timestamp
+	46, // 18: v1consortium.services.ListMedicalFollowUpTasksRequest.due_before:type_name -> google.protobuf.Timestamp
+	8,  // 19: v1consortium.services.ListMedicalFollowUpTasksResponse.tasks:type_name -> v1consortium.services.MedicalFollowUpTask
+	8,  // 20: v1consortium.services.CompleteMedicalFollowUpTaskResponse.task:type_name -> v1consortium.services.MedicalFollowUpTask
+	47, // 21: v1consortium.services.RescheduleDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
+	22, // 22: v1consortium.services.RescheduleDOTPhysicalResponse.slot:type_name -> v1consortium.services.ClinicAvailabilitySlot
+	47, // 23: v1consortium.services.CancelDOTPhysicalResponse.physical:type_name -> pbentity.DotPhysicals
+	47, // 24: v1consortium.services.MarkDOTPhysicalNoShowResponse.physical:type_name -> pbentity.DotPhysicals
+	46, // 25: v1consortium.services.ListDOTPhysicalsRequest.start_date:type_name -> google.protobuf.Timestamp
+	46, // 26: v1consortium.services.ListDOTPhysicalsRequest.end_date:type_name -> google.protobuf.Timestamp
+	47, // 27: v1consortium.services.ListDOTPhysicalsResponse.physicals:type_name -> pbentity.DotPhysicals
+	46, // 28: v1consortium.services.ClinicAvailabilitySlot.starts_at:type_name -> google.protobuf.Timestamp
+	46, // 29: v1consortium.services.ClinicAvailabilitySlot.ends_at:type_name -> google.protobuf.Timestamp
+	46, // 30: v1consortium.services.CreateClinicAvailabilityRequest.start_date:type_name -> google.protobuf.Timestamp
+	46, // 31: v1consortium.services.CreateClinicAvailabilityRequest.end_date:type_name -> google.protobuf.Timestamp
+	22, // 32: v1consortium.services.CreateClinicAvailabilityResponse.slots:type_name -> v1consortium.services.ClinicAvailabilitySlot
+	46, // 33: v1consortium.services.ListClinicAvailabilityRequest.start_time:type_name -> google.protobuf.Timestamp
+	46, // 34: v1consortium.services.ListClinicAvailabilityRequest.end_time:type_name -> google.protobuf.Timestamp
+	22, // 35: v1consortium.services.ListClinicAvailabilityResponse.slots:type_name -> v1consortium.services.ClinicAvailabilitySlot
+	46, // 36: v1consortium.services.RegisterMedicalExaminerRequest.license_expiration:type_name -> google.protobuf.Timestamp
+	46, // 37: v1consortium.services.RegisterMedicalExaminerRequest.certification_expiration:type_name -> google.protobuf.Timestamp
+	21, // 38: v1consortium.services.RegisterMedicalExaminerRequest.clinics:type_name -> v1consortium.services.MedicalExaminerClinic
+	46, // 39: v1consortium.services.RegisterMedicalExaminerRequest.certification_date:type_name -> google.protobuf.Timestamp
+	46, // 40: v1consortium.services.MedicalExaminerInfo.license_expiration:type_name -> google.protobuf.Timestamp
+	46, // 41: v1consortium.services.MedicalExaminerInfo.certification_expiration:type_name -> google.protobuf.Timestamp
+	21, // 42: v1consortium.services.MedicalExaminerInfo.clinics:type_name -> v1consortium.services.MedicalExaminerClinic
+	30, // 43: v1consortium.services.GetMedicalExaminerResponse.examiner:type_name -> v1consortium.services.MedicalExaminerInfo
+	30, // 44: v1consortium.services.ListMedicalExaminersResponse.examiners:type_name -> v1consortium.services.MedicalExaminerInfo
+	35, // 45: v1consortium.services.ImportMedicalExaminersResponse.errors:type_name -> v1consortium.services.MedicalExaminerImportError
+	46, // 46: v1consortium.services.GenerateCertificateResponse.generated_at:type_name -> google.protobuf.Timestamp
+	46, // 47: v1consortium.services.ValidateCertificateRequest.issue_date:type_name -> google.protobuf.Timestamp
+	46, // 48: v1consortium.services.ValidateCertificateRequest.expiration_date:type_name -> google.protobuf.Timestamp
+	46, // 49: v1consortium.services.ValidateCertificateRequest.driver_date_of_birth:type_name -> google.protobuf.Timestamp
+	46, // 50: v1consortium.services.ValidateCertificateResponse.expiration_date:type_name -> google.protobuf.Timestamp
+	46, // 51: v1consortium.services.ValidateCertificateResponse.certificate_uploaded_at:type_name -> google.protobuf.Timestamp
+	46, // 52: v1consortium.services.ExpiringCertificate.expiration_date:type_name -> google.protobuf.Timestamp
+	42, // 53: v1consortium.services.GetExpiringCertificatesResponse.expiring_certificates:type_name -> v1consortium.services.ExpiringCertificate
+	0,  // 54: v1consortium.services.DOTPhysicalService.ScheduleDOTPhysical:input_type -> v1consortium.services.ScheduleDOTPhysicalRequest
+	2,  // 55: v1consortium.services.DOTPhysicalService.GetDOTPhysical:input_type -> v1consortium.services.GetDOTPhysicalRequest
+	4,  // 56: v1consortium.services.DOTPhysicalService.UpdateDOTPhysical:input_type -> v1consortium.services.UpdateDOTPhysicalRequest
+	19, // 57: v1consortium.services.DOTPhysicalService.ListDOTPhysicals:input_type -> v1consortium.services.ListDOTPhysicalsRequest
+	13, // 58: v1consortium.services.DOTPhysicalService.RescheduleDOTPhysical:input_type -> v1consortium.services.RescheduleDOTPhysicalRequest
+	15, // 59: v1consortium.services.DOTPhysicalService.CancelDOTPhysical:input_type -> v1consortium.services.CancelDOTPhysicalRequest
+	17, // 60: v1consortium.services.DOTPhysicalService.MarkDOTPhysicalNoShow:input_type -> v1consortium.services.MarkDOTPhysicalNoShowRequest
+	9,  // 61: v1consortium.services.DOTPhysicalService.ListMedicalFollowUpTasks:input_type -> v1consortium.services.ListMedicalFollowUpTasksRequest
+	11, // 62: v1consortium.services.DOTPhysicalService.CompleteMedicalFollowUpTask:input_type -> v1consortium.services.CompleteMedicalFollowUpTaskRequest
+	23, // 63: v1consortium.services.DOTPhysicalService.CreateClinicAvailability:input_type -> v1consortium.services.CreateClinicAvailabilityRequest
+	25, // 64: v1consortium.services.DOTPhysicalService.ListClinicAvailability:input_type -> v1consortium.services.ListClinicAvailabilityRequest
+	27, // 65: v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer:input_type -> v1consortium.services.RegisterMedicalExaminerRequest
+	29, // 66: v1consortium.services.DOTPhysicalService.GetMedicalExaminer:input_type -> v1consortium.services.GetMedicalExaminerRequest
+	32, // 67: v1consortium.services.DOTPhysicalService.ListMedicalExaminers:input_type -> v1consortium.services.ListMedicalExaminersRequest
+	34, // 68: v1consortium.services.DOTPhysicalService.ImportMedicalExaminers:input_type -> v1consortium.services.ImportMedicalExaminersRequest
+	37, // 69: v1consortium.services.DOTPhysicalService.GenerateCertificate:input_type -> v1consortium.services.GenerateCertificateRequest
+	39, // 70: v1consortium.services.DOTPhysicalService.ValidateCertificate:input_type -> v1consortium.services.ValidateCertificateRequest
+	41, // 71: v1consortium.services.DOTPhysicalService.GetExpiringCertificates:input_type -> v1consortium.services.GetExpiringCertificatesRequest
+	44, // 72: v1consortium.services.DOTPhysicalService.SetExpirationReminder:input_type -> v1consortium.services.SetExpirationReminderRequest
+	1,  // 73: v1consortium.services.DOTPhysicalService.ScheduleDOTPhysical:output_type -> v1consortium.services.ScheduleDOTPhysicalResponse
+	3,  // 74: v1consortium.services.DOTPhysicalService.GetDOTPhysical:output_type -> v1consortium.services.GetDOTPhysicalResponse
+	5,  // 75: v1consortium.services.DOTPhysicalService.UpdateDOTPhysical:output_type -> v1consortium.services.UpdateDOTPhysicalResponse
+	20, // 76: v1consortium.services.DOTPhysicalService.ListDOTPhysicals:output_type -> v1consortium.services.ListDOTPhysicalsResponse
+	14, // 77: v1consortium.services.DOTPhysicalService.RescheduleDOTPhysical:output_type -> v1consortium.services.RescheduleDOTPhysicalResponse
+	16, // 78: v1consortium.services.DOTPhysicalService.CancelDOTPhysical:output_type -> v1consortium.services.CancelDOTPhysicalResponse
+	18, // 79: v1consortium.services.DOTPhysicalService.MarkDOTPhysicalNoShow:output_type -> v1consortium.services.MarkDOTPhysicalNoShowResponse
+	10, // 80: v1consortium.services.DOTPhysicalService.ListMedicalFollowUpTasks:output_type -> v1consortium.services.ListMedicalFollowUpTasksResponse
+	12, // 81: v1consortium.services.DOTPhysicalService.CompleteMedicalFollowUpTask:output_type -> v1consortium.services.CompleteMedicalFollowUpTaskResponse
+	24, // 82: v1consortium.services.DOTPhysicalService.CreateClinicAvailability:output_type -> v1consortium.services.CreateClinicAvailabilityResponse
+	26, // 83: v1consortium.services.DOTPhysicalService.ListClinicAvailability:output_type -> v1consortium.services.ListClinicAvailabilityResponse
+	28, // 84: v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer:output_type -> v1consortium.services.RegisterMedicalExaminerResponse
+	31, // 85: v1consortium.services.DOTPhysicalService.GetMedicalExaminer:output_type -> v1consortium.services.GetMedicalExaminerResponse
+	33, // 86: v1consortium.services.DOTPhysicalService.ListMedicalExaminers:output_type -> v1consortium.services.ListMedicalExaminersResponse
+	36, // 87: v1consortium.services.DOTPhysicalService.ImportMedicalExaminers:output_type -> v1consortium.services.ImportMedicalExaminersResponse
+	38, // 88: v1consortium.services.DOTPhysicalService.GenerateCertificate:output_type -> v1consortium.services.GenerateCertificateResponse
+	40, // 89: v1consortium.services.DOTPhysicalService.ValidateCertificate:output_type -> v1consortium.services.ValidateCertificateResponse
+	43, // 90: v1consortium.services.DOTPhysicalService.GetExpiringCertificates:output_type -> v1consortium.services.GetExpiringCertificatesResponse
+	45, // 91: v1consortium.services.DOTPhysicalService.SetExpirationReminder:output_type -> v1consortium.services.SetExpirationReminderResponse
+	73, // [73:92] is the sub-list for method output_type
+	54, // [54:73] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_services_v1_dot_physical_proto_init() }
@@ -2562,7 +5006,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDOTPhysicalsRequest); i {
+			switch v := v.(*MedicalMonitoringRequirement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2574,7 +5018,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDOTPhysicalsResponse); i {
+			switch v := v.(*MedicalExemption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2586,7 +5030,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterMedicalExaminerRequest); i {
+			switch v := v.(*MedicalFollowUpTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2598,7 +5042,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterMedicalExaminerResponse); i {
+			switch v := v.(*ListMedicalFollowUpTasksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2610,7 +5054,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMedicalExaminerRequest); i {
+			switch v := v.(*ListMedicalFollowUpTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2622,7 +5066,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MedicalExaminerInfo); i {
+			switch v := v.(*CompleteMedicalFollowUpTaskRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2634,7 +5078,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMedicalExaminerResponse); i {
+			switch v := v.(*CompleteMedicalFollowUpTaskResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2646,7 +5090,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMedicalExaminersRequest); i {
+			switch v := v.(*RescheduleDOTPhysicalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2658,7 +5102,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListMedicalExaminersResponse); i {
+			switch v := v.(*RescheduleDOTPhysicalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2670,7 +5114,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateCertificateRequest); i {
+			switch v := v.(*CancelDOTPhysicalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2682,7 +5126,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateCertificateResponse); i {
+			switch v := v.(*CancelDOTPhysicalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2694,7 +5138,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateCertificateRequest); i {
+			switch v := v.(*MarkDOTPhysicalNoShowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2706,7 +5150,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateCertificateResponse); i {
+			switch v := v.(*MarkDOTPhysicalNoShowResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,7 +5162,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpiringCertificatesRequest); i {
+			switch v := v.(*ListDOTPhysicalsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,7 +5174,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExpiringCertificate); i {
+			switch v := v.(*ListDOTPhysicalsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,7 +5186,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExpiringCertificatesResponse); i {
+			switch v := v.(*MedicalExaminerClinic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,7 +5198,7 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetExpirationReminderRequest); i {
+			switch v := v.(*ClinicAvailabilitySlot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,6 +5210,270 @@ func file_services_v1_dot_physical_proto_init() {
 			}
 		}
 		file_services_v1_dot_physical_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClinicAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateClinicAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClinicAvailabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListClinicAvailabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMedicalExaminerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterMedicalExaminerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMedicalExaminerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicalExaminerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMedicalExaminerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMedicalExaminersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMedicalExaminersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMedicalExaminersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicalExaminerImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportMedicalExaminersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateCertificateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExpiringCertificatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetExpiringCertificatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExpirationReminderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_services_v1_dot_physical_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetExpirationReminderResponse); i {
 			case 0:
 				return &v.state
@@ -2784,7 +5492,7 @@ func file_services_v1_dot_physical_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_services_v1_dot_physical_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,17 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DOTPhysicalService_ScheduleDOTPhysical_FullMethodName     = "/v1consortium.services.DOTPhysicalService/ScheduleDOTPhysical"
-	DOTPhysicalService_GetDOTPhysical_FullMethodName          = "/v1consortium.services.DOTPhysicalService/GetDOTPhysical"
-	DOTPhysicalService_UpdateDOTPhysical_FullMethodName       = "/v1consortium.services.DOTPhysicalService/UpdateDOTPhysical"
-	DOTPhysicalService_ListDOTPhysicals_FullMethodName        = "/v1consortium.services.DOTPhysicalService/ListDOTPhysicals"
-	DOTPhysicalService_RegisterMedicalExaminer_FullMethodName = "/v1consortium.services.DOTPhysicalService/RegisterMedicalExaminer"
-	DOTPhysicalService_GetMedicalExaminer_FullMethodName      = "/v1consortium.services.DOTPhysicalService/GetMedicalExaminer"
-	DOTPhysicalService_ListMedicalExaminers_FullMethodName    = "/v1consortium.services.DOTPhysicalService/ListMedicalExaminers"
-	DOTPhysicalService_GenerateCertificate_FullMethodName     = "/v1consortium.services.DOTPhysicalService/GenerateCertificate"
-	DOTPhysicalService_ValidateCertificate_FullMethodName     = "/v1consortium.services.DOTPhysicalService/ValidateCertificate"
-	DOTPhysicalService_GetExpiringCertificates_FullMethodName = "/v1consortium.services.DOTPhysicalService/GetExpiringCertificates"
-	DOTPhysicalService_SetExpirationReminder_FullMethodName   = "/v1consortium.services.DOTPhysicalService/SetExpirationReminder"
+	DOTPhysicalService_ScheduleDOTPhysical_FullMethodName         = "/v1consortium.services.DOTPhysicalService/ScheduleDOTPhysical"
+	DOTPhysicalService_GetDOTPhysical_FullMethodName              = "/v1consortium.services.DOTPhysicalService/GetDOTPhysical"
+	DOTPhysicalService_UpdateDOTPhysical_FullMethodName           = "/v1consortium.services.DOTPhysicalService/UpdateDOTPhysical"
+	DOTPhysicalService_ListDOTPhysicals_FullMethodName            = "/v1consortium.services.DOTPhysicalService/ListDOTPhysicals"
+	DOTPhysicalService_RescheduleDOTPhysical_FullMethodName       = "/v1consortium.services.DOTPhysicalService/RescheduleDOTPhysical"
+	DOTPhysicalService_CancelDOTPhysical_FullMethodName           = "/v1consortium.services.DOTPhysicalService/CancelDOTPhysical"
+	DOTPhysicalService_MarkDOTPhysicalNoShow_FullMethodName       = "/v1consortium.services.DOTPhysicalService/MarkDOTPhysicalNoShow"
+	DOTPhysicalService_ListMedicalFollowUpTasks_FullMethodName    = "/v1consortium.services.DOTPhysicalService/ListMedicalFollowUpTasks"
+	DOTPhysicalService_CompleteMedicalFollowUpTask_FullMethodName = "/v1consortium.services.DOTPhysicalService/CompleteMedicalFollowUpTask"
+	DOTPhysicalService_CreateClinicAvailability_FullMethodName    = "/v1consortium.services.DOTPhysicalService/CreateClinicAvailability"
+	DOTPhysicalService_ListClinicAvailability_FullMethodName      = "/v1consortium.services.DOTPhysicalService/ListClinicAvailability"
+	DOTPhysicalService_RegisterMedicalExaminer_FullMethodName     = "/v1consortium.services.DOTPhysicalService/RegisterMedicalExaminer"
+	DOTPhysicalService_GetMedicalExaminer_FullMethodName          = "/v1consortium.services.DOTPhysicalService/GetMedicalExaminer"
+	DOTPhysicalService_ListMedicalExaminers_FullMethodName        = "/v1consortium.services.DOTPhysicalService/ListMedicalExaminers"
+	DOTPhysicalService_ImportMedicalExaminers_FullMethodName      = "/v1consortium.services.DOTPhysicalService/ImportMedicalExaminers"
+	DOTPhysicalService_GenerateCertificate_FullMethodName         = "/v1consortium.services.DOTPhysicalService/GenerateCertificate"
+	DOTPhysicalService_ValidateCertificate_FullMethodName         = "/v1consortium.services.DOTPhysicalService/ValidateCertificate"
+	DOTPhysicalService_GetExpiringCertificates_FullMethodName     = "/v1consortium.services.DOTPhysicalService/GetExpiringCertificates"
+	DOTPhysicalService_SetExpirationReminder_FullMethodName       = "/v1consortium.services.DOTPhysicalService/SetExpirationReminder"
 )
 
 // DOTPhysicalServiceClient is the client API for DOTPhysicalService service.
@@ -44,10 +52,20 @@ type DOTPhysicalServiceClient interface {
 	GetDOTPhysical(ctx context.Context, in *GetDOTPhysicalRequest, opts ...grpc.CallOption) (*GetDOTPhysicalResponse, error)
 	UpdateDOTPhysical(ctx context.Context, in *UpdateDOTPhysicalRequest, opts ...grpc.CallOption) (*UpdateDOTPhysicalResponse, error)
 	ListDOTPhysicals(ctx context.Context, in *ListDOTPhysicalsRequest, opts ...grpc.CallOption) (*ListDOTPhysicalsResponse, error)
+	RescheduleDOTPhysical(ctx context.Context, in *RescheduleDOTPhysicalRequest, opts ...grpc.CallOption) (*RescheduleDOTPhysicalResponse, error)
+	CancelDOTPhysical(ctx context.Context, in *CancelDOTPhysicalRequest, opts ...grpc.CallOption) (*CancelDOTPhysicalResponse, error)
+	MarkDOTPhysicalNoShow(ctx context.Context, in *MarkDOTPhysicalNoShowRequest, opts ...grpc.CallOption) (*MarkDOTPhysicalNoShowResponse, error)
+	// Medical Follow-Up
+	ListMedicalFollowUpTasks(ctx context.Context, in *ListMedicalFollowUpTasksRequest, opts ...grpc.CallOption) (*ListMedicalFollowUpTasksResponse, error)
+	CompleteMedicalFollowUpTask(ctx context.Context, in *CompleteMedicalFollowUpTaskRequest, opts ...grpc.CallOption) (*CompleteMedicalFollowUpTaskResponse, error)
+	// Clinic Availability
+	CreateClinicAvailability(ctx context.Context, in *CreateClinicAvailabilityRequest, opts ...grpc.CallOption) (*CreateClinicAvailabilityResponse, error)
+	ListClinicAvailability(ctx context.Context, in *ListClinicAvailabilityRequest, opts ...grpc.CallOption) (*ListClinicAvailabilityResponse, error)
 	// Medical Examiner Management
 	RegisterMedicalExaminer(ctx context.Context, in *RegisterMedicalExaminerRequest, opts ...grpc.CallOption) (*RegisterMedicalExaminerResponse, error)
 	GetMedicalExaminer(ctx context.Context, in *GetMedicalExaminerRequest, opts ...grpc.CallOption) (*GetMedicalExaminerResponse, error)
 	ListMedicalExaminers(ctx context.Context, in *ListMedicalExaminersRequest, opts ...grpc.CallOption) (*ListMedicalExaminersResponse, error)
+	ImportMedicalExaminers(ctx context.Context, in *ImportMedicalExaminersRequest, opts ...grpc.CallOption) (*ImportMedicalExaminersResponse, error)
 	// Certificate Management
 	GenerateCertificate(ctx context.Context, in *GenerateCertificateRequest, opts ...grpc.CallOption) (*GenerateCertificateResponse, error)
 	ValidateCertificate(ctx context.Context, in *ValidateCertificateRequest, opts ...grpc.CallOption) (*ValidateCertificateResponse, error)
@@ -104,6 +122,76 @@ func (c *dOTPhysicalServiceClient) ListDOTPhysicals(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *dOTPhysicalServiceClient) RescheduleDOTPhysical(ctx context.Context, in *RescheduleDOTPhysicalRequest, opts ...grpc.CallOption) (*RescheduleDOTPhysicalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleDOTPhysicalResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_RescheduleDOTPhysical_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) CancelDOTPhysical(ctx context.Context, in *CancelDOTPhysicalRequest, opts ...grpc.CallOption) (*CancelDOTPhysicalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelDOTPhysicalResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_CancelDOTPhysical_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) MarkDOTPhysicalNoShow(ctx context.Context, in *MarkDOTPhysicalNoShowRequest, opts ...grpc.CallOption) (*MarkDOTPhysicalNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkDOTPhysicalNoShowResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_MarkDOTPhysicalNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) ListMedicalFollowUpTasks(ctx context.Context, in *ListMedicalFollowUpTasksRequest, opts ...grpc.CallOption) (*ListMedicalFollowUpTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicalFollowUpTasksResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_ListMedicalFollowUpTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) CompleteMedicalFollowUpTask(ctx context.Context, in *CompleteMedicalFollowUpTaskRequest, opts ...grpc.CallOption) (*CompleteMedicalFollowUpTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMedicalFollowUpTaskResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_CompleteMedicalFollowUpTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) CreateClinicAvailability(ctx context.Context, in *CreateClinicAvailabilityRequest, opts ...grpc.CallOption) (*CreateClinicAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateClinicAvailabilityResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_CreateClinicAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dOTPhysicalServiceClient) ListClinicAvailability(ctx context.Context, in *ListClinicAvailabilityRequest, opts ...grpc.CallOption) (*ListClinicAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClinicAvailabilityResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_ListClinicAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dOTPhysicalServiceClient) RegisterMedicalExaminer(ctx context.Context, in *RegisterMedicalExaminerRequest, opts ...grpc.CallOption) (*RegisterMedicalExaminerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterMedicalExaminerResponse)
@@ -134,6 +222,16 @@ func (c *dOTPhysicalServiceClient) ListMedicalExaminers(ctx context.Context, in
 	return out, nil
 }
 
+func (c *dOTPhysicalServiceClient) ImportMedicalExaminers(ctx context.Context, in *ImportMedicalExaminersRequest, opts ...grpc.CallOption) (*ImportMedicalExaminersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMedicalExaminersResponse)
+	err := c.cc.Invoke(ctx, DOTPhysicalService_ImportMedicalExaminers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dOTPhysicalServiceClient) GenerateCertificate(ctx context.Context, in *GenerateCertificateRequest, opts ...grpc.CallOption) (*GenerateCertificateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateCertificateResponse)
@@ -185,10 +283,20 @@ type DOTPhysicalServiceServer interface {
 	GetDOTPhysical(context.Context, *GetDOTPhysicalRequest) (*GetDOTPhysicalResponse, error)
 	UpdateDOTPhysical(context.Context, *UpdateDOTPhysicalRequest) (*UpdateDOTPhysicalResponse, error)
 	ListDOTPhysicals(context.Context, *ListDOTPhysicalsRequest) (*ListDOTPhysicalsResponse, error)
+	RescheduleDOTPhysical(context.Context, *RescheduleDOTPhysicalRequest) (*RescheduleDOTPhysicalResponse, error)
+	CancelDOTPhysical(context.Context, *CancelDOTPhysicalRequest) (*CancelDOTPhysicalResponse, error)
+	MarkDOTPhysicalNoShow(context.Context, *MarkDOTPhysicalNoShowRequest) (*MarkDOTPhysicalNoShowResponse, error)
+	// Medical Follow-Up
+	ListMedicalFollowUpTasks(context.Context, *ListMedicalFollowUpTasksRequest) (*ListMedicalFollowUpTasksResponse, error)
+	CompleteMedicalFollowUpTask(context.Context, *CompleteMedicalFollowUpTaskRequest) (*CompleteMedicalFollowUpTaskResponse, error)
+	// Clinic Availability
+	CreateClinicAvailability(context.Context, *CreateClinicAvailabilityRequest) (*CreateClinicAvailabilityResponse, error)
+	ListClinicAvailability(context.Context, *ListClinicAvailabilityRequest) (*ListClinicAvailabilityResponse, error)
 	// Medical Examiner Management
 	RegisterMedicalExaminer(context.Context, *RegisterMedicalExaminerRequest) (*RegisterMedicalExaminerResponse, error)
 	GetMedicalExaminer(context.Context, *GetMedicalExaminerRequest) (*GetMedicalExaminerResponse, error)
 	ListMedicalExaminers(context.Context, *ListMedicalExaminersRequest) (*ListMedicalExaminersResponse, error)
+	ImportMedicalExaminers(context.Context, *ImportMedicalExaminersRequest) (*ImportMedicalExaminersResponse, error)
 	// Certificate Management
 	GenerateCertificate(context.Context, *GenerateCertificateRequest) (*GenerateCertificateResponse, error)
 	ValidateCertificate(context.Context, *ValidateCertificateRequest) (*ValidateCertificateResponse, error)
@@ -217,6 +325,27 @@ func (UnimplementedDOTPhysicalServiceServer) UpdateDOTPhysical(context.Context,
 func (UnimplementedDOTPhysicalServiceServer) ListDOTPhysicals(context.Context, *ListDOTPhysicalsRequest) (*ListDOTPhysicalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDOTPhysicals not implemented")
 }
+func (UnimplementedDOTPhysicalServiceServer) RescheduleDOTPhysical(context.Context, *RescheduleDOTPhysicalRequest) (*RescheduleDOTPhysicalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleDOTPhysical not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) CancelDOTPhysical(context.Context, *CancelDOTPhysicalRequest) (*CancelDOTPhysicalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDOTPhysical not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) MarkDOTPhysicalNoShow(context.Context, *MarkDOTPhysicalNoShowRequest) (*MarkDOTPhysicalNoShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDOTPhysicalNoShow not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) ListMedicalFollowUpTasks(context.Context, *ListMedicalFollowUpTasksRequest) (*ListMedicalFollowUpTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalFollowUpTasks not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) CompleteMedicalFollowUpTask(context.Context, *CompleteMedicalFollowUpTaskRequest) (*CompleteMedicalFollowUpTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMedicalFollowUpTask not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) CreateClinicAvailability(context.Context, *CreateClinicAvailabilityRequest) (*CreateClinicAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClinicAvailability not implemented")
+}
+func (UnimplementedDOTPhysicalServiceServer) ListClinicAvailability(context.Context, *ListClinicAvailabilityRequest) (*ListClinicAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClinicAvailability not implemented")
+}
 func (UnimplementedDOTPhysicalServiceServer) RegisterMedicalExaminer(context.Context, *RegisterMedicalExaminerRequest) (*RegisterMedicalExaminerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterMedicalExaminer not implemented")
 }
@@ -226,6 +355,9 @@ func (UnimplementedDOTPhysicalServiceServer) GetMedicalExaminer(context.Context,
 func (UnimplementedDOTPhysicalServiceServer) ListMedicalExaminers(context.Context, *ListMedicalExaminersRequest) (*ListMedicalExaminersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalExaminers not implemented")
 }
+func (UnimplementedDOTPhysicalServiceServer) ImportMedicalExaminers(context.Context, *ImportMedicalExaminersRequest) (*ImportMedicalExaminersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMedicalExaminers not implemented")
+}
 func (UnimplementedDOTPhysicalServiceServer) GenerateCertificate(context.Context, *GenerateCertificateRequest) (*GenerateCertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateCertificate not implemented")
 }
@@ -331,6 +463,132 @@ func _DOTPhysicalService_ListDOTPhysicals_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DOTPhysicalService_RescheduleDOTPhysical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleDOTPhysicalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).RescheduleDOTPhysical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_RescheduleDOTPhysical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).RescheduleDOTPhysical(ctx, req.(*RescheduleDOTPhysicalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_CancelDOTPhysical_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDOTPhysicalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).CancelDOTPhysical(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_CancelDOTPhysical_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).CancelDOTPhysical(ctx, req.(*CancelDOTPhysicalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_MarkDOTPhysicalNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkDOTPhysicalNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).MarkDOTPhysicalNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_MarkDOTPhysicalNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).MarkDOTPhysicalNoShow(ctx, req.(*MarkDOTPhysicalNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_ListMedicalFollowUpTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalFollowUpTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).ListMedicalFollowUpTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_ListMedicalFollowUpTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).ListMedicalFollowUpTasks(ctx, req.(*ListMedicalFollowUpTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_CompleteMedicalFollowUpTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMedicalFollowUpTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).CompleteMedicalFollowUpTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_CompleteMedicalFollowUpTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).CompleteMedicalFollowUpTask(ctx, req.(*CompleteMedicalFollowUpTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_CreateClinicAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClinicAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).CreateClinicAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_CreateClinicAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).CreateClinicAvailability(ctx, req.(*CreateClinicAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DOTPhysicalService_ListClinicAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClinicAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).ListClinicAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_ListClinicAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).ListClinicAvailability(ctx, req.(*ListClinicAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DOTPhysicalService_RegisterMedicalExaminer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterMedicalExaminerRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +643,24 @@ func _DOTPhysicalService_ListMedicalExaminers_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DOTPhysicalService_ImportMedicalExaminers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMedicalExaminersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DOTPhysicalServiceServer).ImportMedicalExaminers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DOTPhysicalService_ImportMedicalExaminers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DOTPhysicalServiceServer).ImportMedicalExaminers(ctx, req.(*ImportMedicalExaminersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DOTPhysicalService_GenerateCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateCertificateRequest)
 	if err := dec(in); err != nil {
@@ -480,6 +756,34 @@ var DOTPhysicalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDOTPhysicals",
 			Handler:    _DOTPhysicalService_ListDOTPhysicals_Handler,
 		},
+		{
+			MethodName: "RescheduleDOTPhysical",
+			Handler:    _DOTPhysicalService_RescheduleDOTPhysical_Handler,
+		},
+		{
+			MethodName: "CancelDOTPhysical",
+			Handler:    _DOTPhysicalService_CancelDOTPhysical_Handler,
+		},
+		{
+			MethodName: "MarkDOTPhysicalNoShow",
+			Handler:    _DOTPhysicalService_MarkDOTPhysicalNoShow_Handler,
+		},
+		{
+			MethodName: "ListMedicalFollowUpTasks",
+			Handler:    _DOTPhysicalService_ListMedicalFollowUpTasks_Handler,
+		},
+		{
+			MethodName: "CompleteMedicalFollowUpTask",
+			Handler:    _DOTPhysicalService_CompleteMedicalFollowUpTask_Handler,
+		},
+		{
+			MethodName: "CreateClinicAvailability",
+			Handler:    _DOTPhysicalService_CreateClinicAvailability_Handler,
+		},
+		{
+			MethodName: "ListClinicAvailability",
+			Handler:    _DOTPhysicalService_ListClinicAvailability_Handler,
+		},
 		{
 			MethodName: "RegisterMedicalExaminer",
 			Handler:    _DOTPhysicalService_RegisterMedicalExaminer_Handler,
@@ -492,6 +796,10 @@ var DOTPhysicalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMedicalExaminers",
 			Handler:    _DOTPhysicalService_ListMedicalExaminers_Handler,
 		},
+		{
+			MethodName: "ImportMedicalExaminers",
+			Handler:    _DOTPhysicalService_ImportMedicalExaminers_Handler,
+		},
 		{
 			MethodName: "GenerateCertificate",
 			Handler:    _DOTPhysicalService_GenerateCertificate_Handler,
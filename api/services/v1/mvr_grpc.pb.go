@@ -23,12 +23,15 @@ const (
 	MVRService_OrderMVR_FullMethodName                   = "/v1consortium.services.MVRService/OrderMVR"
 	MVRService_GetMVRReport_FullMethodName               = "/v1consortium.services.MVRService/GetMVRReport"
 	MVRService_UpdateMVRReport_FullMethodName            = "/v1consortium.services.MVRService/UpdateMVRReport"
+	MVRService_ParseMVRReport_FullMethodName             = "/v1consortium.services.MVRService/ParseMVRReport"
 	MVRService_ListMVRReports_FullMethodName             = "/v1consortium.services.MVRService/ListMVRReports"
 	MVRService_AddMVRViolation_FullMethodName            = "/v1consortium.services.MVRService/AddMVRViolation"
 	MVRService_UpdateMVRViolation_FullMethodName         = "/v1consortium.services.MVRService/UpdateMVRViolation"
 	MVRService_ListMVRViolations_FullMethodName          = "/v1consortium.services.MVRService/ListMVRViolations"
 	MVRService_EnableContinuousMonitoring_FullMethodName = "/v1consortium.services.MVRService/EnableContinuousMonitoring"
 	MVRService_GetMonitoringStatus_FullMethodName        = "/v1consortium.services.MVRService/GetMonitoringStatus"
+	MVRService_ListMVRReviews_FullMethodName             = "/v1consortium.services.MVRService/ListMVRReviews"
+	MVRService_SignOffMVRReview_FullMethodName           = "/v1consortium.services.MVRService/SignOffMVRReview"
 	MVRService_GetMVRAnalytics_FullMethodName            = "/v1consortium.services.MVRService/GetMVRAnalytics"
 	MVRService_SyncProviderData_FullMethodName           = "/v1consortium.services.MVRService/SyncProviderData"
 )
@@ -43,6 +46,7 @@ type MVRServiceClient interface {
 	OrderMVR(ctx context.Context, in *OrderMVRRequest, opts ...grpc.CallOption) (*OrderMVRResponse, error)
 	GetMVRReport(ctx context.Context, in *GetMVRReportRequest, opts ...grpc.CallOption) (*GetMVRReportResponse, error)
 	UpdateMVRReport(ctx context.Context, in *UpdateMVRReportRequest, opts ...grpc.CallOption) (*UpdateMVRReportResponse, error)
+	ParseMVRReport(ctx context.Context, in *ParseMVRReportRequest, opts ...grpc.CallOption) (*ParseMVRReportResponse, error)
 	ListMVRReports(ctx context.Context, in *ListMVRReportsRequest, opts ...grpc.CallOption) (*ListMVRReportsResponse, error)
 	// MVR Violation Management
 	AddMVRViolation(ctx context.Context, in *AddMVRViolationRequest, opts ...grpc.CallOption) (*AddMVRViolationResponse, error)
@@ -51,6 +55,9 @@ type MVRServiceClient interface {
 	// Continuous Monitoring
 	EnableContinuousMonitoring(ctx context.Context, in *EnableContinuousMonitoringRequest, opts ...grpc.CallOption) (*EnableContinuousMonitoringResponse, error)
 	GetMonitoringStatus(ctx context.Context, in *GetMonitoringStatusRequest, opts ...grpc.CallOption) (*GetMonitoringStatusResponse, error)
+	// Annual Review
+	ListMVRReviews(ctx context.Context, in *ListMVRReviewsRequest, opts ...grpc.CallOption) (*ListMVRReviewsResponse, error)
+	SignOffMVRReview(ctx context.Context, in *SignOffMVRReviewRequest, opts ...grpc.CallOption) (*SignOffMVRReviewResponse, error)
 	// Analytics and Reporting
 	GetMVRAnalytics(ctx context.Context, in *GetMVRAnalyticsRequest, opts ...grpc.CallOption) (*GetMVRAnalyticsResponse, error)
 	// Provider Integration
@@ -95,6 +102,16 @@ func (c *mVRServiceClient) UpdateMVRReport(ctx context.Context, in *UpdateMVRRep
 	return out, nil
 }
 
+func (c *mVRServiceClient) ParseMVRReport(ctx context.Context, in *ParseMVRReportRequest, opts ...grpc.CallOption) (*ParseMVRReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParseMVRReportResponse)
+	err := c.cc.Invoke(ctx, MVRService_ParseMVRReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mVRServiceClient) ListMVRReports(ctx context.Context, in *ListMVRReportsRequest, opts ...grpc.CallOption) (*ListMVRReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMVRReportsResponse)
@@ -155,6 +172,26 @@ func (c *mVRServiceClient) GetMonitoringStatus(ctx context.Context, in *GetMonit
 	return out, nil
 }
 
+func (c *mVRServiceClient) ListMVRReviews(ctx context.Context, in *ListMVRReviewsRequest, opts ...grpc.CallOption) (*ListMVRReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMVRReviewsResponse)
+	err := c.cc.Invoke(ctx, MVRService_ListMVRReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mVRServiceClient) SignOffMVRReview(ctx context.Context, in *SignOffMVRReviewRequest, opts ...grpc.CallOption) (*SignOffMVRReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignOffMVRReviewResponse)
+	err := c.cc.Invoke(ctx, MVRService_SignOffMVRReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mVRServiceClient) GetMVRAnalytics(ctx context.Context, in *GetMVRAnalyticsRequest, opts ...grpc.CallOption) (*GetMVRAnalyticsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMVRAnalyticsResponse)
@@ -185,6 +222,7 @@ type MVRServiceServer interface {
 	OrderMVR(context.Context, *OrderMVRRequest) (*OrderMVRResponse, error)
 	GetMVRReport(context.Context, *GetMVRReportRequest) (*GetMVRReportResponse, error)
 	UpdateMVRReport(context.Context, *UpdateMVRReportRequest) (*UpdateMVRReportResponse, error)
+	ParseMVRReport(context.Context, *ParseMVRReportRequest) (*ParseMVRReportResponse, error)
 	ListMVRReports(context.Context, *ListMVRReportsRequest) (*ListMVRReportsResponse, error)
 	// MVR Violation Management
 	AddMVRViolation(context.Context, *AddMVRViolationRequest) (*AddMVRViolationResponse, error)
@@ -193,6 +231,9 @@ type MVRServiceServer interface {
 	// Continuous Monitoring
 	EnableContinuousMonitoring(context.Context, *EnableContinuousMonitoringRequest) (*EnableContinuousMonitoringResponse, error)
 	GetMonitoringStatus(context.Context, *GetMonitoringStatusRequest) (*GetMonitoringStatusResponse, error)
+	// Annual Review
+	ListMVRReviews(context.Context, *ListMVRReviewsRequest) (*ListMVRReviewsResponse, error)
+	SignOffMVRReview(context.Context, *SignOffMVRReviewRequest) (*SignOffMVRReviewResponse, error)
 	// Analytics and Reporting
 	GetMVRAnalytics(context.Context, *GetMVRAnalyticsRequest) (*GetMVRAnalyticsResponse, error)
 	// Provider Integration
@@ -216,6 +257,9 @@ func (UnimplementedMVRServiceServer) GetMVRReport(context.Context, *GetMVRReport
 func (UnimplementedMVRServiceServer) UpdateMVRReport(context.Context, *UpdateMVRReportRequest) (*UpdateMVRReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMVRReport not implemented")
 }
+func (UnimplementedMVRServiceServer) ParseMVRReport(context.Context, *ParseMVRReportRequest) (*ParseMVRReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseMVRReport not implemented")
+}
 func (UnimplementedMVRServiceServer) ListMVRReports(context.Context, *ListMVRReportsRequest) (*ListMVRReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMVRReports not implemented")
 }
@@ -234,6 +278,12 @@ func (UnimplementedMVRServiceServer) EnableContinuousMonitoring(context.Context,
 func (UnimplementedMVRServiceServer) GetMonitoringStatus(context.Context, *GetMonitoringStatusRequest) (*GetMonitoringStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoringStatus not implemented")
 }
+func (UnimplementedMVRServiceServer) ListMVRReviews(context.Context, *ListMVRReviewsRequest) (*ListMVRReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMVRReviews not implemented")
+}
+func (UnimplementedMVRServiceServer) SignOffMVRReview(context.Context, *SignOffMVRReviewRequest) (*SignOffMVRReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOffMVRReview not implemented")
+}
 func (UnimplementedMVRServiceServer) GetMVRAnalytics(context.Context, *GetMVRAnalyticsRequest) (*GetMVRAnalyticsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMVRAnalytics not implemented")
 }
@@ -315,6 +365,24 @@ func _MVRService_UpdateMVRReport_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MVRService_ParseMVRReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseMVRReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MVRServiceServer).ParseMVRReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MVRService_ParseMVRReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MVRServiceServer).ParseMVRReport(ctx, req.(*ParseMVRReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MVRService_ListMVRReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMVRReportsRequest)
 	if err := dec(in); err != nil {
@@ -423,6 +491,42 @@ func _MVRService_GetMonitoringStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MVRService_ListMVRReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMVRReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MVRServiceServer).ListMVRReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MVRService_ListMVRReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MVRServiceServer).ListMVRReviews(ctx, req.(*ListMVRReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MVRService_SignOffMVRReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignOffMVRReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MVRServiceServer).SignOffMVRReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MVRService_SignOffMVRReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MVRServiceServer).SignOffMVRReview(ctx, req.(*SignOffMVRReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MVRService_GetMVRAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMVRAnalyticsRequest)
 	if err := dec(in); err != nil {
@@ -478,6 +582,10 @@ var MVRService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMVRReport",
 			Handler:    _MVRService_UpdateMVRReport_Handler,
 		},
+		{
+			MethodName: "ParseMVRReport",
+			Handler:    _MVRService_ParseMVRReport_Handler,
+		},
 		{
 			MethodName: "ListMVRReports",
 			Handler:    _MVRService_ListMVRReports_Handler,
@@ -502,6 +610,14 @@ var MVRService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMonitoringStatus",
 			Handler:    _MVRService_GetMonitoringStatus_Handler,
 		},
+		{
+			MethodName: "ListMVRReviews",
+			Handler:    _MVRService_ListMVRReviews_Handler,
+		},
+		{
+			MethodName: "SignOffMVRReview",
+			Handler:    _MVRService_SignOffMVRReview_Handler,
+		},
 		{
 			MethodName: "GetMVRAnalytics",
 			Handler:    _MVRService_GetMVRAnalytics_Handler,
@@ -25,13 +25,16 @@ const (
 	NotificationService_ListNotifications_FullMethodName             = "/v1consortium.services.NotificationService/ListNotifications"
 	NotificationService_MarkNotificationRead_FullMethodName          = "/v1consortium.services.NotificationService/MarkNotificationRead"
 	NotificationService_MarkAllNotificationsRead_FullMethodName      = "/v1consortium.services.NotificationService/MarkAllNotificationsRead"
+	NotificationService_StreamNotifications_FullMethodName           = "/v1consortium.services.NotificationService/StreamNotifications"
 	NotificationService_CreateNotificationTemplate_FullMethodName    = "/v1consortium.services.NotificationService/CreateNotificationTemplate"
 	NotificationService_GetNotificationTemplate_FullMethodName       = "/v1consortium.services.NotificationService/GetNotificationTemplate"
 	NotificationService_ListNotificationTemplates_FullMethodName     = "/v1consortium.services.NotificationService/ListNotificationTemplates"
 	NotificationService_UpdateNotificationTemplate_FullMethodName    = "/v1consortium.services.NotificationService/UpdateNotificationTemplate"
+	NotificationService_PreviewNotificationTemplate_FullMethodName   = "/v1consortium.services.NotificationService/PreviewNotificationTemplate"
 	NotificationService_GetNotificationPreferences_FullMethodName    = "/v1consortium.services.NotificationService/GetNotificationPreferences"
 	NotificationService_UpdateNotificationPreferences_FullMethodName = "/v1consortium.services.NotificationService/UpdateNotificationPreferences"
 	NotificationService_SendBulkNotification_FullMethodName          = "/v1consortium.services.NotificationService/SendBulkNotification"
+	NotificationService_GetBulkNotificationStatus_FullMethodName     = "/v1consortium.services.NotificationService/GetBulkNotificationStatus"
 	NotificationService_ScheduleNotification_FullMethodName          = "/v1consortium.services.NotificationService/ScheduleNotification"
 	NotificationService_ListScheduledNotifications_FullMethodName    = "/v1consortium.services.NotificationService/ListScheduledNotifications"
 	NotificationService_CancelScheduledNotification_FullMethodName   = "/v1consortium.services.NotificationService/CancelScheduledNotification"
@@ -50,16 +53,20 @@ type NotificationServiceClient interface {
 	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
 	MarkNotificationRead(ctx context.Context, in *MarkNotificationReadRequest, opts ...grpc.CallOption) (*MarkNotificationReadResponse, error)
 	MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadRequest, opts ...grpc.CallOption) (*MarkAllNotificationsReadResponse, error)
+	// Pushes the caller's new in-app notifications and read-state changes as they happen
+	StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error)
 	// Template Management
 	CreateNotificationTemplate(ctx context.Context, in *CreateNotificationTemplateRequest, opts ...grpc.CallOption) (*CreateNotificationTemplateResponse, error)
 	GetNotificationTemplate(ctx context.Context, in *GetNotificationTemplateRequest, opts ...grpc.CallOption) (*GetNotificationTemplateResponse, error)
 	ListNotificationTemplates(ctx context.Context, in *ListNotificationTemplatesRequest, opts ...grpc.CallOption) (*ListNotificationTemplatesResponse, error)
 	UpdateNotificationTemplate(ctx context.Context, in *UpdateNotificationTemplateRequest, opts ...grpc.CallOption) (*UpdateNotificationTemplateResponse, error)
+	PreviewNotificationTemplate(ctx context.Context, in *PreviewNotificationTemplateRequest, opts ...grpc.CallOption) (*PreviewNotificationTemplateResponse, error)
 	// Preference Management
 	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
 	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferencesResponse, error)
 	// Bulk Operations
 	SendBulkNotification(ctx context.Context, in *SendBulkNotificationRequest, opts ...grpc.CallOption) (*SendBulkNotificationResponse, error)
+	GetBulkNotificationStatus(ctx context.Context, in *GetBulkNotificationStatusRequest, opts ...grpc.CallOption) (*GetBulkNotificationStatusResponse, error)
 	// Scheduled Notifications
 	ScheduleNotification(ctx context.Context, in *ScheduleNotificationRequest, opts ...grpc.CallOption) (*ScheduleNotificationResponse, error)
 	ListScheduledNotifications(ctx context.Context, in *ListScheduledNotificationsRequest, opts ...grpc.CallOption) (*ListScheduledNotificationsResponse, error)
@@ -126,6 +133,25 @@ func (c *notificationServiceClient) MarkAllNotificationsRead(ctx context.Context
 	return out, nil
 }
 
+func (c *notificationServiceClient) StreamNotifications(ctx context.Context, in *StreamNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NotificationService_ServiceDesc.Streams[0], NotificationService_StreamNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamNotificationsRequest, NotificationEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamNotificationsClient = grpc.ServerStreamingClient[NotificationEvent]
+
 func (c *notificationServiceClient) CreateNotificationTemplate(ctx context.Context, in *CreateNotificationTemplateRequest, opts ...grpc.CallOption) (*CreateNotificationTemplateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateNotificationTemplateResponse)
@@ -166,6 +192,16 @@ func (c *notificationServiceClient) UpdateNotificationTemplate(ctx context.Conte
 	return out, nil
 }
 
+func (c *notificationServiceClient) PreviewNotificationTemplate(ctx context.Context, in *PreviewNotificationTemplateRequest, opts ...grpc.CallOption) (*PreviewNotificationTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewNotificationTemplateResponse)
+	err := c.cc.Invoke(ctx, NotificationService_PreviewNotificationTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNotificationPreferencesResponse)
@@ -196,6 +232,16 @@ func (c *notificationServiceClient) SendBulkNotification(ctx context.Context, in
 	return out, nil
 }
 
+func (c *notificationServiceClient) GetBulkNotificationStatus(ctx context.Context, in *GetBulkNotificationStatusRequest, opts ...grpc.CallOption) (*GetBulkNotificationStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBulkNotificationStatusResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetBulkNotificationStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) ScheduleNotification(ctx context.Context, in *ScheduleNotificationRequest, opts ...grpc.CallOption) (*ScheduleNotificationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ScheduleNotificationResponse)
@@ -248,16 +294,20 @@ type NotificationServiceServer interface {
 	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
 	MarkNotificationRead(context.Context, *MarkNotificationReadRequest) (*MarkNotificationReadResponse, error)
 	MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkAllNotificationsReadResponse, error)
+	// Pushes the caller's new in-app notifications and read-state changes as they happen
+	StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[NotificationEvent]) error
 	// Template Management
 	CreateNotificationTemplate(context.Context, *CreateNotificationTemplateRequest) (*CreateNotificationTemplateResponse, error)
 	GetNotificationTemplate(context.Context, *GetNotificationTemplateRequest) (*GetNotificationTemplateResponse, error)
 	ListNotificationTemplates(context.Context, *ListNotificationTemplatesRequest) (*ListNotificationTemplatesResponse, error)
 	UpdateNotificationTemplate(context.Context, *UpdateNotificationTemplateRequest) (*UpdateNotificationTemplateResponse, error)
+	PreviewNotificationTemplate(context.Context, *PreviewNotificationTemplateRequest) (*PreviewNotificationTemplateResponse, error)
 	// Preference Management
 	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
 	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*UpdateNotificationPreferencesResponse, error)
 	// Bulk Operations
 	SendBulkNotification(context.Context, *SendBulkNotificationRequest) (*SendBulkNotificationResponse, error)
+	GetBulkNotificationStatus(context.Context, *GetBulkNotificationStatusRequest) (*GetBulkNotificationStatusResponse, error)
 	// Scheduled Notifications
 	ScheduleNotification(context.Context, *ScheduleNotificationRequest) (*ScheduleNotificationResponse, error)
 	ListScheduledNotifications(context.Context, *ListScheduledNotificationsRequest) (*ListScheduledNotificationsResponse, error)
@@ -289,6 +339,9 @@ func (UnimplementedNotificationServiceServer) MarkNotificationRead(context.Conte
 func (UnimplementedNotificationServiceServer) MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadRequest) (*MarkAllNotificationsReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsRead not implemented")
 }
+func (UnimplementedNotificationServiceServer) StreamNotifications(*StreamNotificationsRequest, grpc.ServerStreamingServer[NotificationEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamNotifications not implemented")
+}
 func (UnimplementedNotificationServiceServer) CreateNotificationTemplate(context.Context, *CreateNotificationTemplateRequest) (*CreateNotificationTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationTemplate not implemented")
 }
@@ -301,6 +354,9 @@ func (UnimplementedNotificationServiceServer) ListNotificationTemplates(context.
 func (UnimplementedNotificationServiceServer) UpdateNotificationTemplate(context.Context, *UpdateNotificationTemplateRequest) (*UpdateNotificationTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationTemplate not implemented")
 }
+func (UnimplementedNotificationServiceServer) PreviewNotificationTemplate(context.Context, *PreviewNotificationTemplateRequest) (*PreviewNotificationTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewNotificationTemplate not implemented")
+}
 func (UnimplementedNotificationServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
 }
@@ -310,6 +366,9 @@ func (UnimplementedNotificationServiceServer) UpdateNotificationPreferences(cont
 func (UnimplementedNotificationServiceServer) SendBulkNotification(context.Context, *SendBulkNotificationRequest) (*SendBulkNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendBulkNotification not implemented")
 }
+func (UnimplementedNotificationServiceServer) GetBulkNotificationStatus(context.Context, *GetBulkNotificationStatusRequest) (*GetBulkNotificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulkNotificationStatus not implemented")
+}
 func (UnimplementedNotificationServiceServer) ScheduleNotification(context.Context, *ScheduleNotificationRequest) (*ScheduleNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScheduleNotification not implemented")
 }
@@ -433,6 +492,17 @@ func _NotificationService_MarkAllNotificationsRead_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_StreamNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).StreamNotifications(m, &grpc.GenericServerStream[StreamNotificationsRequest, NotificationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NotificationService_StreamNotificationsServer = grpc.ServerStreamingServer[NotificationEvent]
+
 func _NotificationService_CreateNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateNotificationTemplateRequest)
 	if err := dec(in); err != nil {
@@ -505,6 +575,24 @@ func _NotificationService_UpdateNotificationTemplate_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_PreviewNotificationTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewNotificationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PreviewNotificationTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PreviewNotificationTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PreviewNotificationTemplate(ctx, req.(*PreviewNotificationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNotificationPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -559,6 +647,24 @@ func _NotificationService_SendBulkNotification_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_GetBulkNotificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBulkNotificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetBulkNotificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetBulkNotificationStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetBulkNotificationStatus(ctx, req.(*GetBulkNotificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_ScheduleNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ScheduleNotificationRequest)
 	if err := dec(in); err != nil {
@@ -674,6 +780,10 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateNotificationTemplate",
 			Handler:    _NotificationService_UpdateNotificationTemplate_Handler,
 		},
+		{
+			MethodName: "PreviewNotificationTemplate",
+			Handler:    _NotificationService_PreviewNotificationTemplate_Handler,
+		},
 		{
 			MethodName: "GetNotificationPreferences",
 			Handler:    _NotificationService_GetNotificationPreferences_Handler,
@@ -686,6 +796,10 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendBulkNotification",
 			Handler:    _NotificationService_SendBulkNotification_Handler,
 		},
+		{
+			MethodName: "GetBulkNotificationStatus",
+			Handler:    _NotificationService_GetBulkNotificationStatus_Handler,
+		},
 		{
 			MethodName: "ScheduleNotification",
 			Handler:    _NotificationService_ScheduleNotification_Handler,
@@ -703,6 +817,12 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _NotificationService_GetNotificationAnalytics_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamNotifications",
+			Handler:       _NotificationService_StreamNotifications_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "services/v1/notification.proto",
 }
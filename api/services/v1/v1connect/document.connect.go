@@ -36,6 +36,18 @@ const (
 	// DocumentServiceUploadDocumentProcedure is the fully-qualified name of the DocumentService's
 	// UploadDocument RPC.
 	DocumentServiceUploadDocumentProcedure = "/v1consortium.services.DocumentService/UploadDocument"
+	// DocumentServiceStreamUploadDocumentProcedure is the fully-qualified name of the DocumentService's
+	// StreamUploadDocument RPC.
+	DocumentServiceStreamUploadDocumentProcedure = "/v1consortium.services.DocumentService/StreamUploadDocument"
+	// DocumentServiceGetDocumentUploadSessionProcedure is the fully-qualified name of the
+	// DocumentService's GetDocumentUploadSession RPC.
+	DocumentServiceGetDocumentUploadSessionProcedure = "/v1consortium.services.DocumentService/GetDocumentUploadSession"
+	// DocumentServiceGetDocumentUploadLimitsProcedure is the fully-qualified name of the
+	// DocumentService's GetDocumentUploadLimits RPC.
+	DocumentServiceGetDocumentUploadLimitsProcedure = "/v1consortium.services.DocumentService/GetDocumentUploadLimits"
+	// DocumentServiceSetDocumentUploadLimitsProcedure is the fully-qualified name of the
+	// DocumentService's SetDocumentUploadLimits RPC.
+	DocumentServiceSetDocumentUploadLimitsProcedure = "/v1consortium.services.DocumentService/SetDocumentUploadLimits"
 	// DocumentServiceGetDocumentProcedure is the fully-qualified name of the DocumentService's
 	// GetDocument RPC.
 	DocumentServiceGetDocumentProcedure = "/v1consortium.services.DocumentService/GetDocument"
@@ -51,6 +63,9 @@ const (
 	// DocumentServiceGetDocumentVersionsProcedure is the fully-qualified name of the DocumentService's
 	// GetDocumentVersions RPC.
 	DocumentServiceGetDocumentVersionsProcedure = "/v1consortium.services.DocumentService/GetDocumentVersions"
+	// DocumentServiceRollbackDocumentProcedure is the fully-qualified name of the DocumentService's
+	// RollbackDocument RPC.
+	DocumentServiceRollbackDocumentProcedure = "/v1consortium.services.DocumentService/RollbackDocument"
 	// DocumentServiceShareDocumentProcedure is the fully-qualified name of the DocumentService's
 	// ShareDocument RPC.
 	DocumentServiceShareDocumentProcedure = "/v1consortium.services.DocumentService/ShareDocument"
@@ -72,17 +87,37 @@ const (
 	// DocumentServiceApplyRetentionPolicyProcedure is the fully-qualified name of the DocumentService's
 	// ApplyRetentionPolicy RPC.
 	DocumentServiceApplyRetentionPolicyProcedure = "/v1consortium.services.DocumentService/ApplyRetentionPolicy"
+	// DocumentServicePlaceLegalHoldProcedure is the fully-qualified name of the DocumentService's
+	// PlaceLegalHold RPC.
+	DocumentServicePlaceLegalHoldProcedure = "/v1consortium.services.DocumentService/PlaceLegalHold"
+	// DocumentServiceReleaseLegalHoldProcedure is the fully-qualified name of the DocumentService's
+	// ReleaseLegalHold RPC.
+	DocumentServiceReleaseLegalHoldProcedure = "/v1consortium.services.DocumentService/ReleaseLegalHold"
+	// DocumentServiceListLegalHoldsProcedure is the fully-qualified name of the DocumentService's
+	// ListLegalHolds RPC.
+	DocumentServiceListLegalHoldsProcedure = "/v1consortium.services.DocumentService/ListLegalHolds"
+	// DocumentServiceAssembleDriverQualificationFileProcedure is the fully-qualified name of the
+	// DocumentService's AssembleDriverQualificationFile RPC.
+	DocumentServiceAssembleDriverQualificationFileProcedure = "/v1consortium.services.DocumentService/AssembleDriverQualificationFile"
 )
 
 // DocumentServiceClient is a client for the v1consortium.services.DocumentService service.
 type DocumentServiceClient interface {
 	// Document Management
 	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	// Streams a document in chunks after a header message. Interrupted uploads resume by sending
+	// the same upload session ID with the offset reported by GetDocumentUploadSession.
+	StreamUploadDocument(context.Context) *connect.ClientStreamForClient[v1.StreamUploadDocumentRequest, v1.StreamUploadDocumentResponse]
+	GetDocumentUploadSession(context.Context, *connect.Request[v1.GetDocumentUploadSessionRequest]) (*connect.Response[v1.GetDocumentUploadSessionResponse], error)
+	GetDocumentUploadLimits(context.Context, *connect.Request[v1.GetDocumentUploadLimitsRequest]) (*connect.Response[v1.GetDocumentUploadLimitsResponse], error)
+	SetDocumentUploadLimits(context.Context, *connect.Request[v1.SetDocumentUploadLimitsRequest]) (*connect.Response[v1.SetDocumentUploadLimitsResponse], error)
 	GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error)
 	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
 	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
 	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
 	GetDocumentVersions(context.Context, *connect.Request[v1.GetDocumentVersionsRequest]) (*connect.Response[v1.GetDocumentVersionsResponse], error)
+	// Restores a prior version's content and file details as a new current version
+	RollbackDocument(context.Context, *connect.Request[v1.RollbackDocumentRequest]) (*connect.Response[v1.RollbackDocumentResponse], error)
 	// Document Sharing
 	ShareDocument(context.Context, *connect.Request[v1.ShareDocumentRequest]) (*connect.Response[v1.ShareDocumentResponse], error)
 	GetSharedDocuments(context.Context, *connect.Request[v1.GetSharedDocumentsRequest]) (*connect.Response[v1.GetSharedDocumentsResponse], error)
@@ -94,6 +129,12 @@ type DocumentServiceClient interface {
 	// Document Compliance
 	GetDocumentRetentionStatus(context.Context, *connect.Request[v1.GetDocumentRetentionStatusRequest]) (*connect.Response[v1.GetDocumentRetentionStatusResponse], error)
 	ApplyRetentionPolicy(context.Context, *connect.Request[v1.ApplyRetentionPolicyRequest]) (*connect.Response[v1.ApplyRetentionPolicyResponse], error)
+	// Legal holds block deletion of an organization's, a user's or a single document's records
+	PlaceLegalHold(context.Context, *connect.Request[v1.PlaceLegalHoldRequest]) (*connect.Response[v1.PlaceLegalHoldResponse], error)
+	ReleaseLegalHold(context.Context, *connect.Request[v1.ReleaseLegalHoldRequest]) (*connect.Response[v1.ReleaseLegalHoldResponse], error)
+	ListLegalHolds(context.Context, *connect.Request[v1.ListLegalHoldsRequest]) (*connect.Response[v1.ListLegalHoldsResponse], error)
+	// Driver qualification file: every record FMCSA requires for a driver, bundled as one PDF or ZIP
+	AssembleDriverQualificationFile(context.Context, *connect.Request[v1.AssembleDriverQualificationFileRequest]) (*connect.Response[v1.AssembleDriverQualificationFileResponse], error)
 }
 
 // NewDocumentServiceClient constructs a client for the v1consortium.services.DocumentService
@@ -113,6 +154,30 @@ func NewDocumentServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(documentServiceMethods.ByName("UploadDocument")),
 			connect.WithClientOptions(opts...),
 		),
+		streamUploadDocument: connect.NewClient[v1.StreamUploadDocumentRequest, v1.StreamUploadDocumentResponse](
+			httpClient,
+			baseURL+DocumentServiceStreamUploadDocumentProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("StreamUploadDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getDocumentUploadSession: connect.NewClient[v1.GetDocumentUploadSessionRequest, v1.GetDocumentUploadSessionResponse](
+			httpClient,
+			baseURL+DocumentServiceGetDocumentUploadSessionProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("GetDocumentUploadSession")),
+			connect.WithClientOptions(opts...),
+		),
+		getDocumentUploadLimits: connect.NewClient[v1.GetDocumentUploadLimitsRequest, v1.GetDocumentUploadLimitsResponse](
+			httpClient,
+			baseURL+DocumentServiceGetDocumentUploadLimitsProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("GetDocumentUploadLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		setDocumentUploadLimits: connect.NewClient[v1.SetDocumentUploadLimitsRequest, v1.SetDocumentUploadLimitsResponse](
+			httpClient,
+			baseURL+DocumentServiceSetDocumentUploadLimitsProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("SetDocumentUploadLimits")),
+			connect.WithClientOptions(opts...),
+		),
 		getDocument: connect.NewClient[v1.GetDocumentRequest, v1.GetDocumentResponse](
 			httpClient,
 			baseURL+DocumentServiceGetDocumentProcedure,
@@ -143,6 +208,12 @@ func NewDocumentServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(documentServiceMethods.ByName("GetDocumentVersions")),
 			connect.WithClientOptions(opts...),
 		),
+		rollbackDocument: connect.NewClient[v1.RollbackDocumentRequest, v1.RollbackDocumentResponse](
+			httpClient,
+			baseURL+DocumentServiceRollbackDocumentProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("RollbackDocument")),
+			connect.WithClientOptions(opts...),
+		),
 		shareDocument: connect.NewClient[v1.ShareDocumentRequest, v1.ShareDocumentResponse](
 			httpClient,
 			baseURL+DocumentServiceShareDocumentProcedure,
@@ -185,24 +256,57 @@ func NewDocumentServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(documentServiceMethods.ByName("ApplyRetentionPolicy")),
 			connect.WithClientOptions(opts...),
 		),
+		placeLegalHold: connect.NewClient[v1.PlaceLegalHoldRequest, v1.PlaceLegalHoldResponse](
+			httpClient,
+			baseURL+DocumentServicePlaceLegalHoldProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("PlaceLegalHold")),
+			connect.WithClientOptions(opts...),
+		),
+		releaseLegalHold: connect.NewClient[v1.ReleaseLegalHoldRequest, v1.ReleaseLegalHoldResponse](
+			httpClient,
+			baseURL+DocumentServiceReleaseLegalHoldProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("ReleaseLegalHold")),
+			connect.WithClientOptions(opts...),
+		),
+		listLegalHolds: connect.NewClient[v1.ListLegalHoldsRequest, v1.ListLegalHoldsResponse](
+			httpClient,
+			baseURL+DocumentServiceListLegalHoldsProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("ListLegalHolds")),
+			connect.WithClientOptions(opts...),
+		),
+		assembleDriverQualificationFile: connect.NewClient[v1.AssembleDriverQualificationFileRequest, v1.AssembleDriverQualificationFileResponse](
+			httpClient,
+			baseURL+DocumentServiceAssembleDriverQualificationFileProcedure,
+			connect.WithSchema(documentServiceMethods.ByName("AssembleDriverQualificationFile")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // documentServiceClient implements DocumentServiceClient.
 type documentServiceClient struct {
-	uploadDocument             *connect.Client[v1.UploadDocumentRequest, v1.UploadDocumentResponse]
-	getDocument                *connect.Client[v1.GetDocumentRequest, v1.GetDocumentResponse]
-	listDocuments              *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
-	updateDocument             *connect.Client[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse]
-	deleteDocument             *connect.Client[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse]
-	getDocumentVersions        *connect.Client[v1.GetDocumentVersionsRequest, v1.GetDocumentVersionsResponse]
-	shareDocument              *connect.Client[v1.ShareDocumentRequest, v1.ShareDocumentResponse]
-	getSharedDocuments         *connect.Client[v1.GetSharedDocumentsRequest, v1.GetSharedDocumentsResponse]
-	revokeDocumentShare        *connect.Client[v1.RevokeDocumentShareRequest, v1.RevokeDocumentShareResponse]
-	searchDocuments            *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
-	getDocumentAnalytics       *connect.Client[v1.GetDocumentAnalyticsRequest, v1.GetDocumentAnalyticsResponse]
-	getDocumentRetentionStatus *connect.Client[v1.GetDocumentRetentionStatusRequest, v1.GetDocumentRetentionStatusResponse]
-	applyRetentionPolicy       *connect.Client[v1.ApplyRetentionPolicyRequest, v1.ApplyRetentionPolicyResponse]
+	uploadDocument                  *connect.Client[v1.UploadDocumentRequest, v1.UploadDocumentResponse]
+	streamUploadDocument            *connect.Client[v1.StreamUploadDocumentRequest, v1.StreamUploadDocumentResponse]
+	getDocumentUploadSession        *connect.Client[v1.GetDocumentUploadSessionRequest, v1.GetDocumentUploadSessionResponse]
+	getDocumentUploadLimits         *connect.Client[v1.GetDocumentUploadLimitsRequest, v1.GetDocumentUploadLimitsResponse]
+	setDocumentUploadLimits         *connect.Client[v1.SetDocumentUploadLimitsRequest, v1.SetDocumentUploadLimitsResponse]
+	getDocument                     *connect.Client[v1.GetDocumentRequest, v1.GetDocumentResponse]
+	listDocuments                   *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
+	updateDocument                  *connect.Client[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse]
+	deleteDocument                  *connect.Client[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse]
+	getDocumentVersions             *connect.Client[v1.GetDocumentVersionsRequest, v1.GetDocumentVersionsResponse]
+	rollbackDocument                *connect.Client[v1.RollbackDocumentRequest, v1.RollbackDocumentResponse]
+	shareDocument                   *connect.Client[v1.ShareDocumentRequest, v1.ShareDocumentResponse]
+	getSharedDocuments              *connect.Client[v1.GetSharedDocumentsRequest, v1.GetSharedDocumentsResponse]
+	revokeDocumentShare             *connect.Client[v1.RevokeDocumentShareRequest, v1.RevokeDocumentShareResponse]
+	searchDocuments                 *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
+	getDocumentAnalytics            *connect.Client[v1.GetDocumentAnalyticsRequest, v1.GetDocumentAnalyticsResponse]
+	getDocumentRetentionStatus      *connect.Client[v1.GetDocumentRetentionStatusRequest, v1.GetDocumentRetentionStatusResponse]
+	applyRetentionPolicy            *connect.Client[v1.ApplyRetentionPolicyRequest, v1.ApplyRetentionPolicyResponse]
+	placeLegalHold                  *connect.Client[v1.PlaceLegalHoldRequest, v1.PlaceLegalHoldResponse]
+	releaseLegalHold                *connect.Client[v1.ReleaseLegalHoldRequest, v1.ReleaseLegalHoldResponse]
+	listLegalHolds                  *connect.Client[v1.ListLegalHoldsRequest, v1.ListLegalHoldsResponse]
+	assembleDriverQualificationFile *connect.Client[v1.AssembleDriverQualificationFileRequest, v1.AssembleDriverQualificationFileResponse]
 }
 
 // UploadDocument calls v1consortium.services.DocumentService.UploadDocument.
@@ -210,6 +314,26 @@ func (c *documentServiceClient) UploadDocument(ctx context.Context, req *connect
 	return c.uploadDocument.CallUnary(ctx, req)
 }
 
+// StreamUploadDocument calls v1consortium.services.DocumentService.StreamUploadDocument.
+func (c *documentServiceClient) StreamUploadDocument(ctx context.Context) *connect.ClientStreamForClient[v1.StreamUploadDocumentRequest, v1.StreamUploadDocumentResponse] {
+	return c.streamUploadDocument.CallClientStream(ctx)
+}
+
+// GetDocumentUploadSession calls v1consortium.services.DocumentService.GetDocumentUploadSession.
+func (c *documentServiceClient) GetDocumentUploadSession(ctx context.Context, req *connect.Request[v1.GetDocumentUploadSessionRequest]) (*connect.Response[v1.GetDocumentUploadSessionResponse], error) {
+	return c.getDocumentUploadSession.CallUnary(ctx, req)
+}
+
+// GetDocumentUploadLimits calls v1consortium.services.DocumentService.GetDocumentUploadLimits.
+func (c *documentServiceClient) GetDocumentUploadLimits(ctx context.Context, req *connect.Request[v1.GetDocumentUploadLimitsRequest]) (*connect.Response[v1.GetDocumentUploadLimitsResponse], error) {
+	return c.getDocumentUploadLimits.CallUnary(ctx, req)
+}
+
+// SetDocumentUploadLimits calls v1consortium.services.DocumentService.SetDocumentUploadLimits.
+func (c *documentServiceClient) SetDocumentUploadLimits(ctx context.Context, req *connect.Request[v1.SetDocumentUploadLimitsRequest]) (*connect.Response[v1.SetDocumentUploadLimitsResponse], error) {
+	return c.setDocumentUploadLimits.CallUnary(ctx, req)
+}
+
 // GetDocument calls v1consortium.services.DocumentService.GetDocument.
 func (c *documentServiceClient) GetDocument(ctx context.Context, req *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error) {
 	return c.getDocument.CallUnary(ctx, req)
@@ -235,6 +359,11 @@ func (c *documentServiceClient) GetDocumentVersions(ctx context.Context, req *co
 	return c.getDocumentVersions.CallUnary(ctx, req)
 }
 
+// RollbackDocument calls v1consortium.services.DocumentService.RollbackDocument.
+func (c *documentServiceClient) RollbackDocument(ctx context.Context, req *connect.Request[v1.RollbackDocumentRequest]) (*connect.Response[v1.RollbackDocumentResponse], error) {
+	return c.rollbackDocument.CallUnary(ctx, req)
+}
+
 // ShareDocument calls v1consortium.services.DocumentService.ShareDocument.
 func (c *documentServiceClient) ShareDocument(ctx context.Context, req *connect.Request[v1.ShareDocumentRequest]) (*connect.Response[v1.ShareDocumentResponse], error) {
 	return c.shareDocument.CallUnary(ctx, req)
@@ -271,15 +400,44 @@ func (c *documentServiceClient) ApplyRetentionPolicy(ctx context.Context, req *c
 	return c.applyRetentionPolicy.CallUnary(ctx, req)
 }
 
+// PlaceLegalHold calls v1consortium.services.DocumentService.PlaceLegalHold.
+func (c *documentServiceClient) PlaceLegalHold(ctx context.Context, req *connect.Request[v1.PlaceLegalHoldRequest]) (*connect.Response[v1.PlaceLegalHoldResponse], error) {
+	return c.placeLegalHold.CallUnary(ctx, req)
+}
+
+// ReleaseLegalHold calls v1consortium.services.DocumentService.ReleaseLegalHold.
+func (c *documentServiceClient) ReleaseLegalHold(ctx context.Context, req *connect.Request[v1.ReleaseLegalHoldRequest]) (*connect.Response[v1.ReleaseLegalHoldResponse], error) {
+	return c.releaseLegalHold.CallUnary(ctx, req)
+}
+
+// ListLegalHolds calls v1consortium.services.DocumentService.ListLegalHolds.
+func (c *documentServiceClient) ListLegalHolds(ctx context.Context, req *connect.Request[v1.ListLegalHoldsRequest]) (*connect.Response[v1.ListLegalHoldsResponse], error) {
+	return c.listLegalHolds.CallUnary(ctx, req)
+}
+
+// AssembleDriverQualificationFile calls
+// v1consortium.services.DocumentService.AssembleDriverQualificationFile.
+func (c *documentServiceClient) AssembleDriverQualificationFile(ctx context.Context, req *connect.Request[v1.AssembleDriverQualificationFileRequest]) (*connect.Response[v1.AssembleDriverQualificationFileResponse], error) {
+	return c.assembleDriverQualificationFile.CallUnary(ctx, req)
+}
+
 // DocumentServiceHandler is an implementation of the v1consortium.services.DocumentService service.
 type DocumentServiceHandler interface {
 	// Document Management
 	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	// Streams a document in chunks after a header message. Interrupted uploads resume by sending
+	// the same upload session ID with the offset reported by GetDocumentUploadSession.
+	StreamUploadDocument(context.Context, *connect.ClientStream[v1.StreamUploadDocumentRequest]) (*connect.Response[v1.StreamUploadDocumentResponse], error)
+	GetDocumentUploadSession(context.Context, *connect.Request[v1.GetDocumentUploadSessionRequest]) (*connect.Response[v1.GetDocumentUploadSessionResponse], error)
+	GetDocumentUploadLimits(context.Context, *connect.Request[v1.GetDocumentUploadLimitsRequest]) (*connect.Response[v1.GetDocumentUploadLimitsResponse], error)
+	SetDocumentUploadLimits(context.Context, *connect.Request[v1.SetDocumentUploadLimitsRequest]) (*connect.Response[v1.SetDocumentUploadLimitsResponse], error)
 	GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error)
 	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
 	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
 	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
 	GetDocumentVersions(context.Context, *connect.Request[v1.GetDocumentVersionsRequest]) (*connect.Response[v1.GetDocumentVersionsResponse], error)
+	// Restores a prior version's content and file details as a new current version
+	RollbackDocument(context.Context, *connect.Request[v1.RollbackDocumentRequest]) (*connect.Response[v1.RollbackDocumentResponse], error)
 	// Document Sharing
 	ShareDocument(context.Context, *connect.Request[v1.ShareDocumentRequest]) (*connect.Response[v1.ShareDocumentResponse], error)
 	GetSharedDocuments(context.Context, *connect.Request[v1.GetSharedDocumentsRequest]) (*connect.Response[v1.GetSharedDocumentsResponse], error)
@@ -291,6 +449,12 @@ type DocumentServiceHandler interface {
 	// Document Compliance
 	GetDocumentRetentionStatus(context.Context, *connect.Request[v1.GetDocumentRetentionStatusRequest]) (*connect.Response[v1.GetDocumentRetentionStatusResponse], error)
 	ApplyRetentionPolicy(context.Context, *connect.Request[v1.ApplyRetentionPolicyRequest]) (*connect.Response[v1.ApplyRetentionPolicyResponse], error)
+	// Legal holds block deletion of an organization's, a user's or a single document's records
+	PlaceLegalHold(context.Context, *connect.Request[v1.PlaceLegalHoldRequest]) (*connect.Response[v1.PlaceLegalHoldResponse], error)
+	ReleaseLegalHold(context.Context, *connect.Request[v1.ReleaseLegalHoldRequest]) (*connect.Response[v1.ReleaseLegalHoldResponse], error)
+	ListLegalHolds(context.Context, *connect.Request[v1.ListLegalHoldsRequest]) (*connect.Response[v1.ListLegalHoldsResponse], error)
+	// Driver qualification file: every record FMCSA requires for a driver, bundled as one PDF or ZIP
+	AssembleDriverQualificationFile(context.Context, *connect.Request[v1.AssembleDriverQualificationFileRequest]) (*connect.Response[v1.AssembleDriverQualificationFileResponse], error)
 }
 
 // NewDocumentServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -306,6 +470,30 @@ func NewDocumentServiceHandler(svc DocumentServiceHandler, opts ...connect.Handl
 		connect.WithSchema(documentServiceMethods.ByName("UploadDocument")),
 		connect.WithHandlerOptions(opts...),
 	)
+	documentServiceStreamUploadDocumentHandler := connect.NewClientStreamHandler(
+		DocumentServiceStreamUploadDocumentProcedure,
+		svc.StreamUploadDocument,
+		connect.WithSchema(documentServiceMethods.ByName("StreamUploadDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceGetDocumentUploadSessionHandler := connect.NewUnaryHandler(
+		DocumentServiceGetDocumentUploadSessionProcedure,
+		svc.GetDocumentUploadSession,
+		connect.WithSchema(documentServiceMethods.ByName("GetDocumentUploadSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceGetDocumentUploadLimitsHandler := connect.NewUnaryHandler(
+		DocumentServiceGetDocumentUploadLimitsProcedure,
+		svc.GetDocumentUploadLimits,
+		connect.WithSchema(documentServiceMethods.ByName("GetDocumentUploadLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceSetDocumentUploadLimitsHandler := connect.NewUnaryHandler(
+		DocumentServiceSetDocumentUploadLimitsProcedure,
+		svc.SetDocumentUploadLimits,
+		connect.WithSchema(documentServiceMethods.ByName("SetDocumentUploadLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
 	documentServiceGetDocumentHandler := connect.NewUnaryHandler(
 		DocumentServiceGetDocumentProcedure,
 		svc.GetDocument,
@@ -336,6 +524,12 @@ func NewDocumentServiceHandler(svc DocumentServiceHandler, opts ...connect.Handl
 		connect.WithSchema(documentServiceMethods.ByName("GetDocumentVersions")),
 		connect.WithHandlerOptions(opts...),
 	)
+	documentServiceRollbackDocumentHandler := connect.NewUnaryHandler(
+		DocumentServiceRollbackDocumentProcedure,
+		svc.RollbackDocument,
+		connect.WithSchema(documentServiceMethods.ByName("RollbackDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
 	documentServiceShareDocumentHandler := connect.NewUnaryHandler(
 		DocumentServiceShareDocumentProcedure,
 		svc.ShareDocument,
@@ -378,10 +572,42 @@ func NewDocumentServiceHandler(svc DocumentServiceHandler, opts ...connect.Handl
 		connect.WithSchema(documentServiceMethods.ByName("ApplyRetentionPolicy")),
 		connect.WithHandlerOptions(opts...),
 	)
+	documentServicePlaceLegalHoldHandler := connect.NewUnaryHandler(
+		DocumentServicePlaceLegalHoldProcedure,
+		svc.PlaceLegalHold,
+		connect.WithSchema(documentServiceMethods.ByName("PlaceLegalHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceReleaseLegalHoldHandler := connect.NewUnaryHandler(
+		DocumentServiceReleaseLegalHoldProcedure,
+		svc.ReleaseLegalHold,
+		connect.WithSchema(documentServiceMethods.ByName("ReleaseLegalHold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceListLegalHoldsHandler := connect.NewUnaryHandler(
+		DocumentServiceListLegalHoldsProcedure,
+		svc.ListLegalHolds,
+		connect.WithSchema(documentServiceMethods.ByName("ListLegalHolds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	documentServiceAssembleDriverQualificationFileHandler := connect.NewUnaryHandler(
+		DocumentServiceAssembleDriverQualificationFileProcedure,
+		svc.AssembleDriverQualificationFile,
+		connect.WithSchema(documentServiceMethods.ByName("AssembleDriverQualificationFile")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1consortium.services.DocumentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DocumentServiceUploadDocumentProcedure:
 			documentServiceUploadDocumentHandler.ServeHTTP(w, r)
+		case DocumentServiceStreamUploadDocumentProcedure:
+			documentServiceStreamUploadDocumentHandler.ServeHTTP(w, r)
+		case DocumentServiceGetDocumentUploadSessionProcedure:
+			documentServiceGetDocumentUploadSessionHandler.ServeHTTP(w, r)
+		case DocumentServiceGetDocumentUploadLimitsProcedure:
+			documentServiceGetDocumentUploadLimitsHandler.ServeHTTP(w, r)
+		case DocumentServiceSetDocumentUploadLimitsProcedure:
+			documentServiceSetDocumentUploadLimitsHandler.ServeHTTP(w, r)
 		case DocumentServiceGetDocumentProcedure:
 			documentServiceGetDocumentHandler.ServeHTTP(w, r)
 		case DocumentServiceListDocumentsProcedure:
@@ -392,6 +618,8 @@ func NewDocumentServiceHandler(svc DocumentServiceHandler, opts ...connect.Handl
 			documentServiceDeleteDocumentHandler.ServeHTTP(w, r)
 		case DocumentServiceGetDocumentVersionsProcedure:
 			documentServiceGetDocumentVersionsHandler.ServeHTTP(w, r)
+		case DocumentServiceRollbackDocumentProcedure:
+			documentServiceRollbackDocumentHandler.ServeHTTP(w, r)
 		case DocumentServiceShareDocumentProcedure:
 			documentServiceShareDocumentHandler.ServeHTTP(w, r)
 		case DocumentServiceGetSharedDocumentsProcedure:
@@ -406,6 +634,14 @@ func NewDocumentServiceHandler(svc DocumentServiceHandler, opts ...connect.Handl
 			documentServiceGetDocumentRetentionStatusHandler.ServeHTTP(w, r)
 		case DocumentServiceApplyRetentionPolicyProcedure:
 			documentServiceApplyRetentionPolicyHandler.ServeHTTP(w, r)
+		case DocumentServicePlaceLegalHoldProcedure:
+			documentServicePlaceLegalHoldHandler.ServeHTTP(w, r)
+		case DocumentServiceReleaseLegalHoldProcedure:
+			documentServiceReleaseLegalHoldHandler.ServeHTTP(w, r)
+		case DocumentServiceListLegalHoldsProcedure:
+			documentServiceListLegalHoldsHandler.ServeHTTP(w, r)
+		case DocumentServiceAssembleDriverQualificationFileProcedure:
+			documentServiceAssembleDriverQualificationFileHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -419,6 +655,22 @@ func (UnimplementedDocumentServiceHandler) UploadDocument(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.UploadDocument is not implemented"))
 }
 
+func (UnimplementedDocumentServiceHandler) StreamUploadDocument(context.Context, *connect.ClientStream[v1.StreamUploadDocumentRequest]) (*connect.Response[v1.StreamUploadDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.StreamUploadDocument is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) GetDocumentUploadSession(context.Context, *connect.Request[v1.GetDocumentUploadSessionRequest]) (*connect.Response[v1.GetDocumentUploadSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.GetDocumentUploadSession is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) GetDocumentUploadLimits(context.Context, *connect.Request[v1.GetDocumentUploadLimitsRequest]) (*connect.Response[v1.GetDocumentUploadLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.GetDocumentUploadLimits is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) SetDocumentUploadLimits(context.Context, *connect.Request[v1.SetDocumentUploadLimitsRequest]) (*connect.Response[v1.SetDocumentUploadLimitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.SetDocumentUploadLimits is not implemented"))
+}
+
 func (UnimplementedDocumentServiceHandler) GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.GetDocument is not implemented"))
 }
@@ -439,6 +691,10 @@ func (UnimplementedDocumentServiceHandler) GetDocumentVersions(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.GetDocumentVersions is not implemented"))
 }
 
+func (UnimplementedDocumentServiceHandler) RollbackDocument(context.Context, *connect.Request[v1.RollbackDocumentRequest]) (*connect.Response[v1.RollbackDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.RollbackDocument is not implemented"))
+}
+
 func (UnimplementedDocumentServiceHandler) ShareDocument(context.Context, *connect.Request[v1.ShareDocumentRequest]) (*connect.Response[v1.ShareDocumentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.ShareDocument is not implemented"))
 }
@@ -466,3 +722,19 @@ func (UnimplementedDocumentServiceHandler) GetDocumentRetentionStatus(context.Co
 func (UnimplementedDocumentServiceHandler) ApplyRetentionPolicy(context.Context, *connect.Request[v1.ApplyRetentionPolicyRequest]) (*connect.Response[v1.ApplyRetentionPolicyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.ApplyRetentionPolicy is not implemented"))
 }
+
+func (UnimplementedDocumentServiceHandler) PlaceLegalHold(context.Context, *connect.Request[v1.PlaceLegalHoldRequest]) (*connect.Response[v1.PlaceLegalHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.PlaceLegalHold is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) ReleaseLegalHold(context.Context, *connect.Request[v1.ReleaseLegalHoldRequest]) (*connect.Response[v1.ReleaseLegalHoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.ReleaseLegalHold is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) ListLegalHolds(context.Context, *connect.Request[v1.ListLegalHoldsRequest]) (*connect.Response[v1.ListLegalHoldsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.ListLegalHolds is not implemented"))
+}
+
+func (UnimplementedDocumentServiceHandler) AssembleDriverQualificationFile(context.Context, *connect.Request[v1.AssembleDriverQualificationFileRequest]) (*connect.Response[v1.AssembleDriverQualificationFileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DocumentService.AssembleDriverQualificationFile is not implemented"))
+}
@@ -45,6 +45,27 @@ const (
 	// DOTPhysicalServiceListDOTPhysicalsProcedure is the fully-qualified name of the
 	// DOTPhysicalService's ListDOTPhysicals RPC.
 	DOTPhysicalServiceListDOTPhysicalsProcedure = "/v1consortium.services.DOTPhysicalService/ListDOTPhysicals"
+	// DOTPhysicalServiceRescheduleDOTPhysicalProcedure is the fully-qualified name of the
+	// DOTPhysicalService's RescheduleDOTPhysical RPC.
+	DOTPhysicalServiceRescheduleDOTPhysicalProcedure = "/v1consortium.services.DOTPhysicalService/RescheduleDOTPhysical"
+	// DOTPhysicalServiceCancelDOTPhysicalProcedure is the fully-qualified name of the
+	// DOTPhysicalService's CancelDOTPhysical RPC.
+	DOTPhysicalServiceCancelDOTPhysicalProcedure = "/v1consortium.services.DOTPhysicalService/CancelDOTPhysical"
+	// DOTPhysicalServiceMarkDOTPhysicalNoShowProcedure is the fully-qualified name of the
+	// DOTPhysicalService's MarkDOTPhysicalNoShow RPC.
+	DOTPhysicalServiceMarkDOTPhysicalNoShowProcedure = "/v1consortium.services.DOTPhysicalService/MarkDOTPhysicalNoShow"
+	// DOTPhysicalServiceListMedicalFollowUpTasksProcedure is the fully-qualified name of the
+	// DOTPhysicalService's ListMedicalFollowUpTasks RPC.
+	DOTPhysicalServiceListMedicalFollowUpTasksProcedure = "/v1consortium.services.DOTPhysicalService/ListMedicalFollowUpTasks"
+	// DOTPhysicalServiceCompleteMedicalFollowUpTaskProcedure is the fully-qualified name of the
+	// DOTPhysicalService's CompleteMedicalFollowUpTask RPC.
+	DOTPhysicalServiceCompleteMedicalFollowUpTaskProcedure = "/v1consortium.services.DOTPhysicalService/CompleteMedicalFollowUpTask"
+	// DOTPhysicalServiceCreateClinicAvailabilityProcedure is the fully-qualified name of the
+	// DOTPhysicalService's CreateClinicAvailability RPC.
+	DOTPhysicalServiceCreateClinicAvailabilityProcedure = "/v1consortium.services.DOTPhysicalService/CreateClinicAvailability"
+	// DOTPhysicalServiceListClinicAvailabilityProcedure is the fully-qualified name of the
+	// DOTPhysicalService's ListClinicAvailability RPC.
+	DOTPhysicalServiceListClinicAvailabilityProcedure = "/v1consortium.services.DOTPhysicalService/ListClinicAvailability"
 	// DOTPhysicalServiceRegisterMedicalExaminerProcedure is the fully-qualified name of the
 	// DOTPhysicalService's RegisterMedicalExaminer RPC.
 	DOTPhysicalServiceRegisterMedicalExaminerProcedure = "/v1consortium.services.DOTPhysicalService/RegisterMedicalExaminer"
@@ -54,6 +75,9 @@ const (
 	// DOTPhysicalServiceListMedicalExaminersProcedure is the fully-qualified name of the
 	// DOTPhysicalService's ListMedicalExaminers RPC.
 	DOTPhysicalServiceListMedicalExaminersProcedure = "/v1consortium.services.DOTPhysicalService/ListMedicalExaminers"
+	// DOTPhysicalServiceImportMedicalExaminersProcedure is the fully-qualified name of the
+	// DOTPhysicalService's ImportMedicalExaminers RPC.
+	DOTPhysicalServiceImportMedicalExaminersProcedure = "/v1consortium.services.DOTPhysicalService/ImportMedicalExaminers"
 	// DOTPhysicalServiceGenerateCertificateProcedure is the fully-qualified name of the
 	// DOTPhysicalService's GenerateCertificate RPC.
 	DOTPhysicalServiceGenerateCertificateProcedure = "/v1consortium.services.DOTPhysicalService/GenerateCertificate"
@@ -75,10 +99,20 @@ type DOTPhysicalServiceClient interface {
 	GetDOTPhysical(context.Context, *connect.Request[v1.GetDOTPhysicalRequest]) (*connect.Response[v1.GetDOTPhysicalResponse], error)
 	UpdateDOTPhysical(context.Context, *connect.Request[v1.UpdateDOTPhysicalRequest]) (*connect.Response[v1.UpdateDOTPhysicalResponse], error)
 	ListDOTPhysicals(context.Context, *connect.Request[v1.ListDOTPhysicalsRequest]) (*connect.Response[v1.ListDOTPhysicalsResponse], error)
+	RescheduleDOTPhysical(context.Context, *connect.Request[v1.RescheduleDOTPhysicalRequest]) (*connect.Response[v1.RescheduleDOTPhysicalResponse], error)
+	CancelDOTPhysical(context.Context, *connect.Request[v1.CancelDOTPhysicalRequest]) (*connect.Response[v1.CancelDOTPhysicalResponse], error)
+	MarkDOTPhysicalNoShow(context.Context, *connect.Request[v1.MarkDOTPhysicalNoShowRequest]) (*connect.Response[v1.MarkDOTPhysicalNoShowResponse], error)
+	// Medical Follow-Up
+	ListMedicalFollowUpTasks(context.Context, *connect.Request[v1.ListMedicalFollowUpTasksRequest]) (*connect.Response[v1.ListMedicalFollowUpTasksResponse], error)
+	CompleteMedicalFollowUpTask(context.Context, *connect.Request[v1.CompleteMedicalFollowUpTaskRequest]) (*connect.Response[v1.CompleteMedicalFollowUpTaskResponse], error)
+	// Clinic Availability
+	CreateClinicAvailability(context.Context, *connect.Request[v1.CreateClinicAvailabilityRequest]) (*connect.Response[v1.CreateClinicAvailabilityResponse], error)
+	ListClinicAvailability(context.Context, *connect.Request[v1.ListClinicAvailabilityRequest]) (*connect.Response[v1.ListClinicAvailabilityResponse], error)
 	// Medical Examiner Management
 	RegisterMedicalExaminer(context.Context, *connect.Request[v1.RegisterMedicalExaminerRequest]) (*connect.Response[v1.RegisterMedicalExaminerResponse], error)
 	GetMedicalExaminer(context.Context, *connect.Request[v1.GetMedicalExaminerRequest]) (*connect.Response[v1.GetMedicalExaminerResponse], error)
 	ListMedicalExaminers(context.Context, *connect.Request[v1.ListMedicalExaminersRequest]) (*connect.Response[v1.ListMedicalExaminersResponse], error)
+	ImportMedicalExaminers(context.Context, *connect.Request[v1.ImportMedicalExaminersRequest]) (*connect.Response[v1.ImportMedicalExaminersResponse], error)
 	// Certificate Management
 	GenerateCertificate(context.Context, *connect.Request[v1.GenerateCertificateRequest]) (*connect.Response[v1.GenerateCertificateResponse], error)
 	ValidateCertificate(context.Context, *connect.Request[v1.ValidateCertificateRequest]) (*connect.Response[v1.ValidateCertificateResponse], error)
@@ -122,6 +156,48 @@ func NewDOTPhysicalServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListDOTPhysicals")),
 			connect.WithClientOptions(opts...),
 		),
+		rescheduleDOTPhysical: connect.NewClient[v1.RescheduleDOTPhysicalRequest, v1.RescheduleDOTPhysicalResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceRescheduleDOTPhysicalProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("RescheduleDOTPhysical")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelDOTPhysical: connect.NewClient[v1.CancelDOTPhysicalRequest, v1.CancelDOTPhysicalResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceCancelDOTPhysicalProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("CancelDOTPhysical")),
+			connect.WithClientOptions(opts...),
+		),
+		markDOTPhysicalNoShow: connect.NewClient[v1.MarkDOTPhysicalNoShowRequest, v1.MarkDOTPhysicalNoShowResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceMarkDOTPhysicalNoShowProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("MarkDOTPhysicalNoShow")),
+			connect.WithClientOptions(opts...),
+		),
+		listMedicalFollowUpTasks: connect.NewClient[v1.ListMedicalFollowUpTasksRequest, v1.ListMedicalFollowUpTasksResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceListMedicalFollowUpTasksProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListMedicalFollowUpTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		completeMedicalFollowUpTask: connect.NewClient[v1.CompleteMedicalFollowUpTaskRequest, v1.CompleteMedicalFollowUpTaskResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceCompleteMedicalFollowUpTaskProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("CompleteMedicalFollowUpTask")),
+			connect.WithClientOptions(opts...),
+		),
+		createClinicAvailability: connect.NewClient[v1.CreateClinicAvailabilityRequest, v1.CreateClinicAvailabilityResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceCreateClinicAvailabilityProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("CreateClinicAvailability")),
+			connect.WithClientOptions(opts...),
+		),
+		listClinicAvailability: connect.NewClient[v1.ListClinicAvailabilityRequest, v1.ListClinicAvailabilityResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceListClinicAvailabilityProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListClinicAvailability")),
+			connect.WithClientOptions(opts...),
+		),
 		registerMedicalExaminer: connect.NewClient[v1.RegisterMedicalExaminerRequest, v1.RegisterMedicalExaminerResponse](
 			httpClient,
 			baseURL+DOTPhysicalServiceRegisterMedicalExaminerProcedure,
@@ -140,6 +216,12 @@ func NewDOTPhysicalServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListMedicalExaminers")),
 			connect.WithClientOptions(opts...),
 		),
+		importMedicalExaminers: connect.NewClient[v1.ImportMedicalExaminersRequest, v1.ImportMedicalExaminersResponse](
+			httpClient,
+			baseURL+DOTPhysicalServiceImportMedicalExaminersProcedure,
+			connect.WithSchema(dOTPhysicalServiceMethods.ByName("ImportMedicalExaminers")),
+			connect.WithClientOptions(opts...),
+		),
 		generateCertificate: connect.NewClient[v1.GenerateCertificateRequest, v1.GenerateCertificateResponse](
 			httpClient,
 			baseURL+DOTPhysicalServiceGenerateCertificateProcedure,
@@ -169,17 +251,25 @@ func NewDOTPhysicalServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // dOTPhysicalServiceClient implements DOTPhysicalServiceClient.
 type dOTPhysicalServiceClient struct {
-	scheduleDOTPhysical     *connect.Client[v1.ScheduleDOTPhysicalRequest, v1.ScheduleDOTPhysicalResponse]
-	getDOTPhysical          *connect.Client[v1.GetDOTPhysicalRequest, v1.GetDOTPhysicalResponse]
-	updateDOTPhysical       *connect.Client[v1.UpdateDOTPhysicalRequest, v1.UpdateDOTPhysicalResponse]
-	listDOTPhysicals        *connect.Client[v1.ListDOTPhysicalsRequest, v1.ListDOTPhysicalsResponse]
-	registerMedicalExaminer *connect.Client[v1.RegisterMedicalExaminerRequest, v1.RegisterMedicalExaminerResponse]
-	getMedicalExaminer      *connect.Client[v1.GetMedicalExaminerRequest, v1.GetMedicalExaminerResponse]
-	listMedicalExaminers    *connect.Client[v1.ListMedicalExaminersRequest, v1.ListMedicalExaminersResponse]
-	generateCertificate     *connect.Client[v1.GenerateCertificateRequest, v1.GenerateCertificateResponse]
-	validateCertificate     *connect.Client[v1.ValidateCertificateRequest, v1.ValidateCertificateResponse]
-	getExpiringCertificates *connect.Client[v1.GetExpiringCertificatesRequest, v1.GetExpiringCertificatesResponse]
-	setExpirationReminder   *connect.Client[v1.SetExpirationReminderRequest, v1.SetExpirationReminderResponse]
+	scheduleDOTPhysical         *connect.Client[v1.ScheduleDOTPhysicalRequest, v1.ScheduleDOTPhysicalResponse]
+	getDOTPhysical              *connect.Client[v1.GetDOTPhysicalRequest, v1.GetDOTPhysicalResponse]
+	updateDOTPhysical           *connect.Client[v1.UpdateDOTPhysicalRequest, v1.UpdateDOTPhysicalResponse]
+	listDOTPhysicals            *connect.Client[v1.ListDOTPhysicalsRequest, v1.ListDOTPhysicalsResponse]
+	rescheduleDOTPhysical       *connect.Client[v1.RescheduleDOTPhysicalRequest, v1.RescheduleDOTPhysicalResponse]
+	cancelDOTPhysical           *connect.Client[v1.CancelDOTPhysicalRequest, v1.CancelDOTPhysicalResponse]
+	markDOTPhysicalNoShow       *connect.Client[v1.MarkDOTPhysicalNoShowRequest, v1.MarkDOTPhysicalNoShowResponse]
+	listMedicalFollowUpTasks    *connect.Client[v1.ListMedicalFollowUpTasksRequest, v1.ListMedicalFollowUpTasksResponse]
+	completeMedicalFollowUpTask *connect.Client[v1.CompleteMedicalFollowUpTaskRequest, v1.CompleteMedicalFollowUpTaskResponse]
+	createClinicAvailability    *connect.Client[v1.CreateClinicAvailabilityRequest, v1.CreateClinicAvailabilityResponse]
+	listClinicAvailability      *connect.Client[v1.ListClinicAvailabilityRequest, v1.ListClinicAvailabilityResponse]
+	registerMedicalExaminer     *connect.Client[v1.RegisterMedicalExaminerRequest, v1.RegisterMedicalExaminerResponse]
+	getMedicalExaminer          *connect.Client[v1.GetMedicalExaminerRequest, v1.GetMedicalExaminerResponse]
+	listMedicalExaminers        *connect.Client[v1.ListMedicalExaminersRequest, v1.ListMedicalExaminersResponse]
+	importMedicalExaminers      *connect.Client[v1.ImportMedicalExaminersRequest, v1.ImportMedicalExaminersResponse]
+	generateCertificate         *connect.Client[v1.GenerateCertificateRequest, v1.GenerateCertificateResponse]
+	validateCertificate         *connect.Client[v1.ValidateCertificateRequest, v1.ValidateCertificateResponse]
+	getExpiringCertificates     *connect.Client[v1.GetExpiringCertificatesRequest, v1.GetExpiringCertificatesResponse]
+	setExpirationReminder       *connect.Client[v1.SetExpirationReminderRequest, v1.SetExpirationReminderResponse]
 }
 
 // ScheduleDOTPhysical calls v1consortium.services.DOTPhysicalService.ScheduleDOTPhysical.
@@ -202,6 +292,42 @@ func (c *dOTPhysicalServiceClient) ListDOTPhysicals(ctx context.Context, req *co
 	return c.listDOTPhysicals.CallUnary(ctx, req)
 }
 
+// RescheduleDOTPhysical calls v1consortium.services.DOTPhysicalService.RescheduleDOTPhysical.
+func (c *dOTPhysicalServiceClient) RescheduleDOTPhysical(ctx context.Context, req *connect.Request[v1.RescheduleDOTPhysicalRequest]) (*connect.Response[v1.RescheduleDOTPhysicalResponse], error) {
+	return c.rescheduleDOTPhysical.CallUnary(ctx, req)
+}
+
+// CancelDOTPhysical calls v1consortium.services.DOTPhysicalService.CancelDOTPhysical.
+func (c *dOTPhysicalServiceClient) CancelDOTPhysical(ctx context.Context, req *connect.Request[v1.CancelDOTPhysicalRequest]) (*connect.Response[v1.CancelDOTPhysicalResponse], error) {
+	return c.cancelDOTPhysical.CallUnary(ctx, req)
+}
+
+// MarkDOTPhysicalNoShow calls v1consortium.services.DOTPhysicalService.MarkDOTPhysicalNoShow.
+func (c *dOTPhysicalServiceClient) MarkDOTPhysicalNoShow(ctx context.Context, req *connect.Request[v1.MarkDOTPhysicalNoShowRequest]) (*connect.Response[v1.MarkDOTPhysicalNoShowResponse], error) {
+	return c.markDOTPhysicalNoShow.CallUnary(ctx, req)
+}
+
+// ListMedicalFollowUpTasks calls v1consortium.services.DOTPhysicalService.ListMedicalFollowUpTasks.
+func (c *dOTPhysicalServiceClient) ListMedicalFollowUpTasks(ctx context.Context, req *connect.Request[v1.ListMedicalFollowUpTasksRequest]) (*connect.Response[v1.ListMedicalFollowUpTasksResponse], error) {
+	return c.listMedicalFollowUpTasks.CallUnary(ctx, req)
+}
+
+// CompleteMedicalFollowUpTask calls
+// v1consortium.services.DOTPhysicalService.CompleteMedicalFollowUpTask.
+func (c *dOTPhysicalServiceClient) CompleteMedicalFollowUpTask(ctx context.Context, req *connect.Request[v1.CompleteMedicalFollowUpTaskRequest]) (*connect.Response[v1.CompleteMedicalFollowUpTaskResponse], error) {
+	return c.completeMedicalFollowUpTask.CallUnary(ctx, req)
+}
+
+// CreateClinicAvailability calls v1consortium.services.DOTPhysicalService.CreateClinicAvailability.
+func (c *dOTPhysicalServiceClient) CreateClinicAvailability(ctx context.Context, req *connect.Request[v1.CreateClinicAvailabilityRequest]) (*connect.Response[v1.CreateClinicAvailabilityResponse], error) {
+	return c.createClinicAvailability.CallUnary(ctx, req)
+}
+
+// ListClinicAvailability calls v1consortium.services.DOTPhysicalService.ListClinicAvailability.
+func (c *dOTPhysicalServiceClient) ListClinicAvailability(ctx context.Context, req *connect.Request[v1.ListClinicAvailabilityRequest]) (*connect.Response[v1.ListClinicAvailabilityResponse], error) {
+	return c.listClinicAvailability.CallUnary(ctx, req)
+}
+
 // RegisterMedicalExaminer calls v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer.
 func (c *dOTPhysicalServiceClient) RegisterMedicalExaminer(ctx context.Context, req *connect.Request[v1.RegisterMedicalExaminerRequest]) (*connect.Response[v1.RegisterMedicalExaminerResponse], error) {
 	return c.registerMedicalExaminer.CallUnary(ctx, req)
@@ -217,6 +343,11 @@ func (c *dOTPhysicalServiceClient) ListMedicalExaminers(ctx context.Context, req
 	return c.listMedicalExaminers.CallUnary(ctx, req)
 }
 
+// ImportMedicalExaminers calls v1consortium.services.DOTPhysicalService.ImportMedicalExaminers.
+func (c *dOTPhysicalServiceClient) ImportMedicalExaminers(ctx context.Context, req *connect.Request[v1.ImportMedicalExaminersRequest]) (*connect.Response[v1.ImportMedicalExaminersResponse], error) {
+	return c.importMedicalExaminers.CallUnary(ctx, req)
+}
+
 // GenerateCertificate calls v1consortium.services.DOTPhysicalService.GenerateCertificate.
 func (c *dOTPhysicalServiceClient) GenerateCertificate(ctx context.Context, req *connect.Request[v1.GenerateCertificateRequest]) (*connect.Response[v1.GenerateCertificateResponse], error) {
 	return c.generateCertificate.CallUnary(ctx, req)
@@ -245,10 +376,20 @@ type DOTPhysicalServiceHandler interface {
 	GetDOTPhysical(context.Context, *connect.Request[v1.GetDOTPhysicalRequest]) (*connect.Response[v1.GetDOTPhysicalResponse], error)
 	UpdateDOTPhysical(context.Context, *connect.Request[v1.UpdateDOTPhysicalRequest]) (*connect.Response[v1.UpdateDOTPhysicalResponse], error)
 	ListDOTPhysicals(context.Context, *connect.Request[v1.ListDOTPhysicalsRequest]) (*connect.Response[v1.ListDOTPhysicalsResponse], error)
+	RescheduleDOTPhysical(context.Context, *connect.Request[v1.RescheduleDOTPhysicalRequest]) (*connect.Response[v1.RescheduleDOTPhysicalResponse], error)
+	CancelDOTPhysical(context.Context, *connect.Request[v1.CancelDOTPhysicalRequest]) (*connect.Response[v1.CancelDOTPhysicalResponse], error)
+	MarkDOTPhysicalNoShow(context.Context, *connect.Request[v1.MarkDOTPhysicalNoShowRequest]) (*connect.Response[v1.MarkDOTPhysicalNoShowResponse], error)
+	// Medical Follow-Up
+	ListMedicalFollowUpTasks(context.Context, *connect.Request[v1.ListMedicalFollowUpTasksRequest]) (*connect.Response[v1.ListMedicalFollowUpTasksResponse], error)
+	CompleteMedicalFollowUpTask(context.Context, *connect.Request[v1.CompleteMedicalFollowUpTaskRequest]) (*connect.Response[v1.CompleteMedicalFollowUpTaskResponse], error)
+	// Clinic Availability
+	CreateClinicAvailability(context.Context, *connect.Request[v1.CreateClinicAvailabilityRequest]) (*connect.Response[v1.CreateClinicAvailabilityResponse], error)
+	ListClinicAvailability(context.Context, *connect.Request[v1.ListClinicAvailabilityRequest]) (*connect.Response[v1.ListClinicAvailabilityResponse], error)
 	// Medical Examiner Management
 	RegisterMedicalExaminer(context.Context, *connect.Request[v1.RegisterMedicalExaminerRequest]) (*connect.Response[v1.RegisterMedicalExaminerResponse], error)
 	GetMedicalExaminer(context.Context, *connect.Request[v1.GetMedicalExaminerRequest]) (*connect.Response[v1.GetMedicalExaminerResponse], error)
 	ListMedicalExaminers(context.Context, *connect.Request[v1.ListMedicalExaminersRequest]) (*connect.Response[v1.ListMedicalExaminersResponse], error)
+	ImportMedicalExaminers(context.Context, *connect.Request[v1.ImportMedicalExaminersRequest]) (*connect.Response[v1.ImportMedicalExaminersResponse], error)
 	// Certificate Management
 	GenerateCertificate(context.Context, *connect.Request[v1.GenerateCertificateRequest]) (*connect.Response[v1.GenerateCertificateResponse], error)
 	ValidateCertificate(context.Context, *connect.Request[v1.ValidateCertificateRequest]) (*connect.Response[v1.ValidateCertificateResponse], error)
@@ -288,6 +429,48 @@ func NewDOTPhysicalServiceHandler(svc DOTPhysicalServiceHandler, opts ...connect
 		connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListDOTPhysicals")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dOTPhysicalServiceRescheduleDOTPhysicalHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceRescheduleDOTPhysicalProcedure,
+		svc.RescheduleDOTPhysical,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("RescheduleDOTPhysical")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceCancelDOTPhysicalHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceCancelDOTPhysicalProcedure,
+		svc.CancelDOTPhysical,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("CancelDOTPhysical")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceMarkDOTPhysicalNoShowHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceMarkDOTPhysicalNoShowProcedure,
+		svc.MarkDOTPhysicalNoShow,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("MarkDOTPhysicalNoShow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceListMedicalFollowUpTasksHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceListMedicalFollowUpTasksProcedure,
+		svc.ListMedicalFollowUpTasks,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListMedicalFollowUpTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceCompleteMedicalFollowUpTaskHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceCompleteMedicalFollowUpTaskProcedure,
+		svc.CompleteMedicalFollowUpTask,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("CompleteMedicalFollowUpTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceCreateClinicAvailabilityHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceCreateClinicAvailabilityProcedure,
+		svc.CreateClinicAvailability,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("CreateClinicAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
+	dOTPhysicalServiceListClinicAvailabilityHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceListClinicAvailabilityProcedure,
+		svc.ListClinicAvailability,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListClinicAvailability")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dOTPhysicalServiceRegisterMedicalExaminerHandler := connect.NewUnaryHandler(
 		DOTPhysicalServiceRegisterMedicalExaminerProcedure,
 		svc.RegisterMedicalExaminer,
@@ -306,6 +489,12 @@ func NewDOTPhysicalServiceHandler(svc DOTPhysicalServiceHandler, opts ...connect
 		connect.WithSchema(dOTPhysicalServiceMethods.ByName("ListMedicalExaminers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	dOTPhysicalServiceImportMedicalExaminersHandler := connect.NewUnaryHandler(
+		DOTPhysicalServiceImportMedicalExaminersProcedure,
+		svc.ImportMedicalExaminers,
+		connect.WithSchema(dOTPhysicalServiceMethods.ByName("ImportMedicalExaminers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	dOTPhysicalServiceGenerateCertificateHandler := connect.NewUnaryHandler(
 		DOTPhysicalServiceGenerateCertificateProcedure,
 		svc.GenerateCertificate,
@@ -340,12 +529,28 @@ func NewDOTPhysicalServiceHandler(svc DOTPhysicalServiceHandler, opts ...connect
 			dOTPhysicalServiceUpdateDOTPhysicalHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceListDOTPhysicalsProcedure:
 			dOTPhysicalServiceListDOTPhysicalsHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceRescheduleDOTPhysicalProcedure:
+			dOTPhysicalServiceRescheduleDOTPhysicalHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceCancelDOTPhysicalProcedure:
+			dOTPhysicalServiceCancelDOTPhysicalHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceMarkDOTPhysicalNoShowProcedure:
+			dOTPhysicalServiceMarkDOTPhysicalNoShowHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceListMedicalFollowUpTasksProcedure:
+			dOTPhysicalServiceListMedicalFollowUpTasksHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceCompleteMedicalFollowUpTaskProcedure:
+			dOTPhysicalServiceCompleteMedicalFollowUpTaskHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceCreateClinicAvailabilityProcedure:
+			dOTPhysicalServiceCreateClinicAvailabilityHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceListClinicAvailabilityProcedure:
+			dOTPhysicalServiceListClinicAvailabilityHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceRegisterMedicalExaminerProcedure:
 			dOTPhysicalServiceRegisterMedicalExaminerHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceGetMedicalExaminerProcedure:
 			dOTPhysicalServiceGetMedicalExaminerHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceListMedicalExaminersProcedure:
 			dOTPhysicalServiceListMedicalExaminersHandler.ServeHTTP(w, r)
+		case DOTPhysicalServiceImportMedicalExaminersProcedure:
+			dOTPhysicalServiceImportMedicalExaminersHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceGenerateCertificateProcedure:
 			dOTPhysicalServiceGenerateCertificateHandler.ServeHTTP(w, r)
 		case DOTPhysicalServiceValidateCertificateProcedure:
@@ -379,6 +584,34 @@ func (UnimplementedDOTPhysicalServiceHandler) ListDOTPhysicals(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.ListDOTPhysicals is not implemented"))
 }
 
+func (UnimplementedDOTPhysicalServiceHandler) RescheduleDOTPhysical(context.Context, *connect.Request[v1.RescheduleDOTPhysicalRequest]) (*connect.Response[v1.RescheduleDOTPhysicalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.RescheduleDOTPhysical is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) CancelDOTPhysical(context.Context, *connect.Request[v1.CancelDOTPhysicalRequest]) (*connect.Response[v1.CancelDOTPhysicalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.CancelDOTPhysical is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) MarkDOTPhysicalNoShow(context.Context, *connect.Request[v1.MarkDOTPhysicalNoShowRequest]) (*connect.Response[v1.MarkDOTPhysicalNoShowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.MarkDOTPhysicalNoShow is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) ListMedicalFollowUpTasks(context.Context, *connect.Request[v1.ListMedicalFollowUpTasksRequest]) (*connect.Response[v1.ListMedicalFollowUpTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.ListMedicalFollowUpTasks is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) CompleteMedicalFollowUpTask(context.Context, *connect.Request[v1.CompleteMedicalFollowUpTaskRequest]) (*connect.Response[v1.CompleteMedicalFollowUpTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.CompleteMedicalFollowUpTask is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) CreateClinicAvailability(context.Context, *connect.Request[v1.CreateClinicAvailabilityRequest]) (*connect.Response[v1.CreateClinicAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.CreateClinicAvailability is not implemented"))
+}
+
+func (UnimplementedDOTPhysicalServiceHandler) ListClinicAvailability(context.Context, *connect.Request[v1.ListClinicAvailabilityRequest]) (*connect.Response[v1.ListClinicAvailabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.ListClinicAvailability is not implemented"))
+}
+
 func (UnimplementedDOTPhysicalServiceHandler) RegisterMedicalExaminer(context.Context, *connect.Request[v1.RegisterMedicalExaminerRequest]) (*connect.Response[v1.RegisterMedicalExaminerResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.RegisterMedicalExaminer is not implemented"))
 }
@@ -391,6 +624,10 @@ func (UnimplementedDOTPhysicalServiceHandler) ListMedicalExaminers(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.ListMedicalExaminers is not implemented"))
 }
 
+func (UnimplementedDOTPhysicalServiceHandler) ImportMedicalExaminers(context.Context, *connect.Request[v1.ImportMedicalExaminersRequest]) (*connect.Response[v1.ImportMedicalExaminersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.ImportMedicalExaminers is not implemented"))
+}
+
 func (UnimplementedDOTPhysicalServiceHandler) GenerateCertificate(context.Context, *connect.Request[v1.GenerateCertificateRequest]) (*connect.Response[v1.GenerateCertificateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.DOTPhysicalService.GenerateCertificate is not implemented"))
 }
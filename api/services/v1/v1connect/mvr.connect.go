@@ -40,6 +40,9 @@ const (
 	// MVRServiceUpdateMVRReportProcedure is the fully-qualified name of the MVRService's
 	// UpdateMVRReport RPC.
 	MVRServiceUpdateMVRReportProcedure = "/v1consortium.services.MVRService/UpdateMVRReport"
+	// MVRServiceParseMVRReportProcedure is the fully-qualified name of the MVRService's ParseMVRReport
+	// RPC.
+	MVRServiceParseMVRReportProcedure = "/v1consortium.services.MVRService/ParseMVRReport"
 	// MVRServiceListMVRReportsProcedure is the fully-qualified name of the MVRService's ListMVRReports
 	// RPC.
 	MVRServiceListMVRReportsProcedure = "/v1consortium.services.MVRService/ListMVRReports"
@@ -58,6 +61,12 @@ const (
 	// MVRServiceGetMonitoringStatusProcedure is the fully-qualified name of the MVRService's
 	// GetMonitoringStatus RPC.
 	MVRServiceGetMonitoringStatusProcedure = "/v1consortium.services.MVRService/GetMonitoringStatus"
+	// MVRServiceListMVRReviewsProcedure is the fully-qualified name of the MVRService's ListMVRReviews
+	// RPC.
+	MVRServiceListMVRReviewsProcedure = "/v1consortium.services.MVRService/ListMVRReviews"
+	// MVRServiceSignOffMVRReviewProcedure is the fully-qualified name of the MVRService's
+	// SignOffMVRReview RPC.
+	MVRServiceSignOffMVRReviewProcedure = "/v1consortium.services.MVRService/SignOffMVRReview"
 	// MVRServiceGetMVRAnalyticsProcedure is the fully-qualified name of the MVRService's
 	// GetMVRAnalytics RPC.
 	MVRServiceGetMVRAnalyticsProcedure = "/v1consortium.services.MVRService/GetMVRAnalytics"
@@ -72,6 +81,7 @@ type MVRServiceClient interface {
 	OrderMVR(context.Context, *connect.Request[v1.OrderMVRRequest]) (*connect.Response[v1.OrderMVRResponse], error)
 	GetMVRReport(context.Context, *connect.Request[v1.GetMVRReportRequest]) (*connect.Response[v1.GetMVRReportResponse], error)
 	UpdateMVRReport(context.Context, *connect.Request[v1.UpdateMVRReportRequest]) (*connect.Response[v1.UpdateMVRReportResponse], error)
+	ParseMVRReport(context.Context, *connect.Request[v1.ParseMVRReportRequest]) (*connect.Response[v1.ParseMVRReportResponse], error)
 	ListMVRReports(context.Context, *connect.Request[v1.ListMVRReportsRequest]) (*connect.Response[v1.ListMVRReportsResponse], error)
 	// MVR Violation Management
 	AddMVRViolation(context.Context, *connect.Request[v1.AddMVRViolationRequest]) (*connect.Response[v1.AddMVRViolationResponse], error)
@@ -80,6 +90,9 @@ type MVRServiceClient interface {
 	// Continuous Monitoring
 	EnableContinuousMonitoring(context.Context, *connect.Request[v1.EnableContinuousMonitoringRequest]) (*connect.Response[v1.EnableContinuousMonitoringResponse], error)
 	GetMonitoringStatus(context.Context, *connect.Request[v1.GetMonitoringStatusRequest]) (*connect.Response[v1.GetMonitoringStatusResponse], error)
+	// Annual Review
+	ListMVRReviews(context.Context, *connect.Request[v1.ListMVRReviewsRequest]) (*connect.Response[v1.ListMVRReviewsResponse], error)
+	SignOffMVRReview(context.Context, *connect.Request[v1.SignOffMVRReviewRequest]) (*connect.Response[v1.SignOffMVRReviewResponse], error)
 	// Analytics and Reporting
 	GetMVRAnalytics(context.Context, *connect.Request[v1.GetMVRAnalyticsRequest]) (*connect.Response[v1.GetMVRAnalyticsResponse], error)
 	// Provider Integration
@@ -115,6 +128,12 @@ func NewMVRServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(mVRServiceMethods.ByName("UpdateMVRReport")),
 			connect.WithClientOptions(opts...),
 		),
+		parseMVRReport: connect.NewClient[v1.ParseMVRReportRequest, v1.ParseMVRReportResponse](
+			httpClient,
+			baseURL+MVRServiceParseMVRReportProcedure,
+			connect.WithSchema(mVRServiceMethods.ByName("ParseMVRReport")),
+			connect.WithClientOptions(opts...),
+		),
 		listMVRReports: connect.NewClient[v1.ListMVRReportsRequest, v1.ListMVRReportsResponse](
 			httpClient,
 			baseURL+MVRServiceListMVRReportsProcedure,
@@ -151,6 +170,18 @@ func NewMVRServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(mVRServiceMethods.ByName("GetMonitoringStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		listMVRReviews: connect.NewClient[v1.ListMVRReviewsRequest, v1.ListMVRReviewsResponse](
+			httpClient,
+			baseURL+MVRServiceListMVRReviewsProcedure,
+			connect.WithSchema(mVRServiceMethods.ByName("ListMVRReviews")),
+			connect.WithClientOptions(opts...),
+		),
+		signOffMVRReview: connect.NewClient[v1.SignOffMVRReviewRequest, v1.SignOffMVRReviewResponse](
+			httpClient,
+			baseURL+MVRServiceSignOffMVRReviewProcedure,
+			connect.WithSchema(mVRServiceMethods.ByName("SignOffMVRReview")),
+			connect.WithClientOptions(opts...),
+		),
 		getMVRAnalytics: connect.NewClient[v1.GetMVRAnalyticsRequest, v1.GetMVRAnalyticsResponse](
 			httpClient,
 			baseURL+MVRServiceGetMVRAnalyticsProcedure,
@@ -171,12 +202,15 @@ type mVRServiceClient struct {
 	orderMVR                   *connect.Client[v1.OrderMVRRequest, v1.OrderMVRResponse]
 	getMVRReport               *connect.Client[v1.GetMVRReportRequest, v1.GetMVRReportResponse]
 	updateMVRReport            *connect.Client[v1.UpdateMVRReportRequest, v1.UpdateMVRReportResponse]
+	parseMVRReport             *connect.Client[v1.ParseMVRReportRequest, v1.ParseMVRReportResponse]
 	listMVRReports             *connect.Client[v1.ListMVRReportsRequest, v1.ListMVRReportsResponse]
 	addMVRViolation            *connect.Client[v1.AddMVRViolationRequest, v1.AddMVRViolationResponse]
 	updateMVRViolation         *connect.Client[v1.UpdateMVRViolationRequest, v1.UpdateMVRViolationResponse]
 	listMVRViolations          *connect.Client[v1.ListMVRViolationsRequest, v1.ListMVRViolationsResponse]
 	enableContinuousMonitoring *connect.Client[v1.EnableContinuousMonitoringRequest, v1.EnableContinuousMonitoringResponse]
 	getMonitoringStatus        *connect.Client[v1.GetMonitoringStatusRequest, v1.GetMonitoringStatusResponse]
+	listMVRReviews             *connect.Client[v1.ListMVRReviewsRequest, v1.ListMVRReviewsResponse]
+	signOffMVRReview           *connect.Client[v1.SignOffMVRReviewRequest, v1.SignOffMVRReviewResponse]
 	getMVRAnalytics            *connect.Client[v1.GetMVRAnalyticsRequest, v1.GetMVRAnalyticsResponse]
 	syncProviderData           *connect.Client[v1.SyncProviderDataRequest, v1.SyncProviderDataResponse]
 }
@@ -196,6 +230,11 @@ func (c *mVRServiceClient) UpdateMVRReport(ctx context.Context, req *connect.Req
 	return c.updateMVRReport.CallUnary(ctx, req)
 }
 
+// ParseMVRReport calls v1consortium.services.MVRService.ParseMVRReport.
+func (c *mVRServiceClient) ParseMVRReport(ctx context.Context, req *connect.Request[v1.ParseMVRReportRequest]) (*connect.Response[v1.ParseMVRReportResponse], error) {
+	return c.parseMVRReport.CallUnary(ctx, req)
+}
+
 // ListMVRReports calls v1consortium.services.MVRService.ListMVRReports.
 func (c *mVRServiceClient) ListMVRReports(ctx context.Context, req *connect.Request[v1.ListMVRReportsRequest]) (*connect.Response[v1.ListMVRReportsResponse], error) {
 	return c.listMVRReports.CallUnary(ctx, req)
@@ -226,6 +265,16 @@ func (c *mVRServiceClient) GetMonitoringStatus(ctx context.Context, req *connect
 	return c.getMonitoringStatus.CallUnary(ctx, req)
 }
 
+// ListMVRReviews calls v1consortium.services.MVRService.ListMVRReviews.
+func (c *mVRServiceClient) ListMVRReviews(ctx context.Context, req *connect.Request[v1.ListMVRReviewsRequest]) (*connect.Response[v1.ListMVRReviewsResponse], error) {
+	return c.listMVRReviews.CallUnary(ctx, req)
+}
+
+// SignOffMVRReview calls v1consortium.services.MVRService.SignOffMVRReview.
+func (c *mVRServiceClient) SignOffMVRReview(ctx context.Context, req *connect.Request[v1.SignOffMVRReviewRequest]) (*connect.Response[v1.SignOffMVRReviewResponse], error) {
+	return c.signOffMVRReview.CallUnary(ctx, req)
+}
+
 // GetMVRAnalytics calls v1consortium.services.MVRService.GetMVRAnalytics.
 func (c *mVRServiceClient) GetMVRAnalytics(ctx context.Context, req *connect.Request[v1.GetMVRAnalyticsRequest]) (*connect.Response[v1.GetMVRAnalyticsResponse], error) {
 	return c.getMVRAnalytics.CallUnary(ctx, req)
@@ -242,6 +291,7 @@ type MVRServiceHandler interface {
 	OrderMVR(context.Context, *connect.Request[v1.OrderMVRRequest]) (*connect.Response[v1.OrderMVRResponse], error)
 	GetMVRReport(context.Context, *connect.Request[v1.GetMVRReportRequest]) (*connect.Response[v1.GetMVRReportResponse], error)
 	UpdateMVRReport(context.Context, *connect.Request[v1.UpdateMVRReportRequest]) (*connect.Response[v1.UpdateMVRReportResponse], error)
+	ParseMVRReport(context.Context, *connect.Request[v1.ParseMVRReportRequest]) (*connect.Response[v1.ParseMVRReportResponse], error)
 	ListMVRReports(context.Context, *connect.Request[v1.ListMVRReportsRequest]) (*connect.Response[v1.ListMVRReportsResponse], error)
 	// MVR Violation Management
 	AddMVRViolation(context.Context, *connect.Request[v1.AddMVRViolationRequest]) (*connect.Response[v1.AddMVRViolationResponse], error)
@@ -250,6 +300,9 @@ type MVRServiceHandler interface {
 	// Continuous Monitoring
 	EnableContinuousMonitoring(context.Context, *connect.Request[v1.EnableContinuousMonitoringRequest]) (*connect.Response[v1.EnableContinuousMonitoringResponse], error)
 	GetMonitoringStatus(context.Context, *connect.Request[v1.GetMonitoringStatusRequest]) (*connect.Response[v1.GetMonitoringStatusResponse], error)
+	// Annual Review
+	ListMVRReviews(context.Context, *connect.Request[v1.ListMVRReviewsRequest]) (*connect.Response[v1.ListMVRReviewsResponse], error)
+	SignOffMVRReview(context.Context, *connect.Request[v1.SignOffMVRReviewRequest]) (*connect.Response[v1.SignOffMVRReviewResponse], error)
 	// Analytics and Reporting
 	GetMVRAnalytics(context.Context, *connect.Request[v1.GetMVRAnalyticsRequest]) (*connect.Response[v1.GetMVRAnalyticsResponse], error)
 	// Provider Integration
@@ -281,6 +334,12 @@ func NewMVRServiceHandler(svc MVRServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(mVRServiceMethods.ByName("UpdateMVRReport")),
 		connect.WithHandlerOptions(opts...),
 	)
+	mVRServiceParseMVRReportHandler := connect.NewUnaryHandler(
+		MVRServiceParseMVRReportProcedure,
+		svc.ParseMVRReport,
+		connect.WithSchema(mVRServiceMethods.ByName("ParseMVRReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	mVRServiceListMVRReportsHandler := connect.NewUnaryHandler(
 		MVRServiceListMVRReportsProcedure,
 		svc.ListMVRReports,
@@ -317,6 +376,18 @@ func NewMVRServiceHandler(svc MVRServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(mVRServiceMethods.ByName("GetMonitoringStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	mVRServiceListMVRReviewsHandler := connect.NewUnaryHandler(
+		MVRServiceListMVRReviewsProcedure,
+		svc.ListMVRReviews,
+		connect.WithSchema(mVRServiceMethods.ByName("ListMVRReviews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mVRServiceSignOffMVRReviewHandler := connect.NewUnaryHandler(
+		MVRServiceSignOffMVRReviewProcedure,
+		svc.SignOffMVRReview,
+		connect.WithSchema(mVRServiceMethods.ByName("SignOffMVRReview")),
+		connect.WithHandlerOptions(opts...),
+	)
 	mVRServiceGetMVRAnalyticsHandler := connect.NewUnaryHandler(
 		MVRServiceGetMVRAnalyticsProcedure,
 		svc.GetMVRAnalytics,
@@ -337,6 +408,8 @@ func NewMVRServiceHandler(svc MVRServiceHandler, opts ...connect.HandlerOption)
 			mVRServiceGetMVRReportHandler.ServeHTTP(w, r)
 		case MVRServiceUpdateMVRReportProcedure:
 			mVRServiceUpdateMVRReportHandler.ServeHTTP(w, r)
+		case MVRServiceParseMVRReportProcedure:
+			mVRServiceParseMVRReportHandler.ServeHTTP(w, r)
 		case MVRServiceListMVRReportsProcedure:
 			mVRServiceListMVRReportsHandler.ServeHTTP(w, r)
 		case MVRServiceAddMVRViolationProcedure:
@@ -349,6 +422,10 @@ func NewMVRServiceHandler(svc MVRServiceHandler, opts ...connect.HandlerOption)
 			mVRServiceEnableContinuousMonitoringHandler.ServeHTTP(w, r)
 		case MVRServiceGetMonitoringStatusProcedure:
 			mVRServiceGetMonitoringStatusHandler.ServeHTTP(w, r)
+		case MVRServiceListMVRReviewsProcedure:
+			mVRServiceListMVRReviewsHandler.ServeHTTP(w, r)
+		case MVRServiceSignOffMVRReviewProcedure:
+			mVRServiceSignOffMVRReviewHandler.ServeHTTP(w, r)
 		case MVRServiceGetMVRAnalyticsProcedure:
 			mVRServiceGetMVRAnalyticsHandler.ServeHTTP(w, r)
 		case MVRServiceSyncProviderDataProcedure:
@@ -374,6 +451,10 @@ func (UnimplementedMVRServiceHandler) UpdateMVRReport(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.UpdateMVRReport is not implemented"))
 }
 
+func (UnimplementedMVRServiceHandler) ParseMVRReport(context.Context, *connect.Request[v1.ParseMVRReportRequest]) (*connect.Response[v1.ParseMVRReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.ParseMVRReport is not implemented"))
+}
+
 func (UnimplementedMVRServiceHandler) ListMVRReports(context.Context, *connect.Request[v1.ListMVRReportsRequest]) (*connect.Response[v1.ListMVRReportsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.ListMVRReports is not implemented"))
 }
@@ -398,6 +479,14 @@ func (UnimplementedMVRServiceHandler) GetMonitoringStatus(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.GetMonitoringStatus is not implemented"))
 }
 
+func (UnimplementedMVRServiceHandler) ListMVRReviews(context.Context, *connect.Request[v1.ListMVRReviewsRequest]) (*connect.Response[v1.ListMVRReviewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.ListMVRReviews is not implemented"))
+}
+
+func (UnimplementedMVRServiceHandler) SignOffMVRReview(context.Context, *connect.Request[v1.SignOffMVRReviewRequest]) (*connect.Response[v1.SignOffMVRReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.SignOffMVRReview is not implemented"))
+}
+
 func (UnimplementedMVRServiceHandler) GetMVRAnalytics(context.Context, *connect.Request[v1.GetMVRAnalyticsRequest]) (*connect.Response[v1.GetMVRAnalyticsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.MVRService.GetMVRAnalytics is not implemented"))
 }
@@ -48,6 +48,9 @@ const (
 	// NotificationServiceMarkAllNotificationsReadProcedure is the fully-qualified name of the
 	// NotificationService's MarkAllNotificationsRead RPC.
 	NotificationServiceMarkAllNotificationsReadProcedure = "/v1consortium.services.NotificationService/MarkAllNotificationsRead"
+	// NotificationServiceStreamNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's StreamNotifications RPC.
+	NotificationServiceStreamNotificationsProcedure = "/v1consortium.services.NotificationService/StreamNotifications"
 	// NotificationServiceCreateNotificationTemplateProcedure is the fully-qualified name of the
 	// NotificationService's CreateNotificationTemplate RPC.
 	NotificationServiceCreateNotificationTemplateProcedure = "/v1consortium.services.NotificationService/CreateNotificationTemplate"
@@ -60,6 +63,9 @@ const (
 	// NotificationServiceUpdateNotificationTemplateProcedure is the fully-qualified name of the
 	// NotificationService's UpdateNotificationTemplate RPC.
 	NotificationServiceUpdateNotificationTemplateProcedure = "/v1consortium.services.NotificationService/UpdateNotificationTemplate"
+	// NotificationServicePreviewNotificationTemplateProcedure is the fully-qualified name of the
+	// NotificationService's PreviewNotificationTemplate RPC.
+	NotificationServicePreviewNotificationTemplateProcedure = "/v1consortium.services.NotificationService/PreviewNotificationTemplate"
 	// NotificationServiceGetNotificationPreferencesProcedure is the fully-qualified name of the
 	// NotificationService's GetNotificationPreferences RPC.
 	NotificationServiceGetNotificationPreferencesProcedure = "/v1consortium.services.NotificationService/GetNotificationPreferences"
@@ -69,6 +75,9 @@ const (
 	// NotificationServiceSendBulkNotificationProcedure is the fully-qualified name of the
 	// NotificationService's SendBulkNotification RPC.
 	NotificationServiceSendBulkNotificationProcedure = "/v1consortium.services.NotificationService/SendBulkNotification"
+	// NotificationServiceGetBulkNotificationStatusProcedure is the fully-qualified name of the
+	// NotificationService's GetBulkNotificationStatus RPC.
+	NotificationServiceGetBulkNotificationStatusProcedure = "/v1consortium.services.NotificationService/GetBulkNotificationStatus"
 	// NotificationServiceScheduleNotificationProcedure is the fully-qualified name of the
 	// NotificationService's ScheduleNotification RPC.
 	NotificationServiceScheduleNotificationProcedure = "/v1consortium.services.NotificationService/ScheduleNotification"
@@ -91,16 +100,20 @@ type NotificationServiceClient interface {
 	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
 	MarkNotificationRead(context.Context, *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error)
 	MarkAllNotificationsRead(context.Context, *connect.Request[v1.MarkAllNotificationsReadRequest]) (*connect.Response[v1.MarkAllNotificationsReadResponse], error)
+	// Pushes the caller's new in-app notifications and read-state changes as they happen
+	StreamNotifications(context.Context, *connect.Request[v1.StreamNotificationsRequest]) (*connect.ServerStreamForClient[v1.NotificationEvent], error)
 	// Template Management
 	CreateNotificationTemplate(context.Context, *connect.Request[v1.CreateNotificationTemplateRequest]) (*connect.Response[v1.CreateNotificationTemplateResponse], error)
 	GetNotificationTemplate(context.Context, *connect.Request[v1.GetNotificationTemplateRequest]) (*connect.Response[v1.GetNotificationTemplateResponse], error)
 	ListNotificationTemplates(context.Context, *connect.Request[v1.ListNotificationTemplatesRequest]) (*connect.Response[v1.ListNotificationTemplatesResponse], error)
 	UpdateNotificationTemplate(context.Context, *connect.Request[v1.UpdateNotificationTemplateRequest]) (*connect.Response[v1.UpdateNotificationTemplateResponse], error)
+	PreviewNotificationTemplate(context.Context, *connect.Request[v1.PreviewNotificationTemplateRequest]) (*connect.Response[v1.PreviewNotificationTemplateResponse], error)
 	// Preference Management
 	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
 	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
 	// Bulk Operations
 	SendBulkNotification(context.Context, *connect.Request[v1.SendBulkNotificationRequest]) (*connect.Response[v1.SendBulkNotificationResponse], error)
+	GetBulkNotificationStatus(context.Context, *connect.Request[v1.GetBulkNotificationStatusRequest]) (*connect.Response[v1.GetBulkNotificationStatusResponse], error)
 	// Scheduled Notifications
 	ScheduleNotification(context.Context, *connect.Request[v1.ScheduleNotificationRequest]) (*connect.Response[v1.ScheduleNotificationResponse], error)
 	ListScheduledNotifications(context.Context, *connect.Request[v1.ListScheduledNotificationsRequest]) (*connect.Response[v1.ListScheduledNotificationsResponse], error)
@@ -150,6 +163,12 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("MarkAllNotificationsRead")),
 			connect.WithClientOptions(opts...),
 		),
+		streamNotifications: connect.NewClient[v1.StreamNotificationsRequest, v1.NotificationEvent](
+			httpClient,
+			baseURL+NotificationServiceStreamNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("StreamNotifications")),
+			connect.WithClientOptions(opts...),
+		),
 		createNotificationTemplate: connect.NewClient[v1.CreateNotificationTemplateRequest, v1.CreateNotificationTemplateResponse](
 			httpClient,
 			baseURL+NotificationServiceCreateNotificationTemplateProcedure,
@@ -174,6 +193,12 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationTemplate")),
 			connect.WithClientOptions(opts...),
 		),
+		previewNotificationTemplate: connect.NewClient[v1.PreviewNotificationTemplateRequest, v1.PreviewNotificationTemplateResponse](
+			httpClient,
+			baseURL+NotificationServicePreviewNotificationTemplateProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("PreviewNotificationTemplate")),
+			connect.WithClientOptions(opts...),
+		),
 		getNotificationPreferences: connect.NewClient[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse](
 			httpClient,
 			baseURL+NotificationServiceGetNotificationPreferencesProcedure,
@@ -192,6 +217,12 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceMethods.ByName("SendBulkNotification")),
 			connect.WithClientOptions(opts...),
 		),
+		getBulkNotificationStatus: connect.NewClient[v1.GetBulkNotificationStatusRequest, v1.GetBulkNotificationStatusResponse](
+			httpClient,
+			baseURL+NotificationServiceGetBulkNotificationStatusProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetBulkNotificationStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		scheduleNotification: connect.NewClient[v1.ScheduleNotificationRequest, v1.ScheduleNotificationResponse](
 			httpClient,
 			baseURL+NotificationServiceScheduleNotificationProcedure,
@@ -226,13 +257,16 @@ type notificationServiceClient struct {
 	listNotifications             *connect.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
 	markNotificationRead          *connect.Client[v1.MarkNotificationReadRequest, v1.MarkNotificationReadResponse]
 	markAllNotificationsRead      *connect.Client[v1.MarkAllNotificationsReadRequest, v1.MarkAllNotificationsReadResponse]
+	streamNotifications           *connect.Client[v1.StreamNotificationsRequest, v1.NotificationEvent]
 	createNotificationTemplate    *connect.Client[v1.CreateNotificationTemplateRequest, v1.CreateNotificationTemplateResponse]
 	getNotificationTemplate       *connect.Client[v1.GetNotificationTemplateRequest, v1.GetNotificationTemplateResponse]
 	listNotificationTemplates     *connect.Client[v1.ListNotificationTemplatesRequest, v1.ListNotificationTemplatesResponse]
 	updateNotificationTemplate    *connect.Client[v1.UpdateNotificationTemplateRequest, v1.UpdateNotificationTemplateResponse]
+	previewNotificationTemplate   *connect.Client[v1.PreviewNotificationTemplateRequest, v1.PreviewNotificationTemplateResponse]
 	getNotificationPreferences    *connect.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
 	updateNotificationPreferences *connect.Client[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse]
 	sendBulkNotification          *connect.Client[v1.SendBulkNotificationRequest, v1.SendBulkNotificationResponse]
+	getBulkNotificationStatus     *connect.Client[v1.GetBulkNotificationStatusRequest, v1.GetBulkNotificationStatusResponse]
 	scheduleNotification          *connect.Client[v1.ScheduleNotificationRequest, v1.ScheduleNotificationResponse]
 	listScheduledNotifications    *connect.Client[v1.ListScheduledNotificationsRequest, v1.ListScheduledNotificationsResponse]
 	cancelScheduledNotification   *connect.Client[v1.CancelScheduledNotificationRequest, v1.CancelScheduledNotificationResponse]
@@ -265,6 +299,11 @@ func (c *notificationServiceClient) MarkAllNotificationsRead(ctx context.Context
 	return c.markAllNotificationsRead.CallUnary(ctx, req)
 }
 
+// StreamNotifications calls v1consortium.services.NotificationService.StreamNotifications.
+func (c *notificationServiceClient) StreamNotifications(ctx context.Context, req *connect.Request[v1.StreamNotificationsRequest]) (*connect.ServerStreamForClient[v1.NotificationEvent], error) {
+	return c.streamNotifications.CallServerStream(ctx, req)
+}
+
 // CreateNotificationTemplate calls
 // v1consortium.services.NotificationService.CreateNotificationTemplate.
 func (c *notificationServiceClient) CreateNotificationTemplate(ctx context.Context, req *connect.Request[v1.CreateNotificationTemplateRequest]) (*connect.Response[v1.CreateNotificationTemplateResponse], error) {
@@ -288,6 +327,12 @@ func (c *notificationServiceClient) UpdateNotificationTemplate(ctx context.Conte
 	return c.updateNotificationTemplate.CallUnary(ctx, req)
 }
 
+// PreviewNotificationTemplate calls
+// v1consortium.services.NotificationService.PreviewNotificationTemplate.
+func (c *notificationServiceClient) PreviewNotificationTemplate(ctx context.Context, req *connect.Request[v1.PreviewNotificationTemplateRequest]) (*connect.Response[v1.PreviewNotificationTemplateResponse], error) {
+	return c.previewNotificationTemplate.CallUnary(ctx, req)
+}
+
 // GetNotificationPreferences calls
 // v1consortium.services.NotificationService.GetNotificationPreferences.
 func (c *notificationServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
@@ -305,6 +350,12 @@ func (c *notificationServiceClient) SendBulkNotification(ctx context.Context, re
 	return c.sendBulkNotification.CallUnary(ctx, req)
 }
 
+// GetBulkNotificationStatus calls
+// v1consortium.services.NotificationService.GetBulkNotificationStatus.
+func (c *notificationServiceClient) GetBulkNotificationStatus(ctx context.Context, req *connect.Request[v1.GetBulkNotificationStatusRequest]) (*connect.Response[v1.GetBulkNotificationStatusResponse], error) {
+	return c.getBulkNotificationStatus.CallUnary(ctx, req)
+}
+
 // ScheduleNotification calls v1consortium.services.NotificationService.ScheduleNotification.
 func (c *notificationServiceClient) ScheduleNotification(ctx context.Context, req *connect.Request[v1.ScheduleNotificationRequest]) (*connect.Response[v1.ScheduleNotificationResponse], error) {
 	return c.scheduleNotification.CallUnary(ctx, req)
@@ -337,16 +388,20 @@ type NotificationServiceHandler interface {
 	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
 	MarkNotificationRead(context.Context, *connect.Request[v1.MarkNotificationReadRequest]) (*connect.Response[v1.MarkNotificationReadResponse], error)
 	MarkAllNotificationsRead(context.Context, *connect.Request[v1.MarkAllNotificationsReadRequest]) (*connect.Response[v1.MarkAllNotificationsReadResponse], error)
+	// Pushes the caller's new in-app notifications and read-state changes as they happen
+	StreamNotifications(context.Context, *connect.Request[v1.StreamNotificationsRequest], *connect.ServerStream[v1.NotificationEvent]) error
 	// Template Management
 	CreateNotificationTemplate(context.Context, *connect.Request[v1.CreateNotificationTemplateRequest]) (*connect.Response[v1.CreateNotificationTemplateResponse], error)
 	GetNotificationTemplate(context.Context, *connect.Request[v1.GetNotificationTemplateRequest]) (*connect.Response[v1.GetNotificationTemplateResponse], error)
 	ListNotificationTemplates(context.Context, *connect.Request[v1.ListNotificationTemplatesRequest]) (*connect.Response[v1.ListNotificationTemplatesResponse], error)
 	UpdateNotificationTemplate(context.Context, *connect.Request[v1.UpdateNotificationTemplateRequest]) (*connect.Response[v1.UpdateNotificationTemplateResponse], error)
+	PreviewNotificationTemplate(context.Context, *connect.Request[v1.PreviewNotificationTemplateRequest]) (*connect.Response[v1.PreviewNotificationTemplateResponse], error)
 	// Preference Management
 	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
 	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
 	// Bulk Operations
 	SendBulkNotification(context.Context, *connect.Request[v1.SendBulkNotificationRequest]) (*connect.Response[v1.SendBulkNotificationResponse], error)
+	GetBulkNotificationStatus(context.Context, *connect.Request[v1.GetBulkNotificationStatusRequest]) (*connect.Response[v1.GetBulkNotificationStatusResponse], error)
 	// Scheduled Notifications
 	ScheduleNotification(context.Context, *connect.Request[v1.ScheduleNotificationRequest]) (*connect.Response[v1.ScheduleNotificationResponse], error)
 	ListScheduledNotifications(context.Context, *connect.Request[v1.ListScheduledNotificationsRequest]) (*connect.Response[v1.ListScheduledNotificationsResponse], error)
@@ -392,6 +447,12 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("MarkAllNotificationsRead")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceStreamNotificationsHandler := connect.NewServerStreamHandler(
+		NotificationServiceStreamNotificationsProcedure,
+		svc.StreamNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("StreamNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
 	notificationServiceCreateNotificationTemplateHandler := connect.NewUnaryHandler(
 		NotificationServiceCreateNotificationTemplateProcedure,
 		svc.CreateNotificationTemplate,
@@ -416,6 +477,12 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("UpdateNotificationTemplate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServicePreviewNotificationTemplateHandler := connect.NewUnaryHandler(
+		NotificationServicePreviewNotificationTemplateProcedure,
+		svc.PreviewNotificationTemplate,
+		connect.WithSchema(notificationServiceMethods.ByName("PreviewNotificationTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	notificationServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
 		NotificationServiceGetNotificationPreferencesProcedure,
 		svc.GetNotificationPreferences,
@@ -434,6 +501,12 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceMethods.ByName("SendBulkNotification")),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceGetBulkNotificationStatusHandler := connect.NewUnaryHandler(
+		NotificationServiceGetBulkNotificationStatusProcedure,
+		svc.GetBulkNotificationStatus,
+		connect.WithSchema(notificationServiceMethods.ByName("GetBulkNotificationStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	notificationServiceScheduleNotificationHandler := connect.NewUnaryHandler(
 		NotificationServiceScheduleNotificationProcedure,
 		svc.ScheduleNotification,
@@ -470,6 +543,8 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceMarkNotificationReadHandler.ServeHTTP(w, r)
 		case NotificationServiceMarkAllNotificationsReadProcedure:
 			notificationServiceMarkAllNotificationsReadHandler.ServeHTTP(w, r)
+		case NotificationServiceStreamNotificationsProcedure:
+			notificationServiceStreamNotificationsHandler.ServeHTTP(w, r)
 		case NotificationServiceCreateNotificationTemplateProcedure:
 			notificationServiceCreateNotificationTemplateHandler.ServeHTTP(w, r)
 		case NotificationServiceGetNotificationTemplateProcedure:
@@ -478,12 +553,16 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceListNotificationTemplatesHandler.ServeHTTP(w, r)
 		case NotificationServiceUpdateNotificationTemplateProcedure:
 			notificationServiceUpdateNotificationTemplateHandler.ServeHTTP(w, r)
+		case NotificationServicePreviewNotificationTemplateProcedure:
+			notificationServicePreviewNotificationTemplateHandler.ServeHTTP(w, r)
 		case NotificationServiceGetNotificationPreferencesProcedure:
 			notificationServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
 		case NotificationServiceUpdateNotificationPreferencesProcedure:
 			notificationServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
 		case NotificationServiceSendBulkNotificationProcedure:
 			notificationServiceSendBulkNotificationHandler.ServeHTTP(w, r)
+		case NotificationServiceGetBulkNotificationStatusProcedure:
+			notificationServiceGetBulkNotificationStatusHandler.ServeHTTP(w, r)
 		case NotificationServiceScheduleNotificationProcedure:
 			notificationServiceScheduleNotificationHandler.ServeHTTP(w, r)
 		case NotificationServiceListScheduledNotificationsProcedure:
@@ -521,6 +600,10 @@ func (UnimplementedNotificationServiceHandler) MarkAllNotificationsRead(context.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.MarkAllNotificationsRead is not implemented"))
 }
 
+func (UnimplementedNotificationServiceHandler) StreamNotifications(context.Context, *connect.Request[v1.StreamNotificationsRequest], *connect.ServerStream[v1.NotificationEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.StreamNotifications is not implemented"))
+}
+
 func (UnimplementedNotificationServiceHandler) CreateNotificationTemplate(context.Context, *connect.Request[v1.CreateNotificationTemplateRequest]) (*connect.Response[v1.CreateNotificationTemplateResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.CreateNotificationTemplate is not implemented"))
 }
@@ -537,6 +620,10 @@ func (UnimplementedNotificationServiceHandler) UpdateNotificationTemplate(contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.UpdateNotificationTemplate is not implemented"))
 }
 
+func (UnimplementedNotificationServiceHandler) PreviewNotificationTemplate(context.Context, *connect.Request[v1.PreviewNotificationTemplateRequest]) (*connect.Response[v1.PreviewNotificationTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.PreviewNotificationTemplate is not implemented"))
+}
+
 func (UnimplementedNotificationServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.GetNotificationPreferences is not implemented"))
 }
@@ -549,6 +636,10 @@ func (UnimplementedNotificationServiceHandler) SendBulkNotification(context.Cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.SendBulkNotification is not implemented"))
 }
 
+func (UnimplementedNotificationServiceHandler) GetBulkNotificationStatus(context.Context, *connect.Request[v1.GetBulkNotificationStatusRequest]) (*connect.Response[v1.GetBulkNotificationStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.GetBulkNotificationStatus is not implemented"))
+}
+
 func (UnimplementedNotificationServiceHandler) ScheduleNotification(context.Context, *connect.Request[v1.ScheduleNotificationRequest]) (*connect.Response[v1.ScheduleNotificationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1consortium.services.NotificationService.ScheduleNotification is not implemented"))
 }
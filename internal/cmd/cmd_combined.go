@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -19,11 +20,18 @@ import (
 	gatewayv1connect "v1consortium/api/gateway/v1/v1connect"
 	servicesv1connect "v1consortium/api/services/v1/v1connect"
 	"v1consortium/internal/config"
+	"v1consortium/internal/controller/files"
+	"v1consortium/internal/controller/webhooks"
 	authconnect "v1consortium/internal/controllerconnect/auth"
 	gatewayconnect "v1consortium/internal/controllerconnect/gateway"
 	servicesconnect "v1consortium/internal/controllerconnect/services"
 	"v1consortium/internal/gateway"
+	"v1consortium/internal/pkg/blobstore"
+	"v1consortium/internal/pkg/emailpkg"
 	"v1consortium/internal/pkg/interceptors"
+	"v1consortium/internal/pkg/notifystream"
+	"v1consortium/internal/pkg/smspkg"
+	"v1consortium/internal/service"
 )
 
 var (
@@ -49,6 +57,8 @@ func runCombinedServer(ctx context.Context) error {
 		panic(fmt.Errorf("failed to setup River services: %w", err))
 	}
 
+	setupNotificationEvents(ctx)
+
 	// Initialize GoFrame server
 	s := g.Server()
 	s.SetAddr(cfg.HTTPAddr)
@@ -79,6 +89,18 @@ func runCombinedServer(ctx context.Context) error {
 	return nil
 }
 
+// setupNotificationEvents listens for in-app notification events on the database, for the
+// notification streams served by this instance
+func setupNotificationEvents(ctx context.Context) {
+	hub := notifystream.NewHub()
+	go func() {
+		if err := notifystream.Listen(ctx, globalComponents.DBPool, hub); err != nil && ctx.Err() == nil {
+			g.Log().Errorf(ctx, "Notification event listener stopped: %v", err)
+		}
+	}()
+	service.RegisterNotificationEvents(hub)
+}
+
 // setupRoutes configures all HTTP routes using GoFrame server
 func setupRoutes(s *ghttp.Server, cfg *config.Config, transcoder *vanguard.Transcoder) {
 	// Add CORS middleware using GoFrame's native CORS handling
@@ -95,6 +117,15 @@ func setupRoutes(s *ghttp.Server, cfg *config.Config, transcoder *vanguard.Trans
 		r.Response.Write(response)
 	})
 
+	// Presigned document downloads when documents are stored on the local filesystem
+	setupStorageRoutes(s)
+
+	// Delivery status reports and inbound messages from the SMS provider, and bounces,
+	// complaints, opens and clicks from the email providers
+	providerWebhooks := webhooks.New(service.Notification().GetEmailConfig(context.Background()))
+	setupSMSRoutes(s, providerWebhooks)
+	setupEmailWebhookRoutes(s, providerWebhooks)
+
 	// API routes - All Connect/gRPC traffic goes through transcoder
 	s.BindHandler("/*", func(r *ghttp.Request) {
 		transcoder.ServeHTTP(r.Response.ResponseWriter, r.Request)
@@ -106,6 +137,93 @@ func setupRoutes(s *ghttp.Server, cfg *config.Config, transcoder *vanguard.Trans
 	log.Println("✅ Routes configured successfully")
 }
 
+// setupStorageRoutes serves downloads of encrypted documents, which are decrypted by the API,
+// signed document share links, driver qualification file bundles, and presigned downloads for the
+// local filesystem document store. S3-compatible stores serve their presigned URLs themselves.
+func setupStorageRoutes(s *ghttp.Server) {
+	ctx := context.Background()
+	ctrl := &files.Controller{}
+	downloads, err := url.Parse(service.Document().GetDownloadBaseURL(ctx))
+	if err != nil || downloads.Path == "" || downloads.Path == "/" {
+		log.Printf("⚠️  Invalid document download URL %q, encrypted document downloads disabled", service.Document().GetDownloadBaseURL(ctx))
+	} else {
+		s.BindHandler(downloads.Path+"/{documentId}", ctrl.DocumentDownload)
+		log.Printf("🔐 Encrypted document downloads served at %s", downloads.Path)
+	}
+	shares, err := url.Parse(service.Document().GetShareBaseURL(ctx))
+	if err != nil || shares.Path == "" || shares.Path == "/" {
+		log.Printf("⚠️  Invalid document share URL %q, share links disabled", service.Document().GetShareBaseURL(ctx))
+	} else {
+		s.BindHandler(shares.Path+"/{shareId}", ctrl.DocumentShare)
+		log.Printf("🔗 Document share links served at %s", shares.Path)
+	}
+	dqFiles, err := url.Parse(service.Document().GetDQFileBaseURL(ctx))
+	if err != nil || dqFiles.Path == "" || dqFiles.Path == "/" {
+		log.Printf("⚠️  Invalid driver qualification file URL %q, DQ file downloads disabled", service.Document().GetDQFileBaseURL(ctx))
+	} else {
+		s.BindHandler(dqFiles.Path+"/{userId}", ctrl.DQFile)
+		log.Printf("🗂️  Driver qualification files served at %s", dqFiles.Path)
+	}
+
+	store, err := service.Document().Store(ctx)
+	if err != nil {
+		log.Printf("⚠️  Document storage unavailable: %v", err)
+		return
+	}
+	local, ok := store.(*blobstore.LocalStore)
+	if !ok {
+		return
+	}
+	base, err := url.Parse(local.BaseURL())
+	if err != nil || base.Path == "" || base.Path == "/" {
+		log.Printf("⚠️  Invalid local storage base URL %q, document downloads disabled", local.BaseURL())
+		return
+	}
+	handler := http.StripPrefix(base.Path, local)
+	s.BindHandler(base.Path+"/*", func(r *ghttp.Request) {
+		handler.ServeHTTP(r.Response.ResponseWriter, r.Request)
+	})
+	log.Printf("📁 Local document downloads served at %s", base.Path)
+}
+
+// setupSMSRoutes serves the SMS provider's delivery status callbacks and inbound message webhook
+// under the configured callback URL
+func setupSMSRoutes(s *ghttp.Server, ctrl *webhooks.Controller) {
+	ctx := context.Background()
+	callbackBaseURL := service.Notification().GetSMSConfig(ctx).CallbackBaseURL
+	if callbackBaseURL == "" {
+		log.Println("⚠️  No SMS callback URL configured, SMS delivery reports and opt-outs disabled")
+		return
+	}
+	callbacks, err := url.Parse(callbackBaseURL)
+	if err != nil || callbacks.Path == "" || callbacks.Path == "/" {
+		log.Printf("⚠️  Invalid SMS callback URL %q, SMS delivery reports and opt-outs disabled", callbackBaseURL)
+		return
+	}
+	s.BindHandler("POST:"+callbacks.Path+smspkg.StatusCallbackPath, ctrl.SMSStatus)
+	s.BindHandler("POST:"+callbacks.Path+smspkg.InboundMessagePath, ctrl.SMSInbound)
+	log.Printf("📱 SMS callbacks served at %s", callbacks.Path)
+}
+
+// setupEmailWebhookRoutes serves the email providers' delivery event webhooks under the configured
+// webhook URL
+func setupEmailWebhookRoutes(s *ghttp.Server, ctrl *webhooks.Controller) {
+	ctx := context.Background()
+	webhookBaseURL := service.Notification().GetEmailConfig(ctx).WebhookBaseURL
+	if webhookBaseURL == "" {
+		log.Println("⚠️  No email webhook URL configured, email bounces and opens not recorded")
+		return
+	}
+	endpoint, err := url.Parse(webhookBaseURL)
+	if err != nil || endpoint.Path == "" || endpoint.Path == "/" {
+		log.Printf("⚠️  Invalid email webhook URL %q, email bounces and opens not recorded", webhookBaseURL)
+		return
+	}
+	s.BindHandler("POST:"+endpoint.Path+emailpkg.SESWebhookPath, ctrl.SESEvents)
+	s.BindHandler("POST:"+endpoint.Path+emailpkg.BrevoWebhookPath, ctrl.BrevoEvents)
+	log.Printf("📧 Email webhooks served at %s", endpoint.Path)
+}
+
 // corsMiddleware uses GoFrame's native CORS handling
 func corsMiddleware(cfg *config.Config) ghttp.HandlerFunc {
 	return func(r *ghttp.Request) {
@@ -19,6 +19,12 @@ import (
 	"v1consortium/internal/logic/workflowbridge"
 	"v1consortium/internal/pkg/riverjobsv2"
 	"v1consortium/internal/service"
+	"v1consortium/internal/workflow/documentindex"
+	"v1consortium/internal/workflow/documentretention"
+	"v1consortium/internal/workflow/medcertreminder"
+	"v1consortium/internal/workflow/mvrmonitoring"
+	"v1consortium/internal/workflow/mvrreview"
+	"v1consortium/internal/workflow/notificationdelivery"
 	signupv2 "v1consortium/internal/workflow/signupv2"
 )
 
@@ -103,6 +109,15 @@ func initializeRiverComponents(ctx context.Context) (*RiverComponents, error) {
 	// Create Workers bundle and register the workflow executor
 	workers := river.NewWorkers()
 	river.AddWorker[riverjobsv2.WorkflowArgs](workers, workflowExecutor)
+	river.AddWorker[mvrmonitoring.SweepArgs](workers, &mvrmonitoring.SweepWorker{})
+	river.AddWorker[mvrreview.SweepArgs](workers, &mvrreview.SweepWorker{})
+	river.AddWorker[medcertreminder.SweepArgs](workers, &medcertreminder.SweepWorker{})
+	river.AddWorker[documentindex.SweepArgs](workers, &documentindex.SweepWorker{})
+	river.AddWorker[documentretention.PurgeArgs](workers, &documentretention.PurgeWorker{})
+	river.AddWorker[notificationdelivery.DeliverArgs](workers, &notificationdelivery.DeliverWorker{})
+	river.AddWorker[notificationdelivery.DigestArgs](workers, &notificationdelivery.DigestWorker{})
+	river.AddWorker[notificationdelivery.ScheduledArgs](workers, &notificationdelivery.ScheduledWorker{})
+	river.AddWorker[notificationdelivery.BulkArgs](workers, &notificationdelivery.BulkWorker{})
 
 	// Create River client with the workers bundle
 	riverClient, err := river.NewClient(riverpgxv5.New(dbPool), &river.Config{
@@ -113,6 +128,14 @@ func initializeRiverComponents(ctx context.Context) (*RiverComponents, error) {
 			"notifications":    {MaxWorkers: 25},
 		},
 		Workers: workers,
+		PeriodicJobs: []*river.PeriodicJob{
+			mvrmonitoring.NewPeriodicJob(),
+			mvrreview.NewPeriodicJob(),
+			medcertreminder.NewPeriodicJob(),
+			documentindex.NewPeriodicJob(),
+			documentretention.NewPeriodicJob(),
+			notificationdelivery.NewDigestPeriodicJob(),
+		},
 	})
 	if err != nil {
 		dbPool.Close()
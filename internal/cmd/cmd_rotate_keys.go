@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gcmd"
+
+	"v1consortium/internal/service"
+)
+
+var (
+	// RotateDocumentKeys re-wraps organization data keys after encryption.activeMasterKeyId has been
+	// switched to a new master key. The previous master key must stay configured until this has run.
+	RotateDocumentKeys = gcmd.Command{
+		Name:  "rotate_document_keys",
+		Usage: "rotate_document_keys",
+		Brief: "re-wrap document data keys with the active master key",
+		Func: func(ctx context.Context, parser *gcmd.Parser) error {
+			rotated, err := service.Document().RotateDataKeys(ctx)
+			if rotated > 0 {
+				g.Log().Infof(ctx, "Re-wrapped %d document data keys with master key %s",
+					rotated, g.Cfg().MustGet(ctx, "encryption.activeMasterKeyId").String())
+			}
+			if err != nil {
+				return err
+			}
+			if rotated == 0 {
+				g.Log().Info(ctx, "All document data keys are already wrapped with the active master key")
+			}
+			return nil
+		},
+	}
+)
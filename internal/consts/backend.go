@@ -110,6 +110,53 @@ const (
 	MVRStatusFlagged  MVRStatus = "flagged"
 )
 
+// MVR Violation Severities
+type ViolationSeverity string
+
+const (
+	ViolationSeverityMinor   ViolationSeverity = "minor"
+	ViolationSeverityMajor   ViolationSeverity = "major"
+	ViolationSeveritySerious ViolationSeverity = "serious"
+)
+
+// MVR Annual Review Status
+type MVRReviewStatus string
+
+const (
+	MVRReviewPending   MVRReviewStatus = "pending"
+	MVRReviewCompleted MVRReviewStatus = "completed"
+)
+
+// MVR Annual Review Determinations (49 CFR 391.25)
+type MVRReviewDetermination string
+
+const (
+	MVRDeterminationMeetsRequirements MVRReviewDetermination = "meets_requirements"
+	MVRDeterminationDisqualified      MVRReviewDetermination = "disqualified"
+)
+
+// MVR Monitoring Frequencies
+type MonitoringFrequency string
+
+const (
+	MonitoringMonthly   MonitoringFrequency = "monthly"
+	MonitoringQuarterly MonitoringFrequency = "quarterly"
+	MonitoringAnnual    MonitoringFrequency = "annual"
+)
+
+// Months returns the number of months between monitoring pulls, or 0 if the frequency is unknown.
+func (f MonitoringFrequency) Months() int {
+	switch f {
+	case MonitoringMonthly:
+		return 1
+	case MonitoringQuarterly:
+		return 3
+	case MonitoringAnnual:
+		return 12
+	}
+	return 0
+}
+
 // Physical Status
 type PhysicalStatus string
 
@@ -119,6 +166,101 @@ const (
 	PhysicalStatusFailed        PhysicalStatus = "failed"
 	PhysicalStatusExpired       PhysicalStatus = "expired"
 	PhysicalStatusPendingReview PhysicalStatus = "pending_review"
+	PhysicalStatusCancelled     PhysicalStatus = "cancelled"
+	PhysicalStatusNoShow        PhysicalStatus = "no_show"
+)
+
+// DOT Physical Examination Types
+type ExaminationType string
+
+const (
+	ExaminationInitial  ExaminationType = "initial"
+	ExaminationRenewal  ExaminationType = "renewal"
+	ExaminationFollowUp ExaminationType = "follow_up"
+)
+
+// DOT Physical Medical Qualification
+type MedicalQualification string
+
+const (
+	MedicalQualified                 MedicalQualification = "qualified"
+	MedicalDisqualified              MedicalQualification = "disqualified"
+	MedicalQualifiedWithRestrictions MedicalQualification = "qualified_with_restrictions"
+)
+
+// DOT Physical Medical Restrictions (MCSA-5876)
+type MedicalRestriction string
+
+const (
+	RestrictionCorrectiveLenses    MedicalRestriction = "corrective_lenses"
+	RestrictionHearingAid          MedicalRestriction = "hearing_aid"
+	RestrictionWaiverExemption     MedicalRestriction = "waiver_exemption"
+	RestrictionSPECertificate      MedicalRestriction = "spe_certificate"
+	RestrictionExemptIntracityZone MedicalRestriction = "exempt_intracity_zone"
+	RestrictionGrandfathered       MedicalRestriction = "grandfathered_391_64"
+	RestrictionIntrastateOnly      MedicalRestriction = "intrastate_only"
+)
+
+// DOT Physical Medical Exemption Types
+type MedicalExemptionType string
+
+const (
+	ExemptionVision                     MedicalExemptionType = "vision"
+	ExemptionHearing                    MedicalExemptionType = "hearing"
+	ExemptionSeizure                    MedicalExemptionType = "seizure"
+	ExemptionSkillPerformanceEvaluation MedicalExemptionType = "skill_performance_evaluation"
+	ExemptionOther                      MedicalExemptionType = "other"
+)
+
+// Monitored Medical Conditions
+type MonitoringCondition string
+
+const (
+	ConditionHypertension           MonitoringCondition = "hypertension"
+	ConditionInsulinTreatedDiabetes MonitoringCondition = "insulin_treated_diabetes"
+	ConditionSleepApnea             MonitoringCondition = "sleep_apnea"
+	ConditionCardiovascular         MonitoringCondition = "cardiovascular"
+	ConditionVision                 MonitoringCondition = "vision"
+	ConditionHearing                MonitoringCondition = "hearing"
+	ConditionOther                  MonitoringCondition = "other"
+)
+
+// Medical Follow-Up Task Types
+type FollowUpTaskType string
+
+const (
+	FollowUpRecertification  FollowUpTaskType = "recertification"
+	FollowUpExemptionRenewal FollowUpTaskType = "exemption_renewal"
+	FollowUpMonitoringCheck  FollowUpTaskType = "monitoring_check"
+)
+
+// Medical Follow-Up Task Status
+type FollowUpTaskStatus string
+
+const (
+	FollowUpOpen      FollowUpTaskStatus = "open"
+	FollowUpCompleted FollowUpTaskStatus = "completed"
+	FollowUpCancelled FollowUpTaskStatus = "cancelled"
+)
+
+// Medical Examiner's Certificate Validation Status
+type CertificateValidationStatus string
+
+const (
+	CertificateValid    CertificateValidationStatus = "valid"
+	CertificateExpired  CertificateValidationStatus = "expired"
+	CertificateInvalid  CertificateValidationStatus = "invalid"
+	CertificateNotFound CertificateValidationStatus = "not_found"
+)
+
+// Medical Examiner National Registry Status
+type ExaminerRegistryStatus string
+
+const (
+	ExaminerRegistryActive    ExaminerRegistryStatus = "active"
+	ExaminerRegistryLapsed    ExaminerRegistryStatus = "lapsed"
+	ExaminerRegistrySuspended ExaminerRegistryStatus = "suspended"
+	ExaminerRegistryRevoked   ExaminerRegistryStatus = "revoked"
 )
 
 // Background Check Types
@@ -143,6 +285,25 @@ const (
 	BackgroundStatusRequiresReview BackgroundCheckStatus = "requires_review"
 )
 
+// Background Check Results
+type BackgroundCheckResult string
+
+const (
+	BackgroundResultClear    BackgroundCheckResult = "clear"
+	BackgroundResultConsider BackgroundCheckResult = "consider"
+	BackgroundResultNotClear BackgroundCheckResult = "not_clear"
+	BackgroundResultEngaged  BackgroundCheckResult = "engaged"
+)
+
+// Analytics Time Buckets
+type AnalyticsBucket string
+
+const (
+	AnalyticsBucketDay   AnalyticsBucket = "day"
+	AnalyticsBucketWeek  AnalyticsBucket = "week"
+	AnalyticsBucketMonth AnalyticsBucket = "month"
+)
+
 // Document Types
 type DocumentType string
 
@@ -154,6 +315,88 @@ const (
 	DocTypeComplianceDocument  DocumentType = "compliance_document"
 	DocTypePolicyDocument      DocumentType = "policy_document"
 	DocTypeBackgroundReport    DocumentType = "background_report"
+	DocTypeDriverApplication   DocumentType = "driver_application"
+	DocTypeRoadTestCertificate DocumentType = "road_test_certificate"
+)
+
+// Document Confidentiality Levels
+type DocumentConfidentiality string
+
+const (
+	ConfidentialityPublic       DocumentConfidentiality = "public"
+	ConfidentialityInternal     DocumentConfidentiality = "internal"
+	ConfidentialityConfidential DocumentConfidentiality = "confidential"
+	ConfidentialityRestricted   DocumentConfidentiality = "restricted"
+)
+
+// Document Upload Session Status
+type UploadSessionStatus string
+
+const (
+	UploadSessionActive    UploadSessionStatus = "active"
+	UploadSessionCompleted UploadSessionStatus = "completed"
+)
+
+// Document Share Permission Levels
+type DocumentSharePermission string
+
+const (
+	SharePermissionView     DocumentSharePermission = "view"
+	SharePermissionDownload DocumentSharePermission = "download"
+	SharePermissionEdit     DocumentSharePermission = "edit"
+)
+
+// Organization Data Key Status
+type DataKeyStatus string
+
+const (
+	DataKeyActive  DataKeyStatus = "active"
+	DataKeyRetired DataKeyStatus = "retired"
+)
+
+// Document Text Extraction Status
+type TextExtractionStatus string
+
+const (
+	TextExtractionPending     TextExtractionStatus = "pending"
+	TextExtractionIndexed     TextExtractionStatus = "indexed"
+	TextExtractionUnsupported TextExtractionStatus = "unsupported"
+	TextExtractionProtected   TextExtractionStatus = "protected"
+	TextExtractionFailed      TextExtractionStatus = "failed"
+)
+
+// Legal Hold Scopes
+type LegalHoldScope string
+
+const (
+	LegalHoldOrganization LegalHoldScope = "organization"
+	LegalHoldUser         LegalHoldScope = "user"
+	LegalHoldDocument     LegalHoldScope = "document"
+)
+
+// Certificate Types
+const CertificateTypeRoadTest = "road_test"
+
+// Driver Qualification File Requirements (49 CFR 391.51)
+type DQRequirement string
+
+const (
+	DQEmploymentApplication DQRequirement = "employment_application" // 391.21
+	DQMotorVehicleRecord    DQRequirement = "motor_vehicle_record"   // 391.23
+	DQRoadTest              DQRequirement = "road_test"              // 391.31, 391.33
+	DQMedicalCertificate    DQRequirement = "medical_certificate"    // 391.43
+	DQAnnualReview          DQRequirement = "annual_review"          // 391.25
+)
+
+// Driver Qualification File Item Sources
+type DQSource string
+
+const (
+	DQSourceDocument        DQSource = "document"
+	DQSourceMVRReport       DQSource = "mvr_report"
+	DQSourceDOTPhysical     DQSource = "dot_physical"
+	DQSourceCertificate     DQSource = "certificate"
+	DQSourceMVRAnnualReview DQSource = "mvr_annual_review"
 )
 
 // Notification Types
@@ -176,6 +419,70 @@ const (
 	NotificationPriorityUrgent NotificationPriority = "urgent"
 )
 
+// Notification Categories
+type NotificationCategory string
+
+const (
+	NotificationCategoryGeneral             NotificationCategory = "general"
+	NotificationCategoryTestReminder        NotificationCategory = "test_reminder"
+	NotificationCategoryCertificateExpiring NotificationCategory = "certificate_expiring"
+	NotificationCategoryViolationAlert      NotificationCategory = "violation_alert"
+	NotificationCategoryAppointment         NotificationCategory = "appointment"
+	NotificationCategoryComplianceAlert     NotificationCategory = "compliance_alert"
+	NotificationCategoryDocumentShared      NotificationCategory = "document_shared"
+
+	// NotificationCategoryAll holds preferences covering every category; a channel turned off
+	// there is off for all notifications
+	NotificationCategoryAll NotificationCategory = "all"
+)
+
+// Notification Delivery Statuses
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliverySent      NotificationDeliveryStatus = "sent"
+	NotificationDeliveryDelivered NotificationDeliveryStatus = "delivered"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+// Notification Recurrences
+type NotificationRecurrence string
+
+const (
+	NotificationRecurrenceNone    NotificationRecurrence = ""
+	NotificationRecurrenceDaily   NotificationRecurrence = "daily"
+	NotificationRecurrenceWeekly  NotificationRecurrence = "weekly"
+	NotificationRecurrenceMonthly NotificationRecurrence = "monthly"
+)
+
+// Bulk Notification Statuses
+type BulkNotificationStatus string
+
+const (
+	BulkNotificationQueued    BulkNotificationStatus = "queued"
+	BulkNotificationRunning   BulkNotificationStatus = "running"
+	BulkNotificationCompleted BulkNotificationStatus = "completed"
+)
+
+// Bulk Notification Recipient Statuses
+type BulkRecipientStatus string
+
+const (
+	BulkRecipientPending BulkRecipientStatus = "pending"
+	BulkRecipientSent    BulkRecipientStatus = "sent"
+	BulkRecipientSkipped BulkRecipientStatus = "skipped"
+	BulkRecipientFailed  BulkRecipientStatus = "failed"
+)
+
+// Email Suppression Reasons
+type EmailSuppressionReason string
+
+const (
+	EmailSuppressionHardBounce EmailSuppressionReason = "hard_bounce"
+	EmailSuppressionComplaint  EmailSuppressionReason = "complaint"
+)
+
 // Workflow Status
 type WorkflowStatus string
 
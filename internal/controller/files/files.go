@@ -0,0 +1,97 @@
+package files
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/docbundle"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+)
+
+// Controller serves document content over plain HTTP for signed links: downloads of encrypted
+// documents, which are decrypted by the API, document share links and driver qualification file
+// bundles
+type Controller struct{}
+
+// DocumentDownload streams an encrypted document's decrypted content for a signed download URL
+func (*Controller) DocumentDownload(r *ghttp.Request) {
+	q := r.URL.Query()
+	rc, document, err := service.Document().OpenSignedDownload(r.Context(), r.Get("documentId").String(),
+		q.Get("expires"), q.Get("user"), q.Get("signature"))
+	writeDocument(r, rc, document, "attachment", err)
+}
+
+// DocumentShare streams the shared document for a signed share link. Adding download=1 downloads
+// it, which the share's permission level must allow; otherwise it is displayed inline.
+func (*Controller) DocumentShare(r *ghttp.Request) {
+	download := r.URL.Query().Get("download") == "1"
+	rc, document, err := service.Document().OpenSharedDocument(r.Context(), r.Get("shareId").String(),
+		r.URL.Query().Get("signature"), download)
+	disposition := "inline"
+	if download {
+		disposition = "attachment"
+	}
+	writeDocument(r, rc, document, disposition, err)
+}
+
+// DQFile assembles and streams a driver qualification file bundle for a signed download URL
+func (*Controller) DQFile(r *ghttp.Request) {
+	q := r.URL.Query()
+	rc, fileName, err := service.Document().OpenSignedDQFile(r.Context(), r.Get("userId").String(),
+		q.Get("format"), q.Get("expires"), q.Get("user"), q.Get("signature"))
+	if err != nil {
+		writeDownloadError(r, err)
+		return
+	}
+	defer rc.Close()
+
+	ctx := r.Context()
+	w := r.Response.ResponseWriter
+	format, _ := docbundle.ParseFormat(q.Get("format"))
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("Cache-Control", "no-store")
+	if _, err := io.Copy(w, rc); err != nil {
+		g.Log().Warningf(ctx, "driver qualification file download %s interrupted: %v", fileName, err)
+	}
+}
+
+// writeDocument writes opened document content, or the HTTP status for the error opening it
+func writeDocument(r *ghttp.Request, rc io.ReadCloser, document *entity.Documents, disposition string, err error) {
+	ctx := r.Context()
+	w := r.Response.ResponseWriter
+	if err != nil {
+		writeDownloadError(r, err)
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", document.MimeType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": document.FileName}))
+	w.Header().Set("Cache-Control", "no-store")
+	if _, err := io.Copy(w, rc); err != nil {
+		g.Log().Warningf(ctx, "document download %s interrupted: %v", document.Id, err)
+	}
+}
+
+// writeDownloadError writes the HTTP status for an error opening a download
+func writeDownloadError(r *ghttp.Request, err error) {
+	status := http.StatusInternalServerError
+	switch gerror.Code(err) {
+	case gcode.CodeNotAuthorized:
+		status = http.StatusForbidden
+	case gcode.CodeNotFound:
+		status = http.StatusNotFound
+	case gcode.CodeInvalidParameter:
+		status = http.StatusBadRequest
+	default:
+		g.Log().Errorf(r.Context(), "document download failed: %v", err)
+	}
+	http.Error(r.Response.ResponseWriter, http.StatusText(status), status)
+}
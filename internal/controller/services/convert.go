@@ -0,0 +1,456 @@
+package services
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	v1 "v1consortium/api/services/v1"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/msgtemplate"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func toBackgroundCheckTurnaround(in []*model.BackgroundCheckTurnaround) []*v1.BackgroundCheckTurnaround {
+	out := make([]*v1.BackgroundCheckTurnaround, 0, len(in))
+	for _, t := range in {
+		out = append(out, &v1.BackgroundCheckTurnaround{
+			Provider:               t.Provider,
+			CheckType:              t.CheckType,
+			TotalChecks:            int32(t.TotalChecks),
+			CompletedChecks:        int32(t.CompletedChecks),
+			AverageTurnaroundHours: float32(t.AverageTurnaroundHours),
+			RequiresReviewRate:     float32(t.RequiresReviewRate),
+			AdverseActionRate:      float32(t.AdverseActionRate),
+			TotalCost:              float32(t.TotalCost),
+		})
+	}
+	return out
+}
+
+func toMVRAnnualReview(in *entity.MvrAnnualReviews) *v1.MVRAnnualReview {
+	out := &v1.MVRAnnualReview{
+		Id:             in.Id,
+		OrganizationId: in.OrganizationId,
+		UserId:         in.UserId,
+		MvrReportId:    in.MvrReportId,
+		Status:         in.Status,
+		Determination:  in.Determination,
+		ReviewedBy:     in.ReviewedBy,
+		Notes:          in.Notes,
+		DocumentId:     in.DocumentId,
+	}
+	if in.DueDate != nil {
+		out.DueDate = timestamppb.New(in.DueDate.Time)
+	}
+	if in.ReviewedAt != nil {
+		out.ReviewedAt = timestamppb.New(in.ReviewedAt.Time)
+	}
+	return out
+}
+
+func toMedicalExaminerInfo(in *model.MedicalExaminerDetail) *v1.MedicalExaminerInfo {
+	e := in.Examiner
+	out := &v1.MedicalExaminerInfo{
+		ExaminerId:              e.Id,
+		FirstName:               e.FirstName,
+		LastName:                e.LastName,
+		Email:                   e.Email,
+		Phone:                   e.Phone,
+		LicenseNumber:           e.LicenseNumber,
+		LicenseState:            e.LicenseState,
+		LicenseExpiration:       toTimestamp(e.LicenseExpiration),
+		CertificationNumber:     e.RegistryNumber,
+		CertificationExpiration: toTimestamp(e.CertificationExpiration),
+		PracticeName:            e.PracticeName,
+		FullAddress:             joinAddress(e.AddressLine1, e.AddressLine2, e.City, e.State, e.ZipCode),
+		AcceptsNewPatients:      e.AcceptsNewPatients,
+		IsActive:                e.IsActive,
+		ExaminationsCompleted:   int32(in.ExaminationsCompleted),
+		RegistryStatus:          e.RegistryStatus,
+		RegistrationCurrent:     in.RegistrationCurrent,
+	}
+	if e.Specializations != "" {
+		_ = json.Unmarshal([]byte(e.Specializations), &out.Specializations)
+	}
+	for _, c := range in.Clinics {
+		out.Clinics = append(out.Clinics, &v1.MedicalExaminerClinic{
+			ClinicId:     c.Id,
+			Name:         c.Name,
+			AddressLine1: c.AddressLine1,
+			AddressLine2: c.AddressLine2,
+			City:         c.City,
+			State:        c.State,
+			ZipCode:      c.ZipCode,
+			Phone:        c.Phone,
+			Timezone:     c.Timezone,
+		})
+	}
+	return out
+}
+
+func toMedicalExemption(in *entity.DotPhysicalExemptions) *v1.MedicalExemption {
+	out := &v1.MedicalExemption{
+		ExemptionId:      in.Id,
+		ExemptionType:    in.ExemptionType,
+		ExemptionNumber:  in.ExemptionNumber,
+		IssuingAuthority: in.IssuingAuthority,
+		DocumentId:       in.DocumentId,
+	}
+	if in.IssuedDate != nil {
+		out.IssuedDate = timestamppb.New(in.IssuedDate.Time)
+	}
+	if in.ExpirationDate != nil {
+		out.ExpirationDate = timestamppb.New(in.ExpirationDate.Time)
+		out.IsExpired = in.ExpirationDate.Before(gtime.Now())
+	}
+	return out
+}
+
+func toMedicalFollowUpTask(in *entity.MedicalFollowUpTasks) *v1.MedicalFollowUpTask {
+	out := &v1.MedicalFollowUpTask{
+		TaskId:         in.Id,
+		OrganizationId: in.OrganizationId,
+		UserId:         in.UserId,
+		PhysicalId:     in.PhysicalId,
+		TaskType:       in.TaskType,
+		Title:          in.Title,
+		Description:    in.Description,
+		Status:         in.Status,
+		CompletedBy:    in.CompletedBy,
+		Notes:          in.Notes,
+	}
+	if in.DueDate != nil {
+		out.DueDate = timestamppb.New(in.DueDate.Time)
+	}
+	if in.CompletedAt != nil {
+		out.CompletedAt = timestamppb.New(in.CompletedAt.Time)
+	}
+	return out
+}
+
+func toClinicAvailabilitySlot(in *model.ClinicAvailabilitySlot) *v1.ClinicAvailabilitySlot {
+	if in == nil {
+		return nil
+	}
+	slot := in.Slot
+	out := &v1.ClinicAvailabilitySlot{
+		SlotId:     slot.Id,
+		ClinicId:   slot.ClinicId,
+		ExaminerId: slot.ExaminerId,
+		StartsAt:   toTimestamp(slot.StartsAt),
+		EndsAt:     toTimestamp(slot.EndsAt),
+		IsBooked:   slot.PhysicalId != "",
+		IsBlocked:  slot.IsBlocked,
+	}
+	if c := in.Clinic; c != nil {
+		out.ClinicName = c.Name
+		out.ClinicAddress = joinAddress(c.AddressLine1, c.AddressLine2, c.City, c.State, c.ZipCode)
+		out.ClinicPhone = c.Phone
+		out.Timezone = c.Timezone
+	}
+	if e := in.Examiner; e != nil {
+		out.ExaminerName = e.FirstName + " " + e.LastName
+	}
+	return out
+}
+
+// joinAddress formats an address as "line1, line2, city, ST zip", skipping blank parts.
+func joinAddress(line1, line2, city, state, zip string) string {
+	parts := make([]string, 0, 4)
+	for _, p := range []string{line1, line2, city} {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if region := strings.TrimSpace(state + " " + zip); region != "" {
+		parts = append(parts, region)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func toDocumentUploadSession(in *entity.DocumentUploadSessions) *v1.DocumentUploadSession {
+	return &v1.DocumentUploadSession{
+		UploadSessionId: in.Id,
+		Status:          in.Status,
+		TotalSize:       in.TotalSize,
+		ReceivedBytes:   in.ReceivedBytes,
+		ExpiresAt:       toTimestamp(in.ExpiresAt),
+		DocumentId:      in.DocumentId,
+	}
+}
+
+func toDocumentUploadLimits(in *model.DocumentUploadLimits) *v1.DocumentUploadLimits {
+	return &v1.DocumentUploadLimits{
+		OrganizationId:   in.OrganizationID,
+		MaxFileSizeBytes: in.MaxFileSizeBytes,
+		AllowedMimeTypes: in.AllowedMimeTypes,
+	}
+}
+
+func toDocumentVersionDiff(in *model.DocumentVersionDiff) *v1.DocumentVersionDiff {
+	out := &v1.DocumentVersionDiff{
+		FromVersion: int32(in.FromVersion),
+		ToVersion:   int32(in.ToVersion),
+	}
+	for _, c := range in.Changes {
+		out.Changes = append(out.Changes, &v1.DocumentFieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return out
+}
+
+func toSharedDocument(in *model.SharedDocument) (*v1.SharedDocument, error) {
+	out := &v1.SharedDocument{
+		ShareId:         in.Share.Id,
+		SharedBy:        in.Share.SharedBy,
+		SharedWith:      in.Share.SharedWith,
+		PermissionLevel: in.Share.PermissionLevel,
+		SharedAt:        toTimestamp(in.Share.CreatedAt),
+		ExpiresAt:       toTimestamp(in.Share.ExpiresAt),
+		IsActive:        in.Active,
+		Message:         in.Share.Message,
+		RevokedAt:       toTimestamp(in.Share.RevokedAt),
+		ShareUrl:        in.URL,
+	}
+	if in.Document != nil {
+		if err := gconv.Struct(in.Document, &out.Document); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func toDocumentRetentionInfo(in *model.DocumentRetentionInfo, now *gtime.Time) *v1.DocumentRetentionInfo {
+	d := in.Document
+	out := &v1.DocumentRetentionInfo{
+		DocumentId:             d.Id,
+		Title:                  d.Title,
+		DocumentType:           d.DocumentType,
+		UploadedAt:             toTimestamp(d.UploadedAt),
+		AutoDeleteAt:           toTimestamp(in.DeleteAt),
+		RetentionPolicyApplied: in.PolicyApplied,
+		OnLegalHold:            in.OnLegalHold,
+		RetainUntil:            toTimestamp(in.RetainUntil),
+	}
+	if in.DeleteAt != nil && in.DeleteAt.After(now) {
+		out.DaysUntilDeletion = int32(math.Ceil(in.DeleteAt.Sub(now).Hours() / 24))
+	}
+	return out
+}
+
+func toLegalHold(in *entity.LegalHolds) *v1.LegalHold {
+	return &v1.LegalHold{
+		HoldId:         in.Id,
+		OrganizationId: in.OrganizationId,
+		CaseReference:  in.CaseReference,
+		Reason:         in.Reason,
+		Scope:          in.Scope,
+		UserId:         in.UserId,
+		DocumentId:     in.DocumentId,
+		PlacedBy:       in.PlacedBy,
+		PlacedAt:       toTimestamp(in.PlacedAt),
+		ReleasedBy:     in.ReleasedBy,
+		ReleasedAt:     toTimestamp(in.ReleasedAt),
+		IsActive:       in.ReleasedAt == nil,
+	}
+}
+
+func toDriverQualificationFile(in *model.DQFile) *v1.AssembleDriverQualificationFileResponse {
+	out := &v1.AssembleDriverQualificationFileResponse{
+		UserId:         in.UserID,
+		OrganizationId: in.OrganizationID,
+		DriverName:     in.DriverName,
+		Items:          make([]*v1.DriverQualificationFileItem, 0, len(in.Items)),
+		MissingItems:   make([]*v1.DriverQualificationFileMissingItem, 0, len(in.Missing)),
+	}
+	for _, item := range in.Items {
+		out.Items = append(out.Items, &v1.DriverQualificationFileItem{
+			Requirement: string(item.Requirement),
+			Source:      string(item.Source),
+			SourceId:    item.SourceID,
+			Title:       item.Title,
+			Date:        toTimestamp(item.Date),
+			Details:     item.Details,
+			DocumentId:  item.DocumentID,
+			Withheld:    item.Withheld,
+		})
+	}
+	for _, m := range in.Missing {
+		out.MissingItems = append(out.MissingItems, &v1.DriverQualificationFileMissingItem{
+			Requirement: string(m.Requirement),
+			Description: m.Description,
+		})
+	}
+	return out
+}
+
+// toNotificationPriority maps a requested priority to a notification priority; "medium" is normal
+func toNotificationPriority(priority string) consts.NotificationPriority {
+	if priority == "medium" {
+		return consts.NotificationPriorityNormal
+	}
+	return consts.NotificationPriority(priority)
+}
+
+func toNotificationTemplate(in *entity.NotificationTemplates) (*v1.NotificationTemplate, error) {
+	out := &v1.NotificationTemplate{
+		TemplateId:       in.Id,
+		OrganizationId:   in.OrganizationId,
+		TemplateName:     in.TemplateName,
+		NotificationType: in.Category,
+		Channel:          in.Channel,
+		SubjectTemplate:  in.SubjectTemplate,
+		BodyTemplate:     in.BodyTemplate,
+		IsSystemTemplate: in.OrganizationId == "",
+		IsActive:         in.IsActive,
+		CreatedAt:        toTimestamp(in.CreatedAt),
+		UpdatedAt:        toTimestamp(in.UpdatedAt),
+		TemplateKey:      in.TemplateKey,
+		Locale:           in.Locale,
+		HtmlTemplate:     in.HtmlTemplate,
+	}
+	var variables []msgtemplate.Variable
+	if in.Variables != "" {
+		if err := json.Unmarshal([]byte(in.Variables), &variables); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range variables {
+		out.Variables = append(out.Variables, &v1.TemplateVariable{
+			Name:        v.Name,
+			Type:        string(v.Type),
+			Required:    v.Required,
+			Description: v.Description,
+		})
+		if v.Required {
+			out.RequiredVariables = append(out.RequiredVariables, v.Name)
+		}
+	}
+	return out, nil
+}
+
+// fromTemplateVariables returns the variables of a template request. Variables named only in
+// requiredVariables are required strings. It returns nil when the request has neither.
+func fromTemplateVariables(variables []*v1.TemplateVariable, requiredVariables []string) []msgtemplate.Variable {
+	if len(variables) == 0 && len(requiredVariables) == 0 {
+		return nil
+	}
+	out := make([]msgtemplate.Variable, 0, len(variables)+len(requiredVariables))
+	declared := map[string]bool{}
+	for _, v := range variables {
+		t := msgtemplate.VarType(v.Type)
+		if t == "" {
+			t = msgtemplate.TypeString
+		}
+		out = append(out, msgtemplate.Variable{Name: v.Name, Type: t, Required: v.Required, Description: v.Description})
+		declared[v.Name] = true
+	}
+	for _, name := range requiredVariables {
+		if !declared[name] {
+			out = append(out, msgtemplate.Variable{Name: name, Type: msgtemplate.TypeString, Required: true})
+			declared[name] = true
+		}
+	}
+	return out
+}
+
+func fromBulkRecipientFilter(in *v1.BulkRecipientFilter) model.BulkRecipientFilter {
+	var filter model.BulkRecipientFilter
+	if in == nil {
+		return filter
+	}
+	filter.RandomPoolID = in.RandomPoolId
+	for _, role := range in.Roles {
+		filter.Roles = append(filter.Roles, consts.UserRole(role))
+	}
+	return filter
+}
+
+func toBulkNotificationStatus(in *model.BulkNotificationStatus) *v1.BulkNotificationStatus {
+	return &v1.BulkNotificationStatus{
+		BulkSendId:        in.ID,
+		OrganizationId:    in.OrganizationID,
+		Status:            in.Status,
+		TotalRecipients:   int32(in.TotalRecipients),
+		RecipientsSent:    int32(in.Sent),
+		RecipientsSkipped: int32(in.Skipped),
+		RecipientsFailed:  int32(in.Failed),
+		RecipientsPending: int32(in.Pending),
+		FailedUserIds:     in.FailedUserIDs,
+		CreatedAt:         toTimestamp(in.CreatedAt),
+		StartedAt:         toTimestamp(in.StartedAt),
+		CompletedAt:       toTimestamp(in.CompletedAt),
+	}
+}
+
+func toNotificationEvent(in *model.NotificationEvent) (*v1.NotificationEvent, error) {
+	out := &v1.NotificationEvent{
+		Event:          string(in.Type),
+		NotificationId: in.NotificationID,
+		UnreadCount:    int32(in.UnreadCount),
+		OccurredAt:     toTimestamp(in.OccurredAt),
+	}
+	if in.Notification != nil {
+		if err := gconv.Struct(in.Notification, &out.Notification); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func toScheduledNotification(in *model.ScheduledNotification) *v1.ScheduledNotification {
+	out := &v1.ScheduledNotification{
+		ScheduledNotificationId: in.ID,
+		OrganizationId:          in.OrganizationID,
+		UserId:                  in.UserID,
+		NotificationType:        string(in.Category),
+		Subject:                 in.Title,
+		Message:                 in.Message,
+		SendAt:                  toTimestamp(in.SendAt),
+		Status:                  in.Status,
+		CreatedAt:               toTimestamp(in.CreatedAt),
+		Priority:                string(in.Priority),
+		RecurrencePattern:       string(in.Recurrence),
+		RecurrenceEnd:           toTimestamp(in.RecurrenceEnd),
+		TemplateId:              in.TemplateID,
+	}
+	if len(in.Channels) > 0 {
+		out.Channel = string(in.Channels[0])
+	}
+	return out
+}
+
+func toNotificationMetrics(in *model.NotificationAnalytics) *v1.NotificationMetrics {
+	out := &v1.NotificationMetrics{
+		TotalSent:       int32(in.Sent),
+		TotalDelivered:  int32(in.Delivered),
+		TotalFailed:     int32(in.Failed),
+		TotalRead:       int32(in.Read),
+		DeliveryRate:    float32(in.DeliveryRate),
+		ReadRate:        float32(in.ReadRate),
+		ByChannel:       make(map[string]int32, len(in.ByChannel)),
+		ByType:          make(map[string]int32, len(in.ByCategory)),
+		TotalBounced:    int32(in.Bounced),
+		TotalComplained: int32(in.Complained),
+		TotalOpened:     int32(in.Opened),
+		TotalClicked:    int32(in.Clicked),
+		BounceRate:      float32(in.BounceRate),
+		OpenRate:        float32(in.OpenRate),
+		ClickRate:       float32(in.ClickRate),
+	}
+	for channel, count := range in.ByChannel {
+		out.ByChannel[channel] = int32(count)
+	}
+	for category, count := range in.ByCategory {
+		out.ByType[category] = int32(count)
+	}
+	return out
+}
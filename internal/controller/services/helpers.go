@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/util/gconv"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func init() {
+	// Lets gconv.Struct convert entity structs into their pbentity messages.
+	if err := gconv.RegisterConverter(func(in gtime.Time) (*timestamppb.Timestamp, error) {
+		return timestamppb.New(in.Time), nil
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// currentUserID returns the authenticated user's ID, or an empty string when there is none.
+func currentUserID(ctx context.Context) string {
+	user, err := service.BizCtx().GetSupabaseUser(ctx)
+	if err != nil || user == nil {
+		return ""
+	}
+	return user.User.ID.String()
+}
+
+// pagination normalizes a 1-based page request into page, page size and row offset.
+func pagination(page, pageSize int32) (int32, int32, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	return page, pageSize, int((page - 1) * pageSize)
+}
+
+// toGTime converts an optional request timestamp, returning nil when it is unset.
+func toGTime(ts *timestamppb.Timestamp) *gtime.Time {
+	if ts == nil {
+		return nil
+	}
+	return gtime.New(ts.AsTime())
+}
+
+// toTimestamp converts an optional time for a response, returning nil when it is unset.
+func toTimestamp(t *gtime.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
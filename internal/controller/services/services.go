@@ -1,12 +1,25 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	v1 "v1consortium/api/services/v1"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/deliverywindow"
+	"v1consortium/internal/pkg/docbundle"
+	"v1consortium/internal/service"
 
 	"github.com/gogf/gf/contrib/rpc/grpcx/v2"
 	"github.com/gogf/gf/v2/errors/gcode"
 	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/gogf/gf/v2/text/gstr"
+	"github.com/gogf/gf/v2/util/gconv"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Controller struct {
@@ -66,7 +79,69 @@ func (*Controller) GetProviderStatus(ctx context.Context, req *v1.GetProviderSta
 }
 
 func (*Controller) GetBackgroundCheckAnalytics(ctx context.Context, req *v1.GetBackgroundCheckAnalyticsRequest) (res *v1.GetBackgroundCheckAnalyticsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.BackgroundCheckAnalyticsInput{
+		OrganizationID: req.OrganizationId,
+		Bucket:         consts.AnalyticsBucket(req.Bucket),
+		RequestedBy:    currentUserID(ctx),
+	}
+	if req.StartDate != nil {
+		in.StartDate = gtime.New(req.StartDate.AsTime())
+	}
+	if req.EndDate != nil {
+		in.EndDate = gtime.New(req.EndDate.AsTime())
+	}
+
+	out, err := service.BackgroundCheck().GetBackgroundCheckAnalytics(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetBackgroundCheckAnalyticsResponse{
+		TotalChecks:                int32(out.TotalChecks),
+		CompletedChecks:            int32(out.CompletedChecks),
+		PendingChecks:              int32(out.PendingChecks),
+		ClearResults:               int32(out.ClearResults),
+		ConsiderResults:            int32(out.ConsiderResults),
+		EngagedResults:             int32(out.EngagedResults),
+		AdverseActionsInitiated:    int32(out.AdverseActionsInitiated),
+		AverageCompletionTimeHours: float32(out.AverageTurnaroundHours),
+		TotalCost:                  float32(out.TotalCost),
+		RequiresReviewRate:         float32(out.RequiresReviewRate),
+		AdverseActionRate:          float32(out.AdverseActionRate),
+		Turnaround:                 toBackgroundCheckTurnaround(out.Turnaround),
+	}
+	for _, f := range out.Findings {
+		res.FindingsSummary = append(res.FindingsSummary, &v1.FindingSummary{
+			FindingType: f.FindingType,
+			Severity:    f.Severity,
+			Count:       int32(f.Count),
+		})
+	}
+	for _, b := range out.Buckets {
+		res.Buckets = append(res.Buckets, &v1.BackgroundCheckAnalyticsBucket{
+			OrganizationId:         b.OrganizationID,
+			BucketStart:            timestamppb.New(b.BucketStart.Time),
+			TotalChecks:            int32(b.TotalChecks),
+			CompletedChecks:        int32(b.CompletedChecks),
+			AverageTurnaroundHours: float32(b.AverageTurnaroundHours),
+			RequiresReviewRate:     float32(b.RequiresReviewRate),
+			AdverseActionRate:      float32(b.AdverseActionRate),
+			TotalCost:              float32(b.TotalCost),
+			Turnaround:             toBackgroundCheckTurnaround(b.Turnaround),
+		})
+	}
+	for _, pc := range out.PackageCosts {
+		res.PackageCosts = append(res.PackageCosts, &v1.BackgroundCheckPackageCost{
+			PackageId:   pc.PackageID,
+			PackageCode: pc.PackageCode,
+			Name:        pc.Name,
+			Provider:    pc.Provider,
+			CheckType:   pc.CheckType,
+			TotalChecks: int32(pc.TotalChecks),
+			TotalCost:   float32(pc.TotalCost),
+		})
+	}
+	return res, nil
 }
 
 func (*Controller) GetComplianceStatus(ctx context.Context, req *v1.GetComplianceStatusRequest) (res *v1.GetComplianceStatusResponse, err error) {
@@ -126,11 +201,123 @@ func (*Controller) GetComplianceAuditTrail(ctx context.Context, req *v1.GetCompl
 }
 
 func (*Controller) UploadDocument(ctx context.Context, req *v1.UploadDocumentRequest) (res *v1.UploadDocumentResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	document, err := service.Document().UploadDocument(ctx, &model.DocumentUploadInput{
+		OrganizationID:       req.OrganizationId,
+		UserID:               req.UserId,
+		DocumentType:         consts.DocumentType(req.DocumentType),
+		Title:                req.Title,
+		Description:          req.Description,
+		FileName:             req.Filename,
+		ContentType:          req.ContentType,
+		RelatedEntityType:    req.RelatedEntityType,
+		RelatedEntityID:      req.RelatedEntityId,
+		IsHipaaProtected:     req.IsHipaaProtected,
+		ConfidentialityLevel: consts.DocumentConfidentiality(req.ConfidentialityLevel),
+		AutoDeleteAt:         toGTime(req.AutoDeleteAt),
+		UploadedBy:           currentUserID(ctx),
+	}, bytes.NewReader(req.FileData))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.UploadDocumentResponse{}
+	if err = gconv.Struct(document, &res.Document); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) GetDocument(ctx context.Context, req *v1.GetDocumentRequest) (res *v1.GetDocumentResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	res = &v1.GetDocumentResponse{}
+	var document *entity.Documents
+	if req.IncludeContent {
+		var rc io.ReadCloser
+		if rc, document, err = service.Document().OpenDocument(ctx, req.DocumentId, currentUserID(ctx)); err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		if res.FileData, err = io.ReadAll(rc); err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to read document")
+		}
+	} else {
+		if document, err = service.Document().GetDocument(ctx, req.DocumentId); err != nil {
+			return nil, err
+		}
+		if res.DownloadUrl, err = service.Document().GetDownloadURL(ctx, req.DocumentId, currentUserID(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	if err = gconv.Struct(document, &res.Document); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StreamUploadDocument receives a streamed upload once the transport has decoded its header; r
+// yields the chunks that follow it.
+func (*Controller) StreamUploadDocument(ctx context.Context, header *v1.UploadDocumentHeader, r io.Reader) (res *v1.StreamUploadDocumentResponse, err error) {
+	uploadedBy := currentUserID(ctx)
+	progress, err := service.Document().ReceiveUpload(ctx, &model.DocumentUploadHeader{
+		SessionID:  header.UploadSessionId,
+		Offset:     header.Offset,
+		TotalSize:  header.TotalSize,
+		UploadedBy: uploadedBy,
+		Upload: &model.DocumentUploadInput{
+			OrganizationID:       header.OrganizationId,
+			UserID:               header.UserId,
+			DocumentType:         consts.DocumentType(header.DocumentType),
+			Title:                header.Title,
+			Description:          header.Description,
+			FileName:             header.Filename,
+			ContentType:          header.ContentType,
+			RelatedEntityType:    header.RelatedEntityType,
+			RelatedEntityID:      header.RelatedEntityId,
+			IsHipaaProtected:     header.IsHipaaProtected,
+			ConfidentialityLevel: consts.DocumentConfidentiality(header.ConfidentialityLevel),
+			AutoDeleteAt:         toGTime(header.AutoDeleteAt),
+			UploadedBy:           uploadedBy,
+		},
+	}, r)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.StreamUploadDocumentResponse{Session: toDocumentUploadSession(progress.Session)}
+	if progress.Document != nil {
+		if err = gconv.Struct(progress.Document, &res.Document); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (*Controller) GetDocumentUploadSession(ctx context.Context, req *v1.GetDocumentUploadSessionRequest) (res *v1.GetDocumentUploadSessionResponse, err error) {
+	session, err := service.Document().GetUploadSession(ctx, req.UploadSessionId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetDocumentUploadSessionResponse{Session: toDocumentUploadSession(session)}, nil
+}
+
+func (*Controller) GetDocumentUploadLimits(ctx context.Context, req *v1.GetDocumentUploadLimitsRequest) (res *v1.GetDocumentUploadLimitsResponse, err error) {
+	limits, err := service.Document().GetUploadLimits(ctx, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetDocumentUploadLimitsResponse{Limits: toDocumentUploadLimits(limits)}, nil
+}
+
+func (*Controller) SetDocumentUploadLimits(ctx context.Context, req *v1.SetDocumentUploadLimitsRequest) (res *v1.SetDocumentUploadLimitsResponse, err error) {
+	limits, err := service.Document().SetUploadLimits(ctx, &model.DocumentUploadLimits{
+		OrganizationID:   req.OrganizationId,
+		MaxFileSizeBytes: req.MaxFileSizeBytes,
+		AllowedMimeTypes: req.AllowedMimeTypes,
+		UpdatedBy:        currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SetDocumentUploadLimitsResponse{Limits: toDocumentUploadLimits(limits)}, nil
 }
 
 func (*Controller) ListDocuments(ctx context.Context, req *v1.ListDocumentsRequest) (res *v1.ListDocumentsResponse, err error) {
@@ -138,7 +325,30 @@ func (*Controller) ListDocuments(ctx context.Context, req *v1.ListDocumentsReque
 }
 
 func (*Controller) UpdateDocument(ctx context.Context, req *v1.UpdateDocumentRequest) (res *v1.UpdateDocumentResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	var content io.Reader
+	if len(req.FileData) > 0 {
+		content = bytes.NewReader(req.FileData)
+	}
+	document, err := service.Document().UpdateDocument(ctx, &model.DocumentUpdateInput{
+		DocumentID:           req.DocumentId,
+		Title:                req.Title,
+		Description:          req.Description,
+		ConfidentialityLevel: consts.DocumentConfidentiality(req.ConfidentialityLevel),
+		AutoDeleteAt:         toGTime(req.AutoDeleteAt),
+		FileName:             req.Filename,
+		ContentType:          req.ContentType,
+		ChangeNote:           req.ChangeNote,
+		UpdatedBy:            currentUserID(ctx),
+	}, content)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.UpdateDocumentResponse{}
+	if err = gconv.Struct(document, &res.Document); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) DeleteDocument(ctx context.Context, req *v1.DeleteDocumentRequest) (res *v1.DeleteDocumentResponse, err error) {
@@ -146,23 +356,116 @@ func (*Controller) DeleteDocument(ctx context.Context, req *v1.DeleteDocumentReq
 }
 
 func (*Controller) GetDocumentVersions(ctx context.Context, req *v1.GetDocumentVersionsRequest) (res *v1.GetDocumentVersionsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	history, err := service.Document().GetDocumentVersions(ctx, req.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetDocumentVersionsResponse{}
+	if err = gconv.Structs(history.Versions, &res.Versions); err != nil {
+		return nil, err
+	}
+	for _, diff := range history.Diffs {
+		res.Diffs = append(res.Diffs, toDocumentVersionDiff(diff))
+	}
+	return res, nil
+}
+
+func (*Controller) RollbackDocument(ctx context.Context, req *v1.RollbackDocumentRequest) (res *v1.RollbackDocumentResponse, err error) {
+	document, err := service.Document().RollbackDocument(ctx, &model.DocumentRollbackInput{
+		DocumentID:   req.DocumentId,
+		Version:      int(req.Version),
+		ChangeNote:   req.ChangeNote,
+		RolledBackBy: currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.RollbackDocumentResponse{}
+	if err = gconv.Struct(document, &res.Document); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) ShareDocument(ctx context.Context, req *v1.ShareDocumentRequest) (res *v1.ShareDocumentResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	shares, err := service.Document().ShareDocument(ctx, &model.DocumentShareInput{
+		DocumentID:      req.DocumentId,
+		SharedWith:      req.ShareWithUserIds,
+		PermissionLevel: consts.DocumentSharePermission(req.PermissionLevel),
+		ExpiresAt:       toGTime(req.ExpiresAt),
+		SharedBy:        currentUserID(ctx),
+		Message:         req.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ShareDocumentResponse{Message: fmt.Sprintf("Document shared with %d recipient(s)", len(shares))}
+	for _, share := range shares {
+		res.ShareIds = append(res.ShareIds, share.Id)
+	}
+	res.ShareId = res.ShareIds[0]
+	return res, nil
 }
 
 func (*Controller) GetSharedDocuments(ctx context.Context, req *v1.GetSharedDocumentsRequest) (res *v1.GetSharedDocumentsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	shared, err := service.Document().GetSharedDocuments(ctx, currentUserID(ctx), req.SharedWithMe, consts.DocumentSharePermission(req.PermissionLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetSharedDocumentsResponse{}
+	for _, sd := range shared {
+		out, err := toSharedDocument(sd)
+		if err != nil {
+			return nil, err
+		}
+		res.SharedDocuments = append(res.SharedDocuments, out)
+	}
+	return res, nil
 }
 
 func (*Controller) RevokeDocumentShare(ctx context.Context, req *v1.RevokeDocumentShareRequest) (res *v1.RevokeDocumentShareResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	if err = service.Document().RevokeDocumentShare(ctx, req.ShareId, currentUserID(ctx)); err != nil {
+		return nil, err
+	}
+	return &v1.RevokeDocumentShareResponse{Message: "Document share revoked"}, nil
 }
 
 func (*Controller) SearchDocuments(ctx context.Context, req *v1.SearchDocumentsRequest) (res *v1.SearchDocumentsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	page, pageSize, offset := pagination(req.Page, req.PageSize)
+	levels := make([]consts.DocumentConfidentiality, 0, len(req.ConfidentialityLevels))
+	for _, level := range req.ConfidentialityLevels {
+		levels = append(levels, consts.DocumentConfidentiality(level))
+	}
+	result, err := service.Document().SearchDocuments(ctx, &model.DocumentSearchInput{
+		OrganizationID:        req.OrganizationId,
+		Query:                 req.Query,
+		DocumentTypes:         req.DocumentTypes,
+		ConfidentialityLevels: levels,
+		UserID:                req.UserId,
+		DateFrom:              toGTime(req.DateFrom),
+		DateTo:                toGTime(req.DateTo),
+		Offset:                offset,
+		Limit:                 int(pageSize),
+		SearchedBy:            currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.SearchDocumentsResponse{
+		TotalCount:       int32(result.TotalCount),
+		Page:             page,
+		PageSize:         pageSize,
+		SearchHighlights: result.Highlights,
+	}
+	if err = gconv.Structs(result.Documents, &res.Documents); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) GetDocumentAnalytics(ctx context.Context, req *v1.GetDocumentAnalyticsRequest) (res *v1.GetDocumentAnalyticsResponse, err error) {
@@ -170,39 +473,450 @@ func (*Controller) GetDocumentAnalytics(ctx context.Context, req *v1.GetDocument
 }
 
 func (*Controller) GetDocumentRetentionStatus(ctx context.Context, req *v1.GetDocumentRetentionStatusRequest) (res *v1.GetDocumentRetentionStatusResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	status, err := service.Document().GetRetentionStatus(ctx, req.OrganizationId, req.DocumentType, int(req.WithinDays), currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetDocumentRetentionStatusResponse{
+		RetentionInfo:                 make([]*v1.DocumentRetentionInfo, 0, len(status.Documents)),
+		DocumentsScheduledForDeletion: int32(status.ScheduledForDeletion),
+	}
+	now := gtime.Now()
+	for _, info := range status.Documents {
+		res.RetentionInfo = append(res.RetentionInfo, toDocumentRetentionInfo(info, now))
+	}
+	return res, nil
 }
 
 func (*Controller) ApplyRetentionPolicy(ctx context.Context, req *v1.ApplyRetentionPolicyRequest) (res *v1.ApplyRetentionPolicyResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	updated, err := service.Document().ApplyRetentionPolicy(ctx, &model.DocumentRetentionPolicyInput{
+		OrganizationID: req.OrganizationId,
+		DocumentType:   req.DocumentType,
+		RetentionDays:  int(req.RetentionDays),
+		ForceApply:     req.ForceApply,
+		AppliedBy:      currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ApplyRetentionPolicyResponse{
+		DocumentsUpdated: int32(updated),
+		Message:          fmt.Sprintf("Retention of %d days applied to %d document(s)", req.RetentionDays, updated),
+	}, nil
+}
+
+func (*Controller) PlaceLegalHold(ctx context.Context, req *v1.PlaceLegalHoldRequest) (res *v1.PlaceLegalHoldResponse, err error) {
+	hold, err := service.Document().PlaceLegalHold(ctx, &model.LegalHoldInput{
+		OrganizationID: req.OrganizationId,
+		CaseReference:  req.CaseReference,
+		Reason:         req.Reason,
+		UserID:         req.UserId,
+		DocumentID:     req.DocumentId,
+		PlacedBy:       currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PlaceLegalHoldResponse{LegalHold: toLegalHold(hold)}, nil
+}
+
+func (*Controller) ReleaseLegalHold(ctx context.Context, req *v1.ReleaseLegalHoldRequest) (res *v1.ReleaseLegalHoldResponse, err error) {
+	hold, err := service.Document().ReleaseLegalHold(ctx, req.HoldId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ReleaseLegalHoldResponse{LegalHold: toLegalHold(hold)}, nil
+}
+
+func (*Controller) ListLegalHolds(ctx context.Context, req *v1.ListLegalHoldsRequest) (res *v1.ListLegalHoldsResponse, err error) {
+	holds, err := service.Document().ListLegalHolds(ctx, req.OrganizationId, req.CaseReference, req.IncludeReleased, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ListLegalHoldsResponse{LegalHolds: make([]*v1.LegalHold, 0, len(holds))}
+	for _, hold := range holds {
+		res.LegalHolds = append(res.LegalHolds, toLegalHold(hold))
+	}
+	return res, nil
+}
+
+func (*Controller) AssembleDriverQualificationFile(ctx context.Context, req *v1.AssembleDriverQualificationFileRequest) (res *v1.AssembleDriverQualificationFileResponse, err error) {
+	format, err := docbundle.ParseFormat(req.Format)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err)
+	}
+	requestedBy := currentUserID(ctx)
+	file, err := service.Document().GetDQFile(ctx, req.UserId, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	download, err := service.Document().GetDQFileDownload(ctx, req.UserId, format, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	res = toDriverQualificationFile(file)
+	res.DownloadUrl = download.URL
+	res.DownloadUrlExpiresAt = toTimestamp(download.ExpiresAt)
+	return res, nil
 }
 
 func (*Controller) ScheduleDOTPhysical(ctx context.Context, req *v1.ScheduleDOTPhysicalRequest) (res *v1.ScheduleDOTPhysicalResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	scheduledBy := req.ScheduledBy
+	if scheduledBy == "" {
+		scheduledBy = currentUserID(ctx)
+	}
+	appointment, err := service.DotPhysical().SchedulePhysical(ctx, &model.DOTPhysicalScheduleInput{
+		OrganizationID:      req.OrganizationId,
+		UserID:              req.UserId,
+		ScheduledBy:         scheduledBy,
+		SlotID:              req.SlotId,
+		ExaminerID:          req.MedicalExaminerId,
+		PreferredDate:       toGTime(req.PreferredDate),
+		ExaminationType:     consts.ExaminationType(req.ExaminationType),
+		SpecialRequirements: req.SpecialRequirements,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	physical := appointment.Physical
+	res = &v1.ScheduleDOTPhysicalResponse{
+		AppointmentConfirmation: physical.Id,
+		ExaminerName:            physical.ExaminerName,
+		FacilityName:            physical.ClinicName,
+		FacilityAddress:         physical.ClinicAddress,
+		Slot:                    toClinicAvailabilitySlot(appointment.Slot),
+		CalendarInvite:          appointment.CalendarInvite,
+	}
+	if err = gconv.Struct(physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) GetDOTPhysical(ctx context.Context, req *v1.GetDOTPhysicalRequest) (res *v1.GetDOTPhysicalResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	detail, err := service.DotPhysical().GetPhysicalDetail(ctx, req.PhysicalId)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetDOTPhysicalResponse{
+		RestrictionCodes: gconv.Strings(detail.Restrictions),
+		Monitoring:       make([]*v1.MedicalMonitoringRequirement, 0, len(detail.Monitoring)),
+		Exemptions:       make([]*v1.MedicalExemption, 0, len(detail.Exemptions)),
+		FollowUpTasks:    make([]*v1.MedicalFollowUpTask, 0, len(detail.FollowUpTasks)),
+	}
+	if err = gconv.Struct(detail.Physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	for _, m := range detail.Monitoring {
+		res.Monitoring = append(res.Monitoring, &v1.MedicalMonitoringRequirement{
+			Condition:      string(m.Condition),
+			IntervalMonths: int32(m.IntervalMonths),
+			Notes:          m.Notes,
+		})
+	}
+	for _, e := range detail.Exemptions {
+		res.Exemptions = append(res.Exemptions, toMedicalExemption(e))
+	}
+	for _, t := range detail.FollowUpTasks {
+		res.FollowUpTasks = append(res.FollowUpTasks, toMedicalFollowUpTask(t))
+	}
+	return res, nil
 }
 
 func (*Controller) UpdateDOTPhysical(ctx context.Context, req *v1.UpdateDOTPhysicalRequest) (res *v1.UpdateDOTPhysicalResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.DOTPhysicalUpdateInput{
+		PhysicalID:                req.PhysicalId,
+		Status:                    req.Status,
+		ExaminationDate:           toGTime(req.ExaminationDate),
+		QualificationStatus:       req.QualificationStatus,
+		CertificateIssueDate:      toGTime(req.CertificateIssueDate),
+		CertificateExpirationDate: toGTime(req.CertificateExpirationDate),
+		CertificateNumber:         req.CertificateNumber,
+		Restrictions:              req.Restrictions,
+		ExaminerNotes:             req.ExaminerNotes,
+		RequiresMonitoring:        req.RequiresMonitoring,
+		MonitoringRequirements:    req.MonitoringRequirements,
+		ExemptionType:             req.ExemptionType,
+		ExemptionExpiration:       toGTime(req.ExemptionExpiration),
+		ExaminerRegistryNumber:    req.ExaminerRegistryNumber,
+		CertificationPeriodMonths: int(req.CertificationPeriodMonths),
+	}
+	for _, code := range req.RestrictionCodes {
+		in.RestrictionCodes = append(in.RestrictionCodes, consts.MedicalRestriction(code))
+	}
+	for _, m := range req.Monitoring {
+		in.Monitoring = append(in.Monitoring, &model.MedicalMonitoringRequirement{
+			Condition:      consts.MonitoringCondition(m.Condition),
+			IntervalMonths: int(m.IntervalMonths),
+			Notes:          m.Notes,
+		})
+	}
+	for _, e := range req.Exemptions {
+		in.Exemptions = append(in.Exemptions, &model.MedicalExemptionInput{
+			ExemptionType:    consts.MedicalExemptionType(e.ExemptionType),
+			ExemptionNumber:  e.ExemptionNumber,
+			IssuingAuthority: e.IssuingAuthority,
+			DocumentID:       e.DocumentId,
+			IssuedDate:       toGTime(e.IssuedDate),
+			ExpirationDate:   toGTime(e.ExpirationDate),
+		})
+	}
+
+	physical, err := service.DotPhysical().UpdatePhysical(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.UpdateDOTPhysicalResponse{}
+	if err = gconv.Struct(physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) ListDOTPhysicals(ctx context.Context, req *v1.ListDOTPhysicalsRequest) (res *v1.ListDOTPhysicalsResponse, err error) {
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+func (*Controller) RescheduleDOTPhysical(ctx context.Context, req *v1.RescheduleDOTPhysicalRequest) (res *v1.RescheduleDOTPhysicalResponse, err error) {
+	appointment, err := service.DotPhysical().ReschedulePhysical(ctx, &model.DOTPhysicalRescheduleInput{
+		PhysicalID: req.PhysicalId,
+		SlotID:     req.SlotId,
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.RescheduleDOTPhysicalResponse{
+		Slot:           toClinicAvailabilitySlot(appointment.Slot),
+		CalendarInvite: appointment.CalendarInvite,
+	}
+	if err = gconv.Struct(appointment.Physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (*Controller) CancelDOTPhysical(ctx context.Context, req *v1.CancelDOTPhysicalRequest) (res *v1.CancelDOTPhysicalResponse, err error) {
+	appointment, err := service.DotPhysical().CancelPhysical(ctx, req.PhysicalId, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.CancelDOTPhysicalResponse{CalendarInvite: appointment.CalendarInvite}
+	if err = gconv.Struct(appointment.Physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (*Controller) MarkDOTPhysicalNoShow(ctx context.Context, req *v1.MarkDOTPhysicalNoShowRequest) (res *v1.MarkDOTPhysicalNoShowResponse, err error) {
+	physical, err := service.DotPhysical().MarkNoShow(ctx, req.PhysicalId, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.MarkDOTPhysicalNoShowResponse{}
+	if err = gconv.Struct(physical, &res.Physical); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (*Controller) ListMedicalFollowUpTasks(ctx context.Context, req *v1.ListMedicalFollowUpTasksRequest) (res *v1.ListMedicalFollowUpTasksResponse, err error) {
+	page, pageSize, offset := pagination(req.Page, req.PageSize)
+	tasks, total, err := service.DotPhysical().ListFollowUpTasks(ctx, &model.MedicalFollowUpTaskListInput{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		Status:         consts.FollowUpTaskStatus(req.Status),
+		TaskType:       consts.FollowUpTaskType(req.TaskType),
+		DueBefore:      toGTime(req.DueBefore),
+		Offset:         offset,
+		Limit:          int(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ListMedicalFollowUpTasksResponse{
+		Tasks:      make([]*v1.MedicalFollowUpTask, 0, len(tasks)),
+		TotalCount: int32(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for _, t := range tasks {
+		res.Tasks = append(res.Tasks, toMedicalFollowUpTask(t))
+	}
+	return res, nil
+}
+
+func (*Controller) CompleteMedicalFollowUpTask(ctx context.Context, req *v1.CompleteMedicalFollowUpTaskRequest) (res *v1.CompleteMedicalFollowUpTaskResponse, err error) {
+	task, err := service.DotPhysical().CompleteFollowUpTask(ctx, &model.MedicalFollowUpTaskCompleteInput{
+		TaskID:      req.TaskId,
+		CompletedBy: currentUserID(ctx),
+		Notes:       req.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CompleteMedicalFollowUpTaskResponse{Task: toMedicalFollowUpTask(task)}, nil
+}
+
+func (*Controller) CreateClinicAvailability(ctx context.Context, req *v1.CreateClinicAvailabilityRequest) (res *v1.CreateClinicAvailabilityResponse, err error) {
+	weekdays := make([]int, 0, len(req.Weekdays))
+	for _, d := range req.Weekdays {
+		weekdays = append(weekdays, int(d))
+	}
+	slots, err := service.DotPhysical().CreateAvailability(ctx, &model.ClinicAvailabilityInput{
+		ClinicID:    req.ClinicId,
+		StartDate:   toGTime(req.StartDate),
+		EndDate:     toGTime(req.EndDate),
+		Weekdays:    weekdays,
+		DayStart:    req.DayStart,
+		DayEnd:      req.DayEnd,
+		SlotMinutes: int(req.SlotMinutes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.CreateClinicAvailabilityResponse{
+		SlotsCreated: int32(len(slots)),
+		Slots:        make([]*v1.ClinicAvailabilitySlot, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		res.Slots = append(res.Slots, toClinicAvailabilitySlot(slot))
+	}
+	return res, nil
+}
+
+func (*Controller) ListClinicAvailability(ctx context.Context, req *v1.ListClinicAvailabilityRequest) (res *v1.ListClinicAvailabilityResponse, err error) {
+	page, pageSize, offset := pagination(req.Page, req.PageSize)
+	slots, total, err := service.DotPhysical().ListAvailability(ctx, &model.ClinicAvailabilityListInput{
+		ClinicID:      req.ClinicId,
+		ExaminerID:    req.MedicalExaminerId,
+		State:         req.State,
+		City:          req.City,
+		ZipCode:       req.ZipCode,
+		StartTime:     toGTime(req.StartTime),
+		EndTime:       toGTime(req.EndTime),
+		IncludeBooked: req.IncludeBooked,
+		Offset:        offset,
+		Limit:         int(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ListClinicAvailabilityResponse{
+		Slots:      make([]*v1.ClinicAvailabilitySlot, 0, len(slots)),
+		TotalCount: int32(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for _, slot := range slots {
+		res.Slots = append(res.Slots, toClinicAvailabilitySlot(slot))
+	}
+	return res, nil
+}
+
 func (*Controller) RegisterMedicalExaminer(ctx context.Context, req *v1.RegisterMedicalExaminerRequest) (res *v1.RegisterMedicalExaminerResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.MedicalExaminerInput{
+		FirstName:               req.FirstName,
+		LastName:                req.LastName,
+		Email:                   req.Email,
+		Phone:                   req.Phone,
+		LicenseNumber:           req.LicenseNumber,
+		LicenseState:            req.LicenseState,
+		LicenseExpiration:       toGTime(req.LicenseExpiration),
+		RegistryNumber:          req.CertificationNumber,
+		CertificationDate:       toGTime(req.CertificationDate),
+		CertificationExpiration: toGTime(req.CertificationExpiration),
+		PracticeName:            req.PracticeName,
+		AddressLine1:            req.AddressLine1,
+		AddressLine2:            req.AddressLine2,
+		City:                    req.City,
+		State:                   req.State,
+		ZipCode:                 req.ZipCode,
+		AcceptsNewPatients:      req.AcceptsNewPatients,
+		Specializations:         req.Specializations,
+	}
+	for _, c := range req.Clinics {
+		in.Clinics = append(in.Clinics, &model.MedicalExaminerClinicInput{
+			Name:         c.Name,
+			AddressLine1: c.AddressLine1,
+			AddressLine2: c.AddressLine2,
+			City:         c.City,
+			State:        c.State,
+			ZipCode:      c.ZipCode,
+			Phone:        c.Phone,
+			Timezone:     c.Timezone,
+		})
+	}
+
+	examiner, err := service.DotPhysical().RegisterExaminer(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Medical examiner registered"
+	if !examiner.RegistrationCurrent {
+		message = fmt.Sprintf("Medical examiner registered; registration is %s and physicals cannot be recorded", examiner.Examiner.RegistryStatus)
+	}
+	return &v1.RegisterMedicalExaminerResponse{ExaminerId: examiner.Examiner.Id, Message: message}, nil
 }
 
 func (*Controller) GetMedicalExaminer(ctx context.Context, req *v1.GetMedicalExaminerRequest) (res *v1.GetMedicalExaminerResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	examiner, err := service.DotPhysical().GetExaminer(ctx, req.ExaminerId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetMedicalExaminerResponse{Examiner: toMedicalExaminerInfo(examiner)}, nil
 }
 
 func (*Controller) ListMedicalExaminers(ctx context.Context, req *v1.ListMedicalExaminersRequest) (res *v1.ListMedicalExaminersResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	// Radius search needs geocoded clinics; location filters narrow by state, city and zip code.
+	examiners, err := service.DotPhysical().ListExaminers(ctx, &model.MedicalExaminerListInput{
+		State:              req.State,
+		City:               req.City,
+		ZipCode:            req.ZipCode,
+		AcceptsNewPatients: req.AcceptsNewPatients,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ListMedicalExaminersResponse{Examiners: make([]*v1.MedicalExaminerInfo, 0, len(examiners))}
+	for _, e := range examiners {
+		res.Examiners = append(res.Examiners, toMedicalExaminerInfo(e))
+	}
+	return res, nil
+}
+
+func (*Controller) ImportMedicalExaminers(ctx context.Context, req *v1.ImportMedicalExaminersRequest) (res *v1.ImportMedicalExaminersResponse, err error) {
+	out, err := service.DotPhysical().ImportExaminersCSV(ctx, bytes.NewReader(req.CsvData))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ImportMedicalExaminersResponse{
+		Created: int32(out.Created),
+		Updated: int32(out.Updated),
+	}
+	for _, e := range out.Errors {
+		res.Errors = append(res.Errors, &v1.MedicalExaminerImportError{
+			Line:           int32(e.Line),
+			RegistryNumber: e.RegistryNumber,
+			Message:        e.Message,
+		})
+	}
+	return res, nil
 }
 
 func (*Controller) GenerateCertificate(ctx context.Context, req *v1.GenerateCertificateRequest) (res *v1.GenerateCertificateResponse, err error) {
@@ -210,15 +924,96 @@ func (*Controller) GenerateCertificate(ctx context.Context, req *v1.GenerateCert
 }
 
 func (*Controller) ValidateCertificate(ctx context.Context, req *v1.ValidateCertificateRequest) (res *v1.ValidateCertificateResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.MedicalCertificateInput{
+		UserID:                 req.UserId,
+		DocumentID:             req.DocumentId,
+		CertificateNumber:      req.CertificateNumber,
+		ExaminerRegistryNumber: req.ExaminerRegistryNumber,
+		DriverLicenseNumber:    req.DriverLicenseNumber,
+		DriverLicenseState:     req.DriverLicenseState,
+		DriverDateOfBirth:      toGTime(req.DriverDateOfBirth),
+		IssueDate:              toGTime(req.IssueDate),
+		ExpirationDate:         toGTime(req.ExpirationDate),
+		RequestedBy:            currentUserID(ctx),
+	}
+	for _, code := range req.RestrictionCodes {
+		in.RestrictionCodes = append(in.RestrictionCodes, consts.MedicalRestriction(code))
+	}
+	if in.UserID == "" && (in.IssueDate != nil || in.ExpirationDate != nil || in.ExaminerRegistryNumber != "") {
+		in.UserID = in.RequestedBy
+	}
+
+	out, err := service.DotPhysical().ValidateCertificate(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ValidateCertificateResponse{
+		IsValid:         out.Status == consts.CertificateValid,
+		Status:          string(out.Status),
+		HolderName:      out.HolderName,
+		IssuingExaminer: out.IssuingExaminer,
+		Errors:          out.Errors,
+	}
+	if p := out.Physical; p != nil {
+		res.PhysicalId = p.Id
+		res.Restrictions = p.Restrictions
+		if p.CertificateExpirationDate != nil {
+			res.ExpirationDate = timestamppb.New(p.CertificateExpirationDate.Time)
+		}
+		if p.CertificateUploadedAt != nil {
+			res.CertificateUploadedAt = timestamppb.New(p.CertificateUploadedAt.Time)
+		}
+	} else if in.ExpirationDate != nil {
+		res.ExpirationDate = timestamppb.New(in.ExpirationDate.Time)
+	}
+	return res, nil
 }
 
 func (*Controller) GetExpiringCertificates(ctx context.Context, req *v1.GetExpiringCertificatesRequest) (res *v1.GetExpiringCertificatesResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	certs, err := service.DotPhysical().GetExpiringCertificates(ctx, req.OrganizationId, int(req.DaysAhead))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetExpiringCertificatesResponse{
+		ExpiringCertificates: make([]*v1.ExpiringCertificate, 0, len(certs)),
+	}
+	for _, c := range certs {
+		res.ExpiringCertificates = append(res.ExpiringCertificates, &v1.ExpiringCertificate{
+			UserId:              c.UserID,
+			UserName:            c.UserName,
+			CertificateNumber:   c.CertificateNumber,
+			ExpirationDate:      toTimestamp(c.ExpirationDate),
+			DaysUntilExpiration: int32(c.DaysUntilExpiration),
+			QualificationStatus: c.QualificationStatus,
+			PhysicalId:          c.PhysicalID,
+		})
+	}
+	return res, nil
 }
 
 func (*Controller) SetExpirationReminder(ctx context.Context, req *v1.SetExpirationReminderRequest) (res *v1.SetExpirationReminderResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	days := make([]int, 0, len(req.ReminderDays))
+	for _, d := range req.ReminderDays {
+		days = append(days, int(d))
+	}
+	settings, err := service.DotPhysical().SetExpirationReminder(ctx, &model.ExpirationReminderInput{
+		OrganizationID:         req.OrganizationId,
+		UpdatedBy:              currentUserID(ctx),
+		ReminderDays:           days,
+		EmailEnabled:           req.EmailEnabled,
+		SmsEnabled:             req.SmsEnabled,
+		NotificationRecipients: req.NotificationRecipients,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetExpirationReminderResponse{
+		Message: fmt.Sprintf("Medical certificate reminders will be sent %s days before expiration",
+			gstr.JoinAny(gconv.Ints(settings.ReminderDays), ", ")),
+	}, nil
 }
 
 func (*Controller) CreateTestingProgram(ctx context.Context, req *v1.CreateTestingProgramRequest) (res *v1.CreateTestingProgramResponse, err error) {
@@ -297,12 +1092,59 @@ func (*Controller) UpdateMVRReport(ctx context.Context, req *v1.UpdateMVRReportR
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+func (*Controller) ParseMVRReport(ctx context.Context, req *v1.ParseMVRReportRequest) (res *v1.ParseMVRReportResponse, err error) {
+	out, err := service.Mvr().ParseRawReport(ctx, req.ReportId, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ParseMVRReportResponse{
+		Format:            out.Format,
+		DuplicatesSkipped: int32(out.DuplicatesSkipped),
+	}
+	if err = gconv.Struct(out.Report, &res.Report); err != nil {
+		return nil, err
+	}
+	if err = gconv.Structs(out.Violations, &res.Violations); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (*Controller) ListMVRReports(ctx context.Context, req *v1.ListMVRReportsRequest) (res *v1.ListMVRReportsResponse, err error) {
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
 func (*Controller) AddMVRViolation(ctx context.Context, req *v1.AddMVRViolationRequest) (res *v1.AddMVRViolationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.MVRViolationInput{
+		ReportID:                     req.MvrReportId,
+		ViolationCode:                req.ViolationCode,
+		ViolationType:                req.ViolationType,
+		State:                        req.Jurisdiction,
+		Description:                  req.Description,
+		Severity:                     consts.ViolationSeverity(req.Severity),
+		FineAmount:                   gconv.Float64(req.FineAmount),
+		CaseNumber:                   req.CaseNumber,
+		AffectsCdl:                   req.AffectsCdl,
+		RequiresEmployerNotification: req.RequiresAction,
+	}
+	if req.ViolationDate != nil {
+		in.ViolationDate = gtime.New(req.ViolationDate.AsTime())
+	}
+
+	violation, report, err := service.Mvr().AddViolation(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.AddMVRViolationResponse{}
+	if err = gconv.Struct(violation, &res.Violation); err != nil {
+		return nil, err
+	}
+	if err = gconv.Struct(report, &res.Report); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) UpdateMVRViolation(ctx context.Context, req *v1.UpdateMVRViolationRequest) (res *v1.UpdateMVRViolationResponse, err error) {
@@ -314,11 +1156,89 @@ func (*Controller) ListMVRViolations(ctx context.Context, req *v1.ListMVRViolati
 }
 
 func (*Controller) EnableContinuousMonitoring(ctx context.Context, req *v1.EnableContinuousMonitoringRequest) (res *v1.EnableContinuousMonitoringResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	enrollment, err := service.Mvr().EnableContinuousMonitoring(ctx, &model.MVRMonitoringInput{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		EnrolledBy:     currentUserID(ctx),
+		Frequency:      consts.MonitoringFrequency(req.MonitoringFrequency),
+		Enabled:        req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !enrollment.IsEnabled {
+		return &v1.EnableContinuousMonitoringResponse{Message: "MVR monitoring disabled"}, nil
+	}
+	return &v1.EnableContinuousMonitoringResponse{
+		Message: fmt.Sprintf("MVR monitoring enabled (%s), next check %s", enrollment.MonitoringFrequency, enrollment.NextCheckDate.Format("Y-m-d")),
+	}, nil
 }
 
 func (*Controller) GetMonitoringStatus(ctx context.Context, req *v1.GetMonitoringStatusRequest) (res *v1.GetMonitoringStatusResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	status, err := service.Mvr().GetMonitoringStatus(ctx, req.UserId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.GetMonitoringStatusResponse{
+		TotalViolations:  int32(status.TotalViolations),
+		ActiveViolations: int32(status.ActiveViolations),
+	}
+	if e := status.Enrollment; e != nil {
+		res.IsMonitoringEnabled = e.IsEnabled
+		res.MonitoringFrequency = e.MonitoringFrequency
+		if e.LastCheckDate != nil {
+			res.LastCheckDate = timestamppb.New(e.LastCheckDate.Time)
+		}
+		if e.NextCheckDate != nil && e.IsEnabled {
+			res.NextCheckDate = timestamppb.New(e.NextCheckDate.Time)
+		}
+	}
+	return res, nil
+}
+
+func (*Controller) ListMVRReviews(ctx context.Context, req *v1.ListMVRReviewsRequest) (res *v1.ListMVRReviewsResponse, err error) {
+	page, pageSize, offset := pagination(req.Page, req.PageSize)
+	reviews, total, err := service.Mvr().ListAnnualReviews(ctx, &model.MVRReviewListInput{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		Status:         consts.MVRReviewStatus(req.Status),
+		Offset:         offset,
+		Limit:          int(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.ListMVRReviewsResponse{
+		Reviews:    make([]*v1.MVRAnnualReview, 0, len(reviews)),
+		TotalCount: int32(total),
+		Page:       page,
+		PageSize:   pageSize,
+	}
+	for _, r := range reviews {
+		res.Reviews = append(res.Reviews, toMVRAnnualReview(r))
+	}
+	return res, nil
+}
+
+func (*Controller) SignOffMVRReview(ctx context.Context, req *v1.SignOffMVRReviewRequest) (res *v1.SignOffMVRReviewResponse, err error) {
+	out, err := service.Mvr().SignOffAnnualReview(ctx, &model.MVRReviewSignOffInput{
+		ReviewID:      req.ReviewId,
+		ReviewerID:    currentUserID(ctx),
+		Determination: consts.MVRReviewDetermination(req.Determination),
+		Notes:         req.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.SignOffMVRReviewResponse{Review: toMVRAnnualReview(out.Review)}
+	if err = gconv.Struct(out.Document, &res.Document); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) GetMVRAnalytics(ctx context.Context, req *v1.GetMVRAnalyticsRequest) (res *v1.GetMVRAnalyticsResponse, err error) {
@@ -330,11 +1250,67 @@ func (*Controller) SyncProviderData(ctx context.Context, req *v1.SyncProviderDat
 }
 
 func (*Controller) SendNotification(ctx context.Context, req *v1.SendNotificationRequest) (res *v1.SendNotificationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.SendNotificationInput{
+		NotificationInput: model.NotificationInput{
+			OrganizationID: req.OrganizationId,
+			UserID:         req.UserId,
+			Category:       consts.NotificationCategory(req.NotificationType),
+			Title:          req.Subject,
+			Message:        req.Message,
+			TemplateID:     req.TemplateId,
+			TemplateData:   req.TemplateData,
+			Locale:         req.Locale,
+			Priority:       toNotificationPriority(req.Priority),
+		},
+		AdditionalRecipients: req.AdditionalRecipients,
+		RequestedBy:          currentUserID(ctx),
+	}
+	if req.Channel != "" {
+		in.Channels = []consts.NotificationType{consts.NotificationType(req.Channel)}
+	}
+	if req.ScheduledFor != nil {
+		sendAt := toGTime(req.ScheduledFor)
+		id, err := service.Notification().ScheduleNotification(ctx, &model.ScheduledNotificationInput{
+			SendNotificationInput: *in,
+			SendAt:                sendAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &v1.SendNotificationResponse{
+			Message:                 fmt.Sprintf("Notification scheduled for %s", sendAt.Format("Y-m-d H:i:s T")),
+			ScheduledNotificationId: id,
+		}, nil
+	}
+	notifications, err := service.Notification().SendNotification(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.SendNotificationResponse{
+		Message: fmt.Sprintf("Notification recorded on %d channel(s); email and SMS deliveries are queued", len(notifications)),
+	}
+	if len(notifications) == 0 {
+		res.Message = "No channel is enabled for the recipients; nothing was sent"
+		return res, nil
+	}
+	if err = gconv.Structs(notifications, &res.Notifications); err != nil {
+		return nil, err
+	}
+	res.Notification = res.Notifications[0]
+	return res, nil
 }
 
 func (*Controller) GetNotification(ctx context.Context, req *v1.GetNotificationRequest) (res *v1.GetNotificationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	notification, err := service.Notification().GetNotification(ctx, req.NotificationId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.GetNotificationResponse{}
+	if err = gconv.Struct(notification, &res.Notification); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) ListNotifications(ctx context.Context, req *v1.ListNotificationsRequest) (res *v1.ListNotificationsResponse, err error) {
@@ -349,48 +1325,297 @@ func (*Controller) MarkAllNotificationsRead(ctx context.Context, req *v1.MarkAll
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+// StreamNotifications hands the caller's in-app notification events to send as they happen.
+func (*Controller) StreamNotifications(ctx context.Context, req *v1.StreamNotificationsRequest, send func(*v1.NotificationEvent) error) error {
+	in := &model.NotificationStreamInput{
+		UserID:         currentUserID(ctx),
+		OrganizationID: req.OrganizationId,
+	}
+	return service.Notification().StreamNotifications(ctx, in, func(event *model.NotificationEvent) error {
+		out, err := toNotificationEvent(event)
+		if err != nil {
+			return err
+		}
+		return send(out)
+	})
+}
+
 func (*Controller) CreateNotificationTemplate(ctx context.Context, req *v1.CreateNotificationTemplateRequest) (res *v1.CreateNotificationTemplateResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	organizationID := req.OrganizationId
+	if req.IsSystemTemplate {
+		organizationID = ""
+	} else if organizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required for organization templates")
+	}
+	template, err := service.Notification().CreateNotificationTemplate(ctx, &model.NotificationTemplateInput{
+		OrganizationID:  organizationID,
+		TemplateKey:     req.TemplateKey,
+		Locale:          req.Locale,
+		TemplateName:    req.TemplateName,
+		Category:        consts.NotificationCategory(req.NotificationType),
+		Channel:         consts.NotificationType(req.Channel),
+		SubjectTemplate: req.SubjectTemplate,
+		BodyTemplate:    req.BodyTemplate,
+		HTMLTemplate:    req.HtmlTemplate,
+		Variables:       fromTemplateVariables(req.Variables, req.RequiredVariables),
+		RequestedBy:     currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateNotificationTemplateResponse{
+		TemplateId: template.Id,
+		Message:    fmt.Sprintf("Template %s (%s) created", template.TemplateKey, template.Locale),
+	}, nil
 }
 
 func (*Controller) GetNotificationTemplate(ctx context.Context, req *v1.GetNotificationTemplateRequest) (res *v1.GetNotificationTemplateResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	template, err := service.Notification().GetNotificationTemplate(ctx, req.TemplateId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.GetNotificationTemplateResponse{}
+	if res.Template, err = toNotificationTemplate(template); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (*Controller) ListNotificationTemplates(ctx context.Context, req *v1.ListNotificationTemplatesRequest) (res *v1.ListNotificationTemplatesResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	templates, err := service.Notification().ListNotificationTemplates(ctx, &model.NotificationTemplateListInput{
+		OrganizationID: req.OrganizationId,
+		Category:       consts.NotificationCategory(req.NotificationType),
+		Channel:        consts.NotificationType(req.Channel),
+		Locale:         req.Locale,
+		TemplateKey:    req.TemplateKey,
+		IncludeSystem:  req.IncludeSystemTemplates,
+		ActiveOnly:     req.ActiveOnly,
+		RequestedBy:    currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.ListNotificationTemplatesResponse{}
+	for _, t := range templates {
+		template, err := toNotificationTemplate(t)
+		if err != nil {
+			return nil, err
+		}
+		res.Templates = append(res.Templates, template)
+	}
+	return res, nil
 }
 
 func (*Controller) UpdateNotificationTemplate(ctx context.Context, req *v1.UpdateNotificationTemplateRequest) (res *v1.UpdateNotificationTemplateResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	template, err := service.Notification().UpdateNotificationTemplate(ctx, &model.NotificationTemplateUpdateInput{
+		TemplateID:      req.TemplateId,
+		TemplateName:    req.TemplateName,
+		SubjectTemplate: req.SubjectTemplate,
+		BodyTemplate:    req.BodyTemplate,
+		HTMLTemplate:    req.HtmlTemplate,
+		Variables:       fromTemplateVariables(req.Variables, req.RequiredVariables),
+		IsActive:        req.IsActive,
+		RequestedBy:     currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.UpdateNotificationTemplateResponse{}
+	if res.Template, err = toNotificationTemplate(template); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (*Controller) PreviewNotificationTemplate(ctx context.Context, req *v1.PreviewNotificationTemplateRequest) (res *v1.PreviewNotificationTemplateResponse, err error) {
+	preview, err := service.Notification().PreviewNotificationTemplate(ctx, &model.NotificationTemplatePreviewInput{
+		TemplateID:     req.TemplateId,
+		OrganizationID: req.OrganizationId,
+		Locale:         req.Locale,
+		SampleData:     req.SampleData,
+		RequestedBy:    currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PreviewNotificationTemplateResponse{
+		Subject:              preview.Subject,
+		Body:                 preview.Body,
+		HtmlBody:             preview.HTML,
+		TemplateId:           preview.TemplateID,
+		Locale:               preview.Locale,
+		PlaceholderVariables: preview.Placeholders,
+	}, nil
 }
 
 func (*Controller) GetNotificationPreferences(ctx context.Context, req *v1.GetNotificationPreferencesRequest) (res *v1.GetNotificationPreferencesResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	prefs, err := service.Notification().GetNotificationPreferences(ctx, req.UserId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.GetNotificationPreferencesResponse{}
+	for _, p := range prefs.Preferences {
+		res.Preferences = append(res.Preferences, &v1.NotificationPreference{
+			NotificationType: string(p.Category),
+			EmailEnabled:     p.EmailEnabled,
+			SmsEnabled:       p.SMSEnabled,
+			InAppEnabled:     p.InAppEnabled,
+			Frequency:        string(p.Frequency),
+		})
+	}
+	if q := prefs.QuietHours; q != nil {
+		res.QuietHours = &v1.QuietHours{Enabled: q.Enabled, Start: q.Start, End: q.End, Timezone: q.Timezone}
+	}
+	return res, nil
 }
 
 func (*Controller) UpdateNotificationPreferences(ctx context.Context, req *v1.UpdateNotificationPreferencesRequest) (res *v1.UpdateNotificationPreferencesResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.NotificationPreferencesUpdateInput{
+		UserID:      req.UserId,
+		RequestedBy: currentUserID(ctx),
+	}
+	for _, p := range req.Preferences {
+		if p.PhoneEnabled {
+			return nil, gerror.NewCode(gcode.CodeNotSupported, "phone call notifications are not supported")
+		}
+		in.Preferences = append(in.Preferences, &model.NotificationPreference{
+			Category:     consts.NotificationCategory(p.NotificationType),
+			EmailEnabled: p.EmailEnabled,
+			SMSEnabled:   p.SmsEnabled,
+			InAppEnabled: p.InAppEnabled,
+			Frequency:    deliverywindow.Frequency(p.Frequency),
+		})
+	}
+	if q := req.QuietHours; q != nil {
+		in.QuietHours = &model.NotificationQuietHours{Enabled: q.Enabled, Start: q.Start, End: q.End, Timezone: q.Timezone}
+	}
+	if err = service.Notification().UpdateNotificationPreferences(ctx, in); err != nil {
+		return nil, err
+	}
+	return &v1.UpdateNotificationPreferencesResponse{Message: "Notification preferences updated"}, nil
 }
 
 func (*Controller) SendBulkNotification(ctx context.Context, req *v1.SendBulkNotificationRequest) (res *v1.SendBulkNotificationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.BulkNotificationInput{
+		NotificationInput: model.NotificationInput{
+			OrganizationID: req.OrganizationId,
+			Category:       consts.NotificationCategory(req.NotificationType),
+			Title:          req.Subject,
+			Message:        req.Message,
+			TemplateID:     req.TemplateId,
+			TemplateData:   req.TemplateData,
+			Locale:         req.Locale,
+			Priority:       toNotificationPriority(req.Priority),
+		},
+		Filter:      fromBulkRecipientFilter(req.Filter),
+		RequestedBy: currentUserID(ctx),
+	}
+	if req.Channel != "" {
+		in.Channels = []consts.NotificationType{consts.NotificationType(req.Channel)}
+	}
+	in.Filter.UserIDs = req.UserIds
+	for _, r := range req.Recipients {
+		in.Recipients = append(in.Recipients, &model.BulkNotificationRecipient{UserID: r.UserId, TemplateData: r.TemplateData})
+	}
+	status, err := service.Notification().SendBulkNotification(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	res = &v1.SendBulkNotificationResponse{
+		NotificationsSent:   int32(status.Sent),
+		NotificationsFailed: int32(status.Failed),
+		FailedUserIds:       status.FailedUserIDs,
+		BulkSendId:          status.ID,
+		TotalRecipients:     int32(status.TotalRecipients),
+		Status:              status.Status,
+		Message:             fmt.Sprintf("Bulk notification to %d recipients queued", status.TotalRecipients),
+	}
+	if consts.BulkNotificationStatus(status.Status) == consts.BulkNotificationCompleted {
+		res.Message = fmt.Sprintf("Notified %d of %d recipients; %d skipped, %d failed", status.Sent, status.TotalRecipients, status.Skipped, status.Failed)
+	}
+	return res, nil
+}
+
+func (*Controller) GetBulkNotificationStatus(ctx context.Context, req *v1.GetBulkNotificationStatusRequest) (res *v1.GetBulkNotificationStatusResponse, err error) {
+	status, err := service.Notification().GetBulkNotificationStatus(ctx, req.BulkSendId, currentUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetBulkNotificationStatusResponse{BulkSend: toBulkNotificationStatus(status)}, nil
 }
 
 func (*Controller) ScheduleNotification(ctx context.Context, req *v1.ScheduleNotificationRequest) (res *v1.ScheduleNotificationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	in := &model.ScheduledNotificationInput{
+		SendNotificationInput: model.SendNotificationInput{
+			NotificationInput: model.NotificationInput{
+				OrganizationID: req.OrganizationId,
+				UserID:         req.UserId,
+				Category:       consts.NotificationCategory(req.NotificationType),
+				Title:          req.Subject,
+				Message:        req.Message,
+				TemplateID:     req.TemplateId,
+				TemplateData:   req.TemplateData,
+				Locale:         req.Locale,
+				Priority:       toNotificationPriority(req.Priority),
+			},
+			RequestedBy: currentUserID(ctx),
+		},
+		Filter:        fromBulkRecipientFilter(req.Filter),
+		SendAt:        toGTime(req.SendAt),
+		Recurrence:    consts.NotificationRecurrence(req.RecurrencePattern),
+		RecurrenceEnd: toGTime(req.RecurrenceEnd),
+	}
+	if req.Channel != "" {
+		in.Channels = []consts.NotificationType{consts.NotificationType(req.Channel)}
+	}
+	id, err := service.Notification().ScheduleNotification(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ScheduleNotificationResponse{
+		ScheduledNotificationId: id,
+		Message:                 fmt.Sprintf("Notification scheduled for %s", in.SendAt.Format("Y-m-d H:i:s T")),
+	}, nil
 }
 
 func (*Controller) ListScheduledNotifications(ctx context.Context, req *v1.ListScheduledNotificationsRequest) (res *v1.ListScheduledNotificationsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	scheduled, err := service.Notification().ListScheduledNotifications(ctx, &model.ScheduledNotificationListInput{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		PendingOnly:    req.PendingOnly,
+		RequestedBy:    currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = &v1.ListScheduledNotificationsResponse{}
+	for _, n := range scheduled {
+		res.Notifications = append(res.Notifications, toScheduledNotification(n))
+	}
+	return res, nil
 }
 
 func (*Controller) CancelScheduledNotification(ctx context.Context, req *v1.CancelScheduledNotificationRequest) (res *v1.CancelScheduledNotificationResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	if err = service.Notification().CancelScheduledNotification(ctx, req.ScheduledNotificationId, currentUserID(ctx)); err != nil {
+		return nil, err
+	}
+	return &v1.CancelScheduledNotificationResponse{Message: "Scheduled notification cancelled"}, nil
 }
 
 func (*Controller) GetNotificationAnalytics(ctx context.Context, req *v1.GetNotificationAnalyticsRequest) (res *v1.GetNotificationAnalyticsResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	analytics, err := service.Notification().GetNotificationAnalytics(ctx, &model.NotificationAnalyticsInput{
+		OrganizationID: req.OrganizationId,
+		StartDate:      toGTime(req.StartDate),
+		EndDate:        toGTime(req.EndDate),
+		Category:       consts.NotificationCategory(req.NotificationType),
+		Channel:        consts.NotificationType(req.Channel),
+		RequestedBy:    currentUserID(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetNotificationAnalyticsResponse{Metrics: toNotificationMetrics(analytics)}, nil
 }
 
 func (*Controller) CreateOrganization(ctx context.Context, req *v1.CreateOrganizationRequest) (res *v1.CreateOrganizationResponse, err error) {
@@ -477,8 +1702,21 @@ func (*Controller) StartDrugTestOrderWorkflow(ctx context.Context, req *v1.Start
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+// StartMVRMonitoringWorkflow enrolls the driver in continuous monitoring. Pulls are scheduled by
+// the periodic MVR monitoring sweep, so the enrollment ID identifies the workflow.
 func (*Controller) StartMVRMonitoringWorkflow(ctx context.Context, req *v1.StartMVRMonitoringWorkflowRequest) (res *v1.StartMVRMonitoringWorkflowResponse, err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	enrollment, err := service.Mvr().EnableContinuousMonitoring(ctx, &model.MVRMonitoringInput{
+		OrganizationID: req.OrganizationId,
+		UserID:         req.UserId,
+		EnrolledBy:     currentUserID(ctx),
+		Frequency:      consts.MonitoringFrequency(req.MonitoringFrequency),
+		Provider:       req.Provider,
+		Enabled:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StartMVRMonitoringWorkflowResponse{WorkflowId: enrollment.Id}, nil
 }
 
 func (*Controller) StartRandomSelectionWorkflow(ctx context.Context, req *v1.StartRandomSelectionWorkflowRequest) (res *v1.StartRandomSelectionWorkflowResponse, err error) {
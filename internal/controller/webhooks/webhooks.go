@@ -0,0 +1,116 @@
+package webhooks
+
+import (
+	"net/http"
+	"v1consortium/internal/pkg/emailpkg"
+	"v1consortium/internal/pkg/smspkg"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/net/ghttp"
+)
+
+// Controller handles the callbacks of the SMS provider and the delivery event webhooks of the
+// email providers
+type Controller struct {
+	email *emailpkg.WebhookParser
+}
+
+// New returns a controller that verifies email webhooks with the email configuration
+func New(config *emailpkg.EmailConfig) *Controller {
+	return &Controller{email: emailpkg.NewWebhookParser(config)}
+}
+
+// SMSStatus records a delivery status report from the SMS provider
+func (c *Controller) SMSStatus(r *ghttp.Request) {
+	ctx := r.Context()
+	smsService, err := smspkg.NewSMSService(service.Notification().GetSMSConfig(ctx))
+	if err != nil {
+		writeSMSCallbackError(r, err)
+		return
+	}
+	cb, err := smsService.ParseStatusCallback(r.Request)
+	if err == nil {
+		err = service.Notification().RecordSMSStatus(ctx, cb)
+	}
+	if err != nil {
+		writeSMSCallbackError(r, err)
+		return
+	}
+	r.Response.WriteStatus(http.StatusNoContent)
+}
+
+// SMSInbound handles a message the SMS provider received, replying with no message of our own
+func (c *Controller) SMSInbound(r *ghttp.Request) {
+	ctx := r.Context()
+	smsService, err := smspkg.NewSMSService(service.Notification().GetSMSConfig(ctx))
+	if err != nil {
+		writeSMSCallbackError(r, err)
+		return
+	}
+	msg, err := smsService.ParseInboundMessage(r.Request)
+	if err == nil {
+		err = service.Notification().ReceiveSMS(ctx, msg)
+	}
+	if err != nil {
+		writeSMSCallbackError(r, err)
+		return
+	}
+	r.Response.Header().Set("Content-Type", "text/xml")
+	r.Response.Write(`<?xml version="1.0" encoding="UTF-8"?><Response/>`)
+}
+
+// SESEvents records the delivery events of an Amazon SES notification
+func (c *Controller) SESEvents(r *ghttp.Request) {
+	c.recordEmailEvents(r, c.email.ParseSESWebhook)
+}
+
+// BrevoEvents records the delivery events of a Brevo webhook
+func (c *Controller) BrevoEvents(r *ghttp.Request) {
+	c.recordEmailEvents(r, c.email.ParseBrevoWebhook)
+}
+
+// recordEmailEvents records the delivery events an email provider's webhook reports
+func (c *Controller) recordEmailEvents(r *ghttp.Request, parse func(*http.Request) ([]*emailpkg.DeliveryEvent, error)) {
+	events, err := parse(r.Request)
+	if err == nil {
+		err = service.Notification().RecordEmailEvents(r.Context(), events)
+	}
+	if err != nil {
+		writeEmailWebhookError(r, err)
+		return
+	}
+	r.Response.WriteStatus(http.StatusNoContent)
+}
+
+// writeSMSCallbackError writes the HTTP status for an error handling an SMS provider callback
+func writeSMSCallbackError(r *ghttp.Request, err error) {
+	status := http.StatusInternalServerError
+	switch smspkg.GetErrorCode(err) {
+	case smspkg.ErrCodeInvalidSignature:
+		status = http.StatusForbidden
+	case smspkg.ErrCodeCallbacksDisabled:
+		status = http.StatusNotFound
+	case smspkg.ErrCodeInvalidCallback:
+		status = http.StatusBadRequest
+	default:
+		g.Log().Errorf(r.Context(), "SMS callback failed: %v", err)
+	}
+	http.Error(r.Response.ResponseWriter, http.StatusText(status), status)
+}
+
+// writeEmailWebhookError writes the HTTP status for an error handling an email provider webhook
+func writeEmailWebhookError(r *ghttp.Request, err error) {
+	status := http.StatusInternalServerError
+	switch emailpkg.GetErrorCode(err) {
+	case emailpkg.ErrCodeInvalidSignature:
+		status = http.StatusForbidden
+	case emailpkg.ErrCodeWebhooksDisabled:
+		status = http.StatusNotFound
+	case emailpkg.ErrCodeInvalidWebhook:
+		status = http.StatusBadRequest
+	default:
+		g.Log().Errorf(r.Context(), "Email webhook failed: %v", err)
+	}
+	http.Error(r.Response.ResponseWriter, http.StatusText(status), status)
+}
@@ -2,6 +2,8 @@ package servicesconnect
 
 import (
 	"context"
+	"errors"
+	"io"
 	v1 "v1consortium/api/services/v1"
 	"v1consortium/internal/controller/services"
 
@@ -77,7 +79,11 @@ func (s *ServicesConnectService) GetProviderStatus(ctx context.Context, req *con
 }
 
 func (s *ServicesConnectService) GetBackgroundCheckAnalytics(ctx context.Context, req *connect.Request[v1.GetBackgroundCheckAnalyticsRequest]) (res *connect.Response[v1.GetBackgroundCheckAnalyticsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetBackgroundCheckAnalytics(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetComplianceStatus(ctx context.Context, req *connect.Request[v1.GetComplianceStatusRequest]) (res *connect.Response[v1.GetComplianceStatusResponse], err error) {
@@ -137,11 +143,63 @@ func (s *ServicesConnectService) GetComplianceAuditTrail(ctx context.Context, re
 }
 
 func (s *ServicesConnectService) UploadDocument(ctx context.Context, req *connect.Request[v1.UploadDocumentRequest]) (res *connect.Response[v1.UploadDocumentResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.UploadDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetDocument(ctx context.Context, req *connect.Request[v1.GetDocumentRequest]) (res *connect.Response[v1.GetDocumentResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// StreamUploadDocument reads the header message and hands the chunks that follow to the
+// controller as a reader. Connect serves the stream over the gRPC protocol as well.
+func (s *ServicesConnectService) StreamUploadDocument(ctx context.Context, stream *connect.ClientStream[v1.StreamUploadDocumentRequest]) (res *connect.Response[v1.StreamUploadDocumentResponse], err error) {
+	if !stream.Receive() {
+		if err = stream.Err(); err != nil {
+			return nil, err
+		}
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("upload header is required"))
+	}
+	header := stream.Msg().GetHeader()
+	if header == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("first message must be the upload header"))
+	}
+	resp, err := s.servicesController.StreamUploadDocument(ctx, header, &uploadChunkReader{stream: stream})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) GetDocumentUploadSession(ctx context.Context, req *connect.Request[v1.GetDocumentUploadSessionRequest]) (res *connect.Response[v1.GetDocumentUploadSessionResponse], err error) {
+	resp, err := s.servicesController.GetDocumentUploadSession(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) GetDocumentUploadLimits(ctx context.Context, req *connect.Request[v1.GetDocumentUploadLimitsRequest]) (res *connect.Response[v1.GetDocumentUploadLimitsResponse], err error) {
+	resp, err := s.servicesController.GetDocumentUploadLimits(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) SetDocumentUploadLimits(ctx context.Context, req *connect.Request[v1.SetDocumentUploadLimitsRequest]) (res *connect.Response[v1.SetDocumentUploadLimitsResponse], err error) {
+	resp, err := s.servicesController.SetDocumentUploadLimits(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListDocuments(ctx context.Context, req *connect.Request[v1.ListDocumentsRequest]) (res *connect.Response[v1.ListDocumentsResponse], err error) {
@@ -149,7 +207,11 @@ func (s *ServicesConnectService) ListDocuments(ctx context.Context, req *connect
 }
 
 func (s *ServicesConnectService) UpdateDocument(ctx context.Context, req *connect.Request[v1.UpdateDocumentRequest]) (res *connect.Response[v1.UpdateDocumentResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.UpdateDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) DeleteDocument(ctx context.Context, req *connect.Request[v1.DeleteDocumentRequest]) (res *connect.Response[v1.DeleteDocumentResponse], err error) {
@@ -157,23 +219,51 @@ func (s *ServicesConnectService) DeleteDocument(ctx context.Context, req *connec
 }
 
 func (s *ServicesConnectService) GetDocumentVersions(ctx context.Context, req *connect.Request[v1.GetDocumentVersionsRequest]) (res *connect.Response[v1.GetDocumentVersionsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetDocumentVersions(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) RollbackDocument(ctx context.Context, req *connect.Request[v1.RollbackDocumentRequest]) (res *connect.Response[v1.RollbackDocumentResponse], err error) {
+	resp, err := s.servicesController.RollbackDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ShareDocument(ctx context.Context, req *connect.Request[v1.ShareDocumentRequest]) (res *connect.Response[v1.ShareDocumentResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ShareDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetSharedDocuments(ctx context.Context, req *connect.Request[v1.GetSharedDocumentsRequest]) (res *connect.Response[v1.GetSharedDocumentsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetSharedDocuments(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) RevokeDocumentShare(ctx context.Context, req *connect.Request[v1.RevokeDocumentShareRequest]) (res *connect.Response[v1.RevokeDocumentShareResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.RevokeDocumentShare(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) SearchDocuments(ctx context.Context, req *connect.Request[v1.SearchDocumentsRequest]) (res *connect.Response[v1.SearchDocumentsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.SearchDocuments(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetDocumentAnalytics(ctx context.Context, req *connect.Request[v1.GetDocumentAnalyticsRequest]) (res *connect.Response[v1.GetDocumentAnalyticsResponse], err error) {
@@ -181,39 +271,167 @@ func (s *ServicesConnectService) GetDocumentAnalytics(ctx context.Context, req *
 }
 
 func (s *ServicesConnectService) GetDocumentRetentionStatus(ctx context.Context, req *connect.Request[v1.GetDocumentRetentionStatusRequest]) (res *connect.Response[v1.GetDocumentRetentionStatusResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetDocumentRetentionStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ApplyRetentionPolicy(ctx context.Context, req *connect.Request[v1.ApplyRetentionPolicyRequest]) (res *connect.Response[v1.ApplyRetentionPolicyResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ApplyRetentionPolicy(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) PlaceLegalHold(ctx context.Context, req *connect.Request[v1.PlaceLegalHoldRequest]) (res *connect.Response[v1.PlaceLegalHoldResponse], err error) {
+	resp, err := s.servicesController.PlaceLegalHold(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ReleaseLegalHold(ctx context.Context, req *connect.Request[v1.ReleaseLegalHoldRequest]) (res *connect.Response[v1.ReleaseLegalHoldResponse], err error) {
+	resp, err := s.servicesController.ReleaseLegalHold(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ListLegalHolds(ctx context.Context, req *connect.Request[v1.ListLegalHoldsRequest]) (res *connect.Response[v1.ListLegalHoldsResponse], err error) {
+	resp, err := s.servicesController.ListLegalHolds(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) AssembleDriverQualificationFile(ctx context.Context, req *connect.Request[v1.AssembleDriverQualificationFileRequest]) (res *connect.Response[v1.AssembleDriverQualificationFileResponse], err error) {
+	resp, err := s.servicesController.AssembleDriverQualificationFile(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ScheduleDOTPhysical(ctx context.Context, req *connect.Request[v1.ScheduleDOTPhysicalRequest]) (res *connect.Response[v1.ScheduleDOTPhysicalResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ScheduleDOTPhysical(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetDOTPhysical(ctx context.Context, req *connect.Request[v1.GetDOTPhysicalRequest]) (res *connect.Response[v1.GetDOTPhysicalResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetDOTPhysical(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) UpdateDOTPhysical(ctx context.Context, req *connect.Request[v1.UpdateDOTPhysicalRequest]) (res *connect.Response[v1.UpdateDOTPhysicalResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.UpdateDOTPhysical(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListDOTPhysicals(ctx context.Context, req *connect.Request[v1.ListDOTPhysicalsRequest]) (res *connect.Response[v1.ListDOTPhysicalsResponse], err error) {
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+func (s *ServicesConnectService) RescheduleDOTPhysical(ctx context.Context, req *connect.Request[v1.RescheduleDOTPhysicalRequest]) (res *connect.Response[v1.RescheduleDOTPhysicalResponse], err error) {
+	resp, err := s.servicesController.RescheduleDOTPhysical(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) CancelDOTPhysical(ctx context.Context, req *connect.Request[v1.CancelDOTPhysicalRequest]) (res *connect.Response[v1.CancelDOTPhysicalResponse], err error) {
+	resp, err := s.servicesController.CancelDOTPhysical(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) MarkDOTPhysicalNoShow(ctx context.Context, req *connect.Request[v1.MarkDOTPhysicalNoShowRequest]) (res *connect.Response[v1.MarkDOTPhysicalNoShowResponse], err error) {
+	resp, err := s.servicesController.MarkDOTPhysicalNoShow(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ListMedicalFollowUpTasks(ctx context.Context, req *connect.Request[v1.ListMedicalFollowUpTasksRequest]) (res *connect.Response[v1.ListMedicalFollowUpTasksResponse], err error) {
+	resp, err := s.servicesController.ListMedicalFollowUpTasks(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) CompleteMedicalFollowUpTask(ctx context.Context, req *connect.Request[v1.CompleteMedicalFollowUpTaskRequest]) (res *connect.Response[v1.CompleteMedicalFollowUpTaskResponse], err error) {
+	resp, err := s.servicesController.CompleteMedicalFollowUpTask(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) CreateClinicAvailability(ctx context.Context, req *connect.Request[v1.CreateClinicAvailabilityRequest]) (res *connect.Response[v1.CreateClinicAvailabilityResponse], err error) {
+	resp, err := s.servicesController.CreateClinicAvailability(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ListClinicAvailability(ctx context.Context, req *connect.Request[v1.ListClinicAvailabilityRequest]) (res *connect.Response[v1.ListClinicAvailabilityResponse], err error) {
+	resp, err := s.servicesController.ListClinicAvailability(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ServicesConnectService) RegisterMedicalExaminer(ctx context.Context, req *connect.Request[v1.RegisterMedicalExaminerRequest]) (res *connect.Response[v1.RegisterMedicalExaminerResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.RegisterMedicalExaminer(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetMedicalExaminer(ctx context.Context, req *connect.Request[v1.GetMedicalExaminerRequest]) (res *connect.Response[v1.GetMedicalExaminerResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetMedicalExaminer(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListMedicalExaminers(ctx context.Context, req *connect.Request[v1.ListMedicalExaminersRequest]) (res *connect.Response[v1.ListMedicalExaminersResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ListMedicalExaminers(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ImportMedicalExaminers(ctx context.Context, req *connect.Request[v1.ImportMedicalExaminersRequest]) (res *connect.Response[v1.ImportMedicalExaminersResponse], err error) {
+	resp, err := s.servicesController.ImportMedicalExaminers(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GenerateCertificate(ctx context.Context, req *connect.Request[v1.GenerateCertificateRequest]) (res *connect.Response[v1.GenerateCertificateResponse], err error) {
@@ -225,11 +443,19 @@ func (s *ServicesConnectService) ValidateCertificate(ctx context.Context, req *c
 }
 
 func (s *ServicesConnectService) GetExpiringCertificates(ctx context.Context, req *connect.Request[v1.GetExpiringCertificatesRequest]) (res *connect.Response[v1.GetExpiringCertificatesResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetExpiringCertificates(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) SetExpirationReminder(ctx context.Context, req *connect.Request[v1.SetExpirationReminderRequest]) (res *connect.Response[v1.SetExpirationReminderResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.SetExpirationReminder(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) CreateTestingProgram(ctx context.Context, req *connect.Request[v1.CreateTestingProgramRequest]) (res *connect.Response[v1.CreateTestingProgramResponse], err error) {
@@ -308,12 +534,24 @@ func (s *ServicesConnectService) UpdateMVRReport(ctx context.Context, req *conne
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+func (s *ServicesConnectService) ParseMVRReport(ctx context.Context, req *connect.Request[v1.ParseMVRReportRequest]) (res *connect.Response[v1.ParseMVRReportResponse], err error) {
+	resp, err := s.servicesController.ParseMVRReport(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *ServicesConnectService) ListMVRReports(ctx context.Context, req *connect.Request[v1.ListMVRReportsRequest]) (res *connect.Response[v1.ListMVRReportsResponse], err error) {
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
 func (s *ServicesConnectService) AddMVRViolation(ctx context.Context, req *connect.Request[v1.AddMVRViolationRequest]) (res *connect.Response[v1.AddMVRViolationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.AddMVRViolation(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) UpdateMVRViolation(ctx context.Context, req *connect.Request[v1.UpdateMVRViolationRequest]) (res *connect.Response[v1.UpdateMVRViolationResponse], err error) {
@@ -325,11 +563,35 @@ func (s *ServicesConnectService) ListMVRViolations(ctx context.Context, req *con
 }
 
 func (s *ServicesConnectService) EnableContinuousMonitoring(ctx context.Context, req *connect.Request[v1.EnableContinuousMonitoringRequest]) (res *connect.Response[v1.EnableContinuousMonitoringResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.EnableContinuousMonitoring(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetMonitoringStatus(ctx context.Context, req *connect.Request[v1.GetMonitoringStatusRequest]) (res *connect.Response[v1.GetMonitoringStatusResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetMonitoringStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) ListMVRReviews(ctx context.Context, req *connect.Request[v1.ListMVRReviewsRequest]) (res *connect.Response[v1.ListMVRReviewsResponse], err error) {
+	resp, err := s.servicesController.ListMVRReviews(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) SignOffMVRReview(ctx context.Context, req *connect.Request[v1.SignOffMVRReviewRequest]) (res *connect.Response[v1.SignOffMVRReviewResponse], err error) {
+	resp, err := s.servicesController.SignOffMVRReview(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetMVRAnalytics(ctx context.Context, req *connect.Request[v1.GetMVRAnalyticsRequest]) (res *connect.Response[v1.GetMVRAnalyticsResponse], err error) {
@@ -341,11 +603,19 @@ func (s *ServicesConnectService) SyncProviderData(ctx context.Context, req *conn
 }
 
 func (s *ServicesConnectService) SendNotification(ctx context.Context, req *connect.Request[v1.SendNotificationRequest]) (res *connect.Response[v1.SendNotificationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.SendNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetNotification(ctx context.Context, req *connect.Request[v1.GetNotificationRequest]) (res *connect.Response[v1.GetNotificationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListNotifications(ctx context.Context, req *connect.Request[v1.ListNotificationsRequest]) (res *connect.Response[v1.ListNotificationsResponse], err error) {
@@ -360,48 +630,114 @@ func (s *ServicesConnectService) MarkAllNotificationsRead(ctx context.Context, r
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
 
+// StreamNotifications sends the caller's notification events on the stream until the client
+// disconnects.
+func (s *ServicesConnectService) StreamNotifications(ctx context.Context, req *connect.Request[v1.StreamNotificationsRequest], stream *connect.ServerStream[v1.NotificationEvent]) error {
+	return s.servicesController.StreamNotifications(ctx, req.Msg, stream.Send)
+}
+
 func (s *ServicesConnectService) CreateNotificationTemplate(ctx context.Context, req *connect.Request[v1.CreateNotificationTemplateRequest]) (res *connect.Response[v1.CreateNotificationTemplateResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.CreateNotificationTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetNotificationTemplate(ctx context.Context, req *connect.Request[v1.GetNotificationTemplateRequest]) (res *connect.Response[v1.GetNotificationTemplateResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetNotificationTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListNotificationTemplates(ctx context.Context, req *connect.Request[v1.ListNotificationTemplatesRequest]) (res *connect.Response[v1.ListNotificationTemplatesResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ListNotificationTemplates(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) UpdateNotificationTemplate(ctx context.Context, req *connect.Request[v1.UpdateNotificationTemplateRequest]) (res *connect.Response[v1.UpdateNotificationTemplateResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.UpdateNotificationTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) PreviewNotificationTemplate(ctx context.Context, req *connect.Request[v1.PreviewNotificationTemplateRequest]) (res *connect.Response[v1.PreviewNotificationTemplateResponse], err error) {
+	resp, err := s.servicesController.PreviewNotificationTemplate(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (res *connect.Response[v1.GetNotificationPreferencesResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetNotificationPreferences(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[v1.UpdateNotificationPreferencesRequest]) (res *connect.Response[v1.UpdateNotificationPreferencesResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.UpdateNotificationPreferences(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) SendBulkNotification(ctx context.Context, req *connect.Request[v1.SendBulkNotificationRequest]) (res *connect.Response[v1.SendBulkNotificationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.SendBulkNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *ServicesConnectService) GetBulkNotificationStatus(ctx context.Context, req *connect.Request[v1.GetBulkNotificationStatusRequest]) (res *connect.Response[v1.GetBulkNotificationStatusResponse], err error) {
+	resp, err := s.servicesController.GetBulkNotificationStatus(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ScheduleNotification(ctx context.Context, req *connect.Request[v1.ScheduleNotificationRequest]) (res *connect.Response[v1.ScheduleNotificationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ScheduleNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) ListScheduledNotifications(ctx context.Context, req *connect.Request[v1.ListScheduledNotificationsRequest]) (res *connect.Response[v1.ListScheduledNotificationsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.ListScheduledNotifications(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) CancelScheduledNotification(ctx context.Context, req *connect.Request[v1.CancelScheduledNotificationRequest]) (res *connect.Response[v1.CancelScheduledNotificationResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.CancelScheduledNotification(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) GetNotificationAnalytics(ctx context.Context, req *connect.Request[v1.GetNotificationAnalyticsRequest]) (res *connect.Response[v1.GetNotificationAnalyticsResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.GetNotificationAnalytics(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) CreateOrganization(ctx context.Context, req *connect.Request[v1.CreateOrganizationRequest]) (res *connect.Response[v1.CreateOrganizationResponse], err error) {
@@ -489,7 +825,11 @@ func (s *ServicesConnectService) StartDrugTestOrderWorkflow(ctx context.Context,
 }
 
 func (s *ServicesConnectService) StartMVRMonitoringWorkflow(ctx context.Context, req *connect.Request[v1.StartMVRMonitoringWorkflowRequest]) (res *connect.Response[v1.StartMVRMonitoringWorkflowResponse], err error) {
-	return nil, gerror.NewCode(gcode.CodeNotImplemented)
+	resp, err := s.servicesController.StartMVRMonitoringWorkflow(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
 }
 
 func (s *ServicesConnectService) StartRandomSelectionWorkflow(ctx context.Context, req *connect.Request[v1.StartRandomSelectionWorkflowRequest]) (res *connect.Response[v1.StartRandomSelectionWorkflowResponse], err error) {
@@ -515,3 +855,27 @@ func (s *ServicesConnectService) GetFailedWorkflows(ctx context.Context, req *co
 func (s *ServicesConnectService) GetRunningWorkflows(ctx context.Context, req *connect.Request[v1.GetRunningWorkflowsRequest]) (res *connect.Response[v1.GetRunningWorkflowsResponse], err error) {
 	return nil, gerror.NewCode(gcode.CodeNotImplemented)
 }
+
+// uploadChunkReader reads the chunk messages of an upload stream as one byte stream
+type uploadChunkReader struct {
+	stream *connect.ClientStream[v1.StreamUploadDocumentRequest]
+	chunk  []byte
+}
+
+func (r *uploadChunkReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		if r.stream.Msg().GetHeader() != nil {
+			return 0, connect.NewError(connect.CodeInvalidArgument, errors.New("upload header can only be sent once"))
+		}
+		r.chunk = r.stream.Msg().GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// backgroundCheckPackagesDao is the data access object for the table background_check_packages.
+// You can define custom methods on it to extend its functionality as needed.
+type backgroundCheckPackagesDao struct {
+	*internal.BackgroundCheckPackagesDao
+}
+
+var (
+	// BackgroundCheckPackages is a globally accessible object for table background_check_packages operations.
+	BackgroundCheckPackages = backgroundCheckPackagesDao{internal.NewBackgroundCheckPackagesDao()}
+)
+
+// Add your custom methods and functionality below.
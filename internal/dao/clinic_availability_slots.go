@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// clinicAvailabilitySlotsDao is the data access object for the table clinic_availability_slots.
+// You can define custom methods on it to extend its functionality as needed.
+type clinicAvailabilitySlotsDao struct {
+	*internal.ClinicAvailabilitySlotsDao
+}
+
+var (
+	// ClinicAvailabilitySlots is a globally accessible object for table clinic_availability_slots operations.
+	ClinicAvailabilitySlots = clinicAvailabilitySlotsDao{internal.NewClinicAvailabilitySlotsDao()}
+)
+
+// Add your custom methods and functionality below.
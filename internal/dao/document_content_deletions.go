@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentContentDeletionsDao is the data access object for the table document_content_deletions.
+// You can define custom methods on it to extend its functionality as needed.
+type documentContentDeletionsDao struct {
+	*internal.DocumentContentDeletionsDao
+}
+
+var (
+	// DocumentContentDeletions is a globally accessible object for table document_content_deletions operations.
+	DocumentContentDeletions = documentContentDeletionsDao{internal.NewDocumentContentDeletionsDao()}
+)
+
+// Add your custom methods and functionality below.
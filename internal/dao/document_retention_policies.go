@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentRetentionPoliciesDao is the data access object for the table document_retention_policies.
+// You can define custom methods on it to extend its functionality as needed.
+type documentRetentionPoliciesDao struct {
+	*internal.DocumentRetentionPoliciesDao
+}
+
+var (
+	// DocumentRetentionPolicies is a globally accessible object for table document_retention_policies operations.
+	DocumentRetentionPolicies = documentRetentionPoliciesDao{internal.NewDocumentRetentionPoliciesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentSearchIndexDao is the data access object for the table document_search_index.
+// You can define custom methods on it to extend its functionality as needed.
+type documentSearchIndexDao struct {
+	*internal.DocumentSearchIndexDao
+}
+
+var (
+	// DocumentSearchIndex is a globally accessible object for table document_search_index operations.
+	DocumentSearchIndex = documentSearchIndexDao{internal.NewDocumentSearchIndexDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentSharesDao is the data access object for the table document_shares.
+// You can define custom methods on it to extend its functionality as needed.
+type documentSharesDao struct {
+	*internal.DocumentSharesDao
+}
+
+var (
+	// DocumentShares is a globally accessible object for table document_shares operations.
+	DocumentShares = documentSharesDao{internal.NewDocumentSharesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentUploadLimitsDao is the data access object for the table document_upload_limits.
+// You can define custom methods on it to extend its functionality as needed.
+type documentUploadLimitsDao struct {
+	*internal.DocumentUploadLimitsDao
+}
+
+var (
+	// DocumentUploadLimits is a globally accessible object for table document_upload_limits operations.
+	DocumentUploadLimits = documentUploadLimitsDao{internal.NewDocumentUploadLimitsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// documentUploadSessionsDao is the data access object for the table document_upload_sessions.
+// You can define custom methods on it to extend its functionality as needed.
+type documentUploadSessionsDao struct {
+	*internal.DocumentUploadSessionsDao
+}
+
+var (
+	// DocumentUploadSessions is a globally accessible object for table document_upload_sessions operations.
+	DocumentUploadSessions = documentUploadSessionsDao{internal.NewDocumentUploadSessionsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// dotPhysicalExemptionsDao is the data access object for the table dot_physical_exemptions.
+// You can define custom methods on it to extend its functionality as needed.
+type dotPhysicalExemptionsDao struct {
+	*internal.DotPhysicalExemptionsDao
+}
+
+var (
+	// DotPhysicalExemptions is a globally accessible object for table dot_physical_exemptions operations.
+	DotPhysicalExemptions = dotPhysicalExemptionsDao{internal.NewDotPhysicalExemptionsDao()}
+)
+
+// Add your custom methods and functionality below.
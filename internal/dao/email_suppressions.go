@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// emailSuppressionsDao is the data access object for the table email_suppressions.
+// You can define custom methods on it to extend its functionality as needed.
+type emailSuppressionsDao struct {
+	*internal.EmailSuppressionsDao
+}
+
+var (
+	// EmailSuppressions is a globally accessible object for table email_suppressions operations.
+	EmailSuppressions = emailSuppressionsDao{internal.NewEmailSuppressionsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -42,6 +42,7 @@ type BackgroundChecksColumns struct {
 	Notes                     string //
 	CreatedAt                 string //
 	UpdatedAt                 string //
+	PackageId                 string //
 }
 
 // backgroundChecksColumns holds the columns for the table background_checks.
@@ -67,6 +68,7 @@ var backgroundChecksColumns = BackgroundChecksColumns{
 	Notes:                     "notes",
 	CreatedAt:                 "created_at",
 	UpdatedAt:                 "updated_at",
+	PackageId:                 "package_id",
 }
 
 // NewBackgroundChecksDao creates and returns a new DAO object for table data access.
@@ -50,6 +50,11 @@ type DocumentsColumns struct {
 	IsCurrentVersion     string //
 	CreatedAt            string //
 	UpdatedAt            string //
+	ChecksumSha256       string //
+	IsEncrypted          string //
+	EncryptionKeyId      string //
+	VersionNote          string //
+	RestoredFromVersion  string //
 }
 
 // documentsColumns holds the columns for the table documents.
@@ -83,6 +88,11 @@ var documentsColumns = DocumentsColumns{
 	IsCurrentVersion:     "is_current_version",
 	CreatedAt:            "created_at",
 	UpdatedAt:            "updated_at",
+	ChecksumSha256:       "checksum_sha256",
+	IsEncrypted:          "is_encrypted",
+	EncryptionKeyId:      "encryption_key_id",
+	VersionNote:          "version_note",
+	RestoredFromVersion:  "restored_from_version",
 }
 
 // NewDocumentsDao creates and returns a new DAO object for table data access.
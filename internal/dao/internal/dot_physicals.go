@@ -49,6 +49,15 @@ type DotPhysicalsColumns struct {
 	Notes                     string //
 	CreatedAt                 string //
 	UpdatedAt                 string //
+	ClinicId                  string //
+	MedicalExaminerId         string //
+	ExaminationType           string //
+	SpecialRequirements       string //
+	AppointmentSequence       string //
+	CancelledAt               string //
+	CancellationReason        string //
+	NoShowAt                  string //
+	CertificationPeriodMonths string //
 }
 
 // dotPhysicalsColumns holds the columns for the table dot_physicals.
@@ -81,6 +90,15 @@ var dotPhysicalsColumns = DotPhysicalsColumns{
 	Notes:                     "notes",
 	CreatedAt:                 "created_at",
 	UpdatedAt:                 "updated_at",
+	ClinicId:                  "clinic_id",
+	MedicalExaminerId:         "medical_examiner_id",
+	ExaminationType:           "examination_type",
+	SpecialRequirements:       "special_requirements",
+	AppointmentSequence:       "appointment_sequence",
+	CancelledAt:               "cancelled_at",
+	CancellationReason:        "cancellation_reason",
+	NoShowAt:                  "no_show_at",
+	CertificationPeriodMonths: "certification_period_months",
 }
 
 // NewDotPhysicalsDao creates and returns a new DAO object for table data access.
@@ -45,6 +45,8 @@ type MvrReportsColumns struct {
 	ActionNotes           string //
 	CreatedAt             string //
 	UpdatedAt             string //
+	LicenseClass          string //
+	LicenseEndorsements   string //
 }
 
 // mvrReportsColumns holds the columns for the table mvr_reports.
@@ -73,6 +75,8 @@ var mvrReportsColumns = MvrReportsColumns{
 	ActionNotes:           "action_notes",
 	CreatedAt:             "created_at",
 	UpdatedAt:             "updated_at",
+	LicenseClass:          "license_class",
+	LicenseEndorsements:   "license_endorsements",
 }
 
 // NewMvrReportsDao creates and returns a new DAO object for table data access.
@@ -43,6 +43,15 @@ type NotificationsColumns struct {
 	DeliveryError     string //
 	CreatedAt         string //
 	UpdatedAt         string //
+	Category          string //
+	DeliveryStatus    string //
+	DigestAt          string //
+	BouncedAt         string //
+	BounceType        string //
+	ComplainedAt      string //
+	OpenedAt          string //
+	ClickedAt         string //
+	BulkRecipientId   string //
 }
 
 // notificationsColumns holds the columns for the table notifications.
@@ -69,6 +78,15 @@ var notificationsColumns = NotificationsColumns{
 	DeliveryError:     "delivery_error",
 	CreatedAt:         "created_at",
 	UpdatedAt:         "updated_at",
+	Category:          "category",
+	DeliveryStatus:    "delivery_status",
+	DigestAt:          "digest_at",
+	BouncedAt:         "bounced_at",
+	BounceType:        "bounce_type",
+	ComplainedAt:      "complained_at",
+	OpenedAt:          "opened_at",
+	ClickedAt:         "clicked_at",
+	BulkRecipientId:   "bulk_recipient_id",
 }
 
 // NewNotificationsDao creates and returns a new DAO object for table data access.
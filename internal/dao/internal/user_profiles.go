@@ -51,6 +51,7 @@ type UserProfilesColumns struct {
 	UpdatedAt                    string //
 	LastLoginAt                  string //
 	Settings                     string //
+	PreferredLocale              string //
 }
 
 // userProfilesColumns holds the columns for the table user_profiles.
@@ -85,6 +86,7 @@ var userProfilesColumns = UserProfilesColumns{
 	UpdatedAt:                    "updated_at",
 	LastLoginAt:                  "last_login_at",
 	Settings:                     "settings",
+	PreferredLocale:              "preferred_locale",
 }
 
 // NewUserProfilesDao creates and returns a new DAO object for table data access.
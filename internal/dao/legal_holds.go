@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// legalHoldsDao is the data access object for the table legal_holds.
+// You can define custom methods on it to extend its functionality as needed.
+type legalHoldsDao struct {
+	*internal.LegalHoldsDao
+}
+
+var (
+	// LegalHolds is a globally accessible object for table legal_holds operations.
+	LegalHolds = legalHoldsDao{internal.NewLegalHoldsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// medicalCertReminderSettingsDao is the data access object for the table medical_cert_reminder_settings.
+// You can define custom methods on it to extend its functionality as needed.
+type medicalCertReminderSettingsDao struct {
+	*internal.MedicalCertReminderSettingsDao
+}
+
+var (
+	// MedicalCertReminderSettings is a globally accessible object for table medical_cert_reminder_settings operations.
+	MedicalCertReminderSettings = medicalCertReminderSettingsDao{internal.NewMedicalCertReminderSettingsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// medicalCertRemindersSentDao is the data access object for the table medical_cert_reminders_sent.
+// You can define custom methods on it to extend its functionality as needed.
+type medicalCertRemindersSentDao struct {
+	*internal.MedicalCertRemindersSentDao
+}
+
+var (
+	// MedicalCertRemindersSent is a globally accessible object for table medical_cert_reminders_sent operations.
+	MedicalCertRemindersSent = medicalCertRemindersSentDao{internal.NewMedicalCertRemindersSentDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// medicalExaminerClinicsDao is the data access object for the table medical_examiner_clinics.
+// You can define custom methods on it to extend its functionality as needed.
+type medicalExaminerClinicsDao struct {
+	*internal.MedicalExaminerClinicsDao
+}
+
+var (
+	// MedicalExaminerClinics is a globally accessible object for table medical_examiner_clinics operations.
+	MedicalExaminerClinics = medicalExaminerClinicsDao{internal.NewMedicalExaminerClinicsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// medicalExaminersDao is the data access object for the table medical_examiners.
+// You can define custom methods on it to extend its functionality as needed.
+type medicalExaminersDao struct {
+	*internal.MedicalExaminersDao
+}
+
+var (
+	// MedicalExaminers is a globally accessible object for table medical_examiners operations.
+	MedicalExaminers = medicalExaminersDao{internal.NewMedicalExaminersDao()}
+)
+
+// Add your custom methods and functionality below.
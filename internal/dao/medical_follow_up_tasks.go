@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// medicalFollowUpTasksDao is the data access object for the table medical_follow_up_tasks.
+// You can define custom methods on it to extend its functionality as needed.
+type medicalFollowUpTasksDao struct {
+	*internal.MedicalFollowUpTasksDao
+}
+
+var (
+	// MedicalFollowUpTasks is a globally accessible object for table medical_follow_up_tasks operations.
+	MedicalFollowUpTasks = medicalFollowUpTasksDao{internal.NewMedicalFollowUpTasksDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// mvrAnnualReviewsDao is the data access object for the table mvr_annual_reviews.
+// You can define custom methods on it to extend its functionality as needed.
+type mvrAnnualReviewsDao struct {
+	*internal.MvrAnnualReviewsDao
+}
+
+var (
+	// MvrAnnualReviews is a globally accessible object for table mvr_annual_reviews operations.
+	MvrAnnualReviews = mvrAnnualReviewsDao{internal.NewMvrAnnualReviewsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// mvrMonitoringEnrollmentsDao is the data access object for the table mvr_monitoring_enrollments.
+// You can define custom methods on it to extend its functionality as needed.
+type mvrMonitoringEnrollmentsDao struct {
+	*internal.MvrMonitoringEnrollmentsDao
+}
+
+var (
+	// MvrMonitoringEnrollments is a globally accessible object for table mvr_monitoring_enrollments operations.
+	MvrMonitoringEnrollments = mvrMonitoringEnrollmentsDao{internal.NewMvrMonitoringEnrollmentsDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// mvrScoringThresholdsDao is the data access object for the table mvr_scoring_thresholds.
+// You can define custom methods on it to extend its functionality as needed.
+type mvrScoringThresholdsDao struct {
+	*internal.MvrScoringThresholdsDao
+}
+
+var (
+	// MvrScoringThresholds is a globally accessible object for table mvr_scoring_thresholds operations.
+	MvrScoringThresholds = mvrScoringThresholdsDao{internal.NewMvrScoringThresholdsDao()}
+)
+
+// Add your custom methods and functionality below.
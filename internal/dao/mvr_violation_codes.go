@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// mvrViolationCodesDao is the data access object for the table mvr_violation_codes.
+// You can define custom methods on it to extend its functionality as needed.
+type mvrViolationCodesDao struct {
+	*internal.MvrViolationCodesDao
+}
+
+var (
+	// MvrViolationCodes is a globally accessible object for table mvr_violation_codes operations.
+	MvrViolationCodes = mvrViolationCodesDao{internal.NewMvrViolationCodesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// notificationBulkRecipientsDao is the data access object for the table notification_bulk_recipients.
+// You can define custom methods on it to extend its functionality as needed.
+type notificationBulkRecipientsDao struct {
+	*internal.NotificationBulkRecipientsDao
+}
+
+var (
+	// NotificationBulkRecipients is a globally accessible object for table notification_bulk_recipients operations.
+	NotificationBulkRecipients = notificationBulkRecipientsDao{internal.NewNotificationBulkRecipientsDao()}
+)
+
+// Add your custom methods and functionality below.
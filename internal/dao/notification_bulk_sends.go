@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// notificationBulkSendsDao is the data access object for the table notification_bulk_sends.
+// You can define custom methods on it to extend its functionality as needed.
+type notificationBulkSendsDao struct {
+	*internal.NotificationBulkSendsDao
+}
+
+var (
+	// NotificationBulkSends is a globally accessible object for table notification_bulk_sends operations.
+	NotificationBulkSends = notificationBulkSendsDao{internal.NewNotificationBulkSendsDao()}
+)
+
+// Add your custom methods and functionality below.
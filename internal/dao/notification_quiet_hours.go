@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// notificationQuietHoursDao is the data access object for the table notification_quiet_hours.
+// You can define custom methods on it to extend its functionality as needed.
+type notificationQuietHoursDao struct {
+	*internal.NotificationQuietHoursDao
+}
+
+var (
+	// NotificationQuietHours is a globally accessible object for table notification_quiet_hours operations.
+	NotificationQuietHours = notificationQuietHoursDao{internal.NewNotificationQuietHoursDao()}
+)
+
+// Add your custom methods and functionality below.
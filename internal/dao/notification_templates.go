@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// notificationTemplatesDao is the data access object for the table notification_templates.
+// You can define custom methods on it to extend its functionality as needed.
+type notificationTemplatesDao struct {
+	*internal.NotificationTemplatesDao
+}
+
+var (
+	// NotificationTemplates is a globally accessible object for table notification_templates operations.
+	NotificationTemplates = notificationTemplatesDao{internal.NewNotificationTemplatesDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -0,0 +1,22 @@
+// =================================================================================
+// This file is auto-generated by the GoFrame CLI tool. You may modify it as needed.
+// =================================================================================
+
+package dao
+
+import (
+	"v1consortium/internal/dao/internal"
+)
+
+// organizationDataKeysDao is the data access object for the table organization_data_keys.
+// You can define custom methods on it to extend its functionality as needed.
+type organizationDataKeysDao struct {
+	*internal.OrganizationDataKeysDao
+}
+
+var (
+	// OrganizationDataKeys is a globally accessible object for table organization_data_keys operations.
+	OrganizationDataKeys = organizationDataKeysDao{internal.NewOrganizationDataKeysDao()}
+)
+
+// Add your custom methods and functionality below.
@@ -1 +1,59 @@
 package authorization
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// internalRoles are the platform operator's own staff, who act for every organization
+var internalRoles = map[consts.UserRole]bool{
+	consts.RoleInternalSU:      true,
+	consts.RoleInternalAdmin:   true,
+	consts.RoleInternalSupport: true,
+}
+
+type sAuthorization struct{}
+
+func init() {
+	service.RegisterAuthorization(new())
+}
+
+func new() service.IAuthorization {
+	return &sAuthorization{}
+}
+
+// ActiveProfile returns the profile of the requesting user, who must be active
+func (s *sAuthorization) ActiveProfile(ctx context.Context, userID string) (*entity.UserProfiles, error) {
+	if userID == "" {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "requesting user is required")
+	}
+	var profile *entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, userID).Scan(&profile)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil || !profile.IsActive {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "requesting user is not active")
+	}
+	return profile, nil
+}
+
+// IsInternal reports whether a user with the profile is an internal user
+func (s *sAuthorization) IsInternal(profile *entity.UserProfiles) bool {
+	return internalRoles[consts.UserRole(profile.Role)]
+}
+
+// ActsFor reports whether a user with the profile may act for the organization: internal users
+// act for every organization, users with one of the roles only for their own
+func (s *sAuthorization) ActsFor(profile *entity.UserProfiles, organizationID string, roles map[consts.UserRole]bool) bool {
+	if s.IsInternal(profile) {
+		return true
+	}
+	return roles[consts.UserRole(profile.Role)] && profile.OrganizationId == organizationID
+}
@@ -0,0 +1,35 @@
+package backgroundcheck
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// analyticsRoles may report on their own organization's background checks
+var analyticsRoles = map[consts.UserRole]bool{
+	consts.RoleClientAdmin:   true,
+	consts.RoleDER:           true,
+	consts.RoleSafetyManager: true,
+	consts.RoleHRManager:     true,
+}
+
+// analyticsOrganization returns the organization the user may report on. Internal users report on
+// the requested organization, or on all organizations when none is requested; an organization's
+// administrators only on their own.
+func analyticsOrganization(ctx context.Context, userID, requested string) (string, error) {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case service.Authorization().IsInternal(profile):
+		return requested, nil
+	case analyticsRoles[consts.UserRole(profile.Role)] && (requested == "" || requested == profile.OrganizationId):
+		return profile.OrganizationId, nil
+	}
+	return "", gerror.NewCode(gcode.CodeNotAuthorized, "not permitted to view the organization's background check analytics")
+}
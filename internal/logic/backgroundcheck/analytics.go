@@ -0,0 +1,308 @@
+package backgroundcheck
+
+import (
+	"context"
+	"sort"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// GetBackgroundCheckAnalytics computes turnaround, review and adverse action rates and cost
+// for checks ordered in the requested period, broken down by provider and check type and
+// grouped by organization and time bucket, with the cost of each package the checks were
+// ordered under. Only internal users may report across organizations; an organization's
+// administrators see their own organization's checks.
+func (s *sBackgroundCheck) GetBackgroundCheckAnalytics(ctx context.Context, in *model.BackgroundCheckAnalyticsInput) (*model.BackgroundCheckAnalyticsOutput, error) {
+	organizationID, err := analyticsOrganization(ctx, in.RequestedBy, in.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := in.Bucket
+	switch bucket {
+	case "":
+		bucket = consts.AnalyticsBucketMonth
+	case consts.AnalyticsBucketDay, consts.AnalyticsBucketWeek, consts.AnalyticsBucketMonth:
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported analytics bucket: %s", bucket)
+	}
+	if in.StartDate != nil && in.EndDate != nil && in.EndDate.Before(in.StartDate) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "end date must not be before start date")
+	}
+
+	cols := dao.BackgroundChecks.Columns()
+	m := dao.BackgroundChecks.Ctx(ctx)
+	if organizationID != "" {
+		m = m.Where(cols.OrganizationId, organizationID)
+	}
+	if in.StartDate != nil {
+		m = m.WhereGTE(cols.OrderedDate, in.StartDate)
+	}
+	if in.EndDate != nil {
+		m = m.WhereLT(cols.OrderedDate, in.EndDate)
+	}
+
+	var checks []*entity.BackgroundChecks
+	if err := m.OrderAsc(cols.OrderedDate).Scan(&checks); err != nil {
+		return nil, err
+	}
+
+	catalog, err := s.loadPriceCatalog(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &model.BackgroundCheckAnalyticsOutput{}
+	total := &statsAccumulator{}
+	turnaround := newTurnaroundAccumulator()
+	buckets := make(map[bucketKey]*bucketAccumulator)
+	packageCosts := make(map[string]*model.BackgroundCheckPackageCost)
+	checkIDs := make([]string, 0, len(checks))
+
+	for _, check := range checks {
+		checkIDs = append(checkIDs, check.Id)
+		var cost float64
+		pkg := catalog.Package(check)
+		if pkg != nil {
+			cost = pkg.Price
+		}
+		addPackageCost(packageCosts, pkg, cost)
+
+		total.add(check, cost)
+		turnaround.add(check, cost)
+
+		key := bucketKey{organizationID: check.OrganizationId, start: bucketStart(checkTime(check), bucket)}
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucketAccumulator{turnaround: newTurnaroundAccumulator()}
+			buckets[key] = b
+		}
+		b.add(check, cost)
+		b.turnaround.add(check, cost)
+
+		if !isCompleted(check) {
+			out.PendingChecks++
+		}
+		switch consts.BackgroundCheckResult(check.OverallResult) {
+		case consts.BackgroundResultClear:
+			out.ClearResults++
+		case consts.BackgroundResultConsider:
+			out.ConsiderResults++
+		case consts.BackgroundResultEngaged, consts.BackgroundResultNotClear:
+			out.EngagedResults++
+		}
+		if check.PreAdverseActionSent || check.AdverseActionSent {
+			out.AdverseActionsInitiated++
+		}
+	}
+
+	out.BackgroundCheckStats = total.result()
+	out.Turnaround = turnaround.result()
+
+	keys := make([]bucketKey, 0, len(buckets))
+	for key := range buckets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].organizationID != keys[j].organizationID {
+			return keys[i].organizationID < keys[j].organizationID
+		}
+		return keys[i].start.Before(keys[j].start)
+	})
+	for _, key := range keys {
+		b := buckets[key]
+		out.Buckets = append(out.Buckets, &model.BackgroundCheckAnalyticsBucket{
+			OrganizationID:       key.organizationID,
+			BucketStart:          gtime.New(key.start),
+			BackgroundCheckStats: b.result(),
+			Turnaround:           b.turnaround.result(),
+		})
+	}
+
+	for _, pc := range packageCosts {
+		out.PackageCosts = append(out.PackageCosts, pc)
+	}
+	sort.Slice(out.PackageCosts, func(i, j int) bool {
+		if out.PackageCosts[i].TotalCost != out.PackageCosts[j].TotalCost {
+			return out.PackageCosts[i].TotalCost > out.PackageCosts[j].TotalCost
+		}
+		return out.PackageCosts[i].PackageID < out.PackageCosts[j].PackageID
+	})
+
+	out.Findings, err = s.countFindings(ctx, checkIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// countFindings summarises the findings of the given checks by type and severity.
+func (s *sBackgroundCheck) countFindings(ctx context.Context, checkIDs []string) ([]*model.BackgroundCheckFindingCount, error) {
+	if len(checkIDs) == 0 {
+		return nil, nil
+	}
+
+	cols := dao.BackgroundCheckFindings.Columns()
+	var counts []*model.BackgroundCheckFindingCount
+	err := dao.BackgroundCheckFindings.Ctx(ctx).
+		Fields(cols.FindingType+" AS finding_type", cols.Severity+" AS severity", "COUNT(*) AS count").
+		WhereIn(cols.BackgroundCheckId, checkIDs).
+		Group(cols.FindingType, cols.Severity).
+		OrderDesc("count").
+		Scan(&counts)
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// addPackageCost adds a check's cost to the total of the package it was ordered under, or to the
+// total of checks without a catalog package when pkg is nil.
+func addPackageCost(costs map[string]*model.BackgroundCheckPackageCost, pkg *entity.BackgroundCheckPackages, cost float64) {
+	var key string
+	if pkg != nil {
+		key = pkg.Id
+	}
+	pc, ok := costs[key]
+	if !ok {
+		pc = &model.BackgroundCheckPackageCost{}
+		if pkg != nil {
+			pc.PackageID = pkg.Id
+			pc.PackageCode = pkg.PackageCode
+			pc.Name = pkg.Name
+			pc.Provider = pkg.ProviderName
+			pc.CheckType = pkg.CheckType
+		}
+		costs[key] = pc
+	}
+	pc.TotalChecks++
+	pc.TotalCost += cost
+}
+
+type bucketKey struct {
+	organizationID string
+	start          time.Time
+}
+
+type bucketAccumulator struct {
+	statsAccumulator
+	turnaround *turnaroundAccumulator
+}
+
+// statsAccumulator collects the running totals behind model.BackgroundCheckStats.
+type statsAccumulator struct {
+	stats           model.BackgroundCheckStats
+	turnaroundHours float64
+	timedChecks     int
+}
+
+func (a *statsAccumulator) add(check *entity.BackgroundChecks, cost float64) {
+	a.stats.TotalChecks++
+	a.stats.TotalCost += cost
+	if isCompleted(check) {
+		a.stats.CompletedChecks++
+	}
+	if check.RequiresReview || consts.BackgroundCheckStatus(check.Status) == consts.BackgroundStatusRequiresReview {
+		a.stats.RequiresReviewChecks++
+	}
+	if check.AdverseActionRequired {
+		a.stats.AdverseActionChecks++
+	}
+	if check.OrderedDate != nil && check.CompletedDate != nil {
+		a.turnaroundHours += check.CompletedDate.Sub(check.OrderedDate).Hours()
+		a.timedChecks++
+	}
+}
+
+// result returns the totals with averages and rates filled in. Rates are a share of all
+// checks in the set; turnaround only averages checks that have both dates.
+func (a *statsAccumulator) result() model.BackgroundCheckStats {
+	stats := a.stats
+	if a.timedChecks > 0 {
+		stats.AverageTurnaroundHours = a.turnaroundHours / float64(a.timedChecks)
+	}
+	if stats.TotalChecks > 0 {
+		stats.RequiresReviewRate = float64(stats.RequiresReviewChecks) / float64(stats.TotalChecks)
+		stats.AdverseActionRate = float64(stats.AdverseActionChecks) / float64(stats.TotalChecks)
+	}
+	return stats
+}
+
+type turnaroundKey struct {
+	provider  string
+	checkType string
+}
+
+// turnaroundAccumulator collects statistics per provider and check type.
+type turnaroundAccumulator struct {
+	groups map[turnaroundKey]*statsAccumulator
+}
+
+func newTurnaroundAccumulator() *turnaroundAccumulator {
+	return &turnaroundAccumulator{groups: make(map[turnaroundKey]*statsAccumulator)}
+}
+
+func (t *turnaroundAccumulator) add(check *entity.BackgroundChecks, cost float64) {
+	key := turnaroundKey{provider: check.ProviderName, checkType: check.CheckType}
+	a, ok := t.groups[key]
+	if !ok {
+		a = &statsAccumulator{}
+		t.groups[key] = a
+	}
+	a.add(check, cost)
+}
+
+func (t *turnaroundAccumulator) result() []*model.BackgroundCheckTurnaround {
+	out := make([]*model.BackgroundCheckTurnaround, 0, len(t.groups))
+	for key, a := range t.groups {
+		out = append(out, &model.BackgroundCheckTurnaround{
+			Provider:             key.provider,
+			CheckType:            key.checkType,
+			BackgroundCheckStats: a.result(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Provider != out[j].Provider {
+			return out[i].Provider < out[j].Provider
+		}
+		return out[i].CheckType < out[j].CheckType
+	})
+	return out
+}
+
+func isCompleted(check *entity.BackgroundChecks) bool {
+	return check.CompletedDate != nil || consts.BackgroundCheckStatus(check.Status) == consts.BackgroundStatusCompleted
+}
+
+func checkTime(check *entity.BackgroundChecks) time.Time {
+	if check.OrderedDate != nil {
+		return check.OrderedDate.Time
+	}
+	if check.CreatedAt != nil {
+		return check.CreatedAt.Time
+	}
+	return time.Time{}
+}
+
+// bucketStart truncates t (in UTC) to the start of its day, ISO week or month.
+func bucketStart(t time.Time, bucket consts.AnalyticsBucket) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch bucket {
+	case consts.AnalyticsBucketDay:
+		return day
+	case consts.AnalyticsBucketWeek:
+		offset := (int(day.Weekday()) + 6) % 7 // days since Monday
+		return day.AddDate(0, 0, -offset)
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+}
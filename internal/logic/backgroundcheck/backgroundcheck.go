@@ -0,0 +1,97 @@
+package backgroundcheck
+
+import (
+	"context"
+	"strings"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+func new() service.IBackgroundCheck {
+	return &sBackgroundCheck{}
+}
+
+func init() {
+	service.RegisterBackgroundCheck(new())
+}
+
+type sBackgroundCheck struct{}
+
+// priceCatalog resolves the cost of a background check from background_check_packages.
+type priceCatalog struct {
+	packages []*entity.BackgroundCheckPackages
+}
+
+// loadPriceCatalog loads list prices plus any negotiated pricing for the organization.
+// Inactive packages are kept so that historical checks are still costed.
+func (s *sBackgroundCheck) loadPriceCatalog(ctx context.Context, organizationID string) (*priceCatalog, error) {
+	var packages []*entity.BackgroundCheckPackages
+	cols := dao.BackgroundCheckPackages.Columns()
+	m := dao.BackgroundCheckPackages.Ctx(ctx)
+	if organizationID != "" {
+		m = m.Where(m.Builder().WhereNull(cols.OrganizationId).WhereOr(cols.OrganizationId, organizationID))
+	}
+	if err := m.Scan(&packages); err != nil {
+		return nil, err
+	}
+	return &priceCatalog{packages: packages}, nil
+}
+
+// Package returns the package the check was ordered under, or nil if it is not in the catalog.
+// Checks record their package when ordered; for a check without one, it is the package matching
+// the check's provider and type that was in effect on the order date, organization pricing
+// winning over the list price.
+func (c *priceCatalog) Package(check *entity.BackgroundChecks) *entity.BackgroundCheckPackages {
+	if check.PackageId != "" {
+		for _, p := range c.packages {
+			if p.Id == check.PackageId {
+				return p
+			}
+		}
+	}
+
+	orderedAt := check.OrderedDate
+	if orderedAt == nil {
+		orderedAt = check.CreatedAt
+	}
+
+	var best *entity.BackgroundCheckPackages
+	for _, p := range c.packages {
+		if p.CheckType != check.CheckType || !strings.EqualFold(p.ProviderName, check.ProviderName) {
+			continue
+		}
+		if p.OrganizationId != "" && p.OrganizationId != check.OrganizationId {
+			continue
+		}
+		if orderedAt != nil {
+			if p.EffectiveFrom != nil && orderedAt.Before(p.EffectiveFrom) {
+				continue
+			}
+			if p.EffectiveTo != nil && !orderedAt.Before(p.EffectiveTo) {
+				continue
+			}
+		}
+		if best == nil || preferPackage(p, best) {
+			best = p
+		}
+	}
+	return best
+}
+
+// preferPackage reports whether candidate should be used over current.
+func preferPackage(candidate, current *entity.BackgroundCheckPackages) bool {
+	if (candidate.OrganizationId != "") != (current.OrganizationId != "") {
+		return candidate.OrganizationId != ""
+	}
+	return laterThan(candidate.EffectiveFrom, current.EffectiveFrom)
+}
+
+func laterThan(a, b *gtime.Time) bool {
+	if a == nil {
+		return false
+	}
+	return b == nil || a.After(b)
+}
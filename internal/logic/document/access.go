@@ -0,0 +1,89 @@
+package document
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// recordsRoles manage document retention and legal holds; internal roles manage every
+// organization's, the others only their own organization's
+var recordsRoles = map[consts.UserRole]bool{
+	consts.RoleInternalSU:    true,
+	consts.RoleInternalAdmin: true,
+	consts.RoleClientAdmin:   true,
+}
+
+// checkDocumentAccess checks that the user may open a document. Users may open the documents that
+// belong to them; other documents are open to the roles that find them by search.
+func checkDocumentAccess(ctx context.Context, userID string, document *entity.Documents) error {
+	access, err := searchAccessOf(ctx, userID, document.OrganizationId)
+	if err != nil {
+		return err
+	}
+	if document.UserId == userID {
+		return nil
+	}
+	return access.check(document.IsConfidential, document.IsHipaaProtected)
+}
+
+// checkUploadAccess checks that the user may upload a document to the organization. Users may
+// upload their own documents; uploading for another user or the organization takes a role that may
+// see the document once it is uploaded.
+func checkUploadAccess(ctx context.Context, userID, organizationID, ownerID string, confidential, hipaa bool) error {
+	access, err := searchAccessOf(ctx, userID, organizationID)
+	if err != nil {
+		return err
+	}
+	if ownerID == userID {
+		return nil
+	}
+	if access == (searchAccess{}) {
+		return gerror.NewCode(gcode.CodeNotAuthorized, "cannot upload documents for other users")
+	}
+	return access.check(confidential, hipaa)
+}
+
+// check checks that the access covers a document's sensitivity
+func (a searchAccess) check(confidential, hipaa bool) error {
+	if confidential && !a.confidential {
+		return gerror.NewCode(gcode.CodeNotAuthorized, "role cannot access confidential documents")
+	}
+	if hipaa && !a.hipaa {
+		return gerror.NewCode(gcode.CodeNotAuthorized, "role cannot access HIPAA-protected documents")
+	}
+	return nil
+}
+
+// isConfidential reports whether a confidentiality level marks a document confidential
+func isConfidential(level consts.DocumentConfidentiality) bool {
+	return level == consts.ConfidentialityConfidential || level == consts.ConfidentialityRestricted
+}
+
+// recordsOrganization returns the organization whose document retention and legal holds the user
+// manages. Internal users name the organization; other users manage their own, and naming another
+// organization is refused.
+func recordsOrganization(ctx context.Context, userID, organizationID string) (string, error) {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	role := consts.UserRole(profile.Role)
+	if !recordsRoles[role] {
+		return "", gerror.NewCode(gcode.CodeNotAuthorized, "not permitted to manage document retention and legal holds")
+	}
+	if service.Authorization().IsInternal(profile) {
+		if organizationID == "" {
+			return "", gerror.NewCode(gcode.CodeMissingParameter, "organization is required")
+		}
+		return organizationID, nil
+	}
+	if organizationID != "" && organizationID != profile.OrganizationId {
+		return "", gerror.NewCode(gcode.CodeNotAuthorized, "cannot manage another organization's documents")
+	}
+	return profile.OrganizationId, nil
+}
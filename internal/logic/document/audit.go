@@ -0,0 +1,73 @@
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gctx"
+)
+
+// Audit log actions recorded for documents
+const (
+	auditActionDecrypt  = "document.decrypt"
+	auditActionUpdate   = "document.update"
+	auditActionVersion  = "document.version"
+	auditActionRollback = "document.rollback"
+	// share actions are recorded against the current version at the time
+	auditActionShare       = "document.share"
+	auditActionShareRevoke = "document.share_revoke"
+	auditActionShareAccess = "document.share_access"
+	// purge tombstones are recorded against the current version and list every version removed
+	auditActionPurge = "document.purge"
+)
+
+// auditEntityType is the audit log entity type of document entries
+const auditEntityType = "document"
+
+// audit records an action on a document in the audit log with the request's client details.
+// Entries for HIPAA-protected documents are flagged for HIPAA reporting.
+func audit(ctx context.Context, document *entity.Documents, action, userID string, oldValues, newValues g.Map) error {
+	data, err := auditEntry(ctx, document, action, userID, oldValues, newValues)
+	if err != nil {
+		return err
+	}
+	_, err = dao.AuditLogs.Ctx(ctx).Data(data).Insert()
+	return err
+}
+
+// auditEntry returns the audit log entry of an action on a document
+func auditEntry(ctx context.Context, document *entity.Documents, action, userID string, oldValues, newValues g.Map) (do.AuditLogs, error) {
+	data := do.AuditLogs{
+		OrganizationId:    document.OrganizationId,
+		UserId:            nilIfEmpty(userID),
+		Action:            action,
+		EntityType:        auditEntityType,
+		EntityId:          document.Id,
+		RequestId:         nilIfEmpty(gctx.CtxId(ctx)),
+		RetentionRequired: true,
+		HipaaLog:          document.IsHipaaProtected,
+	}
+	if len(oldValues) > 0 {
+		values, err := json.Marshal(oldValues)
+		if err != nil {
+			return data, err
+		}
+		data.OldValues = string(values)
+	}
+	if len(newValues) > 0 {
+		values, err := json.Marshal(newValues)
+		if err != nil {
+			return data, err
+		}
+		data.NewValues = string(values)
+	}
+	if r := g.RequestFromCtx(ctx); r != nil {
+		data.IpAddress = nilIfEmpty(r.GetClientIp())
+		data.UserAgent = nilIfEmpty(r.UserAgent())
+	}
+	return data, nil
+}
@@ -0,0 +1,81 @@
+package document
+
+import (
+	"context"
+	"sync"
+	"time"
+	"v1consortium/internal/pkg/blobstore"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+const (
+	// defaultBucket is the bucket documents are stored in when none is configured
+	defaultBucket = "documents"
+	// defaultDownloadURLExpiry is how long a presigned download URL stays valid
+	defaultDownloadURLExpiry = 15 * time.Minute
+)
+
+type sDocument struct {
+	storeMu sync.Mutex
+	store   blobstore.BlobStore
+}
+
+func init() {
+	service.RegisterDocument(new())
+}
+
+func new() service.IDocument {
+	return &sDocument{}
+}
+
+// GetStorageConfig returns the document storage backend configuration
+func (s *sDocument) GetStorageConfig(ctx context.Context) *blobstore.Config {
+	config := &blobstore.Config{
+		Provider:          blobstore.Provider(g.Cfg().MustGet(ctx, "storage.provider", blobstore.ProviderLocal).String()),
+		Bucket:            g.Cfg().MustGet(ctx, "storage.bucket", defaultBucket).String(),
+		LocalRoot:         g.Cfg().MustGet(ctx, "storage.localRoot", "./storage").String(),
+		LocalBaseURL:      g.Cfg().MustGet(ctx, "storage.localBaseUrl", "/files").String(),
+		SigningKey:        g.Cfg().MustGet(ctx, "storage.signingKey").String(),
+		S3Endpoint:        g.Cfg().MustGet(ctx, "storage.s3Endpoint").String(),
+		S3Region:          g.Cfg().MustGet(ctx, "storage.s3Region").String(),
+		S3AccessKeyID:     g.Cfg().MustGet(ctx, "storage.s3AccessKeyId").String(),
+		S3SecretAccessKey: g.Cfg().MustGet(ctx, "storage.s3SecretAccessKey").String(),
+		S3UsePathStyle:    g.Cfg().MustGet(ctx, "storage.s3UsePathStyle").Bool(),
+		S3PartSize:        g.Cfg().MustGet(ctx, "storage.s3PartSize").Int64(),
+	}
+	return config
+}
+
+// Store returns the configured blob store, creating it on first use
+func (s *sDocument) Store(ctx context.Context) (blobstore.BlobStore, error) {
+	s.storeMu.Lock()
+	defer s.storeMu.Unlock()
+	if s.store != nil {
+		return s.store, nil
+	}
+	store, err := blobstore.New(s.GetStorageConfig(ctx))
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to initialize document storage")
+	}
+	s.store = store
+	return store, nil
+}
+
+// downloadURLExpiry returns the configured lifetime of presigned download URLs
+func downloadURLExpiry(ctx context.Context) time.Duration {
+	if d := g.Cfg().MustGet(ctx, "storage.downloadUrlExpiry").Duration(); d > 0 {
+		return d
+	}
+	return defaultDownloadURLExpiry
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,534 @@
+package document
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/docbundle"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// dqRequirements are the parts of a driver qualification file, in the order they are bundled
+var dqRequirements = []struct {
+	requirement consts.DQRequirement
+	title       string
+}{
+	{consts.DQEmploymentApplication, "Employment application (49 CFR 391.21)"},
+	{consts.DQMotorVehicleRecord, "Motor vehicle records (49 CFR 391.23)"},
+	{consts.DQRoadTest, "Road test certificate (49 CFR 391.31)"},
+	{consts.DQMedicalCertificate, "Medical examiner's certificate (49 CFR 391.43)"},
+	{consts.DQAnnualReview, "Annual review of driving record (49 CFR 391.25)"},
+}
+
+// dqDocumentTypes are the document types filed in each part of a driver qualification file
+var dqDocumentTypes = map[consts.DocumentType]consts.DQRequirement{
+	consts.DocTypeDriverApplication:   consts.DQEmploymentApplication,
+	consts.DocTypeMVRReport:           consts.DQMotorVehicleRecord,
+	consts.DocTypeRoadTestCertificate: consts.DQRoadTest,
+	consts.DocTypeMedicalCertificate:  consts.DQMedicalCertificate,
+}
+
+// dqRecords are the records a driver qualification file is assembled from
+type dqRecords struct {
+	driver       *entity.UserProfiles
+	documents    []*entity.Documents
+	mvrReports   []*entity.MvrReports
+	physicals    []*entity.DotPhysicals
+	certificates []*entity.Certificates
+	reviews      []*entity.MvrAnnualReviews
+}
+
+// GetDQFile lists the records of a driver's qualification file (49 CFR 391.51) from their
+// documents, MVR reports, DOT physicals, certificates and annual reviews, and what the file is
+// missing. Documents the requesting user's role may not view are listed as withheld.
+func (s *sDocument) GetDQFile(ctx context.Context, userID, requestedBy string) (*model.DQFile, error) {
+	records, err := loadDQRecords(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	access, err := searchAccessOf(ctx, requestedBy, records.driver.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := gtime.Now()
+	file := &model.DQFile{
+		UserID:         userID,
+		OrganizationID: records.driver.OrganizationId,
+		DriverName:     strings.TrimSpace(records.driver.FirstName + " " + records.driver.LastName),
+		GeneratedAt:    now,
+	}
+	linked := make(map[string]*entity.Documents)
+	filed := make(map[string]bool)
+	for _, d := range records.documents {
+		linked[d.Id] = d
+	}
+	documentItem := func(item *model.DQFileItem, document *entity.Documents) *model.DQFileItem {
+		if document == nil {
+			return item
+		}
+		filed[document.Id] = true
+		item.DocumentID = document.Id
+		item.Withheld = !canViewDocument(access, document, requestedBy)
+		return item
+	}
+	linkedTo := func(match func(*entity.Documents) bool) *entity.Documents {
+		for _, d := range records.documents {
+			if !filed[d.Id] && match(d) {
+				return d
+			}
+		}
+		return nil
+	}
+
+	var items []*model.DQFileItem
+	for _, r := range records.mvrReports {
+		items = append(items, documentItem(mvrReportItem(r), linkedTo(func(d *entity.Documents) bool { return d.MvrReportId == r.Id })))
+	}
+	for _, p := range records.physicals {
+		items = append(items, documentItem(physicalItem(p), linkedTo(func(d *entity.Documents) bool { return d.PhysicalId == p.Id })))
+	}
+	for _, c := range records.certificates {
+		items = append(items, certificateItem(c))
+	}
+	for _, r := range records.reviews {
+		if r.Status == string(consts.MVRReviewCompleted) {
+			items = append(items, documentItem(annualReviewItem(r), linked[r.DocumentId]))
+		}
+	}
+	for _, d := range records.documents {
+		requirement, ok := dqDocumentTypes[consts.DocumentType(d.DocumentType)]
+		if !ok || filed[d.Id] {
+			continue
+		}
+		items = append(items, documentItem(&model.DQFileItem{
+			Requirement: requirement,
+			Source:      consts.DQSourceDocument,
+			SourceID:    d.Id,
+			Title:       d.Title,
+			Date:        d.UploadedAt,
+			Details:     nonEmpty(d.Description, "File: "+d.FileName),
+		}, d))
+	}
+	for _, req := range dqRequirements {
+		for _, item := range items {
+			if item.Requirement == req.requirement {
+				file.Items = append(file.Items, item)
+			}
+		}
+	}
+	file.Missing = dqMissing(records, file.Items, now)
+	return file, nil
+}
+
+// loadDQRecords loads a driver and the records their qualification file is assembled from, newest
+// first
+func loadDQRecords(ctx context.Context, userID string) (*dqRecords, error) {
+	if userID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "driver is required")
+	}
+	records := &dqRecords{}
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, userID).Scan(&records.driver)
+	if err != nil {
+		return nil, err
+	}
+	if records.driver == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "driver not found")
+	}
+
+	mvrCols := dao.MvrReports.Columns()
+	err = dao.MvrReports.Ctx(ctx).
+		Where(mvrCols.UserId, userID).
+		WhereNot(mvrCols.Status, consts.MVRStatusOrdered).
+		OrderDesc("COALESCE(report_date, report_received_date, ordered_date)").
+		Scan(&records.mvrReports)
+	if err != nil {
+		return nil, err
+	}
+	physicalCols := dao.DotPhysicals.Columns()
+	err = dao.DotPhysicals.Ctx(ctx).
+		Where(physicalCols.UserId, userID).
+		Where(physicalCols.Status, consts.PhysicalStatusCompleted).
+		OrderDesc(physicalCols.ExaminationDate).
+		Scan(&records.physicals)
+	if err != nil {
+		return nil, err
+	}
+	certCols := dao.Certificates.Columns()
+	err = dao.Certificates.Ctx(ctx).
+		Where(certCols.UserId, userID).
+		Where(certCols.IsRevoked, false).
+		Where(fmt.Sprintf("(%s = ? OR %s IS NOT NULL)", certCols.CertificateType, certCols.PhysicalId), consts.CertificateTypeRoadTest).
+		OrderDesc(certCols.IssueDate).
+		Scan(&records.certificates)
+	if err != nil {
+		return nil, err
+	}
+	reviewCols := dao.MvrAnnualReviews.Columns()
+	err = dao.MvrAnnualReviews.Ctx(ctx).
+		Where(reviewCols.UserId, userID).
+		OrderDesc(reviewCols.DueDate).
+		Scan(&records.reviews)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewDocuments := make([]string, 0, len(records.reviews))
+	for _, r := range records.reviews {
+		if r.DocumentId != "" {
+			reviewDocuments = append(reviewDocuments, r.DocumentId)
+		}
+	}
+	types := make([]string, 0, len(dqDocumentTypes))
+	for t := range dqDocumentTypes {
+		types = append(types, string(t))
+	}
+	docCols := dao.Documents.Columns()
+	condition := fmt.Sprintf("(%s IN (?) OR %s IS NOT NULL OR %s IS NOT NULL",
+		docCols.DocumentType, docCols.MvrReportId, docCols.PhysicalId)
+	args := []interface{}{types}
+	if len(reviewDocuments) > 0 {
+		condition += fmt.Sprintf(" OR %s IN (?)", docCols.Id)
+		args = append(args, reviewDocuments)
+	}
+	err = dao.Documents.Ctx(ctx).
+		Where(docCols.UserId, userID).
+		Where(docCols.IsCurrentVersion, true).
+		Where(condition+")", args...).
+		OrderDesc(docCols.UploadedAt).
+		Scan(&records.documents)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// canViewDocument reports whether a user whose role has the given access may view a document's
+// content. Users may always view their own documents.
+func canViewDocument(access searchAccess, document *entity.Documents, userID string) bool {
+	if document.UserId == userID {
+		return true
+	}
+	return (access.hipaa || !document.IsHipaaProtected) && (access.confidential || !document.IsConfidential)
+}
+
+// mvrReportDate returns when an MVR report was issued, or received if the issue date is unknown
+func mvrReportDate(r *entity.MvrReports) *gtime.Time {
+	if r.ReportDate != nil {
+		return r.ReportDate
+	}
+	return r.ReportReceivedDate
+}
+
+func mvrReportItem(r *entity.MvrReports) *model.DQFileItem {
+	details := []string{
+		fmt.Sprintf("License %s %s, class %s, status %s", r.LicenseState, r.LicenseNumber, orNone(r.LicenseClass), orNone(r.LicenseStatus)),
+		fmt.Sprintf("%d violations (%d major, %d minor)", r.TotalViolations, r.MajorViolations, r.MinorViolations),
+	}
+	if r.LicenseExpirationDate != nil {
+		details = append(details, "License expires "+r.LicenseExpirationDate.Format("Y-m-d"))
+	}
+	if r.ReviewedDate != nil {
+		details = append(details, "Reviewed "+r.ReviewedDate.Format("Y-m-d"))
+	}
+	return &model.DQFileItem{
+		Requirement: consts.DQMotorVehicleRecord,
+		Source:      consts.DQSourceMVRReport,
+		SourceID:    r.Id,
+		Title:       fmt.Sprintf("Motor vehicle record, %s (%s)", r.LicenseState, r.Status),
+		Date:        mvrReportDate(r),
+		Details:     append(details, nonEmpty(providerDetail(r.ProviderName))...),
+	}
+}
+
+func physicalItem(p *entity.DotPhysicals) *model.DQFileItem {
+	details := []string{
+		fmt.Sprintf("Examiner %s, National Registry number %s", orNone(p.ExaminerName), orNone(p.ExaminerRegistryNumber)),
+		fmt.Sprintf("Certificate %s, qualification %s", orNone(p.CertificateNumber), orNone(p.MedicalQualification)),
+	}
+	if p.CertificateExpirationDate != nil {
+		details = append(details, "Certificate expires "+p.CertificateExpirationDate.Format("Y-m-d"))
+	}
+	return &model.DQFileItem{
+		Requirement: consts.DQMedicalCertificate,
+		Source:      consts.DQSourceDOTPhysical,
+		SourceID:    p.Id,
+		Title:       "DOT physical examination",
+		Date:        p.ExaminationDate,
+		Details:     append(details, nonEmpty(restrictionsDetail(p.Restrictions))...),
+	}
+}
+
+func certificateItem(c *entity.Certificates) *model.DQFileItem {
+	requirement := consts.DQMedicalCertificate
+	if c.CertificateType == consts.CertificateTypeRoadTest {
+		requirement = consts.DQRoadTest
+	}
+	details := []string{"Certificate " + orNone(c.CertificateNumber)}
+	if c.ExpirationDate != nil {
+		details = append(details, "Expires "+c.ExpirationDate.Format("Y-m-d"))
+	}
+	return &model.DQFileItem{
+		Requirement: requirement,
+		Source:      consts.DQSourceCertificate,
+		SourceID:    c.Id,
+		Title:       c.Title,
+		Date:        c.IssueDate,
+		Details:     append(details, nonEmpty(c.Description)...),
+	}
+}
+
+func annualReviewItem(r *entity.MvrAnnualReviews) *model.DQFileItem {
+	return &model.DQFileItem{
+		Requirement: consts.DQAnnualReview,
+		Source:      consts.DQSourceMVRAnnualReview,
+		SourceID:    r.Id,
+		Title:       "Annual review of driving record",
+		Date:        r.ReviewedAt,
+		Details:     append([]string{"Determination: " + orNone(r.Determination)}, nonEmpty(r.Notes)...),
+	}
+}
+
+// dqMissing returns what a driver qualification file with the given items lacks. The annual
+// review and a motor vehicle record from the past year are required once the driver has been
+// employed for a year.
+func dqMissing(records *dqRecords, items []*model.DQFileItem, now *gtime.Time) []*model.DQFileMissingItem {
+	has := make(map[consts.DQRequirement]bool)
+	for _, item := range items {
+		has[item.Requirement] = true
+	}
+	missing := []*model.DQFileMissingItem{}
+	add := func(requirement consts.DQRequirement, format string, args ...interface{}) {
+		missing = append(missing, &model.DQFileMissingItem{Requirement: requirement, Description: fmt.Sprintf(format, args...)})
+	}
+
+	if !has[consts.DQEmploymentApplication] {
+		add(consts.DQEmploymentApplication, "Employment application (49 CFR 391.21)")
+	}
+	if !has[consts.DQMotorVehicleRecord] {
+		add(consts.DQMotorVehicleRecord, "Pre-employment motor vehicle record from each state licensing the driver in the past 3 years (49 CFR 391.23)")
+	}
+	if !has[consts.DQRoadTest] {
+		add(consts.DQRoadTest, "Road test certificate or accepted equivalent (49 CFR 391.31, 391.33)")
+	}
+	switch {
+	case len(records.physicals) > 0 && records.physicals[0].CertificateExpirationDate != nil &&
+		records.physicals[0].CertificateExpirationDate.Before(now):
+		add(consts.DQMedicalCertificate, "Current medical examiner's certificate; the latest expired %s (49 CFR 391.45)",
+			records.physicals[0].CertificateExpirationDate.Format("Y-m-d"))
+	case !has[consts.DQMedicalCertificate]:
+		add(consts.DQMedicalCertificate, "Medical examiner's certificate (49 CFR 391.43)")
+	}
+
+	employedSince := records.driver.HireDate
+	if employedSince == nil {
+		employedSince = records.driver.CreatedAt
+	}
+	yearAgo := now.AddDate(-1, 0, 0)
+	if employedSince == nil || employedSince.After(yearAgo) {
+		return missing
+	}
+	var reviewed bool
+	var overdue *entity.MvrAnnualReviews
+	for _, r := range records.reviews {
+		switch {
+		case r.Status == string(consts.MVRReviewCompleted) && r.ReviewedAt != nil && r.ReviewedAt.After(yearAgo):
+			reviewed = true
+		case r.Status == string(consts.MVRReviewPending) && r.DueDate != nil && r.DueDate.Before(now):
+			overdue = r
+		}
+	}
+	switch {
+	case reviewed:
+	case overdue != nil:
+		add(consts.DQAnnualReview, "Annual review of driving record, overdue since %s (49 CFR 391.25)", overdue.DueDate.Format("Y-m-d"))
+	default:
+		add(consts.DQAnnualReview, "Annual review of driving record within the past 12 months (49 CFR 391.25)")
+	}
+	var recentMVR bool
+	for _, r := range records.mvrReports {
+		if date := mvrReportDate(r); date != nil && date.After(yearAgo) {
+			recentMVR = true
+			break
+		}
+	}
+	if !recentMVR {
+		add(consts.DQMotorVehicleRecord, "Motor vehicle record obtained within the past 12 months (49 CFR 391.25)")
+	}
+	return missing
+}
+
+func providerDetail(provider string) string {
+	if provider == "" {
+		return ""
+	}
+	return "Provider: " + provider
+}
+
+func restrictionsDetail(restrictions string) string {
+	if restrictions == "" || restrictions == "[]" || restrictions == "null" {
+		return ""
+	}
+	return "Restrictions: " + restrictions
+}
+
+// nonEmpty returns the non-empty strings of values
+func nonEmpty(values ...string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// GetDQFileBaseURL returns the URL prefix driver qualification file bundles are downloaded from
+func (s *sDocument) GetDQFileBaseURL(ctx context.Context) string {
+	return strings.TrimSuffix(g.Cfg().MustGet(ctx, "storage.dqFileDownloadUrl", "/dq-files").String(), "/")
+}
+
+// GetDQFileDownload returns a signed URL the driver's qualification file is assembled and
+// downloaded from as a bundle in the given format, issued to the requesting user
+func (s *sDocument) GetDQFileDownload(ctx context.Context, userID string, format docbundle.Format, requestedBy string) (*model.DQFileDownload, error) {
+	expiresAt := time.Now().Add(downloadURLExpiry(ctx))
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	signature, err := sign(ctx, "dq-file", userID, string(format), expires, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{}
+	q.Set("format", string(format))
+	q.Set("expires", expires)
+	q.Set("user", requestedBy)
+	q.Set("signature", signature)
+	return &model.DQFileDownload{
+		URL:       s.GetDQFileBaseURL(ctx) + "/" + url.PathEscape(userID) + "?" + q.Encode(),
+		ExpiresAt: gtime.New(expiresAt),
+	}, nil
+}
+
+// OpenSignedDQFile assembles a driver's qualification file for a download URL issued by
+// GetDQFileDownload, returning the bundle and its file name. The bundle is written to a temporary
+// file, removed when the reader is closed, so a document that cannot be read fails the download
+// before any of it is sent. The caller must close the reader.
+func (s *sDocument) OpenSignedDQFile(ctx context.Context, userID, format, expires, requestedBy, signature string) (io.ReadCloser, string, error) {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return nil, "", gerror.NewCode(gcode.CodeNotAuthorized, "invalid download URL")
+	}
+	expected, err := sign(ctx, "dq-file", userID, format, expires, requestedBy)
+	if err != nil {
+		return nil, "", err
+	}
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, "", gerror.NewCode(gcode.CodeNotAuthorized, "invalid download URL signature")
+	}
+	if time.Now().Unix() > expiresAt {
+		return nil, "", gerror.NewCode(gcode.CodeNotAuthorized, "download URL has expired")
+	}
+	bundleFormat, err := docbundle.ParseFormat(format)
+	if err != nil {
+		return nil, "", gerror.WrapCode(gcode.CodeInvalidParameter, err)
+	}
+	file, err := s.GetDQFile(ctx, userID, requestedBy)
+	if err != nil {
+		return nil, "", err
+	}
+	bundle, err := s.dqBundle(ctx, file, requestedBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tmp, err := os.CreateTemp("", "dq-file-*")
+	if err != nil {
+		return nil, "", gerror.WrapCode(gcode.CodeInternalError, err, "failed to create driver qualification file")
+	}
+	rc := &tempFileReader{File: tmp}
+	if err = docbundle.Write(tmp, bundle, bundleFormat); err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		rc.Close()
+		return nil, "", gerror.WrapCode(gcode.CodeInternalError, err, "failed to assemble driver qualification file")
+	}
+	name := fmt.Sprintf("dq-file-%s-%s.%s", userID, file.GeneratedAt.Format("Ymd"), bundleFormat)
+	return rc, name, nil
+}
+
+// dqBundle returns the bundle of a driver qualification file, opening each document the
+// requesting user may view when it is written. Every document included is recorded as accessed.
+func (s *sDocument) dqBundle(ctx context.Context, file *model.DQFile, requestedBy string) (*docbundle.Bundle, error) {
+	bundle := &docbundle.Bundle{
+		Title:    "Driver Qualification File",
+		Subtitle: []string{fmt.Sprintf("Driver: %s (%s)", file.DriverName, file.UserID), "Generated " + file.GeneratedAt.Format("Y-m-d H:i:s T")},
+	}
+	for _, req := range dqRequirements {
+		section := docbundle.Section{Title: req.title}
+		for _, item := range file.Items {
+			if item.Requirement != req.requirement {
+				continue
+			}
+			entry := docbundle.Entry{Title: item.Title, Details: item.Details}
+			if item.Date != nil {
+				entry.Date = item.Date.Time
+			}
+			switch {
+			case item.Withheld:
+				entry.Details = append(entry.Details, "Document withheld: restricted to authorized roles")
+			case item.DocumentID != "":
+				document, err := s.GetDocument(ctx, item.DocumentID)
+				if err != nil {
+					return nil, err
+				}
+				entry.FileName = document.FileName
+				entry.Open = func() (io.ReadCloser, error) {
+					if err := s.recordAccess(ctx, document.Id, requestedBy); err != nil {
+						return nil, err
+					}
+					return s.openContent(ctx, document, requestedBy)
+				}
+			}
+			section.Entries = append(section.Entries, entry)
+		}
+		bundle.Sections = append(bundle.Sections, section)
+	}
+	for _, m := range file.Missing {
+		bundle.Missing = append(bundle.Missing, m.Description)
+	}
+	return bundle, nil
+}
+
+// tempFileReader is a temporary file removed when it is closed
+type tempFileReader struct {
+	*os.File
+}
+
+func (f *tempFileReader) Close() error {
+	err := f.File.Close()
+	if rmErr := os.Remove(f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,290 @@
+package document
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+	"v1consortium/internal/pkg/envelope"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// encryptedContentType is the content type encrypted objects are stored with, so the blob store
+// never serves ciphertext as the document's own format
+const encryptedContentType = "application/octet-stream"
+
+// requiresEncryption reports whether a document is encrypted at rest: HIPAA-protected content and
+// anything classified confidential or above
+func requiresEncryption(isHipaaProtected bool, level consts.DocumentConfidentiality) bool {
+	return isHipaaProtected ||
+		level == consts.ConfidentialityConfidential ||
+		level == consts.ConfidentialityRestricted
+}
+
+// StoreContent writes document content to the blob store under key. When encrypt is set the
+// content is encrypted with the organization's data key, which is created on first use.
+func (s *sDocument) StoreContent(ctx context.Context, organizationID, key string, r io.Reader, opts *blobstore.PutOptions, encrypt bool) (*model.StoredContent, error) {
+	store, err := s.Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &blobstore.PutOptions{}
+	}
+	content := &model.StoredContent{}
+	counter := &countingReader{r: r}
+	var body io.Reader = counter
+	if encrypt {
+		keyID, dataKey, err := s.activeDataKey(ctx, organizationID)
+		if err != nil {
+			return nil, err
+		}
+		if body, err = envelope.NewEncryptReader(counter, dataKey); err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to encrypt document")
+		}
+		metadata := map[string]string{"encryption-key-id": keyID}
+		for k, v := range opts.Metadata {
+			metadata[k] = v
+		}
+		opts = &blobstore.PutOptions{ContentType: encryptedContentType, Metadata: metadata}
+		content.EncryptionKeyID = keyID
+	}
+	info, err := store.Put(ctx, key, body, opts)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to store document")
+	}
+	content.Object = info
+	content.Size = counter.n
+	return content, nil
+}
+
+// RotateDataKeys re-wraps every organization data key that is not wrapped by the active master
+// key. Stored content is untouched: it stays encrypted under the same data keys. Returns the number
+// of keys re-wrapped.
+func (s *sDocument) RotateDataKeys(ctx context.Context) (int, error) {
+	masters, activeID, err := masterKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+	cols := dao.OrganizationDataKeys.Columns()
+	var rows []*entity.OrganizationDataKeys
+	err = dao.OrganizationDataKeys.Ctx(ctx).
+		WhereNot(cols.MasterKeyId, activeID).
+		OrderAsc(cols.CreatedAt).
+		Scan(&rows)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, row := range rows {
+		dataKey, err := unwrapDataKey(masters, row)
+		if err != nil {
+			return rotated, err
+		}
+		wrapped, err := envelope.WrapKey(masters[activeID], dataKey)
+		if err != nil {
+			return rotated, gerror.WrapCodef(gcode.CodeInternalError, err, "failed to re-wrap data key %s", row.Id)
+		}
+		_, err = dao.OrganizationDataKeys.Ctx(ctx).
+			Where(cols.Id, row.Id).
+			Where(cols.MasterKeyId, row.MasterKeyId).
+			Data(do.OrganizationDataKeys{
+				WrappedKey:  base64.StdEncoding.EncodeToString(wrapped),
+				MasterKeyId: activeID,
+				RewrappedAt: gtime.Now(),
+			}).Update()
+		if err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+// masterKeys returns the configured master keys by ID and the ID of the key new data keys are
+// wrapped with. Keys are configured base64-encoded under encryption.masterKeys; retired master keys
+// stay configured until RotateDataKeys has re-wrapped every data key they protect.
+func masterKeys(ctx context.Context) (map[string][]byte, string, error) {
+	encoded := g.Cfg().MustGet(ctx, "encryption.masterKeys").MapStrStr()
+	activeID := g.Cfg().MustGet(ctx, "encryption.activeMasterKeyId").String()
+	if len(encoded) == 0 || activeID == "" {
+		return nil, "", gerror.NewCode(gcode.CodeInternalError, "document encryption is not configured")
+	}
+	keys := make(map[string][]byte, len(encoded))
+	for id, value := range encoded {
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil || len(key) != envelope.KeySize {
+			return nil, "", gerror.NewCodef(gcode.CodeInternalError, "master key %s must be %d base64-encoded bytes", id, envelope.KeySize)
+		}
+		keys[id] = key
+	}
+	if _, ok := keys[activeID]; !ok {
+		return nil, "", gerror.NewCodef(gcode.CodeInternalError, "active master key %s is not configured", activeID)
+	}
+	return keys, activeID, nil
+}
+
+// activeDataKey returns the organization's active data key, generating and wrapping one with the
+// active master key on first use. A key created concurrently for the same organization wins.
+func (s *sDocument) activeDataKey(ctx context.Context, organizationID string) (string, []byte, error) {
+	masters, activeID, err := masterKeys(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	row, err := activeDataKeyRow(ctx, organizationID)
+	if err != nil {
+		return "", nil, err
+	}
+	if row == nil {
+		dataKey, err := envelope.GenerateKey()
+		if err != nil {
+			return "", nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to generate data key")
+		}
+		wrapped, err := envelope.WrapKey(masters[activeID], dataKey)
+		if err != nil {
+			return "", nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to wrap data key")
+		}
+		_, err = dao.OrganizationDataKeys.Ctx(ctx).Data(do.OrganizationDataKeys{
+			OrganizationId: organizationID,
+			WrappedKey:     base64.StdEncoding.EncodeToString(wrapped),
+			MasterKeyId:    activeID,
+			Status:         consts.DataKeyActive,
+		}).InsertIgnore()
+		if err != nil {
+			return "", nil, err
+		}
+		if row, err = activeDataKeyRow(ctx, organizationID); err != nil {
+			return "", nil, err
+		}
+		if row == nil {
+			return "", nil, gerror.NewCode(gcode.CodeInternalError, "failed to create organization data key")
+		}
+	}
+	dataKey, err := unwrapDataKey(masters, row)
+	if err != nil {
+		return "", nil, err
+	}
+	return row.Id, dataKey, nil
+}
+
+func activeDataKeyRow(ctx context.Context, organizationID string) (*entity.OrganizationDataKeys, error) {
+	cols := dao.OrganizationDataKeys.Columns()
+	var row *entity.OrganizationDataKeys
+	err := dao.OrganizationDataKeys.Ctx(ctx).
+		Where(cols.OrganizationId, organizationID).
+		Where(cols.Status, consts.DataKeyActive).
+		Scan(&row)
+	return row, err
+}
+
+// dataKey returns the data key with the given ID, active or retired
+func (s *sDocument) dataKey(ctx context.Context, keyID string) ([]byte, error) {
+	masters, _, err := masterKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var row *entity.OrganizationDataKeys
+	err = dao.OrganizationDataKeys.Ctx(ctx).
+		Where(dao.OrganizationDataKeys.Columns().Id, keyID).
+		Scan(&row)
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, gerror.NewCodef(gcode.CodeInternalError, "data key %s not found", keyID)
+	}
+	return unwrapDataKey(masters, row)
+}
+
+// unwrapDataKey decrypts a data key with the master key that wrapped it
+func unwrapDataKey(masters map[string][]byte, row *entity.OrganizationDataKeys) ([]byte, error) {
+	master, ok := masters[row.MasterKeyId]
+	if !ok {
+		return nil, gerror.NewCodef(gcode.CodeInternalError, "master key %s for data key %s is not configured", row.MasterKeyId, row.Id)
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(row.WrappedKey)
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "data key %s is not valid base64", row.Id)
+	}
+	dataKey, err := envelope.UnwrapKey(master, wrapped)
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "failed to unwrap data key %s", row.Id)
+	}
+	return dataKey, nil
+}
+
+// openContent opens a document's stored content, verifying it against the checksum recorded at
+// upload and decrypting it if it is encrypted. Opening a HIPAA-protected document is audited first,
+// so content is never released without an audit entry.
+func (s *sDocument) openContent(ctx context.Context, document *entity.Documents, accessedBy string) (io.ReadCloser, error) {
+	if document.IsEncrypted && document.EncryptionKeyId == "" {
+		return nil, gerror.NewCode(gcode.CodeInternalError, "encrypted document has no data key")
+	}
+	if document.IsHipaaProtected {
+		err := audit(ctx, document, auditActionDecrypt, accessedBy, nil, g.Map{
+			"encrypted":         document.IsEncrypted,
+			"encryption_key_id": document.EncryptionKeyId,
+		})
+		if err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to audit document access")
+		}
+	}
+	var dataKey []byte
+	if document.IsEncrypted {
+		var err error
+		if dataKey, err = s.dataKey(ctx, document.EncryptionKeyId); err != nil {
+			return nil, err
+		}
+	}
+
+	store, err := s.Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rc, _, err := store.Get(ctx, document.StoragePath)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "document content not found")
+		}
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to read document")
+	}
+	verified := blobstore.VerifyChecksum(rc, document.ChecksumSha256)
+	if dataKey == nil {
+		return verified, nil
+	}
+	plaintext, err := envelope.NewDecryptReader(verified, dataKey)
+	if err != nil {
+		verified.Close()
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to decrypt document")
+	}
+	return &readCloser{Reader: plaintext, Closer: verified}, nil
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// readCloser reads from a wrapping reader and closes the underlying stream
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,137 @@
+package document
+
+import (
+	"context"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// PlaceLegalHold blocks deletion of documents for a case: every document of the organization, or
+// only a user's documents or a single document. A document hold covers all of its versions.
+// Internal users place holds on any organization's documents, client admins on their own.
+func (s *sDocument) PlaceLegalHold(ctx context.Context, in *model.LegalHoldInput) (*entity.LegalHolds, error) {
+	caseReference := strings.TrimSpace(in.CaseReference)
+	if caseReference == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "case reference is required")
+	}
+	if in.UserID != "" && in.DocumentID != "" {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "a legal hold covers either a user or a document, not both")
+	}
+	organizationID, err := recordsOrganization(ctx, in.PlacedBy, in.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	in.OrganizationID = organizationID
+
+	holdID := uuid.New().String()
+	data := do.LegalHolds{
+		Id:             holdID,
+		OrganizationId: in.OrganizationID,
+		CaseReference:  caseReference,
+		Reason:         nilIfEmpty(in.Reason),
+		Scope:          consts.LegalHoldOrganization,
+		PlacedBy:       in.PlacedBy,
+		PlacedAt:       gtime.Now(),
+	}
+	switch {
+	case in.UserID != "":
+		cols := dao.UserProfiles.Columns()
+		count, err := dao.UserProfiles.Ctx(ctx).
+			Where(cols.Id, in.UserID).
+			Where(cols.OrganizationId, in.OrganizationID).
+			Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gerror.NewCodef(gcode.CodeNotFound, "user %s not found in organization", in.UserID)
+		}
+		data.Scope = consts.LegalHoldUser
+		data.UserId = in.UserID
+	case in.DocumentID != "":
+		document, err := s.GetDocument(ctx, in.DocumentID)
+		if err != nil {
+			return nil, err
+		}
+		if document.OrganizationId != in.OrganizationID {
+			return nil, gerror.NewCode(gcode.CodeInvalidParameter, "document belongs to another organization")
+		}
+		data.Scope = consts.LegalHoldDocument
+		data.DocumentId = rootDocumentID(document)
+	}
+
+	if _, err = dao.LegalHolds.Ctx(ctx).Data(data).Insert(); err != nil {
+		return nil, err
+	}
+	return getLegalHold(ctx, holdID)
+}
+
+// ReleaseLegalHold ends a legal hold. Documents it covered are deleted on their schedule again
+// unless another hold covers them. Holds are released by the users who may place them.
+func (s *sDocument) ReleaseLegalHold(ctx context.Context, holdID, releasedBy string) (*entity.LegalHolds, error) {
+	hold, err := getLegalHold(ctx, holdID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = recordsOrganization(ctx, releasedBy, hold.OrganizationId); err != nil {
+		return nil, err
+	}
+	if hold.ReleasedAt != nil {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "legal hold has already been released")
+	}
+	cols := dao.LegalHolds.Columns()
+	_, err = dao.LegalHolds.Ctx(ctx).
+		Where(cols.Id, holdID).
+		WhereNull(cols.ReleasedAt).
+		Data(do.LegalHolds{
+			ReleasedAt: gtime.Now(),
+			ReleasedBy: releasedBy,
+		}).
+		Update()
+	if err != nil {
+		return nil, err
+	}
+	return getLegalHold(ctx, holdID)
+}
+
+// ListLegalHolds returns an organization's legal holds, newest first, optionally for one case.
+// Released holds are included only when includeReleased is set. Internal users list any
+// organization's holds, client admins their own.
+func (s *sDocument) ListLegalHolds(ctx context.Context, organizationID, caseReference string, includeReleased bool, requestedBy string) ([]*entity.LegalHolds, error) {
+	organizationID, err := recordsOrganization(ctx, requestedBy, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	cols := dao.LegalHolds.Columns()
+	m := dao.LegalHolds.Ctx(ctx).Where(cols.OrganizationId, organizationID)
+	if caseReference != "" {
+		m = m.Where(cols.CaseReference, caseReference)
+	}
+	if !includeReleased {
+		m = m.WhereNull(cols.ReleasedAt)
+	}
+	var holds []*entity.LegalHolds
+	err = m.OrderDesc(cols.PlacedAt).Scan(&holds)
+	return holds, err
+}
+
+func getLegalHold(ctx context.Context, holdID string) (*entity.LegalHolds, error) {
+	var hold *entity.LegalHolds
+	err := dao.LegalHolds.Ctx(ctx).Where(dao.LegalHolds.Columns().Id, holdID).Scan(&hold)
+	if err != nil {
+		return nil, err
+	}
+	if hold == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "legal hold not found")
+	}
+	return hold, nil
+}
@@ -0,0 +1,95 @@
+package document
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/textextract"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// pendingDocument is a document awaiting search indexing with the error of its last failed read
+type pendingDocument struct {
+	entity.Documents
+	ExtractionError string `orm:"extraction_error"`
+}
+
+// IndexPendingDocuments extracts the text of up to limit documents awaiting search indexing, oldest
+// first, and returns how many were indexed. Encrypted and HIPAA-protected documents are indexed on
+// their details only. A document whose content cannot be read right now is left pending for one
+// more run and then marked failed, so it cannot hold up the documents queued behind it.
+func (s *sDocument) IndexPendingDocuments(ctx context.Context, limit int) (int, error) {
+	var pending []*pendingDocument
+	err := dao.Documents.Ctx(ctx).As("d").
+		InnerJoin(dao.DocumentSearchIndex.Table(), "si", "si.document_id = d.id").
+		Fields("d.*, si.extraction_error").
+		Where("si.extraction_status", consts.TextExtractionPending).
+		OrderAsc("si.created_at").
+		Limit(limit).
+		Scan(&pending)
+	if err != nil {
+		return 0, err
+	}
+
+	indexed := 0
+	cols := dao.DocumentSearchIndex.Columns()
+	for _, document := range pending {
+		data := do.DocumentSearchIndex{ExtractedAt: gtime.Now()}
+		status, text, err := s.extractText(ctx, &document.Documents)
+		if err != nil && status == consts.TextExtractionPending {
+			g.Log().Warningf(ctx, "Failed to read document %s for indexing: %v", document.Id, err)
+			if document.ExtractionError != "" {
+				status = consts.TextExtractionFailed
+			}
+		}
+		switch {
+		case err != nil:
+			data.ExtractionError = err.Error()
+		default:
+			data.ExtractedText = text
+		}
+		data.ExtractionStatus = status
+		_, err = dao.DocumentSearchIndex.Ctx(ctx).
+			Where(cols.DocumentId, document.Id).
+			Where(cols.ExtractionStatus, consts.TextExtractionPending).
+			Data(data).
+			Update()
+		if err != nil {
+			return indexed, err
+		}
+		if status != consts.TextExtractionPending {
+			indexed++
+		}
+	}
+	return indexed, nil
+}
+
+// extractText returns the indexing status and extracted text of a document's content. An error
+// with a pending status means the content could not be read and extraction should be retried.
+func (s *sDocument) extractText(ctx context.Context, document *entity.Documents) (consts.TextExtractionStatus, string, error) {
+	if document.IsEncrypted || document.IsHipaaProtected {
+		return consts.TextExtractionProtected, "", nil
+	}
+	if !textextract.Supported(document.MimeType) {
+		return consts.TextExtractionUnsupported, "", nil
+	}
+	rc, err := s.openContent(ctx, document, "")
+	if err != nil {
+		if gerror.Code(err) == gcode.CodeNotFound {
+			return consts.TextExtractionFailed, "", err
+		}
+		return consts.TextExtractionPending, "", err
+	}
+	defer rc.Close()
+	text, err := textextract.Extract(rc, document.MimeType)
+	if err != nil {
+		return consts.TextExtractionFailed, "", err
+	}
+	return consts.TextExtractionIndexed, text, nil
+}
@@ -0,0 +1,179 @@
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"strings"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+)
+
+// defaultMaxUploadBytes is the upload size limit for organizations that have not configured one
+const defaultMaxUploadBytes = 50 << 20
+
+// defaultAllowedMimeTypes are the document formats accepted from organizations that have not
+// configured their own: scanned forms, PDFs and the text and XML formats MVR providers deliver
+var defaultAllowedMimeTypes = []string{
+	"application/pdf",
+	"image/jpeg",
+	"image/png",
+	"image/tiff",
+	"text/plain",
+	"text/csv",
+	"application/xml",
+	"text/xml",
+}
+
+// errUploadTooLarge is returned by a sizeLimitReader once the content exceeds the limit
+var errUploadTooLarge = errors.New("upload exceeds the size limit")
+
+// GetUploadLimits returns the organization's document size and MIME type limits, or the defaults
+// if it has not configured any
+func (s *sDocument) GetUploadLimits(ctx context.Context, organizationID string) (*model.DocumentUploadLimits, error) {
+	if organizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	var row *entity.DocumentUploadLimits
+	err := dao.DocumentUploadLimits.Ctx(ctx).
+		Where(dao.DocumentUploadLimits.Columns().OrganizationId, organizationID).
+		Scan(&row)
+	if err != nil {
+		return nil, err
+	}
+	limits := &model.DocumentUploadLimits{
+		OrganizationID:   organizationID,
+		MaxFileSizeBytes: g.Cfg().MustGet(ctx, "storage.maxUploadBytes", defaultMaxUploadBytes).Int64(),
+		AllowedMimeTypes: defaultAllowedMimeTypes,
+	}
+	if row == nil {
+		return limits, nil
+	}
+	limits.MaxFileSizeBytes = row.MaxFileSizeBytes
+	limits.UpdatedBy = row.UpdatedBy
+	if row.AllowedMimeTypes != "" {
+		if err = json.Unmarshal([]byte(row.AllowedMimeTypes), &limits.AllowedMimeTypes); err != nil {
+			return nil, gerror.WrapCode(gcode.CodeInternalError, err, "invalid allowed MIME types")
+		}
+	}
+	return limits, nil
+}
+
+// SetUploadLimits saves the organization's document size and MIME type limits
+func (s *sDocument) SetUploadLimits(ctx context.Context, in *model.DocumentUploadLimits) (*model.DocumentUploadLimits, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if in.MaxFileSizeBytes <= 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "maximum file size must be positive")
+	}
+	var types []string
+	seen := make(map[string]bool)
+	for _, t := range in.AllowedMimeTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		if !validMimePattern(t) {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid MIME type: %s", t)
+		}
+		seen[t] = true
+		types = append(types, t)
+	}
+	if len(types) == 0 {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "at least one allowed MIME type is required")
+	}
+	typesJSON, err := json.Marshal(types)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = dao.DocumentUploadLimits.Ctx(ctx).
+		Data(do.DocumentUploadLimits{
+			OrganizationId:   in.OrganizationID,
+			MaxFileSizeBytes: in.MaxFileSizeBytes,
+			AllowedMimeTypes: string(typesJSON),
+			UpdatedBy:        nilIfEmpty(in.UpdatedBy),
+		}).
+		OnConflict(dao.DocumentUploadLimits.Columns().OrganizationId).
+		Save()
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUploadLimits(ctx, in.OrganizationID)
+}
+
+// checkUploadLimits rejects content of a type the organization does not accept or larger than its
+// limit. A size of -1 skips the size check for uploads whose length is not known up front.
+func (s *sDocument) checkUploadLimits(ctx context.Context, organizationID, contentType string, size int64) (*model.DocumentUploadLimits, error) {
+	limits, err := s.GetUploadLimits(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if contentType == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "content type is required")
+	}
+	if !mimeAllowed(limits.AllowedMimeTypes, contentType) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "content type %s is not accepted for this organization", contentType)
+	}
+	if size > limits.MaxFileSizeBytes {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "file exceeds the organization's limit of %d bytes", limits.MaxFileSizeBytes)
+	}
+	return limits, nil
+}
+
+// mimeAllowed reports whether the content type matches one of the allowed types or type/* patterns
+func mimeAllowed(allowed []string, contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// validMimePattern reports whether t is a type/subtype or type/* pattern
+func validMimePattern(t string) bool {
+	if prefix, ok := strings.CutSuffix(t, "/*"); ok {
+		t = prefix + "/any"
+	}
+	mediaType, params, err := mime.ParseMediaType(t)
+	return err == nil && len(params) == 0 && strings.Contains(mediaType, "/")
+}
+
+// sizeLimitReader fails with errUploadTooLarge once more than the limit has been read
+type sizeLimitReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, errUploadTooLarge
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errUploadTooLarge
+	}
+	return n, err
+}
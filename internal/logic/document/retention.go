@@ -0,0 +1,348 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// onLegalHold is the SQL condition that an active legal hold covers a documents row
+const onLegalHold = "document_on_legal_hold(organization_id, user_id, COALESCE(parent_document_id, id))"
+
+// pastMinimumRetention is the SQL condition that a documents row's minimum retention period is over
+const pastMinimumRetention = "(retention_period_years IS NULL OR " +
+	"COALESCE(uploaded_at, created_at) + make_interval(years => retention_period_years) <= NOW())"
+
+// retentionStatusRow is a document with whether a legal hold covers it
+type retentionStatusRow struct {
+	entity.Documents
+	OnLegalHold bool `orm:"on_legal_hold"`
+}
+
+// ApplyRetentionPolicy saves how long the organization keeps documents of a type and schedules its
+// documents of that type for deletion that many days after upload. A policy without a type covers
+// the types that have no policy of their own. Returns the number of document versions scheduled.
+// Internal users set the policies of any organization, client admins those of their own.
+func (s *sDocument) ApplyRetentionPolicy(ctx context.Context, in *model.DocumentRetentionPolicyInput) (int, error) {
+	if in.RetentionDays <= 0 {
+		return 0, gerror.NewCode(gcode.CodeInvalidParameter, "retention days must be positive")
+	}
+	organizationID, err := recordsOrganization(ctx, in.AppliedBy, in.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	in.OrganizationID = organizationID
+
+	var updated int64
+	err = dao.Documents.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		policyCols := dao.DocumentRetentionPolicies.Columns()
+		_, err := dao.DocumentRetentionPolicies.Ctx(ctx).
+			Data(do.DocumentRetentionPolicies{
+				OrganizationId: in.OrganizationID,
+				DocumentType:   in.DocumentType,
+				RetentionDays:  in.RetentionDays,
+				AppliedBy:      in.AppliedBy,
+			}).
+			OnConflict(policyCols.OrganizationId, policyCols.DocumentType).
+			Save()
+		if err != nil {
+			return err
+		}
+
+		cols := dao.Documents.Columns()
+		m := dao.Documents.Ctx(ctx).Where(cols.OrganizationId, in.OrganizationID)
+		if in.DocumentType != "" {
+			m = m.Where(cols.DocumentType, in.DocumentType)
+		} else {
+			ownPolicies, err := dao.DocumentRetentionPolicies.Ctx(ctx).
+				Fields(policyCols.DocumentType).
+				Where(policyCols.OrganizationId, in.OrganizationID).
+				WhereNot(policyCols.DocumentType, "").
+				Array()
+			if err != nil {
+				return err
+			}
+			if len(ownPolicies) > 0 {
+				m = m.WhereNotIn(cols.DocumentType, ownPolicies)
+			}
+		}
+		if !in.ForceApply {
+			m = m.WhereNull(cols.AutoDeleteDate)
+		}
+		result, err := m.Data(g.Map{
+			cols.AutoDeleteDate: gdb.Raw(fmt.Sprintf("COALESCE(%s, %s) + INTERVAL '%d days'",
+				cols.UploadedAt, cols.CreatedAt, in.RetentionDays)),
+		}).Update()
+		if err != nil {
+			return err
+		}
+		updated, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(updated), nil
+}
+
+// scheduledDeletion returns when a document of the type uploaded at the given time is deleted under
+// the organization's retention policy, or nil if no policy covers the type
+func scheduledDeletion(ctx context.Context, organizationID string, documentType consts.DocumentType, uploadedAt *gtime.Time) (*gtime.Time, error) {
+	cols := dao.DocumentRetentionPolicies.Columns()
+	var policy *entity.DocumentRetentionPolicies
+	err := dao.DocumentRetentionPolicies.Ctx(ctx).
+		Where(cols.OrganizationId, organizationID).
+		WhereIn(cols.DocumentType, []string{string(documentType), ""}).
+		OrderDesc(cols.DocumentType).
+		Limit(1).
+		Scan(&policy)
+	if err != nil || policy == nil {
+		return nil, err
+	}
+	return uploadedAt.AddDate(0, 0, policy.RetentionDays), nil
+}
+
+// GetRetentionStatus lists the organization's documents scheduled for deletion, soonest first,
+// optionally of one type and due within the given number of days. Internal users see any
+// organization's, client admins their own.
+func (s *sDocument) GetRetentionStatus(ctx context.Context, organizationID, documentType string, withinDays int, requestedBy string) (*model.DocumentRetentionStatus, error) {
+	organizationID, err := recordsOrganization(ctx, requestedBy, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	cols := dao.Documents.Columns()
+	m := dao.Documents.Ctx(ctx).
+		Fields("*", onLegalHold+" AS on_legal_hold").
+		Where(cols.OrganizationId, organizationID).
+		Where(cols.IsCurrentVersion, true).
+		WhereNotNull(cols.AutoDeleteDate)
+	if documentType != "" {
+		m = m.Where(cols.DocumentType, documentType)
+	}
+	var cutoff *gtime.Time
+	if withinDays > 0 {
+		cutoff = gtime.Now().AddDate(0, 0, withinDays)
+		m = m.WhereLTE(cols.AutoDeleteDate, cutoff)
+	}
+	var rows []*retentionStatusRow
+	if err := m.OrderAsc(cols.AutoDeleteDate).Scan(&rows); err != nil {
+		return nil, err
+	}
+
+	policyCols := dao.DocumentRetentionPolicies.Columns()
+	policyTypes, err := dao.DocumentRetentionPolicies.Ctx(ctx).
+		Fields(policyCols.DocumentType).
+		Where(policyCols.OrganizationId, organizationID).
+		Array()
+	if err != nil {
+		return nil, err
+	}
+	covered := make(map[string]bool, len(policyTypes))
+	for _, t := range policyTypes {
+		covered[t.String()] = true
+	}
+
+	status := &model.DocumentRetentionStatus{Documents: []*model.DocumentRetentionInfo{}}
+	for _, row := range rows {
+		document := row.Documents
+		info := &model.DocumentRetentionInfo{
+			Document:      &document,
+			RetainUntil:   retainUntil(&document),
+			DeleteAt:      document.AutoDeleteDate,
+			PolicyApplied: covered[document.DocumentType] || covered[""],
+			OnLegalHold:   row.OnLegalHold,
+		}
+		if info.RetainUntil != nil && info.RetainUntil.After(info.DeleteAt) {
+			info.DeleteAt = info.RetainUntil
+		}
+		if cutoff != nil && info.DeleteAt.After(cutoff) {
+			continue
+		}
+		status.Documents = append(status.Documents, info)
+		if !info.OnLegalHold {
+			status.ScheduledForDeletion++
+		}
+	}
+	return status, nil
+}
+
+// retainUntil returns the end of a document's minimum retention period, or nil if it has none
+func retainUntil(document *entity.Documents) *gtime.Time {
+	if document.RetentionPeriodYears <= 0 {
+		return nil
+	}
+	uploadedAt := document.UploadedAt
+	if uploadedAt == nil {
+		uploadedAt = document.CreatedAt
+	}
+	if uploadedAt == nil {
+		return nil
+	}
+	return uploadedAt.AddDate(document.RetentionPeriodYears, 0, 0)
+}
+
+// PurgeExpiredDocuments deletes up to limit documents whose deletion date has passed, with every
+// version and their stored content, and returns how many were deleted. Documents still within their
+// minimum retention period or under a legal hold are kept. Each deletion leaves a tombstone in the
+// audit log; a document that fails to delete is logged and retried on the next run. Stored content
+// is deleted once the rows are, and content left over from an earlier run is retried first.
+func (s *sDocument) PurgeExpiredDocuments(ctx context.Context, limit int) (int, error) {
+	store, err := s.Store(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var pending []*entity.DocumentContentDeletions
+	err = dao.DocumentContentDeletions.Ctx(ctx).
+		OrderAsc(dao.DocumentContentDeletions.Columns().CreatedAt).
+		Limit(limit).
+		Scan(&pending)
+	if err != nil {
+		return 0, err
+	}
+	if err = deleteQueuedContent(ctx, store, pending); err != nil {
+		return 0, err
+	}
+
+	cols := dao.Documents.Columns()
+	var expired []*entity.Documents
+	err = dao.Documents.Ctx(ctx).
+		Where(cols.IsCurrentVersion, true).
+		WhereLTE(cols.AutoDeleteDate, gtime.Now()).
+		Where(pastMinimumRetention).
+		Where("NOT " + onLegalHold).
+		OrderAsc(cols.AutoDeleteDate).
+		Limit(limit).
+		Scan(&expired)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, document := range expired {
+		deletions, err := purgeDocument(ctx, document)
+		if err != nil {
+			g.Log().Warningf(ctx, "Failed to purge expired document %s: %v", document.Id, err)
+			continue
+		}
+		purged++
+		if err = deleteQueuedContent(ctx, store, deletions); err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// purgeDocument deletes every version of a document, recording a tombstone of what was deleted,
+// and queues their stored content for deletion. A legal hold placed since the document was selected
+// leaves every version in place. The content is only deleted after the rows are committed, so a
+// failed purge never loses the content of a document it leaves in place.
+func purgeDocument(ctx context.Context, document *entity.Documents) ([]*entity.DocumentContentDeletions, error) {
+	versions, err := versionChain(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(versions))
+	checksums := make([]string, 0, len(versions))
+	var deletions []*entity.DocumentContentDeletions
+	for _, v := range versions {
+		ids = append(ids, v.Id)
+		checksums = append(checksums, v.ChecksumSha256)
+		if v.StoragePath != "" {
+			deletions = append(deletions, &entity.DocumentContentDeletions{
+				Id:          uuid.New().String(),
+				DocumentId:  v.Id,
+				StoragePath: v.StoragePath,
+			})
+		}
+	}
+	tombstone := g.Map{
+		"document_id":            rootDocumentID(document),
+		"version_ids":            ids,
+		"organization_id":        document.OrganizationId,
+		"user_id":                document.UserId,
+		"document_type":          document.DocumentType,
+		"title":                  document.Title,
+		"file_name":              document.FileName,
+		"checksums_sha256":       checksums,
+		"uploaded_at":            timeValue(document.UploadedAt),
+		"auto_delete_date":       timeValue(document.AutoDeleteDate),
+		"retention_period_years": document.RetentionPeriodYears,
+	}
+
+	entry, err := auditEntry(ctx, document, auditActionPurge, "", tombstone, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = dao.Documents.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := dao.Documents.Ctx(ctx).TX(tx).
+			WhereIn(dao.Documents.Columns().Id, ids).
+			Where("NOT " + onLegalHold).
+			Delete()
+		if err != nil {
+			return err
+		}
+		// every version goes or none does
+		if deleted, err := result.RowsAffected(); err != nil || deleted != int64(len(ids)) {
+			if err == nil {
+				err = gerror.NewCode(gcode.CodeInvalidOperation, "document was placed on legal hold or changed since it was selected")
+			}
+			return err
+		}
+		if _, err = dao.AuditLogs.Ctx(ctx).TX(tx).Data(entry).Insert(); err != nil {
+			return err
+		}
+		for _, d := range deletions {
+			_, err = dao.DocumentContentDeletions.Ctx(ctx).TX(tx).Data(do.DocumentContentDeletions{
+				Id:          d.Id,
+				DocumentId:  d.DocumentId,
+				StoragePath: d.StoragePath,
+			}).Insert()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+// deleteQueuedContent deletes queued document content from the store and removes each deletion
+// from the queue once its content is gone. Content that fails to delete stays queued with the
+// error for the next purge to retry.
+func deleteQueuedContent(ctx context.Context, store blobstore.BlobStore, deletions []*entity.DocumentContentDeletions) error {
+	cols := dao.DocumentContentDeletions.Columns()
+	for _, d := range deletions {
+		if delErr := store.Delete(ctx, d.StoragePath); delErr != nil {
+			g.Log().Warningf(ctx, "Failed to delete content %s of purged document %s: %v", d.StoragePath, d.DocumentId, delErr)
+			_, err := dao.DocumentContentDeletions.Ctx(ctx).
+				Where(cols.Id, d.Id).
+				Data(g.Map{
+					cols.Attempts:  gdb.Raw(cols.Attempts + " + 1"),
+					cols.LastError: delErr.Error(),
+				}).Update()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := dao.DocumentContentDeletions.Ctx(ctx).Where(cols.Id, d.Id).Delete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
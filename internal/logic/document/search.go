@@ -0,0 +1,200 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// searchConfig is the text search configuration documents are indexed with
+const searchConfig = "english"
+
+// highlightOptions configures the excerpts returned with search results
+const highlightOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=25, MinWords=8"
+
+// searchAccess is the sensitive documents a role finds by search. Every user finds the documents
+// that belong to them, and roles not listed find only documents that are neither confidential nor
+// HIPAA-protected.
+type searchAccess struct {
+	allOrganizations bool
+	confidential     bool
+	hipaa            bool
+}
+
+var roleSearchAccess = map[consts.UserRole]searchAccess{
+	consts.RoleInternalSU:      {allOrganizations: true, confidential: true, hipaa: true},
+	consts.RoleInternalAdmin:   {allOrganizations: true, confidential: true, hipaa: true},
+	consts.RoleInternalSupport: {allOrganizations: true, confidential: true},
+	consts.RoleClientAdmin:     {confidential: true},
+	consts.RoleDER:             {confidential: true},
+	consts.RoleSafetyManager:   {confidential: true},
+	consts.RoleHRManager:       {confidential: true},
+	consts.RoleMRO:             {confidential: true, hipaa: true},
+	consts.RoleSAP:             {confidential: true, hipaa: true},
+	consts.RoleMedicalExaminer: {confidential: true, hipaa: true},
+}
+
+// searchHighlight is a search result excerpt
+type searchHighlight struct {
+	DocumentId string `orm:"document_id"`
+	Highlight  string `orm:"highlight"`
+}
+
+// SearchDocuments searches the current versions of an organization's documents by title,
+// description, file name and extracted text, best match first. Confidential and HIPAA-protected
+// documents are only returned to roles allowed to see them, or to the user they belong to.
+func (s *sDocument) SearchDocuments(ctx context.Context, in *model.DocumentSearchInput) (*model.DocumentSearchResult, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization is required")
+	}
+	access, err := searchAccessOf(ctx, in.SearchedBy, in.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(in.Query)
+	from := fmt.Sprintf("FROM %s d JOIN %s si ON si.document_id = d.id",
+		dao.Documents.Table(), dao.DocumentSearchIndex.Table())
+	var args []any
+	if query != "" {
+		from += fmt.Sprintf(" CROSS JOIN websearch_to_tsquery('%s', ?) q", searchConfig)
+		args = append(args, query)
+	}
+	where := []string{"d.organization_id = ?", "d.is_current_version"}
+	args = append(args, in.OrganizationID)
+	if query != "" {
+		where = append(where, "si.search_vector @@ q")
+	}
+	if len(in.DocumentTypes) > 0 {
+		where = append(where, "d.document_type IN (?)")
+		args = append(args, in.DocumentTypes)
+	}
+	if cond, err := confidentialityCondition(in.ConfidentialityLevels); err != nil {
+		return nil, err
+	} else if cond != "" {
+		where = append(where, cond)
+	}
+	if in.UserID != "" {
+		where = append(where, "d.user_id = ?")
+		args = append(args, in.UserID)
+	}
+	if in.DateFrom != nil {
+		where = append(where, "d.uploaded_at >= ?")
+		args = append(args, in.DateFrom)
+	}
+	if in.DateTo != nil {
+		where = append(where, "d.uploaded_at <= ?")
+		args = append(args, in.DateTo)
+	}
+	// NULL flags are treated as set, so an unclassified document is never exposed
+	var visible []string
+	if !access.confidential {
+		visible = append(visible, "d.is_confidential IS FALSE")
+	}
+	if !access.hipaa {
+		visible = append(visible, "d.is_hipaa_protected IS FALSE")
+	}
+	if len(visible) > 0 {
+		where = append(where, fmt.Sprintf("(d.user_id = ? OR (%s))", strings.Join(visible, " AND ")))
+		args = append(args, in.SearchedBy)
+	}
+	condition := from + " WHERE " + strings.Join(where, " AND ")
+
+	db := dao.Documents.DB()
+	total, err := db.GetCount(ctx, "SELECT COUNT(1) "+condition, args...)
+	if err != nil {
+		return nil, err
+	}
+	result := &model.DocumentSearchResult{
+		Documents:  []*entity.Documents{},
+		Highlights: map[string]string{},
+		TotalCount: total,
+	}
+	if total == 0 || in.Offset >= total {
+		return result, nil
+	}
+
+	order := " ORDER BY d.uploaded_at DESC, d.id"
+	if query != "" {
+		order = " ORDER BY ts_rank_cd(si.search_vector, q) DESC, d.uploaded_at DESC, d.id"
+	}
+	err = db.GetScan(ctx, &result.Documents, "SELECT d.* "+condition+order+" LIMIT ? OFFSET ?",
+		append(args, in.Limit, in.Offset)...)
+	if err != nil {
+		return nil, err
+	}
+	if query != "" && len(result.Documents) > 0 {
+		if result.Highlights, err = searchHighlights(ctx, query, result.Documents); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// searchAccessOf returns which of the organization's documents the user's role may see
+func searchAccessOf(ctx context.Context, userID, organizationID string) (searchAccess, error) {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return searchAccess{}, err
+	}
+	access := roleSearchAccess[consts.UserRole(profile.Role)]
+	if !access.allOrganizations && profile.OrganizationId != organizationID {
+		return searchAccess{}, gerror.NewCode(gcode.CodeNotAuthorized, "cannot access another organization's documents")
+	}
+	return access, nil
+}
+
+// confidentialityCondition returns the condition selecting documents of the given confidentiality
+// levels, or an empty condition when every level is selected
+func confidentialityCondition(levels []consts.DocumentConfidentiality) (string, error) {
+	var confidential, open bool
+	for _, level := range levels {
+		if err := checkConfidentiality(level); err != nil {
+			return "", err
+		}
+		switch level {
+		case consts.ConfidentialityConfidential, consts.ConfidentialityRestricted:
+			confidential = true
+		case consts.ConfidentialityPublic, consts.ConfidentialityInternal:
+			open = true
+		}
+	}
+	switch {
+	case confidential && !open:
+		return "d.is_confidential IS NOT FALSE", nil
+	case open && !confidential:
+		return "d.is_confidential IS FALSE", nil
+	}
+	return "", nil
+}
+
+// searchHighlights returns excerpts of the documents with the query's terms marked
+func searchHighlights(ctx context.Context, query string, documents []*entity.Documents) (map[string]string, error) {
+	ids := make([]string, 0, len(documents))
+	for _, d := range documents {
+		ids = append(ids, d.Id)
+	}
+	sql := fmt.Sprintf(`SELECT d.id AS document_id,
+		ts_headline('%[1]s', concat_ws(' ', d.title, d.description, si.extracted_text), q, '%[2]s') AS highlight
+		FROM %[3]s d JOIN %[4]s si ON si.document_id = d.id
+		CROSS JOIN websearch_to_tsquery('%[1]s', ?) q
+		WHERE d.id IN (?)`,
+		searchConfig, highlightOptions, dao.Documents.Table(), dao.DocumentSearchIndex.Table())
+	var rows []*searchHighlight
+	if err := dao.Documents.DB().GetScan(ctx, &rows, sql, query, ids); err != nil {
+		return nil, err
+	}
+	highlights := make(map[string]string, len(rows))
+	for _, r := range rows {
+		highlights[r.DocumentId] = r.Highlight
+	}
+	return highlights, nil
+}
@@ -0,0 +1,375 @@
+package document
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// shareSignaturePurpose separates share link signatures from download URL signatures
+const shareSignaturePurpose = "share"
+
+// GetShareBaseURL returns the URL prefix of signed document share links
+func (s *sDocument) GetShareBaseURL(ctx context.Context) string {
+	return strings.TrimSuffix(g.Cfg().MustGet(ctx, "storage.documentShareUrl", "/document-shares").String(), "/")
+}
+
+// ShareDocument shares a document with each user, creating one share per recipient, and notifies
+// them with a signed link to it. The sharer must be able to open the document, and recipients must
+// belong to its organization. Shares follow the document, so recipients always get its current
+// version. Share links only open documents, so edit permission is refused. Notification failures
+// are logged rather than returned.
+func (s *sDocument) ShareDocument(ctx context.Context, in *model.DocumentShareInput) ([]*entity.DocumentShares, error) {
+	switch in.PermissionLevel {
+	case consts.SharePermissionView, consts.SharePermissionDownload:
+	case consts.SharePermissionEdit:
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "share links cannot grant edit permission")
+	case "":
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "permission level is required")
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported permission level: %s", in.PermissionLevel)
+	}
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(gtime.Now()) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "share expiry must be in the future")
+	}
+	var recipientIDs []string
+	seen := make(map[string]bool)
+	for _, id := range in.SharedWith {
+		if id != "" && !seen[id] {
+			seen[id] = true
+			recipientIDs = append(recipientIDs, id)
+		}
+	}
+	if len(recipientIDs) == 0 {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "at least one recipient is required")
+	}
+
+	document, err := s.GetDocument(ctx, in.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if document, err = currentVersion(ctx, document); err != nil {
+		return nil, err
+	}
+	if err = checkDocumentAccess(ctx, in.SharedBy, document); err != nil {
+		return nil, err
+	}
+	var recipients []*entity.UserProfiles
+	err = dao.UserProfiles.Ctx(ctx).WhereIn(dao.UserProfiles.Columns().Id, recipientIDs).Scan(&recipients)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*entity.UserProfiles, len(recipients))
+	for _, r := range recipients {
+		byID[r.Id] = r
+	}
+	for _, id := range recipientIDs {
+		if byID[id] == nil {
+			return nil, gerror.NewCodef(gcode.CodeNotFound, "user %s not found", id)
+		}
+		if byID[id].OrganizationId != document.OrganizationId {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "user %s does not belong to the document's organization", id)
+		}
+	}
+
+	shares := make([]*entity.DocumentShares, 0, len(recipientIDs))
+	err = dao.DocumentShares.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		for _, recipientID := range recipientIDs {
+			shareID := uuid.New().String()
+			_, err := dao.DocumentShares.Ctx(ctx).TX(tx).Data(do.DocumentShares{
+				Id:              shareID,
+				DocumentId:      rootDocumentID(document),
+				OrganizationId:  document.OrganizationId,
+				SharedBy:        in.SharedBy,
+				SharedWith:      recipientID,
+				PermissionLevel: in.PermissionLevel,
+				Message:         nilIfEmpty(in.Message),
+				ExpiresAt:       in.ExpiresAt,
+			}).Insert()
+			if err != nil {
+				return err
+			}
+			err = audit(ctx, document, auditActionShare, in.SharedBy, nil, g.Map{
+				"share_id":         shareID,
+				"shared_with":      recipientID,
+				"permission_level": in.PermissionLevel,
+				"expires_at":       timeValue(in.ExpiresAt),
+			})
+			if err != nil {
+				return err
+			}
+			shares = append(shares, &entity.DocumentShares{
+				Id:              shareID,
+				DocumentId:      rootDocumentID(document),
+				OrganizationId:  document.OrganizationId,
+				SharedBy:        in.SharedBy,
+				SharedWith:      recipientID,
+				PermissionLevel: string(in.PermissionLevel),
+				Message:         in.Message,
+				ExpiresAt:       in.ExpiresAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, share := range shares {
+		s.notifyShare(ctx, share, document, byID[share.SharedWith])
+	}
+	return shares, nil
+}
+
+// GetSharedDocuments returns the shares the user received, or made if sharedWithMe is false,
+// newest first, optionally limited to one permission level. Signed links are included for active
+// shares the user received.
+func (s *sDocument) GetSharedDocuments(ctx context.Context, userID string, sharedWithMe bool, permission consts.DocumentSharePermission) ([]*model.SharedDocument, error) {
+	if userID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "user ID is required")
+	}
+	cols := dao.DocumentShares.Columns()
+	m := dao.DocumentShares.Ctx(ctx)
+	if sharedWithMe {
+		m = m.Where(cols.SharedWith, userID)
+	} else {
+		m = m.Where(cols.SharedBy, userID)
+	}
+	if permission != "" {
+		m = m.Where(cols.PermissionLevel, permission)
+	}
+	var shares []*entity.DocumentShares
+	if err := m.OrderDesc(cols.CreatedAt).Scan(&shares); err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, nil
+	}
+
+	rootIDs := make([]string, 0, len(shares))
+	for _, share := range shares {
+		rootIDs = append(rootIDs, share.DocumentId)
+	}
+	documents, err := currentVersions(ctx, rootIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*model.SharedDocument, 0, len(shares))
+	for _, share := range shares {
+		shared := &model.SharedDocument{
+			Share:    share,
+			Document: documents[share.DocumentId],
+			Active:   shareActive(share),
+		}
+		if sharedWithMe && shared.Active {
+			if shared.URL, err = s.shareURL(ctx, share); err != nil {
+				return nil, err
+			}
+		}
+		out = append(out, shared)
+	}
+	return out, nil
+}
+
+// RevokeDocumentShare ends a share; its link stops working immediately. Shares are revoked by the
+// user who made them, or by a user who can open the document.
+func (s *sDocument) RevokeDocumentShare(ctx context.Context, shareID, revokedBy string) error {
+	share, err := getShare(ctx, shareID)
+	if err != nil {
+		return err
+	}
+	if share.RevokedAt != nil {
+		return gerror.NewCode(gcode.CodeInvalidOperation, "share has already been revoked")
+	}
+	document, err := s.GetDocument(ctx, share.DocumentId)
+	if err != nil {
+		return err
+	}
+	if document, err = currentVersion(ctx, document); err != nil {
+		return err
+	}
+	if revokedBy == "" || revokedBy != share.SharedBy {
+		if err = checkDocumentAccess(ctx, revokedBy, document); err != nil {
+			return err
+		}
+	}
+
+	cols := dao.DocumentShares.Columns()
+	return dao.DocumentShares.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := dao.DocumentShares.Ctx(ctx).TX(tx).
+			Where(cols.Id, shareID).
+			WhereNull(cols.RevokedAt).
+			Data(do.DocumentShares{
+				RevokedAt: gtime.Now(),
+				RevokedBy: revokedBy,
+			}).Update()
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return gerror.NewCode(gcode.CodeInvalidOperation, "share has already been revoked")
+		}
+		return audit(ctx, document, auditActionShareRevoke, revokedBy, nil, g.Map{
+			"share_id":    shareID,
+			"shared_with": share.SharedWith,
+		})
+	})
+}
+
+// OpenSharedDocument opens the current version of a shared document for a signed share link. Share
+// links are bearer links: whoever holds the link may open the document until the share is revoked
+// or expires, and the opener is not checked against the recipient. The signature covers the
+// share's expiry, so changing it invalidates the link. Downloading requires download permission;
+// view shares can only display the document. The access is recorded against the share and audited
+// as the recipient's, whom the link was issued to. The caller must close the reader.
+func (s *sDocument) OpenSharedDocument(ctx context.Context, shareID, signature string, download bool) (io.ReadCloser, *entity.Documents, error) {
+	share, err := getShare(ctx, shareID)
+	if err != nil {
+		return nil, nil, err
+	}
+	expected, err := shareSignature(ctx, share)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return nil, nil, gerror.NewCode(gcode.CodeNotAuthorized, "invalid share link signature")
+	}
+	if share.RevokedAt != nil {
+		return nil, nil, gerror.NewCode(gcode.CodeNotAuthorized, "share has been revoked")
+	}
+	if !shareActive(share) {
+		return nil, nil, gerror.NewCode(gcode.CodeNotAuthorized, "share has expired")
+	}
+	if download && consts.DocumentSharePermission(share.PermissionLevel) == consts.SharePermissionView {
+		return nil, nil, gerror.NewCode(gcode.CodeNotAuthorized, "share only allows viewing the document")
+	}
+	document, err := s.GetDocument(ctx, share.DocumentId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if document, err = currentVersion(ctx, document); err != nil {
+		return nil, nil, err
+	}
+
+	err = audit(ctx, document, auditActionShareAccess, share.SharedWith, nil, g.Map{
+		"share_id": share.Id,
+		"download": download,
+	})
+	if err != nil {
+		return nil, nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to audit document access")
+	}
+	rc, err := s.openContent(ctx, document, share.SharedWith)
+	if err != nil {
+		return nil, nil, err
+	}
+	cols := dao.DocumentShares.Columns()
+	_, err = dao.DocumentShares.Ctx(ctx).
+		Where(cols.Id, share.Id).
+		Data(g.Map{
+			cols.AccessCount:    gdb.Raw(cols.AccessCount + " + 1"),
+			cols.LastAccessedAt: gtime.Now(),
+		}).Update()
+	if err == nil && download {
+		err = s.recordAccess(ctx, document.Id, share.SharedWith)
+	}
+	if err != nil {
+		rc.Close()
+		return nil, nil, err
+	}
+	return rc, document, nil
+}
+
+// notifyShare tells a recipient that a document was shared with them and sends the share link
+func (s *sDocument) notifyShare(ctx context.Context, share *entity.DocumentShares, document *entity.Documents, recipient *entity.UserProfiles) {
+	link, err := s.shareURL(ctx, share)
+	if err != nil {
+		g.Log().Warningf(ctx, "Failed to create link for document share %s: %v", share.Id, err)
+		return
+	}
+	sharedBy := "A colleague"
+	if share.SharedBy != "" {
+		var sharer *entity.UserProfiles
+		err = dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, share.SharedBy).Scan(&sharer)
+		if err == nil && sharer != nil {
+			sharedBy = strings.TrimSpace(sharer.FirstName + " " + sharer.LastName)
+		}
+	}
+
+	message := fmt.Sprintf("%s shared \"%s\" with you (%s access).", sharedBy, document.Title, share.PermissionLevel)
+	if share.Message != "" {
+		message += "\n\n" + share.Message
+	}
+	if share.ExpiresAt != nil {
+		message += fmt.Sprintf("\n\nAccess expires %s.", share.ExpiresAt.Format("F j, Y g:i A T"))
+	}
+	message += "\n\nOpen the document: " + link
+
+	_, err = service.Notification().Notify(ctx, &model.NotificationInput{
+		OrganizationID: document.OrganizationId,
+		UserID:         recipient.Id,
+		RecipientName:  strings.TrimSpace(recipient.FirstName + " " + recipient.LastName),
+		EmailAddress:   recipient.Email,
+		Category:       consts.NotificationCategoryDocumentShared,
+		Title:          "Document shared with you",
+		Message:        message,
+		Priority:       consts.NotificationPriorityNormal,
+		Channels:       []consts.NotificationType{consts.NotificationInApp, consts.NotificationEmail},
+	})
+	if err != nil {
+		g.Log().Warningf(ctx, "Failed to notify %s of document share %s: %v", recipient.Id, share.Id, err)
+	}
+}
+
+// shareURL returns the signed link of a share
+func (s *sDocument) shareURL(ctx context.Context, share *entity.DocumentShares) (string, error) {
+	signature, err := shareSignature(ctx, share)
+	if err != nil {
+		return "", err
+	}
+	return s.GetShareBaseURL(ctx) + "/" + url.PathEscape(share.Id) + "?signature=" + signature, nil
+}
+
+// shareSignature signs a share link for the share and its expiry
+func shareSignature(ctx context.Context, share *entity.DocumentShares) (string, error) {
+	var expires string
+	if share.ExpiresAt != nil {
+		expires = strconv.FormatInt(share.ExpiresAt.Unix(), 10)
+	}
+	return sign(ctx, shareSignaturePurpose, share.Id, expires)
+}
+
+func getShare(ctx context.Context, shareID string) (*entity.DocumentShares, error) {
+	var share *entity.DocumentShares
+	err := dao.DocumentShares.Ctx(ctx).Where(dao.DocumentShares.Columns().Id, shareID).Scan(&share)
+	if err != nil {
+		return nil, err
+	}
+	if share == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "share not found")
+	}
+	return share, nil
+}
+
+// shareActive reports whether a share has been neither revoked nor passed its expiry
+func shareActive(share *entity.DocumentShares) bool {
+	return share.RevokedAt == nil && (share.ExpiresAt == nil || share.ExpiresAt.After(gtime.Now()))
+}
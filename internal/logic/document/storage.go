@@ -0,0 +1,265 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// linkRelatedEntity sets the documents column that links the upload to its related entity
+func linkRelatedEntity(data *do.Documents, entityType, entityID string) error {
+	switch entityType {
+	case "":
+		return nil
+	case "drug_test":
+		data.TestId = entityID
+	case "mvr_report":
+		data.MvrReportId = entityID
+	case "dot_physical":
+		data.PhysicalId = entityID
+	case "background_check":
+		data.BackgroundCheckId = entityID
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported related entity type: %s", entityType)
+	}
+	if entityID == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "related entity ID is required")
+	}
+	return nil
+}
+
+// UploadDocument streams the document content to the blob store and records the document with the
+// stored object's size and checksum. The organization's size and MIME type limits are enforced as
+// the content is read. HIPAA-protected and confidential content is encrypted with the organization's
+// data key. The object is removed again if the record cannot be saved. Uploading a document for
+// another user or the organization takes a role that may open it.
+func (s *sDocument) UploadDocument(ctx context.Context, in *model.DocumentUploadInput, r io.Reader) (*entity.Documents, error) {
+	var data do.Documents
+	fileName, err := validateUpload(in, &data)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkUploadAccess(ctx, in.UploadedBy, in.OrganizationID, in.UserID, isConfidential(in.ConfidentialityLevel), in.IsHipaaProtected); err != nil {
+		return nil, err
+	}
+	autoDeleteAt := in.AutoDeleteAt
+	if autoDeleteAt == nil {
+		if autoDeleteAt, err = scheduledDeletion(ctx, in.OrganizationID, in.DocumentType, gtime.Now()); err != nil {
+			return nil, err
+		}
+	}
+	store, err := s.Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	documentID := uuid.New().String()
+	encrypt := requiresEncryption(in.IsHipaaProtected, in.ConfidentialityLevel)
+	key := documentKey(in.OrganizationID, in.UserID, documentID, fileName)
+	content, err := s.storeUpload(ctx, in.OrganizationID, key, in.ContentType, documentID, r, encrypt)
+	if err != nil {
+		return nil, err
+	}
+	info := content.Object
+
+	title := in.Title
+	if title == "" {
+		title = fileName
+	}
+	data.Id = documentID
+	data.OrganizationId = in.OrganizationID
+	data.UserId = nilIfEmpty(in.UserID)
+	data.DocumentType = in.DocumentType
+	data.Title = title
+	data.Description = in.Description
+	data.FileName = fileName
+	data.FileSize = content.Size
+	data.MimeType = in.ContentType
+	data.StoragePath = info.Key
+	data.StorageBucket = info.Bucket
+	data.ChecksumSha256 = info.Checksum
+	data.UploadedBy = nilIfEmpty(in.UploadedBy)
+	data.IsConfidential = isConfidential(in.ConfidentialityLevel)
+	data.IsHipaaProtected = in.IsHipaaProtected
+	data.IsEncrypted = encrypt
+	data.EncryptionKeyId = nilIfEmpty(content.EncryptionKeyID)
+	data.AutoDeleteDate = autoDeleteAt
+	if _, err = dao.Documents.Ctx(ctx).Data(data).Insert(); err != nil {
+		if delErr := store.Delete(context.WithoutCancel(ctx), info.Key); delErr != nil {
+			g.Log().Warningf(ctx, "failed to remove orphaned document object %s: %v", info.Key, delErr)
+		}
+		return nil, err
+	}
+	return s.GetDocument(ctx, documentID)
+}
+
+// documentKey is the blob store key of a document's content
+func documentKey(organizationID, userID, documentID, fileName string) string {
+	owner := userID
+	if owner == "" {
+		owner = "organization"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", organizationID, owner, documentID, fileName)
+}
+
+// storeUpload stores uploaded content for a document, enforcing the organization's size and MIME
+// type limits as the content is read
+func (s *sDocument) storeUpload(ctx context.Context, organizationID, key, contentType, documentID string, r io.Reader, encrypt bool) (*model.StoredContent, error) {
+	limits, err := s.checkUploadLimits(ctx, organizationID, contentType, -1)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.StoreContent(ctx, organizationID, key, &sizeLimitReader{r: r, remaining: limits.MaxFileSizeBytes}, &blobstore.PutOptions{
+		ContentType: contentType,
+		Metadata:    map[string]string{"document-id": documentID},
+	}, encrypt)
+	if err != nil {
+		if errors.Is(err, errUploadTooLarge) {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "file exceeds the organization's limit of %d bytes", limits.MaxFileSizeBytes)
+		}
+		return nil, err
+	}
+	return content, nil
+}
+
+// validateUpload checks the document details, links the related entity on data and returns the
+// file name the content is stored under
+func validateUpload(in *model.DocumentUploadInput, data *do.Documents) (string, error) {
+	if in.OrganizationID == "" {
+		return "", gerror.NewCode(gcode.CodeMissingParameter, "organization is required")
+	}
+	if in.DocumentType == "" {
+		return "", gerror.NewCode(gcode.CodeMissingParameter, "document type is required")
+	}
+	fileName, err := cleanFileName(in.FileName)
+	if err != nil {
+		return "", err
+	}
+	if err = linkRelatedEntity(data, in.RelatedEntityType, in.RelatedEntityID); err != nil {
+		return "", err
+	}
+	if err = checkConfidentiality(in.ConfidentialityLevel); err != nil {
+		return "", err
+	}
+	return fileName, nil
+}
+
+// cleanFileName reduces a client-supplied file name to its base name
+func cleanFileName(name string) (string, error) {
+	fileName := path.Base(strings.ReplaceAll(strings.TrimSpace(name), "\\", "/"))
+	if fileName == "" || fileName == "." || fileName == "/" || fileName == ".." {
+		return "", gerror.NewCode(gcode.CodeMissingParameter, "file name is required")
+	}
+	return fileName, nil
+}
+
+// checkConfidentiality rejects unknown confidentiality levels; empty means unspecified
+func checkConfidentiality(level consts.DocumentConfidentiality) error {
+	switch level {
+	case "", consts.ConfidentialityPublic, consts.ConfidentialityInternal,
+		consts.ConfidentialityConfidential, consts.ConfidentialityRestricted:
+		return nil
+	}
+	return gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported confidentiality level: %s", level)
+}
+
+// GetDocument returns a document record
+func (s *sDocument) GetDocument(ctx context.Context, documentID string) (*entity.Documents, error) {
+	var document *entity.Documents
+	err := dao.Documents.Ctx(ctx).Where(dao.Documents.Columns().Id, documentID).Scan(&document)
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "document not found")
+	}
+	return document, nil
+}
+
+// OpenDocument opens the document content for reading and records the access. Users open their own
+// documents, and the organization's documents their role may find by search. Encrypted content is
+// decrypted, and opening a HIPAA-protected document is audited. Reading the content to the end fails
+// if it no longer matches the checksum recorded at upload. The caller must close the reader.
+func (s *sDocument) OpenDocument(ctx context.Context, documentID, accessedBy string) (io.ReadCloser, *entity.Documents, error) {
+	document, err := s.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = checkDocumentAccess(ctx, accessedBy, document); err != nil {
+		return nil, nil, err
+	}
+	rc, err := s.openContent(ctx, document, accessedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = s.recordAccess(ctx, documentID, accessedBy); err != nil {
+		rc.Close()
+		return nil, nil, err
+	}
+	return rc, document, nil
+}
+
+// GetDownloadURL returns a presigned URL for downloading the document and records the download.
+// Encrypted documents get a signed URL served by the API, which decrypts them on download.
+func (s *sDocument) GetDownloadURL(ctx context.Context, documentID, accessedBy string) (string, error) {
+	document, err := s.GetDocument(ctx, documentID)
+	if err != nil {
+		return "", err
+	}
+	if err = checkDocumentAccess(ctx, accessedBy, document); err != nil {
+		return "", err
+	}
+	if document.IsEncrypted {
+		url, err := signedDownloadURL(ctx, s.GetDownloadBaseURL(ctx), documentID, accessedBy)
+		if err != nil {
+			return "", err
+		}
+		if err = s.recordAccess(ctx, documentID, accessedBy); err != nil {
+			return "", err
+		}
+		return url, nil
+	}
+	store, err := s.Store(ctx)
+	if err != nil {
+		return "", err
+	}
+	url, err := store.PresignGet(ctx, document.StoragePath, downloadURLExpiry(ctx), &blobstore.PresignOptions{
+		Filename: document.FileName,
+	})
+	if err != nil {
+		return "", gerror.WrapCode(gcode.CodeInternalError, err, "failed to create download URL")
+	}
+	if err = s.recordAccess(ctx, documentID, accessedBy); err != nil {
+		return "", err
+	}
+	return url, nil
+}
+
+// recordAccess increments the document's download count and stamps who last accessed it
+func (s *sDocument) recordAccess(ctx context.Context, documentID, accessedBy string) error {
+	cols := dao.Documents.Columns()
+	data := g.Map{
+		cols.DownloadCount:  gdb.Raw(fmt.Sprintf("COALESCE(%s, 0) + 1", cols.DownloadCount)),
+		cols.LastAccessedAt: gtime.Now(),
+	}
+	if accessedBy != "" {
+		data[cols.LastAccessedBy] = accessedBy
+	}
+	_, err := dao.Documents.Ctx(ctx).Where(cols.Id, documentID).Data(data).Update()
+	return err
+}
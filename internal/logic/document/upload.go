@@ -0,0 +1,444 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+	"v1consortium/internal/pkg/envelope"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+const (
+	// uploadSegmentSize is how much of a stream is buffered before it is stored, and so the most an
+	// interrupted upload has to resend
+	uploadSegmentSize = 4 << 20
+	// defaultUploadSessionTTL is how long an upload can be resumed after it starts
+	defaultUploadSessionTTL = 24 * time.Hour
+)
+
+// ReceiveUpload stores the content of a chunked upload. A new session ID starts an upload with the
+// header's document details; a known one resumes it from the header's offset, skipping any bytes the
+// session already holds. Received content is stored in segments as it arrives so an interrupted
+// stream only loses the segment in progress. Once the declared size has been received the segments
+// are assembled into the document. Resending a completed upload returns its document.
+func (s *sDocument) ReceiveUpload(ctx context.Context, header *model.DocumentUploadHeader, r io.Reader) (*model.DocumentUploadProgress, error) {
+	if _, err := uuid.Parse(header.SessionID); err != nil {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "upload session ID must be a UUID")
+	}
+	session, err := s.uploadSession(ctx, header.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		if session, err = s.startUploadSession(ctx, header); err != nil {
+			return nil, err
+		}
+	}
+	if err = checkResume(session, header); err != nil {
+		return nil, err
+	}
+	if session.Status == string(consts.UploadSessionCompleted) {
+		return s.uploadProgress(ctx, session)
+	}
+
+	if skip := session.ReceivedBytes - header.Offset; skip > 0 {
+		if _, err = io.CopyN(io.Discard, r, skip); err != nil {
+			if err == io.EOF {
+				return s.uploadProgress(ctx, session)
+			}
+			return nil, err
+		}
+	}
+
+	store, err := s.Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, uploadSegmentSize)
+	for session.ReceivedBytes < session.TotalSize {
+		n, readErr := io.ReadFull(r, buf)
+		if n > 0 {
+			if session.ReceivedBytes+int64(n) > session.TotalSize {
+				return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "upload is larger than its declared size of %d bytes", session.TotalSize)
+			}
+			if err = s.storeSegment(ctx, session, buf[:n]); err != nil {
+				return nil, err
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, readErr
+		}
+	}
+	if session.ReceivedBytes < session.TotalSize {
+		return s.uploadProgress(ctx, session)
+	}
+	if n, _ := r.Read(buf[:1]); n > 0 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "upload is larger than its declared size of %d bytes", session.TotalSize)
+	}
+	return s.completeUpload(ctx, store, session)
+}
+
+// GetUploadSession returns an upload session so an interrupted upload can resume from the bytes
+// already received. Only the user who started the upload can see its session.
+func (s *sDocument) GetUploadSession(ctx context.Context, sessionID, requestedBy string) (*entity.DocumentUploadSessions, error) {
+	session, err := s.uploadSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "upload session not found")
+	}
+	if session.CreatedBy == "" || session.CreatedBy != requestedBy {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "upload session belongs to another user")
+	}
+	return session, nil
+}
+
+func (s *sDocument) uploadSession(ctx context.Context, sessionID string) (*entity.DocumentUploadSessions, error) {
+	var session *entity.DocumentUploadSessions
+	err := dao.DocumentUploadSessions.Ctx(ctx).
+		Where(dao.DocumentUploadSessions.Columns().Id, sessionID).
+		Scan(&session)
+	return session, err
+}
+
+// startUploadSession validates a new upload against the organization's limits and records its
+// session. A session started concurrently under the same ID is returned instead.
+func (s *sDocument) startUploadSession(ctx context.Context, header *model.DocumentUploadHeader) (*entity.DocumentUploadSessions, error) {
+	in := header.Upload
+	if in == nil {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "document details are required to start an upload")
+	}
+	if header.Offset != 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "upload session not found; start the upload from offset 0")
+	}
+	if header.TotalSize <= 0 {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "total size is required")
+	}
+	fileName, err := validateUpload(in, &do.Documents{})
+	if err != nil {
+		return nil, err
+	}
+	if err = checkUploadAccess(ctx, header.UploadedBy, in.OrganizationID, in.UserID, isConfidential(in.ConfidentialityLevel), in.IsHipaaProtected); err != nil {
+		return nil, err
+	}
+	if _, err = s.checkUploadLimits(ctx, in.OrganizationID, in.ContentType, header.TotalSize); err != nil {
+		return nil, err
+	}
+
+	ttl := g.Cfg().MustGet(ctx, "storage.uploadSessionTtl").Duration()
+	if ttl <= 0 {
+		ttl = defaultUploadSessionTTL
+	}
+	_, err = dao.DocumentUploadSessions.Ctx(ctx).Data(do.DocumentUploadSessions{
+		Id:                   header.SessionID,
+		OrganizationId:       in.OrganizationID,
+		UserId:               nilIfEmpty(in.UserID),
+		CreatedBy:            nilIfEmpty(header.UploadedBy),
+		DocumentType:         in.DocumentType,
+		Title:                in.Title,
+		Description:          in.Description,
+		FileName:             fileName,
+		ContentType:          in.ContentType,
+		RelatedEntityType:    nilIfEmpty(in.RelatedEntityType),
+		RelatedEntityId:      nilIfEmpty(in.RelatedEntityID),
+		IsHipaaProtected:     in.IsHipaaProtected,
+		ConfidentialityLevel: nilIfEmpty(string(in.ConfidentialityLevel)),
+		AutoDeleteAt:         in.AutoDeleteAt,
+		TotalSize:            header.TotalSize,
+		Status:               consts.UploadSessionActive,
+		ExpiresAt:            gtime.Now().Add(ttl),
+	}).InsertIgnore()
+	if err != nil {
+		return nil, err
+	}
+	return s.GetUploadSession(ctx, header.SessionID, header.UploadedBy)
+}
+
+// checkResume checks that the header continues the session: sent by whoever started it, with the
+// same size, before the session expires and from an offset no later than the bytes received
+func checkResume(session *entity.DocumentUploadSessions, header *model.DocumentUploadHeader) error {
+	if session.CreatedBy == "" || header.UploadedBy != session.CreatedBy {
+		return gerror.NewCode(gcode.CodeNotAuthorized, "upload session belongs to another user")
+	}
+	if session.Status == string(consts.UploadSessionCompleted) {
+		return nil
+	}
+	if header.TotalSize != 0 && header.TotalSize != session.TotalSize {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "total size does not match the upload session's %d bytes", session.TotalSize)
+	}
+	if session.ExpiresAt != nil && session.ExpiresAt.Before(gtime.Now()) {
+		return gerror.NewCode(gcode.CodeInvalidOperation, "upload session has expired; start a new upload")
+	}
+	if header.Offset < 0 || header.Offset > session.ReceivedBytes {
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "upload session has received %d bytes; resume from that offset", session.ReceivedBytes)
+	}
+	return nil
+}
+
+// storeSegment stores the next segment of an upload and advances the session. Segments of uploads
+// that will be encrypted are encrypted too. The update only applies if no other stream has advanced
+// the session in the meantime.
+func (s *sDocument) storeSegment(ctx context.Context, session *entity.DocumentUploadSessions, data []byte) error {
+	key := segmentKey(session.Id, session.ReceivedBytes)
+	if _, err := s.StoreContent(ctx, session.OrganizationId, key, bytes.NewReader(data), nil, sessionEncrypted(session)); err != nil {
+		return err
+	}
+
+	cols := dao.DocumentUploadSessions.Columns()
+	received := session.ReceivedBytes + int64(len(data))
+	result, err := dao.DocumentUploadSessions.Ctx(ctx).
+		Where(cols.Id, session.Id).
+		Where(cols.ReceivedBytes, session.ReceivedBytes).
+		Data(do.DocumentUploadSessions{
+			ReceivedBytes: received,
+			SegmentCount:  session.SegmentCount + 1,
+		}).Update()
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return gerror.NewCode(gcode.CodeInvalidOperation, "upload session was advanced by another stream")
+	}
+	session.ReceivedBytes = received
+	session.SegmentCount++
+	return nil
+}
+
+// completeUpload assembles the session's segments into the document, marks the session completed
+// and removes the segments
+func (s *sDocument) completeUpload(ctx context.Context, store blobstore.BlobStore, session *entity.DocumentUploadSessions) (*model.DocumentUploadProgress, error) {
+	keys, err := segmentKeys(ctx, store, session)
+	if err != nil {
+		return nil, err
+	}
+	segments := &segmentReader{ctx: ctx, store: store, keys: keys}
+	if sessionEncrypted(session) {
+		if _, segments.dataKey, err = s.activeDataKey(ctx, session.OrganizationId); err != nil {
+			return nil, err
+		}
+	}
+	defer segments.Close()
+	document, err := s.UploadDocument(ctx, &model.DocumentUploadInput{
+		OrganizationID:       session.OrganizationId,
+		UserID:               session.UserId,
+		DocumentType:         consts.DocumentType(session.DocumentType),
+		Title:                session.Title,
+		Description:          session.Description,
+		FileName:             session.FileName,
+		ContentType:          session.ContentType,
+		RelatedEntityType:    session.RelatedEntityType,
+		RelatedEntityID:      session.RelatedEntityId,
+		IsHipaaProtected:     session.IsHipaaProtected,
+		ConfidentialityLevel: consts.DocumentConfidentiality(session.ConfidentialityLevel),
+		AutoDeleteAt:         session.AutoDeleteAt,
+		UploadedBy:           session.CreatedBy,
+	}, segments)
+	if err != nil {
+		return nil, err
+	}
+
+	now := gtime.Now()
+	_, err = dao.DocumentUploadSessions.Ctx(ctx).
+		Where(dao.DocumentUploadSessions.Columns().Id, session.Id).
+		Data(do.DocumentUploadSessions{
+			Status:      consts.UploadSessionCompleted,
+			DocumentId:  document.Id,
+			CompletedAt: now,
+		}).Update()
+	if err != nil {
+		return nil, err
+	}
+	session.Status = string(consts.UploadSessionCompleted)
+	session.DocumentId = document.Id
+	session.CompletedAt = now
+
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			g.Log().Warningf(ctx, "failed to remove upload segment %s: %v", key, err)
+		}
+	}
+	return &model.DocumentUploadProgress{Session: session, Document: document}, nil
+}
+
+// uploadProgress returns the session with its document once complete
+func (s *sDocument) uploadProgress(ctx context.Context, session *entity.DocumentUploadSessions) (*model.DocumentUploadProgress, error) {
+	progress := &model.DocumentUploadProgress{Session: session}
+	if session.DocumentId != "" {
+		document, err := s.GetDocument(ctx, session.DocumentId)
+		if err != nil {
+			return nil, err
+		}
+		progress.Document = document
+	}
+	return progress, nil
+}
+
+// PurgeExpiredUploads removes upload sessions that expired before completing, with the segments
+// they stored, and returns how many were removed. Sessions whose segments cannot be removed are
+// logged and retried on the next run.
+func (s *sDocument) PurgeExpiredUploads(ctx context.Context) (int, error) {
+	cols := dao.DocumentUploadSessions.Columns()
+	var sessions []*entity.DocumentUploadSessions
+	err := dao.DocumentUploadSessions.Ctx(ctx).
+		Where(cols.Status, consts.UploadSessionActive).
+		WhereLT(cols.ExpiresAt, gtime.Now()).
+		Scan(&sessions)
+	if err != nil || len(sessions) == 0 {
+		return 0, err
+	}
+	store, err := s.Store(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, session := range sessions {
+		if err = deleteSegments(ctx, store, session); err != nil {
+			g.Log().Warningf(ctx, "Failed to remove segments of expired upload session %s: %v", session.Id, err)
+			continue
+		}
+		_, err = dao.DocumentUploadSessions.Ctx(ctx).
+			Where(cols.Id, session.Id).
+			Where(cols.Status, consts.UploadSessionActive).
+			Delete()
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// deleteSegments removes a session's stored segments, walking them from the start until the bytes
+// received are covered or a segment is missing
+func deleteSegments(ctx context.Context, store blobstore.BlobStore, session *entity.DocumentUploadSessions) error {
+	for offset := int64(0); offset < session.ReceivedBytes; {
+		key := segmentKey(session.Id, offset)
+		info, err := store.Stat(ctx, key)
+		if err != nil {
+			if errors.Is(err, blobstore.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		size := info.Size
+		if sessionEncrypted(session) {
+			size = envelope.PlaintextSize(size)
+		}
+		if err = store.Delete(ctx, key); err != nil {
+			return err
+		}
+		if size <= 0 {
+			return nil
+		}
+		offset += size
+	}
+	return nil
+}
+
+// sessionEncrypted reports whether an upload's document, and so its segments, are encrypted
+func sessionEncrypted(session *entity.DocumentUploadSessions) bool {
+	return requiresEncryption(session.IsHipaaProtected, consts.DocumentConfidentiality(session.ConfidentialityLevel))
+}
+
+// segmentKey is the blob store key of the segment starting at offset. Keying segments by offset
+// lets them be found again by walking from 0 without listing the store.
+func segmentKey(sessionID string, offset int64) string {
+	return fmt.Sprintf("uploads/%s/%015d", sessionID, offset)
+}
+
+// segmentKeys returns the keys of a session's segments in order, checking that they cover exactly
+// the bytes received
+func segmentKeys(ctx context.Context, store blobstore.BlobStore, session *entity.DocumentUploadSessions) ([]string, error) {
+	var keys []string
+	for offset := int64(0); offset < session.ReceivedBytes; {
+		key := segmentKey(session.Id, offset)
+		info, err := store.Stat(ctx, key)
+		if err != nil {
+			if errors.Is(err, blobstore.ErrNotFound) {
+				return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "upload segment at offset %d is missing; start a new upload", offset)
+			}
+			return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to read upload segment")
+		}
+		size := info.Size
+		if sessionEncrypted(session) {
+			size = envelope.PlaintextSize(size)
+		}
+		if size <= 0 {
+			return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "upload segment at offset %d is empty; start a new upload", offset)
+		}
+		keys = append(keys, key)
+		offset += size
+	}
+	return keys, nil
+}
+
+// segmentReader reads a sequence of stored segments as one stream, opening each in turn and
+// decrypting it if a data key is set
+type segmentReader struct {
+	ctx     context.Context
+	store   blobstore.BlobStore
+	keys    []string
+	dataKey []byte
+	current io.ReadCloser
+}
+
+func (r *segmentReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			rc, info, err := r.store.Get(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, err
+			}
+			r.current = blobstore.VerifyChecksum(rc, info.Checksum)
+			r.keys = r.keys[1:]
+			if r.dataKey != nil {
+				plaintext, err := envelope.NewDecryptReader(r.current, r.dataKey)
+				if err != nil {
+					return 0, err
+				}
+				r.current = &readCloser{Reader: plaintext, Closer: r.current}
+			}
+		}
+		n, err := r.current.Read(p)
+		if err == io.EOF {
+			r.current.Close()
+			r.current = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (r *segmentReader) Close() error {
+	if r.current != nil {
+		return r.current.Close()
+	}
+	return nil
+}
@@ -0,0 +1,441 @@
+package document
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// versionedFields are the documents columns compared between versions, in the order changes are
+// listed
+var versionedFields = []string{
+	"title",
+	"description",
+	"file_name",
+	"file_size",
+	"mime_type",
+	"checksum_sha256",
+	"is_confidential",
+	"is_hipaa_protected",
+	"is_encrypted",
+	"auto_delete_date",
+}
+
+// UpdateDocument changes a document's details. New content creates a new version, as does a
+// reclassification that requires content stored unencrypted to be encrypted; both replace the
+// current version atomically. Other changes apply to the current version. Every change is audited.
+func (s *sDocument) UpdateDocument(ctx context.Context, in *model.DocumentUpdateInput, r io.Reader) (*entity.Documents, error) {
+	current, err := s.GetDocument(ctx, in.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if !current.IsCurrentVersion {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "version %d has been superseded; update the current version", current.Version)
+	}
+	if err = checkConfidentiality(in.ConfidentialityLevel); err != nil {
+		return nil, err
+	}
+
+	next := *current
+	if in.Title != "" {
+		next.Title = in.Title
+	}
+	if in.Description != "" {
+		next.Description = in.Description
+	}
+	if in.ConfidentialityLevel != "" {
+		next.IsConfidential = in.ConfidentialityLevel == consts.ConfidentialityConfidential ||
+			in.ConfidentialityLevel == consts.ConfidentialityRestricted
+	}
+	if in.AutoDeleteAt != nil {
+		next.AutoDeleteDate = in.AutoDeleteAt
+	}
+	encrypt := requiresEncryption(next.IsHipaaProtected, confidentialityOf(&next))
+
+	if r == nil && (current.IsEncrypted || !encrypt) {
+		return s.updateCurrentVersion(ctx, current, &next, in.UpdatedBy)
+	}
+
+	next.Id = uuid.New().String()
+	next.VersionNote = in.ChangeNote
+	var content *model.StoredContent
+	if r != nil {
+		if in.FileName != "" {
+			if next.FileName, err = cleanFileName(in.FileName); err != nil {
+				return nil, err
+			}
+		}
+		if in.ContentType != "" {
+			next.MimeType = in.ContentType
+		}
+		key := documentKey(next.OrganizationId, next.UserId, next.Id, next.FileName)
+		if content, err = s.storeUpload(ctx, next.OrganizationId, key, next.MimeType, next.Id, r, encrypt); err != nil {
+			return nil, err
+		}
+	} else {
+		if next.VersionNote == "" {
+			next.VersionNote = "content encrypted after reclassification"
+		}
+		if content, err = s.reencryptContent(ctx, current, &next, in.UpdatedBy); err != nil {
+			return nil, err
+		}
+	}
+	return s.commitVersion(ctx, current, &next, content, auditActionVersion, in.UpdatedBy)
+}
+
+// GetDocumentVersions returns every version of a document, oldest first, with the changes between
+// consecutive versions
+func (s *sDocument) GetDocumentVersions(ctx context.Context, documentID string) (*model.DocumentVersionHistory, error) {
+	document, err := s.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := versionChain(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	history := &model.DocumentVersionHistory{Versions: versions}
+	for i := 1; i < len(versions); i++ {
+		history.Diffs = append(history.Diffs, &model.DocumentVersionDiff{
+			FromVersion: versions[i-1].Version,
+			ToVersion:   versions[i].Version,
+			Changes:     diffVersions(versions[i-1], versions[i]),
+		})
+	}
+	return history, nil
+}
+
+// RollbackDocument restores a prior version's content and file details as a new current version,
+// keeping every version in between. The current classification is kept, so a rollback never
+// relaxes it: content restored into a document that now requires encryption is encrypted.
+func (s *sDocument) RollbackDocument(ctx context.Context, in *model.DocumentRollbackInput) (*entity.Documents, error) {
+	document, err := s.GetDocument(ctx, in.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := versionChain(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	var current, target *entity.Documents
+	for _, v := range versions {
+		if v.IsCurrentVersion {
+			current = v
+		}
+		if v.Version == in.Version {
+			target = v
+		}
+	}
+	if target == nil {
+		return nil, gerror.NewCodef(gcode.CodeNotFound, "version %d of the document not found", in.Version)
+	}
+	if current == nil {
+		return nil, gerror.NewCode(gcode.CodeInternalError, "document has no current version")
+	}
+	if target.Id == current.Id {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "version %d is already the current version", in.Version)
+	}
+
+	next := *current
+	next.Id = uuid.New().String()
+	next.Title = target.Title
+	next.Description = target.Description
+	next.FileName = target.FileName
+	next.MimeType = target.MimeType
+	next.RestoredFromVersion = target.Version
+	next.VersionNote = in.ChangeNote
+	if next.VersionNote == "" {
+		next.VersionNote = "rolled back to version " + strconv.Itoa(target.Version)
+	}
+
+	var content *model.StoredContent
+	if target.IsEncrypted || !requiresEncryption(next.IsHipaaProtected, confidentialityOf(&next)) {
+		content, err = s.copyContent(ctx, target, &next)
+	} else {
+		content, err = s.reencryptContent(ctx, target, &next, in.RolledBackBy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.commitVersion(ctx, current, &next, content, auditActionRollback, in.RolledBackBy)
+}
+
+// updateCurrentVersion applies detail changes to the current version and audits them
+func (s *sDocument) updateCurrentVersion(ctx context.Context, current, next *entity.Documents, updatedBy string) (*entity.Documents, error) {
+	changes := diffVersions(current, next)
+	if len(changes) == 0 {
+		return current, nil
+	}
+	oldValues, newValues := g.Map{}, g.Map{}
+	for _, c := range changes {
+		oldValues[c.Field] = c.OldValue
+		newValues[c.Field] = c.NewValue
+	}
+	err := dao.Documents.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.Documents.Ctx(ctx).TX(tx).
+			Where(dao.Documents.Columns().Id, current.Id).
+			Data(do.Documents{
+				Title:          next.Title,
+				Description:    next.Description,
+				IsConfidential: next.IsConfidential,
+				AutoDeleteDate: next.AutoDeleteDate,
+			}).Update()
+		if err != nil {
+			return err
+		}
+		return audit(ctx, next, auditActionUpdate, updatedBy, oldValues, newValues)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetDocument(ctx, current.Id)
+}
+
+// commitVersion records content stored for next as the document's new current version. The
+// previous version stops being current in the same transaction, and fails the commit if another
+// version replaced it first; the stored content is then removed again.
+func (s *sDocument) commitVersion(ctx context.Context, current, next *entity.Documents, content *model.StoredContent, action, userID string) (*entity.Documents, error) {
+	next.FileSize = content.Size
+	next.StoragePath = content.Object.Key
+	next.StorageBucket = content.Object.Bucket
+	next.ChecksumSha256 = content.Object.Checksum
+	next.IsEncrypted = content.EncryptionKeyID != ""
+	next.EncryptionKeyId = content.EncryptionKeyID
+	next.Version = max(current.Version, 1) + 1
+	next.ParentDocumentId = rootDocumentID(current)
+	next.IsCurrentVersion = true
+	next.UploadedBy = userID
+
+	data := do.Documents{
+		Id:                next.Id,
+		OrganizationId:    next.OrganizationId,
+		UserId:            nilIfEmpty(next.UserId),
+		DocumentType:      next.DocumentType,
+		Title:             next.Title,
+		Description:       next.Description,
+		FileName:          next.FileName,
+		FileSize:          next.FileSize,
+		MimeType:          next.MimeType,
+		StoragePath:       next.StoragePath,
+		StorageBucket:     next.StorageBucket,
+		TestId:            nilIfEmpty(next.TestId),
+		MvrReportId:       nilIfEmpty(next.MvrReportId),
+		PhysicalId:        nilIfEmpty(next.PhysicalId),
+		BackgroundCheckId: nilIfEmpty(next.BackgroundCheckId),
+		UploadedBy:        nilIfEmpty(next.UploadedBy),
+		IsConfidential:    next.IsConfidential,
+		IsHipaaProtected:  next.IsHipaaProtected,
+		AutoDeleteDate:    next.AutoDeleteDate,
+		Version:           next.Version,
+		ParentDocumentId:  next.ParentDocumentId,
+		IsCurrentVersion:  true,
+		ChecksumSha256:    next.ChecksumSha256,
+		IsEncrypted:       next.IsEncrypted,
+		EncryptionKeyId:   nilIfEmpty(next.EncryptionKeyId),
+		VersionNote:       nilIfEmpty(next.VersionNote),
+	}
+	if next.RetentionPeriodYears > 0 {
+		data.RetentionPeriodYears = next.RetentionPeriodYears
+	}
+	if next.RestoredFromVersion > 0 {
+		data.RestoredFromVersion = next.RestoredFromVersion
+	}
+	details := g.Map{
+		"version":             next.Version,
+		"previous_version":    current.Version,
+		"previous_version_id": current.Id,
+		"note":                next.VersionNote,
+		"changes":             diffVersions(current, next),
+	}
+	if next.RestoredFromVersion > 0 {
+		details["restored_from_version"] = next.RestoredFromVersion
+	}
+
+	cols := dao.Documents.Columns()
+	err := dao.Documents.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		result, err := dao.Documents.Ctx(ctx).TX(tx).
+			Where(cols.Id, current.Id).
+			Where(cols.IsCurrentVersion, true).
+			Data(do.Documents{IsCurrentVersion: false}).
+			Update()
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return gerror.NewCode(gcode.CodeInvalidOperation, "the document was changed by another update; reload it and try again")
+		}
+		if _, err = dao.Documents.Ctx(ctx).TX(tx).Data(data).Insert(); err != nil {
+			return err
+		}
+		return audit(ctx, next, action, userID, nil, details)
+	})
+	if err != nil {
+		if store, storeErr := s.Store(ctx); storeErr == nil {
+			if delErr := store.Delete(context.WithoutCancel(ctx), next.StoragePath); delErr != nil {
+				g.Log().Warningf(ctx, "failed to remove orphaned document object %s: %v", next.StoragePath, delErr)
+			}
+		}
+		return nil, err
+	}
+	return s.GetDocument(ctx, next.Id)
+}
+
+// copyContent copies a version's stored object as-is for next, so encrypted content stays under
+// the same data key without being decrypted
+func (s *sDocument) copyContent(ctx context.Context, source, next *entity.Documents) (*model.StoredContent, error) {
+	store, err := s.Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rc, _, err := store.Get(ctx, source.StoragePath)
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "failed to read version %d", source.Version)
+	}
+	defer rc.Close()
+	contentType := source.MimeType
+	if source.IsEncrypted {
+		contentType = encryptedContentType
+	}
+	key := documentKey(next.OrganizationId, next.UserId, next.Id, next.FileName)
+	info, err := store.Put(ctx, key, blobstore.VerifyChecksum(rc, source.ChecksumSha256), &blobstore.PutOptions{
+		ContentType: contentType,
+		Metadata:    map[string]string{"document-id": next.Id},
+	})
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "failed to copy version %d", source.Version)
+	}
+	return &model.StoredContent{Object: info, Size: source.FileSize, EncryptionKeyID: source.EncryptionKeyId}, nil
+}
+
+// reencryptContent stores an unencrypted version's content encrypted for next
+func (s *sDocument) reencryptContent(ctx context.Context, source, next *entity.Documents, userID string) (*model.StoredContent, error) {
+	rc, err := s.openContent(ctx, source, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	key := documentKey(next.OrganizationId, next.UserId, next.Id, next.FileName)
+	return s.StoreContent(ctx, next.OrganizationId, key, rc, &blobstore.PutOptions{
+		ContentType: next.MimeType,
+		Metadata:    map[string]string{"document-id": next.Id},
+	}, true)
+}
+
+// versionChain returns every version of the document, oldest first
+func versionChain(ctx context.Context, document *entity.Documents) ([]*entity.Documents, error) {
+	rootID := rootDocumentID(document)
+	cols := dao.Documents.Columns()
+	var versions []*entity.Documents
+	err := dao.Documents.Ctx(ctx).
+		Where(cols.Id, rootID).
+		WhereOr(cols.ParentDocumentId, rootID).
+		OrderAsc(cols.Version).
+		Scan(&versions)
+	return versions, err
+}
+
+// currentVersion returns the current version of the document
+func currentVersion(ctx context.Context, document *entity.Documents) (*entity.Documents, error) {
+	if document.IsCurrentVersion {
+		return document, nil
+	}
+	versions, err := currentVersions(ctx, []string{rootDocumentID(document)})
+	if err != nil {
+		return nil, err
+	}
+	current := versions[rootDocumentID(document)]
+	if current == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "document has no current version")
+	}
+	return current, nil
+}
+
+// currentVersions returns the current versions of the documents with the given first-version IDs,
+// keyed by those IDs
+func currentVersions(ctx context.Context, rootIDs []string) (map[string]*entity.Documents, error) {
+	cols := dao.Documents.Columns()
+	var versions []*entity.Documents
+	err := dao.Documents.Ctx(ctx).
+		Where(cols.IsCurrentVersion, true).
+		Where(dao.Documents.Ctx(ctx).Builder().
+			WhereIn(cols.Id, rootIDs).
+			WhereOrIn(cols.ParentDocumentId, rootIDs)).
+		Scan(&versions)
+	if err != nil {
+		return nil, err
+	}
+	byRoot := make(map[string]*entity.Documents, len(versions))
+	for _, v := range versions {
+		byRoot[rootDocumentID(v)] = v
+	}
+	return byRoot, nil
+}
+
+// rootDocumentID returns the ID of the first version of a document, which later versions point at
+func rootDocumentID(document *entity.Documents) string {
+	if document.ParentDocumentId != "" {
+		return document.ParentDocumentId
+	}
+	return document.Id
+}
+
+// confidentialityOf returns the confidentiality level recorded by a document's flag
+func confidentialityOf(document *entity.Documents) consts.DocumentConfidentiality {
+	if document.IsConfidential {
+		return consts.ConfidentialityConfidential
+	}
+	return consts.ConfidentialityInternal
+}
+
+// diffVersions lists the versioned fields that differ between two versions
+func diffVersions(from, to *entity.Documents) []*model.DocumentFieldChange {
+	before, after := versionValues(from), versionValues(to)
+	var changes []*model.DocumentFieldChange
+	for _, field := range versionedFields {
+		if before[field] != after[field] {
+			changes = append(changes, &model.DocumentFieldChange{
+				Field:    field,
+				OldValue: before[field],
+				NewValue: after[field],
+			})
+		}
+	}
+	return changes
+}
+
+func versionValues(d *entity.Documents) map[string]string {
+	return map[string]string{
+		"title":              d.Title,
+		"description":        d.Description,
+		"file_name":          d.FileName,
+		"file_size":          strconv.FormatInt(d.FileSize, 10),
+		"mime_type":          d.MimeType,
+		"checksum_sha256":    d.ChecksumSha256,
+		"is_confidential":    strconv.FormatBool(d.IsConfidential),
+		"is_hipaa_protected": strconv.FormatBool(d.IsHipaaProtected),
+		"is_encrypted":       strconv.FormatBool(d.IsEncrypted),
+		"auto_delete_date":   timeValue(d.AutoDeleteDate),
+	}
+}
+
+func timeValue(t *gtime.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
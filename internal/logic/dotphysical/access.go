@@ -0,0 +1,32 @@
+package dotphysical
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// certificateRoles may record certificates for the drivers of their own organization
+var certificateRoles = map[consts.UserRole]bool{
+	consts.RoleClientAdmin:   true,
+	consts.RoleDER:           true,
+	consts.RoleSafetyManager: true,
+	consts.RoleHRManager:     true,
+}
+
+// checkCertificateRecorder checks that the user may record a medical certificate for the driver:
+// the driver themselves, an administrator of the driver's organization or an internal user
+func checkCertificateRecorder(ctx context.Context, userID string, driver *entity.UserProfiles) error {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if profile.Id == driver.Id || service.Authorization().ActsFor(profile, driver.OrganizationId, certificateRoles) {
+		return nil
+	}
+	return gerror.NewCode(gcode.CodeNotAuthorized, "not permitted to record the driver's medical certificate")
+}
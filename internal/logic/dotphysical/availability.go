@@ -0,0 +1,351 @@
+package dotphysical
+
+import (
+	"context"
+	"strings"
+	"time"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+const (
+	defaultSlotMinutes     = 30
+	maxAvailabilityDays    = 90
+	defaultAvailabilityEnd = 30 * 24 * time.Hour
+)
+
+// defaultWeekdays opens availability Monday through Friday
+var defaultWeekdays = []int{1, 2, 3, 4, 5}
+
+// CreateAvailability opens appointment slots at a clinic on the given weekdays between the start
+// and end dates. Slots that already exist are left as they are.
+func (s *sDotPhysical) CreateAvailability(ctx context.Context, in *model.ClinicAvailabilityInput) ([]*model.ClinicAvailabilitySlot, error) {
+	clinic, err := s.getClinic(ctx, in.ClinicID)
+	if err != nil {
+		return nil, err
+	}
+	if !clinic.IsActive {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "clinic is not active")
+	}
+	loc, err := clinicLocation(clinic)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.StartDate == nil || in.EndDate == nil {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "start and end dates are required")
+	}
+	first := localDate(in.StartDate.Time, loc)
+	last := localDate(in.EndDate.Time, loc)
+	if last.Before(first) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "end date is before start date")
+	}
+	if last.Sub(first) > maxAvailabilityDays*24*time.Hour {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "availability can be opened at most %d days at a time", maxAvailabilityDays)
+	}
+
+	dayStart, err := parseClockTime(in.DayStart)
+	if err != nil {
+		return nil, err
+	}
+	dayEnd, err := parseClockTime(in.DayEnd)
+	if err != nil {
+		return nil, err
+	}
+	if dayEnd <= dayStart {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "day end must be after day start")
+	}
+
+	slotMinutes := in.SlotMinutes
+	if slotMinutes == 0 {
+		slotMinutes = defaultSlotMinutes
+	}
+	if slotMinutes < 10 || slotMinutes > 240 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "slot length must be between 10 and 240 minutes")
+	}
+	slotLength := time.Duration(slotMinutes) * time.Minute
+
+	weekdays := in.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = defaultWeekdays
+	}
+	open := make(map[time.Weekday]bool, len(weekdays))
+	for _, d := range weekdays {
+		if d < 0 || d > 6 {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid weekday: %d", d)
+		}
+		open[time.Weekday(d)] = true
+	}
+
+	now := time.Now()
+	var slots []do.ClinicAvailabilitySlots
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		if !open[day.Weekday()] {
+			continue
+		}
+		for offset := dayStart; offset+slotLength <= dayEnd; offset += slotLength {
+			// Build each slot from the wall clock so daylight saving changes keep local times
+			start := time.Date(day.Year(), day.Month(), day.Day(),
+				int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, loc)
+			if start.Before(now) {
+				continue
+			}
+			slots = append(slots, do.ClinicAvailabilitySlots{
+				ClinicId:   clinic.Id,
+				ExaminerId: clinic.ExaminerId,
+				StartsAt:   gtime.New(start),
+				EndsAt:     gtime.New(start.Add(slotLength)),
+			})
+		}
+	}
+	if len(slots) == 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "no future slots fall within the requested availability")
+	}
+
+	cols := dao.ClinicAvailabilitySlots.Columns()
+	_, err = dao.ClinicAvailabilitySlots.Ctx(ctx).Data(slots).InsertIgnore()
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*entity.ClinicAvailabilitySlots
+	err = dao.ClinicAvailabilitySlots.Ctx(ctx).
+		Where(cols.ClinicId, clinic.Id).
+		WhereGTE(cols.StartsAt, slots[0].StartsAt).
+		WhereLTE(cols.StartsAt, slots[len(slots)-1].StartsAt).
+		OrderAsc(cols.StartsAt).
+		Scan(&created)
+	if err != nil {
+		return nil, err
+	}
+	return s.slotDetails(ctx, created)
+}
+
+// ListAvailability searches appointment slots, soonest first. Only open future slots are
+// returned unless booked slots are requested.
+func (s *sDotPhysical) ListAvailability(ctx context.Context, in *model.ClinicAvailabilityListInput) ([]*model.ClinicAvailabilitySlot, int, error) {
+	cols := dao.ClinicAvailabilitySlots.Columns()
+	m := dao.ClinicAvailabilitySlots.Ctx(ctx).Where(cols.IsBlocked, false)
+
+	if in.ClinicID != "" {
+		m = m.Where(cols.ClinicId, in.ClinicID)
+	}
+	if in.ExaminerID != "" {
+		m = m.Where(cols.ExaminerId, in.ExaminerID)
+	}
+	if in.State != "" || in.City != "" || in.ZipCode != "" {
+		clinicCols := dao.MedicalExaminerClinics.Columns()
+		cm := dao.MedicalExaminerClinics.Ctx(ctx).Fields(clinicCols.Id).Where(clinicCols.IsActive, true)
+		if in.State != "" {
+			cm = cm.Where(clinicCols.State, strings.ToUpper(in.State))
+		}
+		if in.City != "" {
+			cm = cm.WhereLike(clinicCols.City, in.City)
+		}
+		if in.ZipCode != "" {
+			cm = cm.WhereLike(clinicCols.ZipCode, in.ZipCode+"%")
+		}
+		clinicIDs, err := cm.Array()
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(clinicIDs) == 0 {
+			return nil, 0, nil
+		}
+		m = m.WhereIn(cols.ClinicId, clinicIDs)
+	}
+	if !in.IncludeBooked {
+		m = m.WhereNull(cols.PhysicalId)
+	}
+
+	start := gtime.Now()
+	if in.StartTime != nil && in.StartTime.After(start) {
+		start = in.StartTime
+	}
+	end := in.EndTime
+	if end == nil {
+		end = start.Add(defaultAvailabilityEnd)
+	}
+	m = m.WhereGTE(cols.StartsAt, start).WhereLTE(cols.StartsAt, end)
+
+	var (
+		slots []*entity.ClinicAvailabilitySlots
+		total int
+	)
+	err := m.OrderAsc(cols.StartsAt).Limit(in.Offset, in.Limit).ScanAndCount(&slots, &total, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	details, err := s.slotDetails(ctx, slots)
+	return details, total, err
+}
+
+// bookSlot assigns an open, future slot to the physical. It fails if another booking took the
+// slot first.
+func bookSlot(ctx context.Context, tx gdb.TX, slotID, physicalID string) error {
+	cols := dao.ClinicAvailabilitySlots.Columns()
+	result, err := dao.ClinicAvailabilitySlots.Ctx(ctx).TX(tx).
+		Where(cols.Id, slotID).
+		WhereNull(cols.PhysicalId).
+		Where(cols.IsBlocked, false).
+		WhereGT(cols.StartsAt, gtime.Now()).
+		Data(do.ClinicAvailabilitySlots{PhysicalId: physicalID, BookedAt: gtime.Now()}).
+		Update()
+	if err != nil {
+		return err
+	}
+	if booked, err := result.RowsAffected(); err != nil || booked == 0 {
+		if err == nil {
+			err = gerror.NewCode(gcode.CodeInvalidOperation, "appointment slot is no longer available")
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseSlot reopens the slot booked by the physical, if any
+func releaseSlot(ctx context.Context, tx gdb.TX, physicalID string) error {
+	cols := dao.ClinicAvailabilitySlots.Columns()
+	_, err := dao.ClinicAvailabilitySlots.Ctx(ctx).TX(tx).
+		Where(cols.PhysicalId, physicalID).
+		Data(gdb.Map{cols.PhysicalId: nil, cols.BookedAt: nil}).
+		Update()
+	return err
+}
+
+// getSlot returns an appointment slot with its clinic and examiner
+func (s *sDotPhysical) getSlot(ctx context.Context, slotID string) (*model.ClinicAvailabilitySlot, error) {
+	var slot *entity.ClinicAvailabilitySlots
+	err := dao.ClinicAvailabilitySlots.Ctx(ctx).Where(dao.ClinicAvailabilitySlots.Columns().Id, slotID).Scan(&slot)
+	if err != nil {
+		return nil, err
+	}
+	if slot == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "appointment slot not found")
+	}
+	details, err := s.slotDetails(ctx, []*entity.ClinicAvailabilitySlots{slot})
+	if err != nil {
+		return nil, err
+	}
+	return details[0], nil
+}
+
+// physicalSlot returns the slot booked by the physical, or nil
+func (s *sDotPhysical) physicalSlot(ctx context.Context, physicalID string) (*model.ClinicAvailabilitySlot, error) {
+	var slot *entity.ClinicAvailabilitySlots
+	err := dao.ClinicAvailabilitySlots.Ctx(ctx).Where(dao.ClinicAvailabilitySlots.Columns().PhysicalId, physicalID).Scan(&slot)
+	if err != nil || slot == nil {
+		return nil, err
+	}
+	details, err := s.slotDetails(ctx, []*entity.ClinicAvailabilitySlots{slot})
+	if err != nil {
+		return nil, err
+	}
+	return details[0], nil
+}
+
+// firstOpenSlot returns the examiner's earliest open slot at or after the date, or nil
+func (s *sDotPhysical) firstOpenSlot(ctx context.Context, examinerID string, from *gtime.Time) (*model.ClinicAvailabilitySlot, error) {
+	if from == nil || from.Before(gtime.Now()) {
+		from = gtime.Now()
+	}
+	slots, _, err := s.ListAvailability(ctx, &model.ClinicAvailabilityListInput{
+		ExaminerID: examinerID,
+		StartTime:  from,
+		EndTime:    from.AddDate(0, 0, maxAvailabilityDays),
+		Limit:      1,
+	})
+	if err != nil || len(slots) == 0 {
+		return nil, err
+	}
+	return slots[0], nil
+}
+
+// slotDetails loads the clinic and examiner for each slot
+func (s *sDotPhysical) slotDetails(ctx context.Context, slots []*entity.ClinicAvailabilitySlots) ([]*model.ClinicAvailabilitySlot, error) {
+	if len(slots) == 0 {
+		return nil, nil
+	}
+	clinicIDs := make([]string, 0, len(slots))
+	examinerIDs := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		clinicIDs = append(clinicIDs, slot.ClinicId)
+		examinerIDs = append(examinerIDs, slot.ExaminerId)
+	}
+
+	var clinics []*entity.MedicalExaminerClinics
+	err := dao.MedicalExaminerClinics.Ctx(ctx).WhereIn(dao.MedicalExaminerClinics.Columns().Id, clinicIDs).Scan(&clinics)
+	if err != nil {
+		return nil, err
+	}
+	var examiners []*entity.MedicalExaminers
+	err = dao.MedicalExaminers.Ctx(ctx).WhereIn(dao.MedicalExaminers.Columns().Id, examinerIDs).Scan(&examiners)
+	if err != nil {
+		return nil, err
+	}
+
+	clinicByID := make(map[string]*entity.MedicalExaminerClinics, len(clinics))
+	for _, c := range clinics {
+		clinicByID[c.Id] = c
+	}
+	examinerByID := make(map[string]*entity.MedicalExaminers, len(examiners))
+	for _, e := range examiners {
+		examinerByID[e.Id] = e
+	}
+
+	out := make([]*model.ClinicAvailabilitySlot, 0, len(slots))
+	for _, slot := range slots {
+		out = append(out, &model.ClinicAvailabilitySlot{
+			Slot:     slot,
+			Clinic:   clinicByID[slot.ClinicId],
+			Examiner: examinerByID[slot.ExaminerId],
+		})
+	}
+	return out, nil
+}
+
+func (s *sDotPhysical) getClinic(ctx context.Context, clinicID string) (*entity.MedicalExaminerClinics, error) {
+	var clinic *entity.MedicalExaminerClinics
+	err := dao.MedicalExaminerClinics.Ctx(ctx).Where(dao.MedicalExaminerClinics.Columns().Id, clinicID).Scan(&clinic)
+	if err != nil {
+		return nil, err
+	}
+	if clinic == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "clinic not found")
+	}
+	return clinic, nil
+}
+
+// clinicLocation returns the clinic's timezone, defaulting to UTC when none is set
+func clinicLocation(clinic *entity.MedicalExaminerClinics) (*time.Location, error) {
+	if clinic.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(clinic.Timezone)
+	if err != nil {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "clinic has an invalid timezone: %s", clinic.Timezone)
+	}
+	return loc, nil
+}
+
+// localDate returns midnight of t's calendar date in loc
+func localDate(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// parseClockTime parses an "HH:MM" wall-clock time as an offset from midnight
+func parseClockTime(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid time of day %q, expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
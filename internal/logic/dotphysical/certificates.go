@@ -0,0 +1,314 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// ValidateCertificate checks a medical examiner's certificate. Given only a certificate number it
+// reports the status of the certificate on file. Given the fields captured from an uploaded
+// certificate it checks the examiner against the National Registry, the issue and expiration dates,
+// and the driver's identity against their CDL, then records the certificate on the driver's
+// physical, marks it uploaded and updates the driver's compliance status. Only the driver, their
+// organization's administrators and internal users may record a certificate. Failed checks are
+// reported in the result rather than returned as an error.
+func (s *sDotPhysical) ValidateCertificate(ctx context.Context, in *model.MedicalCertificateInput) (*model.MedicalCertificateValidation, error) {
+	in.CertificateNumber = strings.TrimSpace(in.CertificateNumber)
+	if in.CertificateNumber == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "certificate number is required")
+	}
+	if in.IssueDate == nil && in.ExpirationDate == nil && in.ExaminerRegistryNumber == "" {
+		return s.lookupCertificate(ctx, in.CertificateNumber, in.UserID)
+	}
+	if in.UserID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "driver is required to validate an uploaded certificate")
+	}
+
+	var driver *entity.UserProfiles
+	if err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, in.UserID).Scan(&driver); err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "driver not found")
+	}
+	if err := checkCertificateRecorder(ctx, in.RequestedBy, driver); err != nil {
+		return nil, err
+	}
+	out := &model.MedicalCertificateValidation{
+		HolderName: strings.TrimSpace(driver.FirstName + " " + driver.LastName),
+	}
+
+	problems := certificateDateProblems(in.IssueDate, in.ExpirationDate)
+	examiner, err := s.CheckExaminerRegistration(ctx, in.ExaminerRegistryNumber, in.IssueDate)
+	switch {
+	case err == nil:
+		out.IssuingExaminer = fmt.Sprintf("%s %s", examiner.FirstName, examiner.LastName)
+	case gerror.Code(err) == gcode.CodeInvalidParameter, gerror.Code(err) == gcode.CodeInvalidOperation:
+		problems = append(problems, err.Error())
+	default:
+		return nil, err
+	}
+	problems = append(problems, driverIdentityProblems(driver, in)...)
+	for _, code := range in.RestrictionCodes {
+		if !validRestriction(code) {
+			problems = append(problems, fmt.Sprintf("unsupported medical restriction: %s", code))
+		}
+	}
+
+	cols := dao.DotPhysicals.Columns()
+	held, err := dao.DotPhysicals.Ctx(ctx).
+		Where(cols.CertificateNumber, in.CertificateNumber).
+		WhereNot(cols.UserId, driver.Id).
+		Count()
+	if err != nil {
+		return nil, err
+	}
+	if held > 0 {
+		problems = append(problems, fmt.Sprintf("certificate %s is on file for another driver", in.CertificateNumber))
+	}
+	if in.DocumentID != "" {
+		count, err := dao.Documents.Ctx(ctx).
+			Where(dao.Documents.Columns().Id, in.DocumentID).
+			Where(dao.Documents.Columns().UserId, driver.Id).
+			Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			problems = append(problems, "uploaded document not found for the driver")
+		}
+	}
+
+	if len(problems) > 0 {
+		out.Status = consts.CertificateInvalid
+		out.Errors = problems
+		return out, nil
+	}
+	if in.ExpirationDate.Format("Y-m-d") < gtime.Now().Format("Y-m-d") {
+		out.Status = consts.CertificateExpired
+		out.Errors = []string{fmt.Sprintf("certificate expired on %s", in.ExpirationDate.Format("Y-m-d"))}
+		return out, nil
+	}
+
+	out.Physical, err = s.recordCertificate(ctx, driver, examiner, in)
+	if gerror.Code(err) == gcode.CodeInvalidParameter {
+		out.Status = consts.CertificateInvalid
+		out.Errors = []string{err.Error()}
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	out.Status = consts.CertificateValid
+	return out, nil
+}
+
+// lookupCertificate reports the status of a certificate already on file
+func (s *sDotPhysical) lookupCertificate(ctx context.Context, certificateNumber, userID string) (*model.MedicalCertificateValidation, error) {
+	cols := dao.DotPhysicals.Columns()
+	m := dao.DotPhysicals.Ctx(ctx).Where(cols.CertificateNumber, certificateNumber)
+	if userID != "" {
+		m = m.Where(cols.UserId, userID)
+	}
+	var physical *entity.DotPhysicals
+	if err := m.OrderDesc(cols.CertificateIssueDate).Limit(1).Scan(&physical); err != nil {
+		return nil, err
+	}
+	if physical == nil {
+		return &model.MedicalCertificateValidation{Status: consts.CertificateNotFound}, nil
+	}
+
+	out := &model.MedicalCertificateValidation{
+		Status:          consts.CertificateValid,
+		Physical:        physical,
+		IssuingExaminer: physical.ExaminerName,
+	}
+	var driver *entity.UserProfiles
+	if err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, physical.UserId).Scan(&driver); err != nil {
+		return nil, err
+	}
+	if driver != nil {
+		out.HolderName = strings.TrimSpace(driver.FirstName + " " + driver.LastName)
+	}
+	if physical.CertificateExpirationDate == nil ||
+		physical.CertificateExpirationDate.Format("Y-m-d") < gtime.Now().Format("Y-m-d") {
+		out.Status = consts.CertificateExpired
+	}
+	return out, nil
+}
+
+// recordCertificate stores a validated certificate on the physical it was issued for, the driver's
+// open appointment, or a new physical for a certificate from an outside examination, and updates
+// the driver's compliance status unless a later certificate is already on file. The certificate
+// is checked against the physical's certification period and exemptions as an examiner's update is.
+func (s *sDotPhysical) recordCertificate(ctx context.Context, driver *entity.UserProfiles, examiner *entity.MedicalExaminers, in *model.MedicalCertificateInput) (*entity.DotPhysicals, error) {
+	cols := dao.DotPhysicals.Columns()
+	var physical *entity.DotPhysicals
+	err := dao.DotPhysicals.Ctx(ctx).
+		Where(cols.UserId, driver.Id).
+		Where(cols.CertificateNumber, in.CertificateNumber).
+		Scan(&physical)
+	if err != nil {
+		return nil, err
+	}
+	if physical == nil {
+		err = dao.DotPhysicals.Ctx(ctx).
+			Where(cols.UserId, driver.Id).
+			WhereIn(cols.Status, []consts.PhysicalStatus{consts.PhysicalStatusScheduled, consts.PhysicalStatusPendingReview}).
+			OrderDesc(cols.ScheduledDate).
+			Limit(1).
+			Scan(&physical)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	qualification := consts.MedicalQualified
+	if len(in.RestrictionCodes) > 0 {
+		qualification = consts.MedicalQualifiedWithRestrictions
+	}
+	restrictions, err := json.Marshal(in.RestrictionCodes)
+	if err != nil {
+		return nil, err
+	}
+	now := gtime.Now()
+	data := do.DotPhysicals{
+		Status:                    consts.PhysicalStatusCompleted,
+		MedicalQualification:      qualification,
+		CertificateNumber:         in.CertificateNumber,
+		CertificateIssueDate:      in.IssueDate,
+		CertificateExpirationDate: in.ExpirationDate,
+		Restrictions:              string(restrictions),
+		ExaminerRegistryNumber:    examiner.RegistryNumber,
+		ExaminerName:              fmt.Sprintf("%s %s", examiner.FirstName, examiner.LastName),
+		ExaminerLicenseNumber:     nilIfEmpty(examiner.LicenseNumber),
+		ExaminerId:                nilIfEmpty(examiner.UserId),
+		MedicalExaminerId:         examiner.Id,
+		CertificateUploadedAt:     now,
+	}
+	if physical == nil || physical.ExaminationDate == nil {
+		data.ExaminationDate = in.IssueDate
+	}
+
+	physicalID := ""
+	err = dao.DotPhysicals.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if physical == nil {
+			physicalID = uuid.New().String()
+			data.Id = physicalID
+			data.OrganizationId = driver.OrganizationId
+			data.UserId = driver.Id
+			if _, err := dao.DotPhysicals.Ctx(ctx).TX(tx).Data(data).Insert(); err != nil {
+				return err
+			}
+		} else {
+			physicalID = physical.Id
+			_, err := dao.DotPhysicals.Ctx(ctx).TX(tx).Where(cols.Id, physicalID).Data(data).Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		var recorded *entity.DotPhysicals
+		if err := dao.DotPhysicals.Ctx(ctx).TX(tx).Where(cols.Id, physicalID).Scan(&recorded); err != nil {
+			return err
+		}
+		if err := applyMedicalSchedule(ctx, tx, recorded, in.ExpirationDate); err != nil {
+			return err
+		}
+
+		if in.DocumentID != "" {
+			_, err := dao.Documents.Ctx(ctx).TX(tx).
+				Where(dao.Documents.Columns().Id, in.DocumentID).
+				Data(do.Documents{
+					PhysicalId:   physicalID,
+					DocumentType: consts.DocTypeMedicalCertificate,
+				}).Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		compliance := dao.ComplianceStatus.Columns()
+		_, err := dao.ComplianceStatus.Ctx(ctx).TX(tx).
+			Where(compliance.UserId, driver.Id).
+			Where(fmt.Sprintf("(%s IS NULL OR %s <= ?)", compliance.MedicalCertExpirationDate, compliance.MedicalCertExpirationDate), in.ExpirationDate).
+			Data(do.ComplianceStatus{
+				PhysicalCurrent:           true,
+				MedicalCertExpirationDate: in.ExpirationDate,
+				LastUpdated:               now,
+			}).Update()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPhysical(ctx, physicalID)
+}
+
+// certificateDateProblems checks that a certificate was issued no later than today and expires
+// after it was issued, within the two-year maximum
+func certificateDateProblems(issued, expires *gtime.Time) []string {
+	var problems []string
+	switch {
+	case issued == nil:
+		problems = append(problems, "issue date is required")
+	case issued.Format("Y-m-d") > gtime.Now().Format("Y-m-d"):
+		problems = append(problems, "issue date is in the future")
+	}
+	switch {
+	case expires == nil:
+		problems = append(problems, "expiration date is required")
+	case issued == nil:
+	case !expires.After(issued):
+		problems = append(problems, "expiration date must be after the issue date")
+	case expires.After(issued.AddDate(0, maxCertificationMonths, 0)):
+		problems = append(problems, fmt.Sprintf("certificate cannot be valid for more than %d months", maxCertificationMonths))
+	}
+	return problems
+}
+
+// driverIdentityProblems compares the driver details on the certificate with the driver's profile
+func driverIdentityProblems(driver *entity.UserProfiles, in *model.MedicalCertificateInput) []string {
+	var problems []string
+	license := normalizeLicenseNumber(in.DriverLicenseNumber)
+	switch {
+	case license == "":
+		problems = append(problems, "driver's license number is required")
+	case driver.CdlNumber == "":
+		problems = append(problems, "driver has no CDL number on file")
+	case license != normalizeLicenseNumber(driver.CdlNumber):
+		problems = append(problems, "license number does not match the driver's CDL on file")
+	}
+	if state := strings.TrimSpace(in.DriverLicenseState); state != "" && driver.CdlState != "" && !strings.EqualFold(state, driver.CdlState) {
+		problems = append(problems, "license state does not match the driver's CDL on file")
+	}
+	if in.DriverDateOfBirth != nil && driver.DateOfBirth != nil &&
+		in.DriverDateOfBirth.Format("Y-m-d") != driver.DateOfBirth.Format("Y-m-d") {
+		problems = append(problems, "date of birth does not match the driver's profile")
+	}
+	return problems
+}
+
+// normalizeLicenseNumber upper-cases a license number and drops spaces and punctuation
+func normalizeLicenseNumber(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return -1
+	}, s)
+}
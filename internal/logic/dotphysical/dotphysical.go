@@ -0,0 +1,15 @@
+package dotphysical
+
+import (
+	"v1consortium/internal/service"
+)
+
+func new() service.IDotPhysical {
+	return &sDotPhysical{}
+}
+
+func init() {
+	service.RegisterDotPhysical(new())
+}
+
+type sDotPhysical struct{}
@@ -0,0 +1,325 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// certificationYears is how long a National Registry certification lasts before the examiner
+// must recertify (49 CFR 390.111).
+const certificationYears = 10
+
+// registryNumberPattern matches a National Registry (NRCME) number
+var registryNumberPattern = regexp.MustCompile(`^[0-9]{10}$`)
+
+// RegisterExaminer adds a medical examiner and the clinics they serve to the registry.
+func (s *sDotPhysical) RegisterExaminer(ctx context.Context, in *model.MedicalExaminerInput) (*model.MedicalExaminerDetail, error) {
+	if err := validateExaminer(in); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.examinerByRegistryNumber(ctx, in.RegistryNumber)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s is already registered", in.RegistryNumber)
+	}
+
+	examinerID := uuid.New().String()
+	err = dao.MedicalExaminers.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data, err := s.examinerData(ctx, in)
+		if err != nil {
+			return err
+		}
+		data.Id = examinerID
+		if _, err = dao.MedicalExaminers.Ctx(ctx).TX(tx).Data(data).Insert(); err != nil {
+			return err
+		}
+		for _, clinic := range in.Clinics {
+			if err = insertClinic(ctx, tx, examinerID, clinic); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetExaminer(ctx, examinerID)
+}
+
+// GetExaminer returns a registry entry with its clinics and completed examination count.
+func (s *sDotPhysical) GetExaminer(ctx context.Context, examinerID string) (*model.MedicalExaminerDetail, error) {
+	var examiner *entity.MedicalExaminers
+	err := dao.MedicalExaminers.Ctx(ctx).Where(dao.MedicalExaminers.Columns().Id, examinerID).Scan(&examiner)
+	if err != nil {
+		return nil, err
+	}
+	if examiner == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "medical examiner not found")
+	}
+
+	details, err := s.examinerDetails(ctx, []*entity.MedicalExaminers{examiner})
+	if err != nil {
+		return nil, err
+	}
+	return details[0], nil
+}
+
+// ListExaminers searches active examiners by location, ordered by name.
+func (s *sDotPhysical) ListExaminers(ctx context.Context, in *model.MedicalExaminerListInput) ([]*model.MedicalExaminerDetail, error) {
+	cols := dao.MedicalExaminers.Columns()
+	m := dao.MedicalExaminers.Ctx(ctx).Where(cols.IsActive, true)
+	if in.State != "" {
+		m = m.Where(cols.State, strings.ToUpper(in.State))
+	}
+	if in.City != "" {
+		m = m.WhereLike(cols.City, in.City)
+	}
+	if in.ZipCode != "" {
+		m = m.WhereLike(cols.ZipCode, in.ZipCode+"%")
+	}
+	if in.AcceptsNewPatients {
+		m = m.Where(cols.AcceptsNewPatients, true)
+	}
+
+	var examiners []*entity.MedicalExaminers
+	if err := m.OrderAsc(cols.LastName).OrderAsc(cols.FirstName).Scan(&examiners); err != nil {
+		return nil, err
+	}
+	return s.examinerDetails(ctx, examiners)
+}
+
+// CheckExaminerRegistration returns the registry entry for the NRCME number, or an error if the
+// examiner is not registered or their registration had lapsed on the examination date.
+func (s *sDotPhysical) CheckExaminerRegistration(ctx context.Context, registryNumber string, examinationDate *gtime.Time) (*entity.MedicalExaminers, error) {
+	registryNumber = strings.TrimSpace(registryNumber)
+	if !registryNumberPattern.MatchString(registryNumber) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid National Registry number: %q", registryNumber)
+	}
+
+	examiner, err := s.examinerByRegistryNumber(ctx, registryNumber)
+	if err != nil {
+		return nil, err
+	}
+	if examiner == nil {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s is not in the National Registry", registryNumber)
+	}
+	if examinationDate == nil {
+		examinationDate = gtime.Now()
+	}
+	if err = registrationLapse(examiner, examinationDate); err != nil {
+		return nil, err
+	}
+	return examiner, nil
+}
+
+// registrationLapse returns why the examiner could not certify drivers on the date, or nil.
+func registrationLapse(examiner *entity.MedicalExaminers, on *gtime.Time) error {
+	switch {
+	case !examiner.IsActive:
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s is inactive", examiner.RegistryNumber)
+	case examiner.RegistryStatus != string(consts.ExaminerRegistryActive):
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s registration is %s", examiner.RegistryNumber, examiner.RegistryStatus)
+	case examiner.CertificationExpiration != nil && examiner.CertificationExpiration.Format("Y-m-d") < on.Format("Y-m-d"):
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s registration lapsed on %s",
+			examiner.RegistryNumber, examiner.CertificationExpiration.Format("Y-m-d"))
+	case examiner.CertificationDate != nil && examiner.CertificationDate.Format("Y-m-d") > on.Format("Y-m-d"):
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "medical examiner %s was not certified until %s",
+			examiner.RegistryNumber, examiner.CertificationDate.Format("Y-m-d"))
+	}
+	return nil
+}
+
+func validateExaminer(in *model.MedicalExaminerInput) error {
+	in.RegistryNumber = strings.TrimSpace(in.RegistryNumber)
+	switch {
+	case in.RegistryNumber == "":
+		return gerror.NewCode(gcode.CodeMissingParameter, "National Registry number is required")
+	case !registryNumberPattern.MatchString(in.RegistryNumber):
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "National Registry number must be 10 digits: %q", in.RegistryNumber)
+	case strings.TrimSpace(in.FirstName) == "" || strings.TrimSpace(in.LastName) == "":
+		return gerror.NewCode(gcode.CodeMissingParameter, "examiner first and last name are required")
+	}
+
+	switch in.RegistryStatus {
+	case "":
+		in.RegistryStatus = consts.ExaminerRegistryActive
+	case consts.ExaminerRegistryActive, consts.ExaminerRegistryLapsed, consts.ExaminerRegistrySuspended, consts.ExaminerRegistryRevoked:
+	default:
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported registry status: %s", in.RegistryStatus)
+	}
+
+	if in.CertificationExpiration == nil && in.CertificationDate != nil {
+		in.CertificationExpiration = in.CertificationDate.AddDate(certificationYears, 0, 0)
+	}
+	if in.CertificationDate != nil && in.CertificationExpiration != nil && in.CertificationExpiration.Before(in.CertificationDate) {
+		return gerror.NewCode(gcode.CodeInvalidParameter, "certification expiration is before the certification date")
+	}
+	if in.RegistryStatus == consts.ExaminerRegistryActive && in.CertificationExpiration != nil && in.CertificationExpiration.Before(gtime.Now()) {
+		in.RegistryStatus = consts.ExaminerRegistryLapsed
+	}
+	return nil
+}
+
+// examinerData maps the input to a registry row, linking the examiner's account by email.
+func (s *sDotPhysical) examinerData(ctx context.Context, in *model.MedicalExaminerInput) (*do.MedicalExaminers, error) {
+	specializations, err := json.Marshal(normalizeList(in.Specializations))
+	if err != nil {
+		return nil, err
+	}
+	data := &do.MedicalExaminers{
+		FirstName:               strings.TrimSpace(in.FirstName),
+		LastName:                strings.TrimSpace(in.LastName),
+		Email:                   nilIfEmpty(strings.ToLower(strings.TrimSpace(in.Email))),
+		Phone:                   nilIfEmpty(in.Phone),
+		LicenseNumber:           nilIfEmpty(in.LicenseNumber),
+		LicenseState:            nilIfEmpty(strings.ToUpper(in.LicenseState)),
+		LicenseExpiration:       in.LicenseExpiration,
+		RegistryNumber:          in.RegistryNumber,
+		CertificationDate:       in.CertificationDate,
+		CertificationExpiration: in.CertificationExpiration,
+		RegistryStatus:          string(in.RegistryStatus),
+		PracticeName:            nilIfEmpty(in.PracticeName),
+		AddressLine1:            nilIfEmpty(in.AddressLine1),
+		AddressLine2:            nilIfEmpty(in.AddressLine2),
+		City:                    nilIfEmpty(in.City),
+		State:                   nilIfEmpty(strings.ToUpper(in.State)),
+		ZipCode:                 nilIfEmpty(in.ZipCode),
+		AcceptsNewPatients:      in.AcceptsNewPatients,
+		Specializations:         string(specializations),
+		IsActive:                true,
+	}
+
+	if in.Email != "" {
+		userID, err := dao.UserProfiles.Ctx(ctx).
+			Fields(dao.UserProfiles.Columns().Id).
+			Where("LOWER("+dao.UserProfiles.Columns().Email+")", strings.ToLower(strings.TrimSpace(in.Email))).
+			Value()
+		if err != nil {
+			return nil, err
+		}
+		if !userID.IsEmpty() {
+			data.UserId = userID.String()
+		}
+	}
+	return data, nil
+}
+
+func insertClinic(ctx context.Context, tx gdb.TX, examinerID string, in *model.MedicalExaminerClinicInput) error {
+	if strings.TrimSpace(in.Name) == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "clinic name is required")
+	}
+	_, err := dao.MedicalExaminerClinics.Ctx(ctx).TX(tx).Data(do.MedicalExaminerClinics{
+		ExaminerId:   examinerID,
+		Name:         strings.TrimSpace(in.Name),
+		AddressLine1: nilIfEmpty(in.AddressLine1),
+		AddressLine2: nilIfEmpty(in.AddressLine2),
+		City:         nilIfEmpty(in.City),
+		State:        nilIfEmpty(strings.ToUpper(in.State)),
+		ZipCode:      nilIfEmpty(in.ZipCode),
+		Phone:        nilIfEmpty(in.Phone),
+		Timezone:     nilIfEmpty(in.Timezone),
+	}).Insert()
+	return err
+}
+
+// examinerDetails loads the clinics and completed examination counts for the examiners.
+func (s *sDotPhysical) examinerDetails(ctx context.Context, examiners []*entity.MedicalExaminers) ([]*model.MedicalExaminerDetail, error) {
+	details := make([]*model.MedicalExaminerDetail, 0, len(examiners))
+	if len(examiners) == 0 {
+		return details, nil
+	}
+
+	ids := make([]string, 0, len(examiners))
+	registryNumbers := make([]string, 0, len(examiners))
+	for _, e := range examiners {
+		ids = append(ids, e.Id)
+		registryNumbers = append(registryNumbers, e.RegistryNumber)
+	}
+
+	clinicCols := dao.MedicalExaminerClinics.Columns()
+	var clinics []*entity.MedicalExaminerClinics
+	err := dao.MedicalExaminerClinics.Ctx(ctx).
+		WhereIn(clinicCols.ExaminerId, ids).
+		Where(clinicCols.IsActive, true).
+		OrderAsc(clinicCols.Name).
+		Scan(&clinics)
+	if err != nil {
+		return nil, err
+	}
+	clinicsByExaminer := make(map[string][]*entity.MedicalExaminerClinics)
+	for _, c := range clinics {
+		clinicsByExaminer[c.ExaminerId] = append(clinicsByExaminer[c.ExaminerId], c)
+	}
+
+	physicalCols := dao.DotPhysicals.Columns()
+	var counts []struct {
+		ExaminerRegistryNumber string
+		Total                  int
+	}
+	err = dao.DotPhysicals.Ctx(ctx).
+		Fields(physicalCols.ExaminerRegistryNumber, "COUNT(*) AS total").
+		WhereIn(physicalCols.ExaminerRegistryNumber, registryNumbers).
+		Where(physicalCols.Status, consts.PhysicalStatusCompleted).
+		Group(physicalCols.ExaminerRegistryNumber).
+		Scan(&counts)
+	if err != nil {
+		return nil, err
+	}
+	completed := make(map[string]int, len(counts))
+	for _, c := range counts {
+		completed[c.ExaminerRegistryNumber] = c.Total
+	}
+
+	now := gtime.Now()
+	for _, e := range examiners {
+		details = append(details, &model.MedicalExaminerDetail{
+			Examiner:              e,
+			Clinics:               clinicsByExaminer[e.Id],
+			ExaminationsCompleted: completed[e.RegistryNumber],
+			RegistrationCurrent:   registrationLapse(e, now) == nil,
+		})
+	}
+	return details, nil
+}
+
+func (s *sDotPhysical) examinerByRegistryNumber(ctx context.Context, registryNumber string) (*entity.MedicalExaminers, error) {
+	var examiner *entity.MedicalExaminers
+	err := dao.MedicalExaminers.Ctx(ctx).
+		Where(dao.MedicalExaminers.Columns().RegistryNumber, registryNumber).
+		Scan(&examiner)
+	return examiner, err
+}
+
+// normalizeList trims entries and drops blanks
+func normalizeList(in []string) []string {
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s = strings.TrimSpace(s); s == "" {
+		return nil
+	}
+	return s
+}
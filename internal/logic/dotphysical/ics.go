@@ -0,0 +1,134 @@
+package dotphysical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icsProdID     = "-//V1 Consortium//DOT Physical Scheduling//EN"
+	icsTimeLayout = "20060102T150405Z"
+	// icsLineLength is the maximum content line length in octets before folding (RFC 5545 3.1)
+	icsLineLength = 75
+)
+
+// calendarEvent is a DOT physical appointment rendered as an iCalendar invite. Updates and
+// cancellations reuse the UID with a higher Sequence so calendar clients replace the event. An
+// event without an End has no DTEND.
+type calendarEvent struct {
+	UID           string
+	Sequence      int
+	Cancelled     bool
+	Start         time.Time
+	End           time.Time
+	Summary       string
+	Description   string
+	Location      string
+	OrganizerName string
+	OrganizerMail string
+	AttendeeName  string
+	AttendeeMail  string
+}
+
+// render returns the event as an iCalendar object with a REQUEST or CANCEL method
+func (e *calendarEvent) render() string {
+	method, status := "REQUEST", "CONFIRMED"
+	if e.Cancelled {
+		method, status = "CANCEL", "CANCELLED"
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + icsProdID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:" + method,
+		"BEGIN:VEVENT",
+		"UID:" + e.UID,
+		fmt.Sprintf("SEQUENCE:%d", e.Sequence),
+		"DTSTAMP:" + time.Now().UTC().Format(icsTimeLayout),
+		"DTSTART:" + e.Start.UTC().Format(icsTimeLayout),
+	}
+	if !e.End.IsZero() {
+		lines = append(lines, "DTEND:"+e.End.UTC().Format(icsTimeLayout))
+	}
+	lines = append(lines,
+		"SUMMARY:"+escapeICSText(e.Summary),
+		"STATUS:"+status,
+	)
+	if e.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+escapeICSText(e.Description))
+	}
+	if e.Location != "" {
+		lines = append(lines, "LOCATION:"+escapeICSText(e.Location))
+	}
+	if e.OrganizerMail != "" {
+		lines = append(lines, "ORGANIZER"+commonName(e.OrganizerName)+":mailto:"+e.OrganizerMail)
+	}
+	if e.AttendeeMail != "" {
+		lines = append(lines, "ATTENDEE"+commonName(e.AttendeeName)+";ROLE=REQ-PARTICIPANT;RSVP=FALSE:mailto:"+e.AttendeeMail)
+	}
+	if !e.Cancelled {
+		lines = append(lines,
+			"BEGIN:VALARM",
+			"ACTION:DISPLAY",
+			"DESCRIPTION:"+escapeICSText(e.Summary),
+			"TRIGGER:-PT24H",
+			"END:VALARM",
+		)
+	}
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldICSLine(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// escapeICSText escapes a TEXT property value
+func escapeICSText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// commonName returns a quoted CN parameter for the name, dropping characters a parameter cannot
+// contain, or nothing when the name is blank
+func commonName(name string) string {
+	name = strings.TrimSpace(strings.NewReplacer(`"`, "", "\r", "", "\n", " ").Replace(name))
+	if name == "" {
+		return ""
+	}
+	return `;CN="` + name + `"`
+}
+
+// foldICSLine splits a content line into 75-octet lines joined by CRLF and a space, without
+// breaking a multi-byte character
+func foldICSLine(line string) string {
+	if len(line) <= icsLineLength {
+		return line
+	}
+	var b strings.Builder
+	limit := icsLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward the limit
+		limit = icsLineLength - 1
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -0,0 +1,287 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// registryColumns maps normalized CSV header names to registry fields. Registry exports and
+// state lists label the same columns differently, so each field accepts several headers.
+var registryColumns = map[string]string{
+	"nationalregistrynumber":      "registry_number",
+	"registrynumber":              "registry_number",
+	"nrcmenumber":                 "registry_number",
+	"nationalregistryid":          "registry_number",
+	"firstname":                   "first_name",
+	"lastname":                    "last_name",
+	"email":                       "email",
+	"emailaddress":                "email",
+	"phone":                       "phone",
+	"phonenumber":                 "phone",
+	"telephone":                   "phone",
+	"licensenumber":               "license_number",
+	"medicallicensenumber":        "license_number",
+	"licensestate":                "license_state",
+	"licensingstate":              "license_state",
+	"licenseexpiration":           "license_expiration",
+	"licenseexpirationdate":       "license_expiration",
+	"certificationdate":           "certification_date",
+	"certifieddate":               "certification_date",
+	"certificationexpiration":     "certification_expiration",
+	"certificationexpirationdate": "certification_expiration",
+	"expirationdate":              "certification_expiration",
+	"status":                      "registry_status",
+	"registrystatus":              "registry_status",
+	"certificationstatus":         "registry_status",
+	"practicename":                "practice_name",
+	"organizationname":            "practice_name",
+	"businessname":                "practice_name",
+	"address":                     "address_line1",
+	"addressline1":                "address_line1",
+	"address1":                    "address_line1",
+	"streetaddress":               "address_line1",
+	"addressline2":                "address_line2",
+	"address2":                    "address_line2",
+	"city":                        "city",
+	"state":                       "state",
+	"zip":                         "zip_code",
+	"zipcode":                     "zip_code",
+	"postalcode":                  "zip_code",
+}
+
+// registryStatuses maps registry export statuses to consts.ExaminerRegistryStatus values
+var registryStatuses = map[string]consts.ExaminerRegistryStatus{
+	"":          consts.ExaminerRegistryActive,
+	"active":    consts.ExaminerRegistryActive,
+	"certified": consts.ExaminerRegistryActive,
+	"lapsed":    consts.ExaminerRegistryLapsed,
+	"expired":   consts.ExaminerRegistryLapsed,
+	"suspended": consts.ExaminerRegistrySuspended,
+	"revoked":   consts.ExaminerRegistryRevoked,
+	"removed":   consts.ExaminerRegistryRevoked,
+}
+
+var csvDateLayouts = []string{"2006-01-02", "01/02/2006", "1/2/2006", "01-02-2006", "20060102"}
+
+// ImportExaminersCSV creates or refreshes registry entries from a National Registry CSV export.
+// Rows are matched on the registry number; rows that fail validation are reported and skipped.
+func (s *sDotPhysical) ImportExaminersCSV(ctx context.Context, r io.Reader) (*model.MedicalExaminerImportOutput, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "registry export is empty")
+	}
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, "failed to read registry export header")
+	}
+	fields := make([]string, len(header))
+	hasRegistryNumber := false
+	for i, h := range header {
+		fields[i] = registryColumns[normalizeHeader(h)]
+		hasRegistryNumber = hasRegistryNumber || fields[i] == "registry_number"
+	}
+	if !hasRegistryNumber {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "registry export has no National Registry number column")
+	}
+
+	out := &model.MedicalExaminerImportOutput{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			out.Errors = append(out.Errors, &model.MedicalExaminerImportError{Line: line, Message: err.Error()})
+			continue
+		}
+
+		row := make(map[string]string, len(record))
+		for i, v := range record {
+			if i < len(fields) && fields[i] != "" {
+				row[fields[i]] = strings.TrimSpace(v)
+			}
+		}
+		if isBlankRow(row) {
+			continue
+		}
+
+		created, err := s.importExaminer(ctx, row)
+		if err != nil {
+			out.Errors = append(out.Errors, &model.MedicalExaminerImportError{
+				Line:           line,
+				RegistryNumber: row["registry_number"],
+				Message:        gerror.Current(err).Error(),
+			})
+			continue
+		}
+		if created {
+			out.Created++
+		} else {
+			out.Updated++
+		}
+	}
+	return out, nil
+}
+
+// importExaminer upserts one export row, adding the practice address as a clinic when the
+// examiner does not already serve it. It reports whether a new examiner was created.
+func (s *sDotPhysical) importExaminer(ctx context.Context, row map[string]string) (bool, error) {
+	in, err := examinerFromRow(row)
+	if err != nil {
+		return false, err
+	}
+	if err = validateExaminer(in); err != nil {
+		return false, err
+	}
+
+	existing, err := s.examinerByRegistryNumber(ctx, in.RegistryNumber)
+	if err != nil {
+		return false, err
+	}
+
+	err = dao.MedicalExaminers.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data, err := s.examinerData(ctx, in)
+		if err != nil {
+			return err
+		}
+		data.ImportedAt = gtime.Now()
+
+		examinerID := ""
+		if existing == nil {
+			examinerID = uuid.New().String()
+			data.Id = examinerID
+			_, err = dao.MedicalExaminers.Ctx(ctx).TX(tx).Data(data).Insert()
+		} else {
+			examinerID = existing.Id
+			if existing.UserId != "" {
+				data.UserId = nil
+			}
+			// Registry exports carry no patient intake or specialty data; keep what was registered.
+			data.AcceptsNewPatients, data.Specializations = nil, nil
+			_, err = dao.MedicalExaminers.Ctx(ctx).TX(tx).
+				Where(dao.MedicalExaminers.Columns().Id, examinerID).
+				Data(data).Update()
+		}
+		if err != nil {
+			return err
+		}
+
+		if in.PracticeName == "" && in.AddressLine1 == "" {
+			return nil
+		}
+		clinic := &model.MedicalExaminerClinicInput{
+			Name:         in.PracticeName,
+			AddressLine1: in.AddressLine1,
+			AddressLine2: in.AddressLine2,
+			City:         in.City,
+			State:        in.State,
+			ZipCode:      in.ZipCode,
+			Phone:        in.Phone,
+		}
+		if clinic.Name == "" {
+			clinic.Name = fmt.Sprintf("%s %s", in.FirstName, in.LastName)
+		}
+		clinicCols := dao.MedicalExaminerClinics.Columns()
+		m := dao.MedicalExaminerClinics.Ctx(ctx).TX(tx).
+			Where(clinicCols.ExaminerId, examinerID).
+			Where(clinicCols.Name, clinic.Name)
+		if clinic.AddressLine1 == "" {
+			m = m.WhereNull(clinicCols.AddressLine1)
+		} else {
+			m = m.Where(clinicCols.AddressLine1, clinic.AddressLine1)
+		}
+		count, err := m.Count()
+		if err != nil || count > 0 {
+			return err
+		}
+		return insertClinic(ctx, tx, examinerID, clinic)
+	})
+	return existing == nil, err
+}
+
+func examinerFromRow(row map[string]string) (*model.MedicalExaminerInput, error) {
+	status, ok := registryStatuses[strings.ToLower(row["registry_status"])]
+	if !ok {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported registry status: %s", row["registry_status"])
+	}
+
+	in := &model.MedicalExaminerInput{
+		FirstName:          row["first_name"],
+		LastName:           row["last_name"],
+		Email:              row["email"],
+		Phone:              row["phone"],
+		LicenseNumber:      row["license_number"],
+		LicenseState:       row["license_state"],
+		RegistryNumber:     row["registry_number"],
+		RegistryStatus:     status,
+		PracticeName:       row["practice_name"],
+		AddressLine1:       row["address_line1"],
+		AddressLine2:       row["address_line2"],
+		City:               row["city"],
+		State:              row["state"],
+		ZipCode:            row["zip_code"],
+		AcceptsNewPatients: true,
+	}
+
+	var err error
+	if in.LicenseExpiration, err = parseCSVDate(row["license_expiration"]); err != nil {
+		return nil, err
+	}
+	if in.CertificationDate, err = parseCSVDate(row["certification_date"]); err != nil {
+		return nil, err
+	}
+	if in.CertificationExpiration, err = parseCSVDate(row["certification_expiration"]); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+func parseCSVDate(s string) (*gtime.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	for _, layout := range csvDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return gtime.New(t), nil
+		}
+	}
+	return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid date: %q", s)
+}
+
+// normalizeHeader lower-cases a header and strips everything but letters and digits
+func normalizeHeader(h string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(h) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func isBlankRow(row map[string]string) bool {
+	for _, v := range row {
+		if v != "" {
+			return false
+		}
+	}
+	return true
+}
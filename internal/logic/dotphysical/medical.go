@@ -0,0 +1,558 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// maxCertificationMonths is the longest a medical examiner's certificate can be issued for (49 CFR 391.45)
+const maxCertificationMonths = 24
+
+// defaultMonitoringIntervals are the recertification intervals recommended in the FMCSA Medical
+// Examiner Handbook for conditions that call for a short-term certificate
+var defaultMonitoringIntervals = map[consts.MonitoringCondition]int{
+	consts.ConditionHypertension:           12,
+	consts.ConditionInsulinTreatedDiabetes: 12,
+	consts.ConditionSleepApnea:             12,
+	consts.ConditionCardiovascular:         12,
+}
+
+// exemptionRestrictions are the restrictions that must be backed by an exemption document, with the
+// exemption types that satisfy them
+var exemptionRestrictions = map[consts.MedicalRestriction][]consts.MedicalExemptionType{
+	consts.RestrictionWaiverExemption: {consts.ExemptionVision, consts.ExemptionHearing, consts.ExemptionSeizure, consts.ExemptionOther},
+	consts.RestrictionSPECertificate:  {consts.ExemptionSkillPerformanceEvaluation},
+}
+
+// medicalFindings are the structured restrictions, monitoring requirements and exemptions recorded
+// with an update. Nil fields leave what is stored unchanged.
+type medicalFindings struct {
+	restrictions []consts.MedicalRestriction
+	monitoring   []*model.MedicalMonitoringRequirement
+	exemptions   []*model.MedicalExemptionInput
+}
+
+// GetPhysicalDetail returns a DOT physical with its restriction codes, monitoring requirements,
+// exemption documents and follow-up tasks.
+func (s *sDotPhysical) GetPhysicalDetail(ctx context.Context, physicalID string) (*model.DOTPhysicalDetail, error) {
+	physical, err := s.GetPhysical(ctx, physicalID)
+	if err != nil {
+		return nil, err
+	}
+	detail := &model.DOTPhysicalDetail{
+		Physical:     physical,
+		Restrictions: decodeRestrictions(physical.Restrictions),
+		Monitoring:   decodeMonitoring(physical.MonitoringRequirements),
+	}
+	if detail.Exemptions, err = physicalExemptions(ctx, physical.Id); err != nil {
+		return nil, err
+	}
+	err = dao.MedicalFollowUpTasks.Ctx(ctx).
+		Where(dao.MedicalFollowUpTasks.Columns().PhysicalId, physical.Id).
+		OrderAsc(dao.MedicalFollowUpTasks.Columns().DueDate).
+		Scan(&detail.FollowUpTasks)
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+// ListFollowUpTasks returns an organization's medical follow-up tasks, earliest due first.
+func (s *sDotPhysical) ListFollowUpTasks(ctx context.Context, in *model.MedicalFollowUpTaskListInput) ([]*entity.MedicalFollowUpTasks, int, error) {
+	cols := dao.MedicalFollowUpTasks.Columns()
+	m := dao.MedicalFollowUpTasks.Ctx(ctx).Where(cols.OrganizationId, in.OrganizationID)
+	if in.UserID != "" {
+		m = m.Where(cols.UserId, in.UserID)
+	}
+	if in.Status != "" {
+		m = m.Where(cols.Status, in.Status)
+	}
+	if in.TaskType != "" {
+		m = m.Where(cols.TaskType, in.TaskType)
+	}
+	if in.DueBefore != nil {
+		m = m.WhereLTE(cols.DueDate, in.DueBefore.Format("Y-m-d"))
+	}
+
+	var (
+		tasks []*entity.MedicalFollowUpTasks
+		total int
+	)
+	err := m.OrderAsc(cols.DueDate).Offset(in.Offset).Limit(in.Limit).ScanAndCount(&tasks, &total, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tasks, total, nil
+}
+
+// CompleteFollowUpTask marks an open medical follow-up task completed.
+func (s *sDotPhysical) CompleteFollowUpTask(ctx context.Context, in *model.MedicalFollowUpTaskCompleteInput) (*entity.MedicalFollowUpTasks, error) {
+	if in.CompletedBy == "" {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "completing user is required")
+	}
+	cols := dao.MedicalFollowUpTasks.Columns()
+	result, err := dao.MedicalFollowUpTasks.Ctx(ctx).
+		Where(cols.Id, in.TaskID).
+		Where(cols.Status, consts.FollowUpOpen).
+		Data(do.MedicalFollowUpTasks{
+			Status:      consts.FollowUpCompleted,
+			CompletedAt: gtime.Now(),
+			CompletedBy: in.CompletedBy,
+			Notes:       nilIfEmpty(in.Notes),
+		}).Update()
+	if err != nil {
+		return nil, err
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		count, err := dao.MedicalFollowUpTasks.Ctx(ctx).Where(cols.Id, in.TaskID).Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gerror.NewCode(gcode.CodeNotFound, "follow-up task not found")
+		}
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "follow-up task is no longer open")
+	}
+
+	var task *entity.MedicalFollowUpTasks
+	if err = dao.MedicalFollowUpTasks.Ctx(ctx).Where(cols.Id, in.TaskID).Scan(&task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// parseMedicalFindings validates the structured findings on an update, falling back to the
+// free-text restrictions, monitoring requirements and single exemption for older callers
+func parseMedicalFindings(in *model.DOTPhysicalUpdateInput) (*medicalFindings, error) {
+	findings := &medicalFindings{}
+
+	codes := in.RestrictionCodes
+	if len(codes) == 0 && strings.TrimSpace(in.Restrictions) != "" {
+		codes = splitRestrictions(in.Restrictions)
+	}
+	if len(codes) > 0 {
+		seen := make(map[consts.MedicalRestriction]bool)
+		for _, code := range codes {
+			code = consts.MedicalRestriction(strings.ToLower(strings.TrimSpace(string(code))))
+			if !validRestriction(code) {
+				return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported medical restriction: %s", code)
+			}
+			if !seen[code] {
+				seen[code] = true
+				findings.restrictions = append(findings.restrictions, code)
+			}
+		}
+	}
+
+	monitoring := in.Monitoring
+	if len(monitoring) == 0 && strings.TrimSpace(in.MonitoringRequirements) != "" {
+		if monitoring = decodeMonitoring(in.MonitoringRequirements); len(monitoring) == 0 {
+			monitoring = []*model.MedicalMonitoringRequirement{{
+				Condition: consts.ConditionOther,
+				Notes:     strings.TrimSpace(in.MonitoringRequirements),
+			}}
+		}
+	}
+	for _, req := range monitoring {
+		switch req.Condition {
+		case consts.ConditionHypertension, consts.ConditionInsulinTreatedDiabetes, consts.ConditionSleepApnea,
+			consts.ConditionCardiovascular, consts.ConditionVision, consts.ConditionHearing, consts.ConditionOther:
+		default:
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported monitoring condition: %s", req.Condition)
+		}
+		if req.IntervalMonths < 0 || req.IntervalMonths > maxCertificationMonths {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "monitoring interval for %s must be between 1 and %d months", req.Condition, maxCertificationMonths)
+		}
+		if req.IntervalMonths == 0 {
+			req.IntervalMonths = defaultMonitoringIntervals[req.Condition]
+		}
+		findings.monitoring = append(findings.monitoring, req)
+	}
+
+	exemptions := in.Exemptions
+	if in.ExemptionType != "" {
+		exemptions = append(exemptions, &model.MedicalExemptionInput{
+			ExemptionType:  consts.MedicalExemptionType(in.ExemptionType),
+			ExpirationDate: in.ExemptionExpiration,
+		})
+	}
+	for _, e := range exemptions {
+		switch e.ExemptionType {
+		case consts.ExemptionVision, consts.ExemptionHearing, consts.ExemptionSeizure,
+			consts.ExemptionSkillPerformanceEvaluation, consts.ExemptionOther:
+		default:
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported exemption type: %s", e.ExemptionType)
+		}
+		if e.IssuedDate != nil && e.ExpirationDate != nil && !e.ExpirationDate.After(e.IssuedDate) {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "%s exemption expires before it was issued", e.ExemptionType)
+		}
+		findings.exemptions = append(findings.exemptions, e)
+	}
+	return findings, nil
+}
+
+// splitRestrictions reads restriction codes from a JSON array or a comma-separated list
+func splitRestrictions(s string) []consts.MedicalRestriction {
+	if codes := decodeRestrictions(s); len(codes) > 0 {
+		return codes
+	}
+	var codes []consts.MedicalRestriction
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+		if part = strings.TrimSpace(part); part != "" {
+			codes = append(codes, consts.MedicalRestriction(part))
+		}
+	}
+	return codes
+}
+
+func validRestriction(code consts.MedicalRestriction) bool {
+	switch code {
+	case consts.RestrictionCorrectiveLenses, consts.RestrictionHearingAid, consts.RestrictionWaiverExemption,
+		consts.RestrictionSPECertificate, consts.RestrictionExemptIntracityZone, consts.RestrictionGrandfathered,
+		consts.RestrictionIntrastateOnly:
+		return true
+	}
+	return false
+}
+
+// decodeRestrictions reads the restriction codes stored on a physical; free text recorded before
+// restrictions were structured yields none
+func decodeRestrictions(s string) []consts.MedicalRestriction {
+	var codes []consts.MedicalRestriction
+	if err := json.Unmarshal([]byte(s), &codes); err != nil {
+		return nil
+	}
+	return codes
+}
+
+// decodeMonitoring reads the monitoring requirements stored on a physical
+func decodeMonitoring(s string) []*model.MedicalMonitoringRequirement {
+	var reqs []*model.MedicalMonitoringRequirement
+	if err := json.Unmarshal([]byte(s), &reqs); err != nil {
+		return nil
+	}
+	return reqs
+}
+
+func physicalExemptions(ctx context.Context, physicalID string) ([]*entity.DotPhysicalExemptions, error) {
+	var exemptions []*entity.DotPhysicalExemptions
+	err := dao.DotPhysicalExemptions.Ctx(ctx).
+		Where(dao.DotPhysicalExemptions.Columns().PhysicalId, physicalID).
+		OrderAsc(dao.DotPhysicalExemptions.Columns().ExpirationDate).
+		Scan(&exemptions)
+	return exemptions, err
+}
+
+// replaceExemptions swaps the physical's exemption documents for those recorded with the update and
+// keeps the exemptions summary column in step
+func replaceExemptions(ctx context.Context, tx gdb.TX, physical *entity.DotPhysicals, exemptions []*model.MedicalExemptionInput) error {
+	_, err := dao.DotPhysicalExemptions.Ctx(ctx).TX(tx).
+		Where(dao.DotPhysicalExemptions.Columns().PhysicalId, physical.Id).
+		Delete()
+	if err != nil {
+		return err
+	}
+
+	summary := make([]map[string]interface{}, 0, len(exemptions))
+	for _, e := range exemptions {
+		_, err = dao.DotPhysicalExemptions.Ctx(ctx).TX(tx).Data(do.DotPhysicalExemptions{
+			Id:               uuid.New().String(),
+			OrganizationId:   physical.OrganizationId,
+			UserId:           physical.UserId,
+			PhysicalId:       physical.Id,
+			ExemptionType:    e.ExemptionType,
+			ExemptionNumber:  nilIfEmpty(e.ExemptionNumber),
+			IssuingAuthority: nilIfEmpty(e.IssuingAuthority),
+			DocumentId:       nilIfEmpty(e.DocumentID),
+			IssuedDate:       e.IssuedDate,
+			ExpirationDate:   e.ExpirationDate,
+		}).Insert()
+		if err != nil {
+			return err
+		}
+		summary = append(summary, map[string]interface{}{
+			"type":             e.ExemptionType,
+			"exemption_number": e.ExemptionNumber,
+			"expiration_date":  e.ExpirationDate,
+		})
+	}
+
+	encoded, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	_, err = dao.DotPhysicals.Ctx(ctx).TX(tx).
+		Where(dao.DotPhysicals.Columns().Id, physical.Id).
+		Data(do.DotPhysicals{Exemptions: string(encoded)}).
+		Update()
+	return err
+}
+
+// checkExemptionRestrictions requires a matching exemption document for each restriction that
+// depends on one
+func checkExemptionRestrictions(restrictions []consts.MedicalRestriction, exemptions []*entity.DotPhysicalExemptions) error {
+	for _, code := range restrictions {
+		types, ok := exemptionRestrictions[code]
+		if !ok {
+			continue
+		}
+		found := false
+		for _, e := range exemptions {
+			for _, t := range types {
+				found = found || e.ExemptionType == string(t)
+			}
+		}
+		if !found {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "restriction %s requires a matching exemption document", code)
+		}
+	}
+	return nil
+}
+
+// certificationPeriod returns the months the physical's certificate is issued for: the recorded
+// period, otherwise the shortest monitoring interval, otherwise the two-year maximum
+func certificationPeriod(physical *entity.DotPhysicals, monitoring []*model.MedicalMonitoringRequirement) int {
+	if physical.CertificationPeriodMonths > 0 {
+		return physical.CertificationPeriodMonths
+	}
+	months := maxCertificationMonths
+	for _, req := range monitoring {
+		if req.IntervalMonths > 0 && req.IntervalMonths < months {
+			months = req.IntervalMonths
+		}
+	}
+	return months
+}
+
+// applyMedicalSchedule derives the certificate expiration and next required date from the
+// physical's certification period, exemption expirations and monitoring intervals, and brings
+// its follow-up tasks in line with them. An expiration the request supplied is checked against the
+// certification period; otherwise the expiration is derived again, as the period may have changed.
+func applyMedicalSchedule(ctx context.Context, tx gdb.TX, physical *entity.DotPhysicals, requestedExpiration *gtime.Time) error {
+	restrictions := decodeRestrictions(physical.Restrictions)
+	monitoring := decodeMonitoring(physical.MonitoringRequirements)
+	var exemptions []*entity.DotPhysicalExemptions
+	err := dao.DotPhysicalExemptions.Ctx(ctx).TX(tx).
+		Where(dao.DotPhysicalExemptions.Columns().PhysicalId, physical.Id).
+		Scan(&exemptions)
+	if err != nil {
+		return err
+	}
+	if err = checkExemptionRestrictions(restrictions, exemptions); err != nil {
+		return err
+	}
+
+	qualified := physical.MedicalQualification == string(consts.MedicalQualified) ||
+		physical.MedicalQualification == string(consts.MedicalQualifiedWithRestrictions)
+	issued := physical.CertificateIssueDate
+	if issued == nil {
+		issued = physical.ExaminationDate
+	}
+	if !qualified || issued == nil {
+		return nil
+	}
+
+	period := certificationPeriod(physical, monitoring)
+	latest := issued.AddDate(0, period, 0)
+	expiration := requestedExpiration
+	if expiration == nil {
+		expiration = latest
+	} else if expiration.After(latest) {
+		return gerror.NewCodef(gcode.CodeInvalidParameter,
+			"certificate expiration %s is beyond the %d-month certification period", expiration.Format("Y-m-d"), period)
+	}
+
+	tasks := followUpTasks(physical, period, expiration, monitoring, exemptions)
+	next := expiration
+	for _, t := range tasks {
+		if due := t.DueDate; due.Before(next) {
+			next = due
+		}
+	}
+
+	_, err = dao.DotPhysicals.Ctx(ctx).TX(tx).
+		Where(dao.DotPhysicals.Columns().Id, physical.Id).
+		Data(do.DotPhysicals{
+			CertificateExpirationDate: expiration,
+			NextRequiredDate:          next,
+		}).Update()
+	if err != nil {
+		return err
+	}
+	return syncFollowUpTasks(ctx, tx, physical, tasks)
+}
+
+// followUpTasks lists the tasks a qualified physical calls for: recertification before a short-term
+// certificate lapses, renewal of each expiring exemption before the certificate does, and checks
+// for monitored conditions that fall due within the certificate
+func followUpTasks(physical *entity.DotPhysicals, period int, expiration *gtime.Time, monitoring []*model.MedicalMonitoringRequirement, exemptions []*entity.DotPhysicalExemptions) []*do.MedicalFollowUpTasks {
+	var tasks []*do.MedicalFollowUpTasks
+	add := func(taskType consts.FollowUpTaskType, key, title, description string, due *gtime.Time) {
+		tasks = append(tasks, &do.MedicalFollowUpTasks{
+			TaskType:     taskType,
+			ReferenceKey: key,
+			Title:        title,
+			Description:  description,
+			DueDate:      due,
+		})
+	}
+
+	if period < maxCertificationMonths {
+		var conditions []string
+		for _, req := range monitoring {
+			if req.IntervalMonths == period {
+				conditions = append(conditions, conditionLabel(req.Condition))
+			}
+		}
+		description := fmt.Sprintf("The driver holds a %d-month medical certificate", period)
+		if len(conditions) > 0 {
+			description += " for " + strings.Join(conditions, ", ")
+		}
+		add(consts.FollowUpRecertification, "certificate",
+			"Schedule DOT physical recertification", description+". Schedule a new examination before it expires.", expiration)
+	}
+
+	for _, e := range exemptions {
+		if e.ExpirationDate == nil || e.ExpirationDate.After(expiration) {
+			continue
+		}
+		label := exemptionLabel(e.ExemptionType)
+		key := e.ExemptionType
+		if e.ExemptionNumber != "" {
+			key += ":" + e.ExemptionNumber
+		}
+		description := fmt.Sprintf("The driver's %s exemption expires before their medical certificate", label)
+		if e.ExemptionNumber != "" {
+			description += fmt.Sprintf(" (exemption %s)", e.ExemptionNumber)
+		}
+		add(consts.FollowUpExemptionRenewal, key,
+			fmt.Sprintf("Renew %s exemption", label), description+". Collect the renewed exemption document.", e.ExpirationDate)
+	}
+
+	for _, req := range monitoring {
+		if req.IntervalMonths == 0 || req.IntervalMonths >= period {
+			continue
+		}
+		label := conditionLabel(req.Condition)
+		description := fmt.Sprintf("Collect evidence that the driver's %s remains controlled", label)
+		if req.Notes != "" {
+			description += ": " + req.Notes
+		}
+		issued := physical.CertificateIssueDate
+		if issued == nil {
+			issued = physical.ExaminationDate
+		}
+		add(consts.FollowUpMonitoringCheck, string(req.Condition),
+			fmt.Sprintf("Monitoring check: %s", label), description, issued.AddDate(0, req.IntervalMonths, 0))
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		return tasks[i].DueDate.Before(tasks[j].DueDate)
+	})
+	return tasks
+}
+
+// syncFollowUpTasks creates or reschedules the physical's open follow-up tasks, cancels those it
+// no longer calls for, and cancels the driver's open tasks from the earlier physicals it supersedes.
+// Completed tasks are left as they are.
+func syncFollowUpTasks(ctx context.Context, tx gdb.TX, physical *entity.DotPhysicals, tasks []*do.MedicalFollowUpTasks) error {
+	cols := dao.MedicalFollowUpTasks.Columns()
+	var existing []*entity.MedicalFollowUpTasks
+	err := dao.MedicalFollowUpTasks.Ctx(ctx).TX(tx).Where(cols.PhysicalId, physical.Id).Scan(&existing)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]*entity.MedicalFollowUpTasks, len(existing))
+	for _, t := range existing {
+		byKey[t.TaskType+"/"+t.ReferenceKey] = t
+	}
+
+	wanted := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		key := fmt.Sprintf("%s/%s", t.TaskType, t.ReferenceKey)
+		wanted[key] = true
+		current, ok := byKey[key]
+		switch {
+		case !ok:
+			t.Id = uuid.New().String()
+			t.OrganizationId = physical.OrganizationId
+			t.UserId = physical.UserId
+			t.PhysicalId = physical.Id
+			t.Status = consts.FollowUpOpen
+			_, err = dao.MedicalFollowUpTasks.Ctx(ctx).TX(tx).Data(t).Insert()
+		case current.Status == string(consts.FollowUpCompleted):
+			continue
+		default:
+			_, err = dao.MedicalFollowUpTasks.Ctx(ctx).TX(tx).
+				Where(cols.Id, current.Id).
+				Data(do.MedicalFollowUpTasks{
+					Title:       t.Title,
+					Description: t.Description,
+					DueDate:     t.DueDate,
+					Status:      consts.FollowUpOpen,
+				}).Update()
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var stale []string
+	for key, t := range byKey {
+		if !wanted[key] && t.Status == string(consts.FollowUpOpen) {
+			stale = append(stale, t.Id)
+		}
+	}
+	if len(stale) > 0 {
+		_, err = dao.MedicalFollowUpTasks.Ctx(ctx).TX(tx).
+			WhereIn(cols.Id, stale).
+			Data(do.MedicalFollowUpTasks{Status: consts.FollowUpCancelled}).
+			Update()
+		if err != nil {
+			return err
+		}
+	}
+
+	earlier, err := dao.DotPhysicals.Ctx(ctx).TX(tx).
+		Fields(dao.DotPhysicals.Columns().Id).
+		Where(dao.DotPhysicals.Columns().UserId, physical.UserId).
+		WhereLT(dao.DotPhysicals.Columns().CreatedAt, physical.CreatedAt).
+		Array()
+	if err != nil || len(earlier) == 0 {
+		return err
+	}
+	_, err = dao.MedicalFollowUpTasks.Ctx(ctx).TX(tx).
+		WhereIn(cols.PhysicalId, earlier).
+		Where(cols.Status, consts.FollowUpOpen).
+		Data(do.MedicalFollowUpTasks{Status: consts.FollowUpCancelled}).
+		Update()
+	return err
+}
+
+func conditionLabel(condition consts.MonitoringCondition) string {
+	if condition == consts.ConditionInsulinTreatedDiabetes {
+		return "insulin-treated diabetes"
+	}
+	return strings.ReplaceAll(string(condition), "_", " ")
+}
+
+func exemptionLabel(exemptionType string) string {
+	if exemptionType == string(consts.ExemptionSkillPerformanceEvaluation) {
+		return "SPE"
+	}
+	return strings.ReplaceAll(exemptionType, "_", " ")
+}
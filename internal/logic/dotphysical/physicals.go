@@ -0,0 +1,177 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// GetPhysical returns a DOT physical by ID.
+func (s *sDotPhysical) GetPhysical(ctx context.Context, physicalID string) (*entity.DotPhysicals, error) {
+	var physical *entity.DotPhysicals
+	err := dao.DotPhysicals.Ctx(ctx).Where(dao.DotPhysicals.Columns().Id, physicalID).Scan(&physical)
+	if err != nil {
+		return nil, err
+	}
+	if physical == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "DOT physical not found")
+	}
+	return physical, nil
+}
+
+// UpdatePhysical records examination results against a DOT physical. Results can only be
+// recorded by an examiner whose National Registry certification was current on the
+// examination date. Cancellations and no-shows are handed to CancelPhysical and MarkNoShow.
+// Restrictions, monitoring requirements and exemptions replace those stored when given; the
+// certificate expiration, next required date and follow-up tasks are then derived from them.
+func (s *sDotPhysical) UpdatePhysical(ctx context.Context, in *model.DOTPhysicalUpdateInput) (*entity.DotPhysicals, error) {
+	physical, err := s.GetPhysical(ctx, in.PhysicalID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch consts.PhysicalStatus(in.Status) {
+	case "", consts.PhysicalStatusScheduled, consts.PhysicalStatusCompleted, consts.PhysicalStatusFailed,
+		consts.PhysicalStatusExpired, consts.PhysicalStatusPendingReview:
+	case consts.PhysicalStatusCancelled:
+		appointment, err := s.CancelPhysical(ctx, physical.Id, in.ExaminerNotes)
+		if err != nil {
+			return nil, err
+		}
+		return appointment.Physical, nil
+	case consts.PhysicalStatusNoShow:
+		return s.MarkNoShow(ctx, physical.Id, in.ExaminerNotes)
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported physical status: %s", in.Status)
+	}
+	switch consts.MedicalQualification(in.QualificationStatus) {
+	case "", consts.MedicalQualified, consts.MedicalDisqualified, consts.MedicalQualifiedWithRestrictions:
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported qualification status: %s", in.QualificationStatus)
+	}
+
+	if in.CertificationPeriodMonths < 0 || in.CertificationPeriodMonths > maxCertificationMonths {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "certification period must be between 1 and %d months", maxCertificationMonths)
+	}
+	findings, err := parseMedicalFindings(in)
+	if err != nil {
+		return nil, err
+	}
+
+	data := do.DotPhysicals{
+		Status:                    nilIfEmpty(in.Status),
+		ExaminationDate:           in.ExaminationDate,
+		MedicalQualification:      nilIfEmpty(in.QualificationStatus),
+		CertificateIssueDate:      in.CertificateIssueDate,
+		CertificateExpirationDate: in.CertificateExpirationDate,
+		CertificateNumber:         nilIfEmpty(in.CertificateNumber),
+		Notes:                     nilIfEmpty(in.ExaminerNotes),
+	}
+	if in.CertificationPeriodMonths > 0 {
+		data.CertificationPeriodMonths = in.CertificationPeriodMonths
+	}
+	if findings.restrictions != nil {
+		restrictions, err := json.Marshal(findings.restrictions)
+		if err != nil {
+			return nil, err
+		}
+		data.Restrictions = string(restrictions)
+	}
+	if findings.monitoring != nil {
+		monitoring, err := json.Marshal(findings.monitoring)
+		if err != nil {
+			return nil, err
+		}
+		data.MonitoringRequirements = string(monitoring)
+		data.RequiresMonitoring = true
+	} else if in.RequiresMonitoring {
+		data.RequiresMonitoring = true
+	}
+
+	recordsResults := in.Status == string(consts.PhysicalStatusCompleted) || in.ExaminationDate != nil ||
+		in.QualificationStatus != "" || in.CertificateNumber != ""
+	if recordsResults {
+		examinationDate := in.ExaminationDate
+		if examinationDate == nil {
+			examinationDate = physical.ExaminationDate
+		}
+		examiner, err := s.certifyingExaminer(ctx, physical, in.ExaminerRegistryNumber, examinationDate)
+		if err != nil {
+			return nil, err
+		}
+		data.ExaminerRegistryNumber = examiner.RegistryNumber
+		data.ExaminerName = fmt.Sprintf("%s %s", examiner.FirstName, examiner.LastName)
+		data.ExaminerLicenseNumber = nilIfEmpty(examiner.LicenseNumber)
+		data.ExaminerId = nilIfEmpty(examiner.UserId)
+	}
+
+	err = dao.DotPhysicals.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.DotPhysicals.Ctx(ctx).TX(tx).
+			Where(dao.DotPhysicals.Columns().Id, physical.Id).
+			Data(data).Update()
+		if err != nil {
+			return err
+		}
+		if findings.exemptions != nil {
+			if err = replaceExemptions(ctx, tx, physical, findings.exemptions); err != nil {
+				return err
+			}
+		}
+
+		var updated *entity.DotPhysicals
+		err = dao.DotPhysicals.Ctx(ctx).TX(tx).Where(dao.DotPhysicals.Columns().Id, physical.Id).Scan(&updated)
+		if err != nil {
+			return err
+		}
+		return applyMedicalSchedule(ctx, tx, updated, in.CertificateExpirationDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetPhysical(ctx, physical.Id)
+}
+
+// certifyingExaminer resolves the examiner recording the physical, from the given registry
+// number, the one already on the physical, or the examiner's account, and checks that their
+// registration was current on the examination date.
+func (s *sDotPhysical) certifyingExaminer(ctx context.Context, physical *entity.DotPhysicals, registryNumber string, examinationDate *gtime.Time) (*entity.MedicalExaminers, error) {
+	if registryNumber == "" {
+		registryNumber = physical.ExaminerRegistryNumber
+	}
+	if registryNumber == "" && physical.ExaminerId != "" {
+		value, err := dao.MedicalExaminers.Ctx(ctx).
+			Fields(dao.MedicalExaminers.Columns().RegistryNumber).
+			Where(dao.MedicalExaminers.Columns().UserId, physical.ExaminerId).
+			Value()
+		if err != nil {
+			return nil, err
+		}
+		registryNumber = value.String()
+	}
+	if registryNumber == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "the examiner's National Registry number is required to record a physical")
+	}
+	return s.CheckExaminerRegistration(ctx, registryNumber, examinationDate)
+}
+
+// organizationDERs returns the organization's active designated employer representatives
+func organizationDERs(ctx context.Context, organizationID string) ([]*entity.UserProfiles, error) {
+	var ders []*entity.UserProfiles
+	cols := dao.UserProfiles.Columns()
+	err := dao.UserProfiles.Ctx(ctx).
+		Where(cols.OrganizationId, organizationID).
+		Where(cols.Role, consts.RoleDER).
+		Where(cols.IsActive, true).
+		Scan(&ders)
+	return ders, err
+}
@@ -0,0 +1,441 @@
+package dotphysical
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"sort"
+	"strings"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// defaultReminderDays is the reminder ladder used by organizations that have not configured one
+var defaultReminderDays = []int{60, 30, 14, 1}
+
+const (
+	defaultExpiringDays = 60
+	maxReminderDays     = 365
+)
+
+// reminderSettings is an organization's reminder ladder with its JSON columns decoded
+type reminderSettings struct {
+	ReminderDays []int
+	EmailEnabled bool
+	SmsEnabled   bool
+	Recipients   []string
+}
+
+// SetExpirationReminder saves the organization's medical certificate reminder ladder.
+func (s *sDotPhysical) SetExpirationReminder(ctx context.Context, in *model.ExpirationReminderInput) (*entity.MedicalCertReminderSettings, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	days, err := normalizeReminderDays(in.ReminderDays)
+	if err != nil {
+		return nil, err
+	}
+	recipients := normalizeList(in.NotificationRecipients)
+	for _, r := range recipients {
+		if _, err = mail.ParseAddress(r); err != nil {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid notification recipient: %s", r)
+		}
+	}
+
+	daysJSON, err := json.Marshal(days)
+	if err != nil {
+		return nil, err
+	}
+	recipientsJSON, err := json.Marshal(recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := dao.MedicalCertReminderSettings.Columns()
+	_, err = dao.MedicalCertReminderSettings.Ctx(ctx).
+		Data(do.MedicalCertReminderSettings{
+			OrganizationId:         in.OrganizationID,
+			ReminderDays:           string(daysJSON),
+			EmailEnabled:           in.EmailEnabled,
+			SmsEnabled:             in.SmsEnabled,
+			NotificationRecipients: string(recipientsJSON),
+			UpdatedBy:              nilIfEmpty(in.UpdatedBy),
+		}).
+		OnConflict(cols.OrganizationId).
+		Save()
+	if err != nil {
+		return nil, err
+	}
+
+	var settings *entity.MedicalCertReminderSettings
+	err = dao.MedicalCertReminderSettings.Ctx(ctx).Where(cols.OrganizationId, in.OrganizationID).Scan(&settings)
+	return settings, err
+}
+
+// GetExpiringCertificates returns the organization's drivers whose current medical examiner's
+// certificate expires within daysAhead days, soonest first.
+func (s *sDotPhysical) GetExpiringCertificates(ctx context.Context, organizationID string, daysAhead int) ([]*model.ExpiringCertificate, error) {
+	if organizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if daysAhead <= 0 {
+		daysAhead = defaultExpiringDays
+	}
+	if daysAhead > maxReminderDays {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "days ahead cannot exceed %d", maxReminderDays)
+	}
+	return s.expiringCertificates(ctx, organizationID, daysAhead)
+}
+
+// SendExpirationReminders sends each organization's due medical certificate reminders to the
+// driver, the organization's DERs and any additional recipients. A certificate is reminded once
+// per ladder rung; when several rungs have passed since the last sweep only the nearest is sent.
+// It returns the number of reminders sent.
+func (s *sDotPhysical) SendExpirationReminders(ctx context.Context) (int, error) {
+	var rows []*entity.MedicalCertReminderSettings
+	if err := dao.MedicalCertReminderSettings.Ctx(ctx).Scan(&rows); err != nil {
+		return 0, err
+	}
+	settings := make(map[string]*reminderSettings, len(rows))
+	horizon := defaultReminderDays[0]
+	for _, row := range rows {
+		rs := decodeReminderSettings(row)
+		settings[row.OrganizationId] = rs
+		if len(rs.ReminderDays) > 0 && rs.ReminderDays[0] > horizon {
+			horizon = rs.ReminderDays[0]
+		}
+	}
+
+	certs, err := s.expiringCertificates(ctx, "", horizon)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, cert := range certs {
+		rs, ok := settings[cert.OrganizationID]
+		if !ok {
+			rs = &reminderSettings{ReminderDays: defaultReminderDays, EmailEnabled: true}
+		}
+		rung, ok := reminderRung(rs.ReminderDays, cert.DaysUntilExpiration)
+		if !ok {
+			continue
+		}
+
+		ok, err = s.sendExpirationReminder(ctx, cert, rs, rung)
+		if err != nil {
+			g.Log().Errorf(ctx, "Failed to send medical certificate reminder for physical %s: %v", cert.PhysicalID, err)
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// sendExpirationReminder claims the reminder for the rung and notifies its recipients. It reports
+// false when the reminder had already been sent. The claim records each recipient and channel as it
+// is notified, so when notification fails part way the next sweep notifies only the ones missed.
+func (s *sDotPhysical) sendExpirationReminder(ctx context.Context, cert *model.ExpiringCertificate, rs *reminderSettings, rung int) (bool, error) {
+	cols := dao.MedicalCertRemindersSent.Columns()
+	_, err := dao.MedicalCertRemindersSent.Ctx(ctx).
+		Data(do.MedicalCertRemindersSent{
+			OrganizationId: cert.OrganizationID,
+			UserId:         cert.UserID,
+			PhysicalId:     cert.PhysicalID,
+			ExpirationDate: cert.ExpirationDate,
+			ReminderDays:   rung,
+		}).
+		InsertIgnore()
+	if err != nil {
+		return false, err
+	}
+	claim := dao.MedicalCertRemindersSent.Ctx(ctx).
+		Where(cols.PhysicalId, cert.PhysicalID).
+		Where(cols.ExpirationDate, cert.ExpirationDate).
+		Where(cols.ReminderDays, rung)
+	var sent *entity.MedicalCertRemindersSent
+	if err = claim.Clone().Scan(&sent); err != nil || sent == nil || sent.CompletedAt != nil {
+		return false, err
+	}
+
+	notified := make(map[string]bool)
+	if sent.Notified != "" {
+		var keys []string
+		if err = json.Unmarshal([]byte(sent.Notified), &keys); err != nil {
+			return false, err
+		}
+		for _, key := range keys {
+			notified[key] = true
+		}
+	}
+
+	recipients, notifyErr := s.notifyExpiration(ctx, cert, rs, notified)
+	keys := make([]string, 0, len(notified))
+	for key := range notified {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	keysJSON, err := json.Marshal(keys)
+	if err != nil {
+		return false, err
+	}
+	progress := do.MedicalCertRemindersSent{Notified: string(keysJSON), Recipients: recipients}
+	if notifyErr == nil {
+		progress.CompletedAt = gtime.Now()
+	}
+	if _, err = claim.Data(progress).Update(); err != nil {
+		if notifyErr != nil {
+			g.Log().Errorf(ctx, "Failed to record medical certificate reminder progress for physical %s: %v", cert.PhysicalID, err)
+			return false, notifyErr
+		}
+		return false, err
+	}
+	return notifyErr == nil, notifyErr
+}
+
+// notifyExpiration notifies the driver, the organization's active DERs and any additional
+// recipients on each channel, skipping the recipients and channels already notified. Each one
+// notified is added to notified. It returns how many recipients have been notified.
+func (s *sDotPhysical) notifyExpiration(ctx context.Context, cert *model.ExpiringCertificate, rs *reminderSettings, notified map[string]bool) (int, error) {
+	channels := []consts.NotificationType{consts.NotificationInApp}
+	if rs.EmailEnabled {
+		channels = append(channels, consts.NotificationEmail)
+	}
+	if rs.SmsEnabled {
+		channels = append(channels, consts.NotificationSMS)
+	}
+
+	priority := consts.NotificationPriorityNormal
+	switch {
+	case cert.DaysUntilExpiration <= 1:
+		priority = consts.NotificationPriorityUrgent
+	case cert.DaysUntilExpiration <= 14:
+		priority = consts.NotificationPriorityHigh
+	}
+
+	title := "DOT medical certificate expires " + expiresIn(cert.DaysUntilExpiration)
+	expires := cert.ExpirationDate.Format("M j, Y")
+	driverMessage := fmt.Sprintf("Your DOT medical examiner's certificate expires on %s. "+
+		"Schedule your DOT physical before then to remain qualified to operate a commercial motor vehicle.", expires)
+	employerMessage := fmt.Sprintf("%s's DOT medical examiner's certificate", cert.UserName)
+	if cert.CertificateNumber != "" {
+		employerMessage += fmt.Sprintf(" (No. %s)", cert.CertificateNumber)
+	}
+	employerMessage += fmt.Sprintf(" expires on %s. The driver may not operate a commercial motor vehicle "+
+		"after it expires until a new certificate is on file.", expires)
+
+	ders, err := organizationDERs(ctx, cert.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+
+	inputs := []*model.NotificationInput{{
+		UserID:  cert.UserID,
+		Message: driverMessage,
+	}}
+	for _, der := range ders {
+		if der.Id == cert.UserID {
+			continue
+		}
+		inputs = append(inputs, &model.NotificationInput{UserID: der.Id, Message: employerMessage})
+	}
+	if rs.EmailEnabled {
+		for _, email := range rs.Recipients {
+			inputs = append(inputs, &model.NotificationInput{EmailAddress: email, Message: employerMessage})
+		}
+	}
+
+	recipients := 0
+	for _, in := range inputs {
+		in.OrganizationID = cert.OrganizationID
+		in.Category = consts.NotificationCategoryCertificateExpiring
+		in.Title = title
+		in.Priority = priority
+		in.PhysicalID = cert.PhysicalID
+		recipientChannels := channels
+		recipient := "user:" + in.UserID
+		if in.UserID == "" {
+			recipientChannels = []consts.NotificationType{consts.NotificationEmail}
+			recipient = "email:" + strings.ToLower(in.EmailAddress)
+		}
+		for _, channel := range recipientChannels {
+			key := recipient + "|" + string(channel)
+			if notified[key] {
+				continue
+			}
+			one := *in
+			one.Channels = []consts.NotificationType{channel}
+			if _, err = service.Notification().Notify(ctx, &one); err != nil {
+				return recipients, err
+			}
+			notified[key] = true
+		}
+		recipients++
+	}
+	return recipients, nil
+}
+
+// expiringCertificates returns each active driver's current certificate when it expires within
+// daysAhead days. A certificate superseded by a later one is not current. An empty
+// organizationID searches all organizations.
+func (s *sDotPhysical) expiringCertificates(ctx context.Context, organizationID string, daysAhead int) ([]*model.ExpiringCertificate, error) {
+	today := today()
+	until := today.AddDate(0, 0, daysAhead)
+	cols := dao.DotPhysicals.Columns()
+
+	m := dao.DotPhysicals.Ctx(ctx).
+		Where(cols.Status, consts.PhysicalStatusCompleted).
+		WhereNotNull(cols.CertificateExpirationDate)
+	if organizationID != "" {
+		m = m.Where(cols.OrganizationId, organizationID)
+	}
+
+	var candidates []*entity.DotPhysicals
+	err := m.Clone().
+		WhereGTE(cols.CertificateExpirationDate, today.Format("2006-01-02")).
+		WhereLTE(cols.CertificateExpirationDate, until.Format("2006-01-02")).
+		OrderAsc(cols.CertificateExpirationDate).
+		OrderDesc(cols.ExaminationDate).
+		Scan(&candidates)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(candidates))
+	for _, p := range candidates {
+		userIDs = append(userIDs, p.UserId)
+	}
+	renewed, err := m.Clone().
+		Fields(cols.UserId).
+		WhereIn(cols.UserId, userIDs).
+		WhereGT(cols.CertificateExpirationDate, until.Format("2006-01-02")).
+		Array()
+	if err != nil {
+		return nil, err
+	}
+	superseded := make(map[string]bool, len(renewed))
+	for _, v := range renewed {
+		superseded[v.String()] = true
+	}
+
+	var users []*entity.UserProfiles
+	err = dao.UserProfiles.Ctx(ctx).
+		WhereIn(dao.UserProfiles.Columns().Id, userIDs).
+		Where(dao.UserProfiles.Columns().IsActive, true).
+		Scan(&users)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(users))
+	for _, u := range users {
+		names[u.Id] = strings.TrimSpace(u.FirstName + " " + u.LastName)
+	}
+
+	// A driver can have several certificates in the window; the one expiring last is current.
+	latest := make(map[string]*entity.DotPhysicals, len(candidates))
+	for _, p := range candidates {
+		if superseded[p.UserId] {
+			continue
+		}
+		if _, active := names[p.UserId]; !active {
+			continue
+		}
+		latest[p.UserId] = p
+	}
+
+	out := make([]*model.ExpiringCertificate, 0, len(latest))
+	for _, p := range candidates {
+		if latest[p.UserId] != p {
+			continue
+		}
+		out = append(out, &model.ExpiringCertificate{
+			PhysicalID:          p.Id,
+			OrganizationID:      p.OrganizationId,
+			UserID:              p.UserId,
+			UserName:            names[p.UserId],
+			CertificateNumber:   p.CertificateNumber,
+			ExpirationDate:      p.CertificateExpirationDate,
+			DaysUntilExpiration: daysBetween(today, p.CertificateExpirationDate),
+			QualificationStatus: p.MedicalQualification,
+		})
+	}
+	return out, nil
+}
+
+// normalizeReminderDays validates a reminder ladder and returns it deduplicated in descending order
+func normalizeReminderDays(days []int) ([]int, error) {
+	if len(days) == 0 {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "at least one reminder day is required")
+	}
+	seen := make(map[int]bool, len(days))
+	out := make([]int, 0, len(days))
+	for _, d := range days {
+		if d < 1 || d > maxReminderDays {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "reminder days must be between 1 and %d", maxReminderDays)
+		}
+		if !seen[d] {
+			seen[d] = true
+			out = append(out, d)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(out)))
+	return out, nil
+}
+
+func decodeReminderSettings(row *entity.MedicalCertReminderSettings) *reminderSettings {
+	rs := &reminderSettings{EmailEnabled: row.EmailEnabled, SmsEnabled: row.SmsEnabled}
+	if err := json.Unmarshal([]byte(row.ReminderDays), &rs.ReminderDays); err != nil || len(rs.ReminderDays) == 0 {
+		rs.ReminderDays = defaultReminderDays
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(rs.ReminderDays)))
+	if row.NotificationRecipients != "" {
+		_ = json.Unmarshal([]byte(row.NotificationRecipients), &rs.Recipients)
+	}
+	return rs
+}
+
+// reminderRung returns the nearest rung of a descending ladder that daysLeft has reached
+func reminderRung(ladder []int, daysLeft int) (int, bool) {
+	rung, ok := 0, false
+	for _, d := range ladder {
+		if daysLeft <= d {
+			rung, ok = d, true
+		}
+	}
+	return rung, ok
+}
+
+// today returns the current date at midnight UTC
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the whole days from the date to the calendar date of t
+func daysBetween(from time.Time, t *gtime.Time) int {
+	to := time.Date(t.Year(), time.Month(t.Month()), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func expiresIn(days int) string {
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	}
+	return fmt.Sprintf("in %d days", days)
+}
@@ -0,0 +1,404 @@
+package dotphysical
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// defaultAppointmentLength is used for calendar invites when the booked slot is no longer known
+const defaultAppointmentLength = 30 * time.Minute
+
+// SchedulePhysical books a DOT physical into an availability slot and sends the driver a
+// calendar invite. Without a slot, the examiner's first open slot from the preferred date is
+// booked.
+func (s *sDotPhysical) SchedulePhysical(ctx context.Context, in *model.DOTPhysicalScheduleInput) (*model.DOTPhysicalAppointment, error) {
+	if in.OrganizationID == "" || in.UserID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID and user ID are required")
+	}
+	switch in.ExaminationType {
+	case "", consts.ExaminationInitial, consts.ExaminationRenewal, consts.ExaminationFollowUp:
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported examination type: %s", in.ExaminationType)
+	}
+
+	var driver *entity.UserProfiles
+	userCols := dao.UserProfiles.Columns()
+	err := dao.UserProfiles.Ctx(ctx).
+		Where(userCols.Id, in.UserID).
+		Where(userCols.OrganizationId, in.OrganizationID).
+		Scan(&driver)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil || !driver.IsActive {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "driver not found in organization")
+	}
+
+	cols := dao.DotPhysicals.Columns()
+	upcoming, err := dao.DotPhysicals.Ctx(ctx).
+		Where(cols.UserId, in.UserID).
+		Where(cols.Status, consts.PhysicalStatusScheduled).
+		WhereGT(cols.ScheduledDate, gtime.Now()).
+		Count()
+	if err != nil {
+		return nil, err
+	}
+	if upcoming > 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "driver already has an upcoming DOT physical; reschedule it instead")
+	}
+
+	var slot *model.ClinicAvailabilitySlot
+	switch {
+	case in.SlotID != "":
+		slot, err = s.getSlot(ctx, in.SlotID)
+	case in.ExaminerID != "":
+		slot, err = s.firstOpenSlot(ctx, in.ExaminerID, in.PreferredDate)
+		if err == nil && slot == nil {
+			err = gerror.NewCode(gcode.CodeNotFound, "examiner has no open appointment slots after the preferred date")
+		}
+	default:
+		err = gerror.NewCode(gcode.CodeMissingParameter, "an appointment slot or medical examiner is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = checkBookable(slot); err != nil {
+		return nil, err
+	}
+
+	physicalID := uuid.New().String()
+	err = dao.DotPhysicals.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data := appointmentData(slot)
+		data.Id = physicalID
+		data.OrganizationId = in.OrganizationID
+		data.UserId = in.UserID
+		data.Status = string(consts.PhysicalStatusScheduled)
+		data.ScheduledBy = nilIfEmpty(in.ScheduledBy)
+		data.ExaminationType = nilIfEmpty(string(in.ExaminationType))
+		data.SpecialRequirements = nilIfEmpty(in.SpecialRequirements)
+		if _, err := dao.DotPhysicals.Ctx(ctx).TX(tx).Data(data).Insert(); err != nil {
+			return err
+		}
+		return bookSlot(ctx, tx, slot.Slot.Id, physicalID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.appointment(ctx, physicalID, slot, "DOT physical scheduled", "")
+}
+
+// ReschedulePhysical moves a scheduled or missed DOT physical to another slot, releasing the slot
+// it held, and sends the driver an updated calendar invite.
+func (s *sDotPhysical) ReschedulePhysical(ctx context.Context, in *model.DOTPhysicalRescheduleInput) (*model.DOTPhysicalAppointment, error) {
+	physical, err := s.GetPhysical(ctx, in.PhysicalID)
+	if err != nil {
+		return nil, err
+	}
+	switch consts.PhysicalStatus(physical.Status) {
+	case consts.PhysicalStatusScheduled, consts.PhysicalStatusNoShow:
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "a %s DOT physical cannot be rescheduled", physical.Status)
+	}
+	if in.SlotID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "slot ID is required")
+	}
+
+	slot, err := s.getSlot(ctx, in.SlotID)
+	if err != nil {
+		return nil, err
+	}
+	if slot.Slot.PhysicalId == physical.Id {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "DOT physical is already booked into this slot")
+	}
+	if err = checkBookable(slot); err != nil {
+		return nil, err
+	}
+
+	err = dao.DotPhysicals.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if err := releaseSlot(ctx, tx, physical.Id); err != nil {
+			return err
+		}
+		if err := bookSlot(ctx, tx, slot.Slot.Id, physical.Id); err != nil {
+			return err
+		}
+		data := appointmentData(slot)
+		data.Status = string(consts.PhysicalStatusScheduled)
+		data.AppointmentSequence = gdb.Raw("appointment_sequence + 1")
+		_, err := dao.DotPhysicals.Ctx(ctx).TX(tx).
+			Where(dao.DotPhysicals.Columns().Id, physical.Id).
+			Data(data).Update()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.appointment(ctx, physical.Id, slot, "DOT physical rescheduled", in.Reason)
+}
+
+// CancelPhysical cancels a scheduled DOT physical, releases its slot and sends the driver a
+// calendar cancellation.
+func (s *sDotPhysical) CancelPhysical(ctx context.Context, physicalID, reason string) (*model.DOTPhysicalAppointment, error) {
+	physical, err := s.GetPhysical(ctx, physicalID)
+	if err != nil {
+		return nil, err
+	}
+	if physical.Status != string(consts.PhysicalStatusScheduled) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "a %s DOT physical cannot be cancelled", physical.Status)
+	}
+
+	slot, err := s.physicalSlot(ctx, physical.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dao.DotPhysicals.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if err := releaseSlot(ctx, tx, physical.Id); err != nil {
+			return err
+		}
+		_, err := dao.DotPhysicals.Ctx(ctx).TX(tx).
+			Where(dao.DotPhysicals.Columns().Id, physical.Id).
+			Data(do.DotPhysicals{
+				Status:              string(consts.PhysicalStatusCancelled),
+				CancelledAt:         gtime.Now(),
+				CancellationReason:  nilIfEmpty(reason),
+				AppointmentSequence: gdb.Raw("appointment_sequence + 1"),
+			}).Update()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.appointment(ctx, physical.Id, slot, "DOT physical cancelled", reason)
+}
+
+// MarkNoShow records that the driver missed a scheduled DOT physical and alerts the
+// organization's DERs. The slot stays booked since its time has passed.
+func (s *sDotPhysical) MarkNoShow(ctx context.Context, physicalID, notes string) (*entity.DotPhysicals, error) {
+	physical, err := s.GetPhysical(ctx, physicalID)
+	if err != nil {
+		return nil, err
+	}
+	if physical.Status != string(consts.PhysicalStatusScheduled) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation, "a %s DOT physical cannot be marked as a no-show", physical.Status)
+	}
+	if physical.ScheduledDate == nil || physical.ScheduledDate.After(gtime.Now()) {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "a DOT physical cannot be marked as a no-show before its appointment")
+	}
+
+	data := do.DotPhysicals{
+		Status:   string(consts.PhysicalStatusNoShow),
+		NoShowAt: gtime.Now(),
+	}
+	if notes = strings.TrimSpace(notes); notes != "" {
+		data.Notes = strings.TrimSpace(physical.Notes + "\n" + notes)
+	}
+	_, err = dao.DotPhysicals.Ctx(ctx).
+		Where(dao.DotPhysicals.Columns().Id, physical.Id).
+		Data(data).Update()
+	if err != nil {
+		return nil, err
+	}
+
+	if physical, err = s.GetPhysical(ctx, physical.Id); err != nil {
+		return nil, err
+	}
+	s.notifyNoShow(ctx, physical)
+	return physical, nil
+}
+
+// checkBookable rejects slots that are taken, withdrawn or past, or whose examiner cannot certify
+// drivers on the appointment date
+func checkBookable(slot *model.ClinicAvailabilitySlot) error {
+	switch {
+	case slot.Slot.PhysicalId != "" || slot.Slot.IsBlocked:
+		return gerror.NewCode(gcode.CodeInvalidOperation, "appointment slot is no longer available")
+	case slot.Slot.StartsAt == nil || !slot.Slot.StartsAt.After(gtime.Now()):
+		return gerror.NewCode(gcode.CodeInvalidOperation, "appointment slot has already passed")
+	case slot.Clinic == nil || !slot.Clinic.IsActive:
+		return gerror.NewCode(gcode.CodeInvalidOperation, "clinic is not accepting appointments")
+	case slot.Examiner == nil:
+		return gerror.NewCode(gcode.CodeNotFound, "medical examiner not found")
+	}
+	return registrationLapse(slot.Examiner, slot.Slot.StartsAt)
+}
+
+// appointmentData copies the slot's time, clinic and examiner onto a physical
+func appointmentData(slot *model.ClinicAvailabilitySlot) do.DotPhysicals {
+	e, c := slot.Examiner, slot.Clinic
+	return do.DotPhysicals{
+		ScheduledDate:          slot.Slot.StartsAt,
+		ClinicId:               c.Id,
+		ClinicName:             c.Name,
+		ClinicAddress:          nilIfEmpty(clinicAddress(c)),
+		ClinicPhone:            nilIfEmpty(c.Phone),
+		MedicalExaminerId:      e.Id,
+		ExaminerId:             nilIfEmpty(e.UserId),
+		ExaminerName:           fmt.Sprintf("%s %s", e.FirstName, e.LastName),
+		ExaminerLicenseNumber:  nilIfEmpty(e.LicenseNumber),
+		ExaminerRegistryNumber: e.RegistryNumber,
+	}
+}
+
+// appointment reloads the physical and sends the driver its calendar invite
+func (s *sDotPhysical) appointment(ctx context.Context, physicalID string, slot *model.ClinicAvailabilitySlot, title, reason string) (*model.DOTPhysicalAppointment, error) {
+	physical, err := s.GetPhysical(ctx, physicalID)
+	if err != nil {
+		return nil, err
+	}
+	out := &model.DOTPhysicalAppointment{Physical: physical, Slot: slot}
+	if out.CalendarInvite, err = s.sendInvite(ctx, physical, slot, title, reason); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// sendInvite renders the physical's calendar invite, or its cancellation, and sends it to the
+// driver. Delivery problems are logged rather than failing the booking.
+func (s *sDotPhysical) sendInvite(ctx context.Context, physical *entity.DotPhysicals, slot *model.ClinicAvailabilitySlot, title, reason string) (string, error) {
+	var driver *entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, physical.UserId).Scan(&driver)
+	if err != nil {
+		return "", err
+	}
+	if driver == nil || physical.ScheduledDate == nil {
+		return "", nil
+	}
+
+	loc := time.UTC
+	end := physical.ScheduledDate.Add(defaultAppointmentLength).Time
+	if slot != nil {
+		if loc, err = clinicLocation(slot.Clinic); err != nil {
+			loc = time.UTC
+		}
+		// An open-ended slot gives the invite no end
+		end = time.Time{}
+		if slot.Slot.EndsAt != nil {
+			end = slot.Slot.EndsAt.Time
+		}
+	}
+	start := physical.ScheduledDate.Time.In(loc)
+	cancelled := physical.Status == string(consts.PhysicalStatusCancelled)
+
+	description := fmt.Sprintf("DOT physical examination with %s.", physical.ExaminerName)
+	if physical.ClinicPhone != "" {
+		description += fmt.Sprintf(" Clinic phone: %s.", physical.ClinicPhone)
+	}
+	if !cancelled {
+		description += " Bring your driver's license, any glasses, contact lenses or hearing aids you use, " +
+			"and a list of your current medications."
+	}
+	emailConfig := service.Notification().GetEmailConfig(ctx)
+	event := &calendarEvent{
+		UID:           physical.Id + "@v1consortium",
+		Sequence:      physical.AppointmentSequence,
+		Cancelled:     cancelled,
+		Start:         start,
+		End:           end,
+		Summary:       "DOT physical examination",
+		Description:   description,
+		Location:      strings.Trim(physical.ClinicName+", "+physical.ClinicAddress, ", "),
+		OrganizerName: emailConfig.DefaultFromName,
+		OrganizerMail: emailConfig.DefaultFromEmail,
+		AttendeeName:  strings.TrimSpace(driver.FirstName + " " + driver.LastName),
+		AttendeeMail:  driver.Email,
+	}
+	invite := event.render()
+
+	when := start.Format("Monday, January 2, 2006 at 3:04 PM MST")
+	message := fmt.Sprintf("Your DOT physical with %s at %s is scheduled for %s.", physical.ExaminerName, event.Location, when)
+	method := "REQUEST"
+	if cancelled {
+		message = fmt.Sprintf("Your DOT physical with %s on %s has been cancelled.", physical.ExaminerName, when)
+		method = "CANCEL"
+	}
+	if reason != "" {
+		message += " Reason: " + reason
+	}
+
+	_, err = service.Notification().Notify(ctx, &model.NotificationInput{
+		OrganizationID: physical.OrganizationId,
+		UserID:         physical.UserId,
+		Category:       consts.NotificationCategoryAppointment,
+		Title:          title,
+		Message:        message,
+		Priority:       consts.NotificationPriorityNormal,
+		Channels:       []consts.NotificationType{consts.NotificationInApp, consts.NotificationEmail},
+		PhysicalID:     physical.Id,
+		Attachments: []*model.NotificationAttachment{{
+			Filename:    "dot-physical.ics",
+			ContentType: fmt.Sprintf("text/calendar; method=%s; charset=UTF-8", method),
+			Content:     []byte(invite),
+		}},
+	})
+	if err != nil {
+		g.Log().Warningf(ctx, "Failed to send calendar invite for DOT physical %s: %v", physical.Id, err)
+	}
+	return invite, nil
+}
+
+// notifyNoShow alerts the organization's DERs that the driver missed their DOT physical
+func (s *sDotPhysical) notifyNoShow(ctx context.Context, physical *entity.DotPhysicals) {
+	ders, err := organizationDERs(ctx, physical.OrganizationId)
+	if err != nil {
+		g.Log().Warningf(ctx, "Failed to load DERs for DOT physical no-show %s: %v", physical.Id, err)
+		return
+	}
+
+	var driver *entity.UserProfiles
+	err = dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, physical.UserId).Scan(&driver)
+	if err != nil || driver == nil {
+		g.Log().Warningf(ctx, "Failed to load driver for DOT physical no-show %s: %v", physical.Id, err)
+		return
+	}
+
+	message := fmt.Sprintf("%s %s did not attend their DOT physical with %s on %s. Reschedule the physical "+
+		"before their current medical certificate expires.",
+		driver.FirstName, driver.LastName, physical.ExaminerName, physical.ScheduledDate.Format("M j, Y"))
+	for _, der := range ders {
+		_, err = service.Notification().Notify(ctx, &model.NotificationInput{
+			OrganizationID: physical.OrganizationId,
+			UserID:         der.Id,
+			Category:       consts.NotificationCategoryComplianceAlert,
+			Title:          "Missed DOT physical",
+			Message:        message,
+			Priority:       consts.NotificationPriorityHigh,
+			Channels:       []consts.NotificationType{consts.NotificationInApp, consts.NotificationEmail},
+			PhysicalID:     physical.Id,
+		})
+		if err != nil {
+			g.Log().Warningf(ctx, "Failed to notify DER %s of DOT physical no-show %s: %v", der.Id, physical.Id, err)
+		}
+	}
+}
+
+// clinicAddress formats a clinic address as "line1, line2, city, ST zip", skipping blank parts
+func clinicAddress(c *entity.MedicalExaminerClinics) string {
+	parts := make([]string, 0, 4)
+	for _, p := range []string{c.AddressLine1, c.AddressLine2, c.City} {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if region := strings.TrimSpace(c.State + " " + c.ZipCode); region != "" {
+		parts = append(parts, region)
+	}
+	return strings.Join(parts, ", ")
+}
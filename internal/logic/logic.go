@@ -7,7 +7,12 @@ package logic
 import (
 	_ "v1consortium/internal/logic/auth"
 	_ "v1consortium/internal/logic/authorization"
+	_ "v1consortium/internal/logic/backgroundcheck"
 	_ "v1consortium/internal/logic/bizctx"
+	_ "v1consortium/internal/logic/document"
+	_ "v1consortium/internal/logic/dotphysical"
+	_ "v1consortium/internal/logic/mvr"
+	_ "v1consortium/internal/logic/notification"
 	_ "v1consortium/internal/logic/oldpkg"
 	_ "v1consortium/internal/logic/organization"
 	_ "v1consortium/internal/logic/session"
@@ -0,0 +1,31 @@
+package mvr
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// complianceRoles review and monitor the driving records of their own organization's drivers
+var complianceRoles = map[consts.UserRole]bool{
+	consts.RoleClientAdmin:   true,
+	consts.RoleDER:           true,
+	consts.RoleSafetyManager: true,
+}
+
+// complianceProfile returns the profile of the requesting user, who must be an internal user or
+// hold a compliance role in the organization
+func complianceProfile(ctx context.Context, userID, organizationID string) (*entity.UserProfiles, error) {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !service.Authorization().ActsFor(profile, organizationID, complianceRoles) {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "not permitted to manage the organization's driving records")
+	}
+	return profile, nil
+}
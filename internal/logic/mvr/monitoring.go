@@ -0,0 +1,450 @@
+package mvr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// receivedStatuses are the report statuses that carry provider results.
+var receivedStatuses = []string{
+	string(consts.MVRStatusReceived),
+	string(consts.MVRStatusReviewed),
+	string(consts.MVRStatusFlagged),
+}
+
+// EnableContinuousMonitoring creates or updates the monitoring enrollment for a driver.
+// A new or re-enabled enrollment is due immediately. The enrolling user must be an internal user or
+// hold a compliance role in the driver's organization.
+func (s *sMvr) EnableContinuousMonitoring(ctx context.Context, in *model.MVRMonitoringInput) (*entity.MvrMonitoringEnrollments, error) {
+	if in.UserID == "" || in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "user and organization are required")
+	}
+	frequency := in.Frequency
+	if frequency == "" {
+		frequency = consts.MonitoringAnnual
+	}
+	if frequency.Months() == 0 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported monitoring frequency: %s", frequency)
+	}
+	if _, err := complianceProfile(ctx, in.EnrolledBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	var driver entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, in.UserID).Scan(&driver)
+	if err != nil {
+		return nil, err
+	}
+	if driver.Id == "" || driver.OrganizationId != in.OrganizationID {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "driver not found in organization")
+	}
+
+	existing, err := s.getEnrollment(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := dao.MvrMonitoringEnrollments.Columns()
+	if existing == nil {
+		_, err = dao.MvrMonitoringEnrollments.Ctx(ctx).Data(do.MvrMonitoringEnrollments{
+			OrganizationId:      in.OrganizationID,
+			UserId:              in.UserID,
+			EnrolledBy:          in.EnrolledBy,
+			MonitoringFrequency: string(frequency),
+			ProviderName:        in.Provider,
+			IsEnabled:           in.Enabled,
+			NextCheckDate:       gtime.Now(),
+		}).Insert()
+	} else {
+		data := do.MvrMonitoringEnrollments{
+			MonitoringFrequency: string(frequency),
+			IsEnabled:           in.Enabled,
+		}
+		if in.Provider != "" {
+			data.ProviderName = in.Provider
+		}
+		if in.Enabled && !existing.IsEnabled {
+			data.NextCheckDate = gtime.Now()
+		} else if existing.LastCheckDate != nil {
+			data.NextCheckDate = existing.LastCheckDate.AddDate(0, frequency.Months(), 0)
+		}
+		_, err = dao.MvrMonitoringEnrollments.Ctx(ctx).Where(cols.Id, existing.Id).Data(data).Update()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getEnrollment(ctx, in.UserID)
+}
+
+// GetMonitoringStatus returns the driver's enrollment and the violations on their latest report.
+// The requesting user must be an internal user or hold a compliance role in the driver's
+// organization.
+func (s *sMvr) GetMonitoringStatus(ctx context.Context, userID string, requestedBy string) (*model.MVRMonitoringStatus, error) {
+	if userID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "user is required")
+	}
+	var driver *entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, userID).Scan(&driver)
+	if err != nil {
+		return nil, err
+	}
+	if driver == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "driver not found")
+	}
+	if _, err = complianceProfile(ctx, requestedBy, driver.OrganizationId); err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.getEnrollment(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	status := &model.MVRMonitoringStatus{Enrollment: enrollment}
+
+	// Monitoring pulls report the same violation again, so violations are counted once each
+	var violations []*entity.MvrViolations
+	err = dao.MvrViolations.Ctx(ctx).
+		WhereIn(dao.MvrViolations.Columns().MvrReportId,
+			dao.MvrReports.Ctx(ctx).Fields(dao.MvrReports.Columns().Id).Where(dao.MvrReports.Columns().UserId, userID)).
+		Scan(&violations)
+	if err != nil {
+		return nil, err
+	}
+	distinct := make(map[string]bool, len(violations))
+	for _, v := range violations {
+		distinct[violationKey(v)] = true
+	}
+	status.TotalViolations = len(distinct)
+
+	latest, err := s.latestReceivedReport(ctx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if latest != nil {
+		count, err := dao.MvrViolations.Ctx(ctx).Where(dao.MvrViolations.Columns().MvrReportId, latest.Id).Count()
+		if err != nil {
+			return nil, err
+		}
+		status.ActiveViolations = count
+	}
+
+	return status, nil
+}
+
+// OrderDueMonitoringReports orders an MVR for every enabled enrollment whose next check is due
+// and schedules the following check. It returns the number of reports ordered.
+func (s *sMvr) OrderDueMonitoringReports(ctx context.Context) (int, error) {
+	cols := dao.MvrMonitoringEnrollments.Columns()
+	m := dao.MvrMonitoringEnrollments.Ctx(ctx)
+
+	var due []*entity.MvrMonitoringEnrollments
+	err := m.Where(cols.IsEnabled, true).
+		Where(m.Builder().WhereNull(cols.NextCheckDate).WhereOrLTE(cols.NextCheckDate, gtime.Now())).
+		Scan(&due)
+	if err != nil {
+		return 0, err
+	}
+
+	ordered := 0
+	for _, enrollment := range due {
+		ok, err := s.orderMonitoringReport(ctx, enrollment)
+		if err != nil {
+			g.Log().Errorf(ctx, "Failed to order monitoring MVR for user %s: %v", enrollment.UserId, err)
+			continue
+		}
+		if ok {
+			ordered++
+		}
+	}
+	return ordered, nil
+}
+
+func (s *sMvr) orderMonitoringReport(ctx context.Context, enrollment *entity.MvrMonitoringEnrollments) (bool, error) {
+	var driver entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, enrollment.UserId).Scan(&driver)
+	if err != nil {
+		return false, err
+	}
+	if driver.CdlNumber == "" || driver.CdlState == "" {
+		g.Log().Warningf(ctx, "Skipping monitoring MVR for user %s: no license on file", enrollment.UserId)
+		return false, nil
+	}
+
+	reportCols := dao.MvrReports.Columns()
+	outstanding, err := dao.MvrReports.Ctx(ctx).
+		Where(reportCols.UserId, enrollment.UserId).
+		Where(reportCols.Status, consts.MVRStatusOrdered).
+		Count()
+	if err != nil {
+		return false, err
+	}
+
+	orderedBy := enrollment.EnrolledBy
+	if orderedBy == "" {
+		orderedBy = enrollment.UserId
+	}
+
+	now := gtime.Now()
+	next := now.AddDate(0, consts.MonitoringFrequency(enrollment.MonitoringFrequency).Months(), 0)
+
+	err = dao.MvrMonitoringEnrollments.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		// An order still waiting on the provider covers this cycle.
+		if outstanding == 0 {
+			_, err := dao.MvrReports.Ctx(ctx).TX(tx).Data(do.MvrReports{
+				OrganizationId: enrollment.OrganizationId,
+				UserId:         enrollment.UserId,
+				Status:         consts.MVRStatusOrdered,
+				OrderedDate:    now,
+				OrderedBy:      orderedBy,
+				LicenseNumber:  driver.CdlNumber,
+				LicenseState:   driver.CdlState,
+				ProviderName:   enrollment.ProviderName,
+			}).Insert()
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := dao.MvrMonitoringEnrollments.Ctx(ctx).TX(tx).
+			Where(dao.MvrMonitoringEnrollments.Columns().Id, enrollment.Id).
+			Data(do.MvrMonitoringEnrollments{
+				LastCheckDate: now,
+				NextCheckDate: next,
+			}).Update()
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return outstanding == 0, nil
+}
+
+// ProcessMonitoringResults compares each monitored driver's newest received report against the
+// previous one and raises an alert when there are new violations or the license status changed.
+// It returns the number of alerts raised.
+func (s *sMvr) ProcessMonitoringResults(ctx context.Context) (int, error) {
+	var enrollments []*entity.MvrMonitoringEnrollments
+	err := dao.MvrMonitoringEnrollments.Ctx(ctx).
+		Where(dao.MvrMonitoringEnrollments.Columns().IsEnabled, true).
+		Scan(&enrollments)
+	if err != nil {
+		return 0, err
+	}
+
+	alerts := 0
+	for _, enrollment := range enrollments {
+		alerted, err := s.processMonitoringResult(ctx, enrollment)
+		if err != nil {
+			g.Log().Errorf(ctx, "Failed to process monitoring MVR for user %s: %v", enrollment.UserId, err)
+			continue
+		}
+		if alerted {
+			alerts++
+		}
+	}
+	return alerts, nil
+}
+
+func (s *sMvr) processMonitoringResult(ctx context.Context, enrollment *entity.MvrMonitoringEnrollments) (bool, error) {
+	latest, err := s.latestReceivedReport(ctx, enrollment.UserId, nil)
+	if err != nil || latest == nil || latest.Id == enrollment.LastMvrReportId {
+		return false, err
+	}
+
+	previous, err := s.latestReceivedReport(ctx, enrollment.UserId, latest)
+	if err != nil {
+		return false, err
+	}
+
+	alerted := false
+	// The first report only establishes the baseline.
+	if previous != nil {
+		diff, err := s.DiffReports(ctx, previous, latest)
+		if err != nil {
+			return false, err
+		}
+		if diff.HasChanges() {
+			if err := s.raiseMonitoringAlert(ctx, latest, diff); err != nil {
+				return false, err
+			}
+			alerted = true
+		}
+	}
+
+	_, err = dao.MvrMonitoringEnrollments.Ctx(ctx).
+		Where(dao.MvrMonitoringEnrollments.Columns().Id, enrollment.Id).
+		Data(do.MvrMonitoringEnrollments{LastMvrReportId: latest.Id}).
+		Update()
+	return alerted, err
+}
+
+// DiffReports returns the violations on current that were not on previous and any change in
+// license status between the two reports.
+func (s *sMvr) DiffReports(ctx context.Context, previous, current *entity.MvrReports) (*model.MVRReportDiff, error) {
+	previousViolations, err := s.reportViolations(ctx, previous.Id)
+	if err != nil {
+		return nil, err
+	}
+	currentViolations, err := s.reportViolations(ctx, current.Id)
+	if err != nil {
+		return nil, err
+	}
+	return diffReports(previous, current, previousViolations, currentViolations), nil
+}
+
+func diffReports(previous, current *entity.MvrReports, previousViolations, currentViolations []*entity.MvrViolations) *model.MVRReportDiff {
+	diff := &model.MVRReportDiff{
+		PreviousLicenseStatus: previous.LicenseStatus,
+		CurrentLicenseStatus:  current.LicenseStatus,
+		LicenseStatusChanged:  !strings.EqualFold(previous.LicenseStatus, current.LicenseStatus),
+	}
+
+	seen := make(map[string]bool, len(previousViolations))
+	for _, v := range previousViolations {
+		seen[violationKey(v)] = true
+	}
+	for _, v := range currentViolations {
+		if !seen[violationKey(v)] {
+			diff.NewViolations = append(diff.NewViolations, v)
+		}
+	}
+	return diff
+}
+
+// violationKey identifies the same violation across reports from different pulls.
+func violationKey(v *entity.MvrViolations) string {
+	code := v.ViolationCode
+	if code == "" {
+		code = v.ViolationType
+	}
+	date := ""
+	if v.ViolationDate != nil {
+		date = v.ViolationDate.Format("Y-m-d")
+	}
+	return strings.ToLower(strings.Join([]string{code, date, v.State, v.CaseNumber}, "|"))
+}
+
+func (s *sMvr) raiseMonitoringAlert(ctx context.Context, report *entity.MvrReports, diff *model.MVRReportDiff) error {
+	var parts []string
+	if len(diff.NewViolations) > 0 {
+		parts = append(parts, fmt.Sprintf("%d new violation(s)", len(diff.NewViolations)))
+	}
+	if diff.LicenseStatusChanged {
+		parts = append(parts, fmt.Sprintf("license status changed from %q to %q", diff.PreviousLicenseStatus, diff.CurrentLicenseStatus))
+	}
+
+	priority := consts.NotificationPriorityHigh
+	for _, v := range diff.NewViolations {
+		if v.Disqualifying || v.AffectsCdl {
+			priority = consts.NotificationPriorityUrgent
+		}
+	}
+	if diff.LicenseStatusChanged {
+		priority = consts.NotificationPriorityUrgent
+	}
+
+	contacts, err := safetyContacts(ctx, report.OrganizationId)
+	if err != nil {
+		return err
+	}
+	if len(contacts) == 0 {
+		g.Log().Warningf(ctx, "No safety contacts to alert of changes on MVR report %s in organization %s", report.Id, report.OrganizationId)
+		return nil
+	}
+	message := fmt.Sprintf("Monitoring MVR for license %s (%s): %s.", report.LicenseNumber, report.LicenseState, strings.Join(parts, "; "))
+	for _, contact := range contacts {
+		_, err = service.Notification().Notify(ctx, &model.NotificationInput{
+			OrganizationID: report.OrganizationId,
+			UserID:         contact.Id,
+			Title:          "MVR monitoring alert",
+			Message:        message,
+			Category:       consts.NotificationCategoryViolationAlert,
+			Priority:       priority,
+			MvrReportID:    report.Id,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// safetyContacts returns the organization's active designated employer representatives and
+// safety managers, who are alerted of changes on their drivers' records
+func safetyContacts(ctx context.Context, organizationID string) ([]*entity.UserProfiles, error) {
+	var contacts []*entity.UserProfiles
+	cols := dao.UserProfiles.Columns()
+	err := dao.UserProfiles.Ctx(ctx).
+		Where(cols.OrganizationId, organizationID).
+		WhereIn(cols.Role, []string{string(consts.RoleDER), string(consts.RoleSafetyManager)}).
+		Where(cols.IsActive, true).
+		Scan(&contacts)
+	return contacts, err
+}
+
+func (s *sMvr) getEnrollment(ctx context.Context, userID string) (*entity.MvrMonitoringEnrollments, error) {
+	var enrollment *entity.MvrMonitoringEnrollments
+	err := dao.MvrMonitoringEnrollments.Ctx(ctx).
+		Where(dao.MvrMonitoringEnrollments.Columns().UserId, userID).
+		Scan(&enrollment)
+	if err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// latestReceivedReport returns the driver's most recent report with provider results,
+// optionally restricted to reports received before the given one.
+func (s *sMvr) latestReceivedReport(ctx context.Context, userID string, before *entity.MvrReports) (*entity.MvrReports, error) {
+	cols := dao.MvrReports.Columns()
+	m := dao.MvrReports.Ctx(ctx).
+		Where(cols.UserId, userID).
+		WhereIn(cols.Status, receivedStatuses)
+	if before != nil {
+		m = m.WhereNot(cols.Id, before.Id)
+		if before.ReportReceivedDate != nil {
+			m = m.WhereLTE(cols.ReportReceivedDate, before.ReportReceivedDate)
+		} else if before.CreatedAt != nil {
+			m = m.WhereLTE(cols.CreatedAt, before.CreatedAt)
+		}
+	}
+
+	var report *entity.MvrReports
+	err := m.Order(cols.ReportReceivedDate + " DESC NULLS LAST").OrderDesc(cols.CreatedAt).Limit(1).Scan(&report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *sMvr) reportViolations(ctx context.Context, reportID string) ([]*entity.MvrViolations, error) {
+	var violations []*entity.MvrViolations
+	err := dao.MvrViolations.Ctx(ctx).
+		Where(dao.MvrViolations.Columns().MvrReportId, reportID).
+		Scan(&violations)
+	if err != nil {
+		return nil, err
+	}
+	return violations, nil
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
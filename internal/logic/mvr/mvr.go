@@ -0,0 +1,15 @@
+package mvr
+
+import (
+	"v1consortium/internal/service"
+)
+
+func new() service.IMvr {
+	return &sMvr{}
+}
+
+func init() {
+	service.RegisterMvr(new())
+}
+
+type sMvr struct{}
@@ -0,0 +1,158 @@
+package mvr
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/mvrparser"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// ParseRawReport parses the report's raw provider data, updates the license details and adds
+// any violations not already on the report. An empty format detects it from the payload.
+func (s *sMvr) ParseRawReport(ctx context.Context, reportID, format string) (*model.MVRParseOutput, error) {
+	report, err := s.getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(report.RawReportData) == "" {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "MVR report has no raw provider data")
+	}
+
+	parsed, err := parseRawData(report.RawReportData, format)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.LicenseNumber != "" && !sameLicense(parsed.LicenseNumber, report.LicenseNumber) {
+		return nil, gerror.NewCodef(gcode.CodeInvalidOperation,
+			"raw report is for license %s, not the ordered license %s", parsed.LicenseNumber, report.LicenseNumber)
+	}
+
+	existing, err := s.reportViolations(ctx, report.Id)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(existing))
+	for _, v := range existing {
+		seen[violationKey(v)] = true
+	}
+
+	out := &model.MVRParseOutput{Format: parsed.Format}
+	var added []string
+	err = dao.MvrReports.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		data := do.MvrReports{
+			LicenseStatus:         nilIfEmpty(parsed.LicenseStatus),
+			LicenseClass:          nilIfEmpty(parsed.LicenseClass),
+			LicenseEndorsements:   nilIfEmpty(strings.Join(parsed.Endorsements, ",")),
+			LicenseExpirationDate: toGTime(parsed.LicenseExpiration),
+		}
+		if report.ReportDate == nil {
+			data.ReportDate = toGTime(parsed.ReportDate)
+		}
+		_, err := dao.MvrReports.Ctx(ctx).TX(tx).
+			Where(dao.MvrReports.Columns().Id, report.Id).
+			Data(data).Update()
+		if err != nil {
+			return err
+		}
+
+		for _, v := range parsed.Violations {
+			in := &model.MVRViolationInput{
+				ReportID:       report.Id,
+				ViolationCode:  v.Code,
+				ViolationDate:  toGTime(v.Date),
+				ConvictionDate: toGTime(v.ConvictionDate),
+				State:          v.State,
+				CourtName:      v.CourtName,
+				Description:    v.Description,
+				FineAmount:     v.FineAmount,
+				Points:         v.Points,
+				CaseNumber:     v.CaseNumber,
+			}
+			key := violationKey(&entity.MvrViolations{
+				ViolationCode: strings.ToUpper(v.Code),
+				ViolationDate: in.ViolationDate,
+				State:         v.State,
+				CaseNumber:    v.CaseNumber,
+			})
+			if seen[key] {
+				out.DuplicatesSkipped++
+				continue
+			}
+			seen[key] = true
+
+			id, err := s.insertViolation(ctx, tx, report, in)
+			if err != nil {
+				return err
+			}
+			added = append(added, id)
+		}
+
+		_, err = s.recomputeReport(ctx, tx, report)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Report, err = s.getReport(ctx, report.Id); err != nil {
+		return nil, err
+	}
+	if len(added) > 0 {
+		err = dao.MvrViolations.Ctx(ctx).
+			WhereIn(dao.MvrViolations.Columns().Id, added).
+			OrderAsc(dao.MvrViolations.Columns().ViolationDate).
+			Scan(&out.Violations)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// parseRawData parses the payload with the named format, or detects the format when empty
+func parseRawData(raw, format string) (*mvrparser.Report, error) {
+	var (
+		parsed *mvrparser.Report
+		err    error
+	)
+	if format != "" {
+		p, ok := mvrparser.Lookup(format)
+		if !ok {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported MVR format: %s", format)
+		}
+		parsed, err = mvrparser.ParseAs(p, []byte(raw))
+	} else {
+		parsed, err = mvrparser.Parse([]byte(raw))
+	}
+
+	switch {
+	case errors.Is(err, mvrparser.ErrUnknownFormat):
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "raw report data is not in a supported MVR format")
+	case err != nil:
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err, "failed to parse raw report data")
+	}
+	return parsed, nil
+}
+
+// sameLicense compares license numbers ignoring case, spaces and dashes
+func sameLicense(a, b string) bool {
+	normalize := strings.NewReplacer(" ", "", "-", "")
+	return strings.EqualFold(normalize.Replace(a), normalize.Replace(b))
+}
+
+func toGTime(t *time.Time) *gtime.Time {
+	if t == nil {
+		return nil
+	}
+	return gtime.New(*t)
+}
@@ -0,0 +1,335 @@
+package mvr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+const (
+	// reviewIntervalMonths is the annual review cycle required by 49 CFR 391.25.
+	reviewIntervalMonths = 12
+	// reviewLeadDays is how far ahead of the due date a review task is created.
+	reviewLeadDays = 30
+)
+
+// GenerateAnnualReviews creates a pending review for every active CDL driver whose next annual
+// review falls within the lead time. Drivers with an open review are skipped. It returns the
+// number of reviews created.
+func (s *sMvr) GenerateAnnualReviews(ctx context.Context) (int, error) {
+	profileCols := dao.UserProfiles.Columns()
+	var drivers []*entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).
+		Where(profileCols.IsActive, true).
+		WhereNotNull(profileCols.CdlNumber).
+		WhereNot(profileCols.CdlNumber, "").
+		Scan(&drivers)
+	if err != nil {
+		return 0, err
+	}
+
+	cols := dao.MvrAnnualReviews.Columns()
+	pending, err := dao.MvrAnnualReviews.Ctx(ctx).
+		Fields(cols.UserId).
+		Where(cols.Status, consts.MVRReviewPending).
+		Array()
+	if err != nil {
+		return 0, err
+	}
+	open := make(map[string]bool, len(pending))
+	for _, v := range pending {
+		open[v.String()] = true
+	}
+
+	var completed []*entity.MvrAnnualReviews
+	err = dao.MvrAnnualReviews.Ctx(ctx).
+		Fields(cols.UserId, fmt.Sprintf("MAX(%s) AS %s", cols.ReviewedAt, cols.ReviewedAt)).
+		Where(cols.Status, consts.MVRReviewCompleted).
+		Group(cols.UserId).
+		Scan(&completed)
+	if err != nil {
+		return 0, err
+	}
+	lastReviewed := make(map[string]*gtime.Time, len(completed))
+	for _, r := range completed {
+		lastReviewed[r.UserId] = r.ReviewedAt
+	}
+
+	now := gtime.Now()
+	created := 0
+	for _, driver := range drivers {
+		if open[driver.Id] {
+			continue
+		}
+
+		due := now.AddDate(0, 0, reviewLeadDays)
+		if last := lastReviewed[driver.Id]; last != nil {
+			due = last.AddDate(0, reviewIntervalMonths, 0)
+			if now.Before(due.AddDate(0, 0, -reviewLeadDays)) {
+				continue
+			}
+		}
+
+		report, err := s.latestReceivedReport(ctx, driver.Id, nil)
+		if err != nil {
+			return created, err
+		}
+		var reportID interface{}
+		if report != nil {
+			reportID = report.Id
+		}
+
+		_, err = dao.MvrAnnualReviews.Ctx(ctx).Data(do.MvrAnnualReviews{
+			OrganizationId: driver.OrganizationId,
+			UserId:         driver.Id,
+			MvrReportId:    reportID,
+			Status:         consts.MVRReviewPending,
+			DueDate:        due,
+		}).Insert()
+		if err != nil {
+			g.Log().Errorf(ctx, "Failed to create annual MVR review for user %s: %v", driver.Id, err)
+			continue
+		}
+		created++
+	}
+	return created, nil
+}
+
+// ListAnnualReviews returns a page of annual reviews ordered by due date, and the total count.
+func (s *sMvr) ListAnnualReviews(ctx context.Context, in *model.MVRReviewListInput) ([]*entity.MvrAnnualReviews, int, error) {
+	cols := dao.MvrAnnualReviews.Columns()
+	m := dao.MvrAnnualReviews.Ctx(ctx).Where(cols.OrganizationId, in.OrganizationID)
+	if in.UserID != "" {
+		m = m.Where(cols.UserId, in.UserID)
+	}
+	if in.Status != "" {
+		m = m.Where(cols.Status, in.Status)
+	}
+
+	var (
+		reviews []*entity.MvrAnnualReviews
+		total   int
+	)
+	err := m.OrderAsc(cols.DueDate).Offset(in.Offset).Limit(in.Limit).ScanAndCount(&reviews, &total, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	return reviews, total, nil
+}
+
+// SignOffAnnualReview records the reviewer's determination against the driver's latest MVR,
+// stores a generated driver review record in documents linked to the report, and marks the
+// report reviewed. The reviewer must be an internal user or hold a compliance role in the driver's
+// organization, may not review their own record, and the MVR must be less than a year old.
+func (s *sMvr) SignOffAnnualReview(ctx context.Context, in *model.MVRReviewSignOffInput) (*model.MVRReviewSignOffOutput, error) {
+	switch in.Determination {
+	case consts.MVRDeterminationMeetsRequirements, consts.MVRDeterminationDisqualified:
+	default:
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported review determination: %s", in.Determination)
+	}
+	if in.ReviewerID == "" {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "reviewer is required")
+	}
+
+	review, err := s.getAnnualReview(ctx, in.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status == string(consts.MVRReviewCompleted) {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "review has already been signed off")
+	}
+	reviewer, err := complianceProfile(ctx, in.ReviewerID, review.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if reviewer.Id == review.UserId {
+		return nil, gerror.NewCode(gcode.CodeNotAuthorized, "drivers cannot sign off their own annual review")
+	}
+
+	report, err := s.latestReceivedReport(ctx, review.UserId, nil)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "no received MVR report on file for this driver")
+	}
+	lastMvrDate := report.ReportDate
+	if lastMvrDate == nil {
+		lastMvrDate = report.ReportReceivedDate
+	}
+	if lastMvrDate == nil || lastMvrDate.AddDate(0, reviewIntervalMonths, 0).Before(gtime.Now()) {
+		return nil, gerror.NewCode(gcode.CodeInvalidOperation, "the driver's latest MVR is more than 12 months old; order a new MVR before signing off")
+	}
+
+	var driver entity.UserProfiles
+	if err = dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, review.UserId).Scan(&driver); err != nil {
+		return nil, err
+	}
+
+	violations, err := s.reportViolations(ctx, report.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := gtime.Now()
+	record := renderReviewRecord(&driver, reviewer, report, violations, in, now)
+	documentID := uuid.New().String()
+	fileName := fmt.Sprintf("mvr-annual-review-%s.txt", now.Format("Y-m-d"))
+	storagePath := fmt.Sprintf("%s/%s/mvr-reviews/%s.txt", review.OrganizationId, review.UserId, documentID)
+
+	store, err := service.Document().Store(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := service.Document().StoreContent(ctx, review.OrganizationId, storagePath, bytes.NewReader(record),
+		&blobstore.PutOptions{ContentType: "text/plain"}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dao.MvrAnnualReviews.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.Documents.Ctx(ctx).TX(tx).Data(do.Documents{
+			Id:              documentID,
+			OrganizationId:  review.OrganizationId,
+			UserId:          review.UserId,
+			DocumentType:    consts.DocTypeMVRReport,
+			Title:           fmt.Sprintf("Annual MVR Review - %s %s - %d", driver.FirstName, driver.LastName, now.Year()),
+			Description:     fmt.Sprintf("Annual review of driving record: %s", in.Determination),
+			FileName:        fileName,
+			FileSize:        stored.Size,
+			MimeType:        "text/plain",
+			StoragePath:     stored.Object.Key,
+			StorageBucket:   stored.Object.Bucket,
+			ChecksumSha256:  stored.Object.Checksum,
+			MvrReportId:     report.Id,
+			UploadedBy:      in.ReviewerID,
+			IsConfidential:  true,
+			IsEncrypted:     true,
+			EncryptionKeyId: stored.EncryptionKeyID,
+		}).Insert()
+		if err != nil {
+			return err
+		}
+
+		// only one sign-off of the review succeeds when several race
+		result, err := dao.MvrAnnualReviews.Ctx(ctx).TX(tx).
+			Where(dao.MvrAnnualReviews.Columns().Id, review.Id).
+			Where(dao.MvrAnnualReviews.Columns().Status, consts.MVRReviewPending).
+			Data(do.MvrAnnualReviews{
+				MvrReportId:   report.Id,
+				Status:        consts.MVRReviewCompleted,
+				Determination: in.Determination,
+				ReviewedBy:    in.ReviewerID,
+				ReviewedAt:    now,
+				Notes:         in.Notes,
+				DocumentId:    documentID,
+			}).Update()
+		if err != nil {
+			return err
+		}
+		signedOff, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if signedOff == 0 {
+			return gerror.NewCode(gcode.CodeInvalidOperation, "review has already been signed off")
+		}
+
+		reportData := do.MvrReports{
+			ReviewedBy:   in.ReviewerID,
+			ReviewedDate: now,
+		}
+		if report.Status == string(consts.MVRStatusReceived) {
+			reportData.Status = consts.MVRStatusReviewed
+		}
+		_, err = dao.MvrReports.Ctx(ctx).TX(tx).
+			Where(dao.MvrReports.Columns().Id, report.Id).
+			Data(reportData).Update()
+		if err != nil {
+			return err
+		}
+
+		_, err = dao.ComplianceStatus.Ctx(ctx).TX(tx).
+			Where(dao.ComplianceStatus.Columns().UserId, review.UserId).
+			Data(do.ComplianceStatus{
+				MvrCurrent:  in.Determination == consts.MVRDeterminationMeetsRequirements,
+				LastMvrDate: lastMvrDate,
+				NextMvrDue:  now.AddDate(0, reviewIntervalMonths, 0),
+			}).Update()
+		return err
+	})
+	if err != nil {
+		// The record is only kept with the sign-off it documents
+		if delErr := store.Delete(context.WithoutCancel(ctx), stored.Object.Key); delErr != nil {
+			g.Log().Warningf(ctx, "failed to remove orphaned review record %s: %v", stored.Object.Key, delErr)
+		}
+		return nil, err
+	}
+
+	out := &model.MVRReviewSignOffOutput{}
+	if out.Review, err = s.getAnnualReview(ctx, review.Id); err != nil {
+		return nil, err
+	}
+	if err = dao.Documents.Ctx(ctx).Where(dao.Documents.Columns().Id, documentID).Scan(&out.Document); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// renderReviewRecord produces the driver review record kept in the driver qualification file.
+func renderReviewRecord(driver, reviewer *entity.UserProfiles, report *entity.MvrReports, violations []*entity.MvrViolations, in *model.MVRReviewSignOffInput, reviewedAt *gtime.Time) []byte {
+	var b strings.Builder
+	fmt.Fprintln(&b, "ANNUAL REVIEW OF DRIVING RECORD (49 CFR 391.25)")
+	fmt.Fprintln(&b)
+	fmt.Fprintf(&b, "Driver:          %s %s\n", driver.FirstName, driver.LastName)
+	fmt.Fprintf(&b, "License:         %s (%s)\n", report.LicenseNumber, report.LicenseState)
+	fmt.Fprintf(&b, "License status:  %s\n", report.LicenseStatus)
+	fmt.Fprintf(&b, "MVR report:      %s\n", report.Id)
+	fmt.Fprintf(&b, "Report date:     %s\n", report.ReportDate.Format("Y-m-d"))
+	fmt.Fprintln(&b)
+
+	fmt.Fprintf(&b, "Violations (%d):\n", len(violations))
+	if len(violations) == 0 {
+		fmt.Fprintln(&b, "  None")
+	}
+	for _, v := range violations {
+		fmt.Fprintf(&b, "  - %s %s %s %s (%s, %d points)\n",
+			v.ViolationDate.Format("Y-m-d"), v.ViolationCode, v.ViolationType, v.ViolationDescription, v.Severity, v.Points)
+	}
+	fmt.Fprintln(&b)
+
+	fmt.Fprintf(&b, "Determination:   %s\n", in.Determination)
+	if in.Notes != "" {
+		fmt.Fprintf(&b, "Notes:           %s\n", in.Notes)
+	}
+	fmt.Fprintf(&b, "Reviewed by:     %s %s\n", reviewer.FirstName, reviewer.LastName)
+	fmt.Fprintf(&b, "Reviewed at:     %s\n", reviewedAt.Format("Y-m-d H:i:s T"))
+	return []byte(b.String())
+}
+
+func (s *sMvr) getAnnualReview(ctx context.Context, reviewID string) (*entity.MvrAnnualReviews, error) {
+	var review *entity.MvrAnnualReviews
+	err := dao.MvrAnnualReviews.Ctx(ctx).Where(dao.MvrAnnualReviews.Columns().Id, reviewID).Scan(&review)
+	if err != nil {
+		return nil, err
+	}
+	if review == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "MVR review not found")
+	}
+	return review, nil
+}
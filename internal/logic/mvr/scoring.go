@@ -0,0 +1,300 @@
+package mvr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// defaultSeverityPoints are used for violations whose code is not in the code table.
+var defaultSeverityPoints = map[consts.ViolationSeverity]int{
+	consts.ViolationSeverityMinor:   2,
+	consts.ViolationSeverityMajor:   4,
+	consts.ViolationSeveritySerious: 6,
+}
+
+// defaultThresholds apply when neither the organization nor the system default row exists.
+var defaultThresholds = entity.MvrScoringThresholds{
+	PointsThreshold:          8,
+	MajorViolationsThreshold: 1,
+	TotalViolationsThreshold: 4,
+	LookbackMonths:           36,
+}
+
+// AddViolation classifies the violation from the code table, stores it and recomputes the
+// report's counters and RequiresAction flag.
+func (s *sMvr) AddViolation(ctx context.Context, in *model.MVRViolationInput) (*entity.MvrViolations, *entity.MvrReports, error) {
+	report, err := s.getReport(ctx, in.ReportID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var violationID string
+	err = dao.MvrViolations.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		if violationID, err = s.insertViolation(ctx, tx, report, in); err != nil {
+			return err
+		}
+		_, err = s.recomputeReport(ctx, tx, report)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var violation entity.MvrViolations
+	err = dao.MvrViolations.Ctx(ctx).Where(dao.MvrViolations.Columns().Id, violationID).Scan(&violation)
+	if err != nil {
+		return nil, nil, err
+	}
+	report, err = s.getReport(ctx, report.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &violation, report, nil
+}
+
+// insertViolation classifies the violation from the code table and inserts it, returning its ID.
+// Points reported by the state are used only when the code is not in the code table.
+func (s *sMvr) insertViolation(ctx context.Context, tx gdb.TX, report *entity.MvrReports, in *model.MVRViolationInput) (string, error) {
+	code, err := s.lookupViolationCode(ctx, report.OrganizationId, in.ViolationCode)
+	if err != nil {
+		return "", err
+	}
+
+	severity := in.Severity
+	if severity == "" {
+		severity = consts.ViolationSeverityMinor
+	}
+	if _, ok := defaultSeverityPoints[severity]; !ok {
+		return "", gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported violation severity: %s", severity)
+	}
+	points := defaultSeverityPoints[severity]
+	if in.Points > 0 {
+		points = in.Points
+	}
+	disqualifying := false
+	affectsCdl := in.AffectsCdl
+	description := in.Description
+	if code != nil {
+		severity = consts.ViolationSeverity(code.Severity)
+		points = code.Points
+		disqualifying = code.Disqualifying
+		affectsCdl = affectsCdl || code.AffectsCdl
+		if description == "" {
+			description = code.Description
+		}
+	}
+
+	violationID := uuid.New().String()
+	_, err = dao.MvrViolations.Ctx(ctx).TX(tx).Data(do.MvrViolations{
+		Id:                           violationID,
+		MvrReportId:                  report.Id,
+		ViolationDate:                in.ViolationDate,
+		ViolationCode:                strings.ToUpper(strings.TrimSpace(in.ViolationCode)),
+		ViolationDescription:         description,
+		ViolationType:                in.ViolationType,
+		Severity:                     string(severity),
+		ConvictionDate:               in.ConvictionDate,
+		FineAmount:                   in.FineAmount,
+		Points:                       points,
+		State:                        in.State,
+		CourtName:                    nilIfEmpty(in.CourtName),
+		CaseNumber:                   in.CaseNumber,
+		Disqualifying:                disqualifying,
+		RequiresEmployerNotification: in.RequiresEmployerNotification,
+		AffectsCdl:                   affectsCdl,
+	}).Insert()
+	if err != nil {
+		return "", err
+	}
+	return violationID, nil
+}
+
+// RecomputeReport rescores all violations on the report and updates its counters and
+// RequiresAction flag.
+func (s *sMvr) RecomputeReport(ctx context.Context, reportID string) (*model.MVRReportScore, error) {
+	report, err := s.getReport(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	var score *model.MVRReportScore
+	err = dao.MvrReports.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		score, err = s.recomputeReport(ctx, tx, report)
+		return err
+	})
+	return score, err
+}
+
+func (s *sMvr) recomputeReport(ctx context.Context, tx gdb.TX, report *entity.MvrReports) (*model.MVRReportScore, error) {
+	var violations []*entity.MvrViolations
+	err := dao.MvrViolations.Ctx(ctx).TX(tx).
+		Where(dao.MvrViolations.Columns().MvrReportId, report.Id).
+		Scan(&violations)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds, err := s.scoringThresholds(ctx, report.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := report.ReportDate
+	if asOf == nil {
+		asOf = gtime.Now()
+	}
+	score := scoreViolations(violations, thresholds, asOf)
+
+	_, err = dao.MvrReports.Ctx(ctx).TX(tx).
+		Where(dao.MvrReports.Columns().Id, report.Id).
+		Data(do.MvrReports{
+			TotalViolations: score.TotalViolations,
+			MajorViolations: score.MajorViolations,
+			MinorViolations: score.MinorViolations,
+			RequiresAction:  score.RequiresAction,
+		}).Update()
+	if err != nil {
+		return nil, err
+	}
+	return score, nil
+}
+
+// scoreViolations counts the report's violations and evaluates the action thresholds. The
+// counters cover every violation on the report; thresholds only consider violations inside
+// the lookback window ending at asOf.
+func scoreViolations(violations []*entity.MvrViolations, thresholds *entity.MvrScoringThresholds, asOf *gtime.Time) *model.MVRReportScore {
+	score := &model.MVRReportScore{}
+
+	var windowStart *gtime.Time
+	if thresholds.LookbackMonths > 0 {
+		windowStart = asOf.AddDate(0, -thresholds.LookbackMonths, 0)
+	}
+
+	windowMajor, windowTotal := 0, 0
+	disqualifying, notify := false, false
+	for _, v := range violations {
+		score.TotalViolations++
+		major := isMajor(consts.ViolationSeverity(v.Severity))
+		if major {
+			score.MajorViolations++
+		} else {
+			score.MinorViolations++
+		}
+
+		if windowStart != nil && v.ViolationDate != nil && v.ViolationDate.Before(windowStart) {
+			continue
+		}
+		windowTotal++
+		score.Points += v.Points
+		if major {
+			windowMajor++
+		}
+		disqualifying = disqualifying || v.Disqualifying
+		notify = notify || v.RequiresEmployerNotification
+	}
+
+	if disqualifying {
+		score.Reasons = append(score.Reasons, "disqualifying violation")
+	}
+	if notify {
+		score.Reasons = append(score.Reasons, "violation requires employer notification")
+	}
+	if t := thresholds.PointsThreshold; t > 0 && score.Points >= t {
+		score.Reasons = append(score.Reasons, fmt.Sprintf("%d points (threshold %d)", score.Points, t))
+	}
+	if t := thresholds.MajorViolationsThreshold; t > 0 && windowMajor >= t {
+		score.Reasons = append(score.Reasons, fmt.Sprintf("%d major violations (threshold %d)", windowMajor, t))
+	}
+	if t := thresholds.TotalViolationsThreshold; t > 0 && windowTotal >= t {
+		score.Reasons = append(score.Reasons, fmt.Sprintf("%d violations (threshold %d)", windowTotal, t))
+	}
+	score.RequiresAction = len(score.Reasons) > 0
+	return score
+}
+
+func isMajor(severity consts.ViolationSeverity) bool {
+	return severity == consts.ViolationSeverityMajor || severity == consts.ViolationSeveritySerious
+}
+
+// lookupViolationCode finds the code table entry for an ACD code. An exact code wins over its
+// one-letter family fallback, and an organization entry wins over the system default.
+func (s *sMvr) lookupViolationCode(ctx context.Context, organizationID, code string) (*entity.MvrViolationCodes, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, nil
+	}
+
+	cols := dao.MvrViolationCodes.Columns()
+	m := dao.MvrViolationCodes.Ctx(ctx)
+	var candidates []*entity.MvrViolationCodes
+	err := m.Where(cols.IsActive, true).
+		WhereIn(cols.Code, []string{code, code[:1]}).
+		Where(m.Builder().WhereNull(cols.OrganizationId).WhereOr(cols.OrganizationId, organizationID)).
+		Scan(&candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *entity.MvrViolationCodes
+	rank := func(c *entity.MvrViolationCodes) int {
+		r := 0
+		if c.Code == code {
+			r += 2
+		}
+		if c.OrganizationId != "" {
+			r++
+		}
+		return r
+	}
+	for _, c := range candidates {
+		if best == nil || rank(c) > rank(best) {
+			best = c
+		}
+	}
+	return best, nil
+}
+
+// scoringThresholds returns the organization's thresholds, falling back to the system default.
+func (s *sMvr) scoringThresholds(ctx context.Context, organizationID string) (*entity.MvrScoringThresholds, error) {
+	cols := dao.MvrScoringThresholds.Columns()
+	m := dao.MvrScoringThresholds.Ctx(ctx)
+	var rows []*entity.MvrScoringThresholds
+	err := m.Where(m.Builder().WhereNull(cols.OrganizationId).WhereOr(cols.OrganizationId, organizationID)).
+		Scan(&rows)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds := defaultThresholds
+	for _, row := range rows {
+		if row.OrganizationId != "" {
+			return row, nil
+		}
+		thresholds = *row
+	}
+	return &thresholds, nil
+}
+
+func (s *sMvr) getReport(ctx context.Context, reportID string) (*entity.MvrReports, error) {
+	var report *entity.MvrReports
+	err := dao.MvrReports.Ctx(ctx).Where(dao.MvrReports.Columns().Id, reportID).Scan(&report)
+	if err != nil {
+		return nil, err
+	}
+	if report == nil {
+		return nil, gerror.NewCode(gcode.CodeNotFound, "MVR report not found")
+	}
+	return report, nil
+}
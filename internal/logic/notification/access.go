@@ -0,0 +1,117 @@
+package notification
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// senderRoles may send and view notifications of their own organization
+var senderRoles = map[consts.UserRole]bool{
+	consts.RoleClientAdmin:   true,
+	consts.RoleDER:           true,
+	consts.RoleSafetyManager: true,
+	consts.RoleHRManager:     true,
+}
+
+// SendNotification sends a notification on a user's request to a user of the organization and to
+// any additional email addresses. Only internal users and the organization's administrators may
+// send notifications.
+func (s *sNotification) SendNotification(ctx context.Context, in *model.SendNotificationInput) ([]*entity.Notifications, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if in.UserID == "" && len(in.AdditionalRecipients) == 0 {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "a user or additional recipients are required")
+	}
+	if err := checkSender(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	// Notify completes its input from the user's profile; additional recipients start from the request
+	base := in.NotificationInput
+	var sent []*entity.Notifications
+	if in.UserID != "" {
+		cols := dao.UserProfiles.Columns()
+		count, err := dao.UserProfiles.Ctx(ctx).
+			Where(cols.Id, in.UserID).
+			Where(cols.OrganizationId, in.OrganizationID).
+			Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gerror.NewCodef(gcode.CodeNotFound, "user %s not found in organization", in.UserID)
+		}
+		user := base
+		if sent, err = s.Notify(ctx, &user); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, email := range in.AdditionalRecipients {
+		extra := base
+		extra.UserID, extra.RecipientName, extra.PhoneNumber = "", "", ""
+		extra.EmailAddress = email
+		extra.Channels = []consts.NotificationType{consts.NotificationEmail}
+		notifications, err := s.Notify(ctx, &extra)
+		if err != nil {
+			return sent, err
+		}
+		sent = append(sent, notifications...)
+	}
+	return sent, nil
+}
+
+// GetNotification returns a notification with its delivery status. Users may view their own
+// notifications; internal users and the organization's administrators may view any of the
+// organization's.
+func (s *sNotification) GetNotification(ctx context.Context, notificationID string, requestedBy string) (*entity.Notifications, error) {
+	if notificationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "notification ID is required")
+	}
+	var n *entity.Notifications
+	err := dao.Notifications.Ctx(ctx).Where(dao.Notifications.Columns().Id, notificationID).Scan(&n)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, gerror.NewCodef(gcode.CodeNotFound, "notification %s not found", notificationID)
+	}
+	if requestedBy == "" || n.UserId != requestedBy {
+		if err = checkSender(ctx, requestedBy, n.OrganizationId); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// checkSender checks that the user may send and view the organization's notifications
+func checkSender(ctx context.Context, userID, organizationID string) error {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if service.Authorization().ActsFor(profile, organizationID, senderRoles) {
+		return nil
+	}
+	return gerror.NewCode(gcode.CodeNotAuthorized, "not permitted to manage the organization's notifications")
+}
+
+// checkInternal checks that the user is an active internal user
+func checkInternal(ctx context.Context, userID string) error {
+	profile, err := service.Authorization().ActiveProfile(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !service.Authorization().IsInternal(profile) {
+		return gerror.NewCode(gcode.CodeNotAuthorized, "only internal users may manage system templates")
+	}
+	return nil
+}
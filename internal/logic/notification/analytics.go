@@ -0,0 +1,115 @@
+package notification
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+)
+
+// analyticsGroup counts the notifications of one channel and category
+type analyticsGroup struct {
+	NotificationType string `json:"notification_type"`
+	Category         string `json:"category"`
+	Total            int    `json:"total"`
+	Sent             int    `json:"sent"`
+	Delivered        int    `json:"delivered"`
+	Failed           int    `json:"failed"`
+	Read             int    `json:"read"`
+	Bounced          int    `json:"bounced"`
+	Complained       int    `json:"complained"`
+	Opened           int    `json:"opened"`
+	Clicked          int    `json:"clicked"`
+}
+
+// GetNotificationAnalytics counts an organization's notifications by what became of them, with the
+// bounces, complaints, opens and clicks the email providers reported
+func (s *sNotification) GetNotificationAnalytics(ctx context.Context, in *model.NotificationAnalyticsInput) (*model.NotificationAnalytics, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := checkSender(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+	if in.StartDate != nil && in.EndDate != nil && in.EndDate.Before(in.StartDate) {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "end date must not be before start date")
+	}
+
+	cols := dao.Notifications.Columns()
+	m := dao.Notifications.Ctx(ctx).Where(cols.OrganizationId, in.OrganizationID)
+	if in.StartDate != nil {
+		m = m.WhereGTE(cols.CreatedAt, in.StartDate)
+	}
+	if in.EndDate != nil {
+		m = m.WhereLT(cols.CreatedAt, in.EndDate)
+	}
+	if in.Category != "" {
+		m = m.Where(cols.Category, string(in.Category))
+	}
+	if in.Channel != "" {
+		m = m.Where(cols.NotificationType, string(in.Channel))
+	}
+
+	var groups []*analyticsGroup
+	err := m.Fields(
+		cols.NotificationType+" AS notification_type",
+		cols.Category+" AS category",
+		"COUNT(*) AS total",
+		"COUNT("+cols.SentAt+") AS sent",
+		"COUNT(*) FILTER (WHERE "+cols.DeliveryStatus+" = '"+string(consts.NotificationDeliveryDelivered)+"') AS delivered",
+		"COUNT(*) FILTER (WHERE "+cols.DeliveryStatus+" = '"+string(consts.NotificationDeliveryFailed)+"') AS failed",
+		"COUNT("+cols.ReadAt+") AS read",
+		"COUNT("+cols.BouncedAt+") AS bounced",
+		"COUNT("+cols.ComplainedAt+") AS complained",
+		"COUNT("+cols.OpenedAt+") AS opened",
+		"COUNT("+cols.ClickedAt+") AS clicked",
+	).Group(cols.NotificationType, cols.Category).Scan(&groups)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &model.NotificationAnalytics{
+		ByChannel:  make(map[string]int),
+		ByCategory: make(map[string]int),
+	}
+	var inAppDelivered, emailSent, emailDelivered int
+	for _, group := range groups {
+		out.Total += group.Total
+		out.Sent += group.Sent
+		out.Delivered += group.Delivered
+		out.Failed += group.Failed
+		out.Read += group.Read
+		out.Bounced += group.Bounced
+		out.Complained += group.Complained
+		out.Opened += group.Opened
+		out.Clicked += group.Clicked
+		out.ByChannel[group.NotificationType] += group.Total
+		out.ByCategory[group.Category] += group.Total
+
+		switch consts.NotificationType(group.NotificationType) {
+		case consts.NotificationInApp:
+			inAppDelivered += group.Delivered
+		case consts.NotificationEmail:
+			emailSent += group.Sent
+			emailDelivered += group.Delivered
+		}
+	}
+
+	out.DeliveryRate = rate(out.Delivered, out.Sent)
+	out.ReadRate = rate(out.Read, inAppDelivered)
+	out.BounceRate = rate(out.Bounced, emailSent)
+	out.OpenRate = rate(out.Opened, emailDelivered)
+	out.ClickRate = rate(out.Clicked, emailDelivered)
+	return out, nil
+}
+
+// rate returns a count as a share of a total, or zero when the total is zero
+func rate(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total)
+}
@@ -0,0 +1,367 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/service"
+	"v1consortium/internal/workflow/notificationdelivery"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// SendBulkNotification sends a notification to the organization's users chosen by the input's
+// recipients and filter. The recipients are recorded and then notified in the background, a chunk
+// at a time; the returned status shows the progress so far. Without a job queue, as in command-line
+// tools, every recipient is notified before it returns. Only internal users and the organization's
+// administrators may send bulk notifications.
+func (s *sNotification) SendBulkNotification(ctx context.Context, in *model.BulkNotificationInput) (*model.BulkNotificationStatus, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := checkSender(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+	if err := checkContent(&in.NotificationInput); err != nil {
+		return nil, err
+	}
+	userIDs, err := bulkRecipients(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return nil, gerror.NewCode(gcode.CodeInvalidParameter, "no active users of the organization match the recipients")
+	}
+
+	// Each recipient's own details are filled in when they are notified
+	base := in.NotificationInput
+	base.UserID, base.RecipientName, base.EmailAddress, base.PhoneNumber = "", "", "", ""
+	notification, err := json.Marshal(base)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to encode the notification")
+	}
+	filter, err := json.Marshal(in.Filter)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to encode the recipient filter")
+	}
+	templateData := make(map[string]map[string]string, len(in.Recipients))
+	for _, r := range in.Recipients {
+		templateData[r.UserID] = r.TemplateData
+	}
+
+	bulkID := uuid.New().String()
+	err = dao.NotificationBulkSends.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		_, err := dao.NotificationBulkSends.Ctx(ctx).TX(tx).Data(do.NotificationBulkSends{
+			Id:              bulkID,
+			OrganizationId:  in.OrganizationID,
+			Notification:    string(notification),
+			RecipientFilter: string(filter),
+			Status:          string(consts.BulkNotificationQueued),
+			TotalRecipients: len(userIDs),
+			RequestedBy:     in.RequestedBy,
+		}).Insert()
+		if err != nil {
+			return err
+		}
+
+		rows := make([]do.NotificationBulkRecipients, 0, len(userIDs))
+		for _, userID := range userIDs {
+			row := do.NotificationBulkRecipients{
+				BulkSendId: bulkID,
+				UserId:     userID,
+				Status:     string(consts.BulkRecipientPending),
+			}
+			if data := templateData[userID]; len(data) > 0 {
+				encoded, err := json.Marshal(data)
+				if err != nil {
+					return gerror.WrapCode(gcode.CodeInternalError, err, "failed to encode recipient template data")
+				}
+				row.TemplateData = string(encoded)
+			}
+			rows = append(rows, row)
+		}
+		_, err = dao.NotificationBulkRecipients.Ctx(ctx).TX(tx).Data(rows).Batch(500).Insert()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client := service.RiverClient()
+	if client == nil {
+		for remaining := true; remaining; {
+			if remaining, err = s.ProcessBulkNotification(ctx, bulkID, notificationdelivery.BulkChunkSize); err != nil {
+				return nil, err
+			}
+		}
+	} else if _, err = client.Insert(ctx, notificationdelivery.BulkArgs{BulkSendID: bulkID}, nil); err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "failed to queue bulk notification %s", bulkID)
+	}
+	return bulkStatus(ctx, bulkID)
+}
+
+// ProcessBulkNotification notifies up to limit of a bulk notification's pending recipients and
+// reports whether any remain. Recipients who cannot be notified, as when a template cannot be
+// rendered with their data, are marked failed; other errors leave them pending to be retried. Each
+// recipient's notifications are recorded against them, so a retry does not notify them again on a
+// channel they were already notified on.
+func (s *sNotification) ProcessBulkNotification(ctx context.Context, bulkSendID string, limit int) (bool, error) {
+	bulk, err := getBulkSend(ctx, bulkSendID)
+	if err != nil {
+		return false, err
+	}
+	if consts.BulkNotificationStatus(bulk.Status) == consts.BulkNotificationCompleted {
+		return false, nil
+	}
+
+	cols := dao.NotificationBulkSends.Columns()
+	if consts.BulkNotificationStatus(bulk.Status) == consts.BulkNotificationQueued {
+		_, err = dao.NotificationBulkSends.Ctx(ctx).
+			Where(cols.Id, bulk.Id).
+			Where(cols.Status, string(consts.BulkNotificationQueued)).
+			Data(do.NotificationBulkSends{Status: string(consts.BulkNotificationRunning), StartedAt: gtime.Now()}).
+			Update()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	var base model.NotificationInput
+	if err = json.Unmarshal([]byte(bulk.Notification), &base); err != nil {
+		return false, gerror.WrapCodef(gcode.CodeInternalError, err, "invalid notification on bulk send %s", bulk.Id)
+	}
+	var recipients []*entity.NotificationBulkRecipients
+	rcols := dao.NotificationBulkRecipients.Columns()
+	err = dao.NotificationBulkRecipients.Ctx(ctx).
+		Where(rcols.BulkSendId, bulk.Id).
+		Where(rcols.Status, string(consts.BulkRecipientPending)).
+		OrderAsc(rcols.CreatedAt).
+		Limit(limit).
+		Scan(&recipients)
+	if err != nil {
+		return false, err
+	}
+
+	counts := map[consts.BulkRecipientStatus]int{}
+	defer func() {
+		if len(counts) == 0 {
+			return
+		}
+		_, countErr := dao.NotificationBulkSends.Ctx(ctx).Where(cols.Id, bulk.Id).Data(do.NotificationBulkSends{
+			SentCount:    gdb.Raw(fmt.Sprintf("%s + %d", cols.SentCount, counts[consts.BulkRecipientSent])),
+			SkippedCount: gdb.Raw(fmt.Sprintf("%s + %d", cols.SkippedCount, counts[consts.BulkRecipientSkipped])),
+			FailedCount:  gdb.Raw(fmt.Sprintf("%s + %d", cols.FailedCount, counts[consts.BulkRecipientFailed])),
+		}).Update()
+		if countErr != nil {
+			g.Log().Warningf(ctx, "Failed to update progress of bulk notification %s: %v", bulk.Id, countErr)
+		}
+	}()
+
+	for _, r := range recipients {
+		in := base
+		in.OrganizationID, in.UserID, in.BulkRecipientID = bulk.OrganizationId, r.UserId, r.Id
+		in.TemplateData = maps.Clone(base.TemplateData)
+		if r.TemplateData != "" {
+			var data map[string]string
+			if err = json.Unmarshal([]byte(r.TemplateData), &data); err != nil {
+				return false, gerror.WrapCodef(gcode.CodeInternalError, err, "invalid template data for recipient %s", r.Id)
+			}
+			if in.TemplateData == nil {
+				in.TemplateData = data
+			} else {
+				maps.Copy(in.TemplateData, data)
+			}
+		}
+
+		update := do.NotificationBulkRecipients{ProcessedAt: gtime.Now()}
+		sent, err := s.Notify(ctx, &in)
+		if err == nil && len(sent) == 0 {
+			sent, err = recipientNotifications(ctx, r.Id)
+		}
+		switch {
+		case err != nil && !recipientError(err):
+			return false, err
+		case err != nil:
+			g.Log().Warningf(ctx, "Bulk notification %s could not notify user %s: %v", bulk.Id, r.UserId, err)
+			update.Status, update.Error = string(consts.BulkRecipientFailed), err.Error()
+		case len(sent) == 0:
+			update.Status = string(consts.BulkRecipientSkipped)
+		default:
+			update.Status = string(consts.BulkRecipientSent)
+		}
+		_, err = dao.NotificationBulkRecipients.Ctx(ctx).Where(rcols.Id, r.Id).Data(update).Update()
+		if err != nil {
+			return false, err
+		}
+		counts[consts.BulkRecipientStatus(update.Status.(string))]++
+	}
+
+	remaining, err := dao.NotificationBulkRecipients.Ctx(ctx).
+		Where(rcols.BulkSendId, bulk.Id).
+		Where(rcols.Status, string(consts.BulkRecipientPending)).
+		Count()
+	if err != nil {
+		return false, err
+	}
+	if remaining > 0 {
+		return true, nil
+	}
+	_, err = dao.NotificationBulkSends.Ctx(ctx).Where(cols.Id, bulk.Id).Data(do.NotificationBulkSends{
+		Status:      string(consts.BulkNotificationCompleted),
+		CompletedAt: gtime.Now(),
+	}).Update()
+	return false, err
+}
+
+// GetBulkNotificationStatus returns the progress of a bulk notification. Internal users and the
+// organization's administrators may follow the organization's bulk notifications.
+func (s *sNotification) GetBulkNotificationStatus(ctx context.Context, bulkSendID, requestedBy string) (*model.BulkNotificationStatus, error) {
+	if bulkSendID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "bulk send ID is required")
+	}
+	bulk, err := getBulkSend(ctx, bulkSendID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSender(ctx, requestedBy, bulk.OrganizationId); err != nil {
+		return nil, err
+	}
+	return bulkStatus(ctx, bulkSendID)
+}
+
+// bulkRecipients returns the active users of the organization a bulk notification goes to. Users
+// listed by ID must all be active users of the organization.
+func bulkRecipients(ctx context.Context, in *model.BulkNotificationInput) ([]string, error) {
+	cols := dao.UserProfiles.Columns()
+	users := dao.UserProfiles.Ctx(ctx).
+		Where(cols.OrganizationId, in.OrganizationID).
+		Where(cols.IsActive, true)
+
+	listed := slices.Clone(in.Filter.UserIDs)
+	for _, r := range in.Recipients {
+		listed = append(listed, r.UserID)
+	}
+	slices.Sort(listed)
+	listed = slices.Compact(listed)
+	if len(listed) > 0 {
+		found, err := users.WhereIn(cols.Id, listed).Array(cols.Id)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) < len(listed) {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "%d of the listed users are not active users of the organization", len(listed)-len(found))
+		}
+		return listed, nil
+	}
+
+	if in.Filter.RandomPoolID != "" {
+		pcols := dao.RandomTestingPools.Columns()
+		count, err := dao.RandomTestingPools.Ctx(ctx).
+			Where(pcols.Id, in.Filter.RandomPoolID).
+			Where(pcols.OrganizationId, in.OrganizationID).
+			Count()
+		if err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gerror.NewCodef(gcode.CodeNotFound, "random testing pool %s not found in organization", in.Filter.RandomPoolID)
+		}
+		mcols := dao.PoolMemberships.Columns()
+		members := dao.PoolMemberships.Ctx(ctx).
+			Fields(mcols.UserId).
+			Where(mcols.PoolId, in.Filter.RandomPoolID).
+			Where(mcols.IsActive, true).
+			WhereNull(mcols.LeftAt)
+		users = users.Where(cols.Id+" IN (?)", members)
+	}
+	if len(in.Filter.Roles) > 0 {
+		users = users.WhereIn(cols.Role, in.Filter.Roles)
+	}
+	ids, err := users.OrderAsc(cols.LastName).OrderAsc(cols.FirstName).Array(cols.Id)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, len(ids))
+	for i, id := range ids {
+		userIDs[i] = id.String()
+	}
+	return userIDs, nil
+}
+
+// recipientNotifications returns the notifications already recorded for a bulk send's recipient,
+// by an earlier attempt that did not record the recipient's outcome
+func recipientNotifications(ctx context.Context, bulkRecipientID string) ([]*entity.Notifications, error) {
+	var sent []*entity.Notifications
+	err := dao.Notifications.Ctx(ctx).
+		Where(dao.Notifications.Columns().BulkRecipientId, bulkRecipientID).
+		Scan(&sent)
+	return sent, err
+}
+
+// recipientError reports whether an error notifying one recipient is particular to them, rather
+// than one that would fail every recipient alike
+func recipientError(err error) bool {
+	switch gerror.Code(err) {
+	case gcode.CodeInvalidParameter, gcode.CodeMissingParameter, gcode.CodeNotFound:
+		return true
+	}
+	return false
+}
+
+func getBulkSend(ctx context.Context, bulkSendID string) (*entity.NotificationBulkSends, error) {
+	var bulk *entity.NotificationBulkSends
+	err := dao.NotificationBulkSends.Ctx(ctx).Where(dao.NotificationBulkSends.Columns().Id, bulkSendID).Scan(&bulk)
+	if err != nil {
+		return nil, err
+	}
+	if bulk == nil {
+		return nil, gerror.NewCodef(gcode.CodeNotFound, "bulk notification %s not found", bulkSendID)
+	}
+	return bulk, nil
+}
+
+// bulkStatus returns the progress of a bulk notification, with the users who could not be notified
+func bulkStatus(ctx context.Context, bulkSendID string) (*model.BulkNotificationStatus, error) {
+	bulk, err := getBulkSend(ctx, bulkSendID)
+	if err != nil {
+		return nil, err
+	}
+	rcols := dao.NotificationBulkRecipients.Columns()
+	failed, err := dao.NotificationBulkRecipients.Ctx(ctx).
+		Where(rcols.BulkSendId, bulk.Id).
+		Where(rcols.Status, string(consts.BulkRecipientFailed)).
+		Array(rcols.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &model.BulkNotificationStatus{
+		ID:              bulk.Id,
+		OrganizationID:  bulk.OrganizationId,
+		Status:          bulk.Status,
+		TotalRecipients: bulk.TotalRecipients,
+		Sent:            bulk.SentCount,
+		Skipped:         bulk.SkippedCount,
+		Failed:          bulk.FailedCount,
+		Pending:         max(bulk.TotalRecipients-bulk.SentCount-bulk.SkippedCount-bulk.FailedCount, 0),
+		CreatedAt:       bulk.CreatedAt,
+		StartedAt:       bulk.StartedAt,
+		CompletedAt:     bulk.CompletedAt,
+	}
+	for _, id := range failed {
+		status.FailedUserIDs = append(status.FailedUserIDs, id.String())
+	}
+	return status, nil
+}
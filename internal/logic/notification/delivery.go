@@ -0,0 +1,186 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/emailpkg"
+	"v1consortium/internal/pkg/smspkg"
+	"v1consortium/internal/service"
+	"v1consortium/internal/workflow/notificationdelivery"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/riverqueue/river"
+)
+
+// DeliverNotification makes one attempt to deliver a pending email or SMS notification and records
+// the outcome on it. It returns the error of a failed attempt worth retrying; failures that another
+// attempt cannot fix, and failures of the final attempt, mark the notification failed instead.
+func (s *sNotification) DeliverNotification(ctx context.Context, in *model.NotificationDelivery) error {
+	var n *entity.Notifications
+	cols := dao.Notifications.Columns()
+	if err := dao.Notifications.Ctx(ctx).Where(cols.Id, in.NotificationID).Scan(&n); err != nil {
+		return err
+	}
+	if n == nil {
+		return gerror.NewCodef(gcode.CodeNotFound, "notification %s not found", in.NotificationID)
+	}
+	if consts.NotificationDeliveryStatus(n.DeliveryStatus) != consts.NotificationDeliveryPending {
+		return nil
+	}
+
+	var messageID string
+	var err error
+	switch consts.NotificationType(n.NotificationType) {
+	case consts.NotificationEmail:
+		messageID, err = s.sendEmail(ctx, n, in)
+	case consts.NotificationSMS:
+		messageID, err = s.sendSMS(ctx, n)
+	default:
+		err = gerror.NewCodef(gcode.CodeInvalidParameter, "%s notifications are not delivered by a provider", n.NotificationType)
+	}
+
+	now := gtime.Now()
+	update := do.Notifications{
+		DeliveryAttempts: gdb.Raw("delivery_attempts + 1"),
+		LastAttemptAt:    now,
+	}
+	retry := err != nil && !in.FinalAttempt && retryable(err)
+	switch {
+	case err == nil:
+		update.DeliveryStatus = string(consts.NotificationDeliverySent)
+		update.SentAt = now
+		update.ExternalMessageId = nilIfEmpty(messageID)
+	case retry:
+		g.Log().Warningf(ctx, "Failed to deliver %s notification %s, will retry: %v", n.NotificationType, n.Id, err)
+		update.DeliveryError = err.Error()
+	default:
+		g.Log().Warningf(ctx, "Failed to deliver %s notification %s: %v", n.NotificationType, n.Id, err)
+		update.DeliveryStatus = string(consts.NotificationDeliveryFailed)
+		update.DeliveryError = err.Error()
+	}
+
+	_, updateErr := dao.Notifications.Ctx(ctx).
+		Where(cols.Id, n.Id).
+		Where(cols.DeliveryStatus, string(consts.NotificationDeliveryPending)).
+		Data(update).Update()
+	if updateErr != nil {
+		return updateErr
+	}
+	if smspkg.GetErrorCode(err) == smspkg.ErrCodeRecipientOptedOut {
+		if optErr := s.setSMSOptOut(ctx, n.PhoneNumber, true); optErr != nil {
+			g.Log().Warningf(ctx, "Failed to record SMS opt-out of %s: %v", n.PhoneNumber, optErr)
+		}
+	}
+	if retry {
+		return err
+	}
+	return nil
+}
+
+// enqueueDelivery queues delivery of an email or SMS notification, to start no earlier than
+// notBefore when it is set. Without a job queue, as in command-line tools, a single delivery attempt
+// is made straight away.
+func (s *sNotification) enqueueDelivery(ctx context.Context, id string, in *model.NotificationInput, notBefore time.Time) error {
+	client := service.RiverClient()
+	if client == nil {
+		return s.DeliverNotification(ctx, &model.NotificationDelivery{
+			NotificationID: id,
+			RecipientName:  in.RecipientName,
+			HTMLMessage:    in.HTMLMessage,
+			Attachments:    in.Attachments,
+			FinalAttempt:   true,
+		})
+	}
+
+	_, err := client.Insert(ctx, notificationdelivery.DeliverArgs{
+		NotificationID: id,
+		RecipientName:  in.RecipientName,
+		HTMLMessage:    in.HTMLMessage,
+		Attachments:    in.Attachments,
+	}, &river.InsertOpts{ScheduledAt: notBefore})
+	if err != nil {
+		return gerror.WrapCodef(gcode.CodeInternalError, err, "failed to queue delivery of notification %s", id)
+	}
+	return nil
+}
+
+// sendEmail sends an email notification, returning the provider's message ID. Email to a suppressed
+// address, which hard bounced or complained after the notification was queued, fails without retry.
+func (s *sNotification) sendEmail(ctx context.Context, n *entity.Notifications, in *model.NotificationDelivery) (string, error) {
+	reason, err := emailSuppressed(ctx, n.EmailAddress)
+	if err != nil {
+		return "", err
+	}
+	if reason != "" {
+		return "", gerror.NewCodef(gcode.CodeInvalidParameter, "email to %s is suppressed (%s)", n.EmailAddress, reason)
+	}
+	message := &emailpkg.EmailMessage{
+		To:       []emailpkg.EmailAddress{{Email: n.EmailAddress, Name: in.RecipientName}},
+		Subject:  n.Title,
+		TextBody: n.Message,
+		HTMLBody: in.HTMLMessage,
+	}
+	for _, a := range in.Attachments {
+		message.Attachments = append(message.Attachments, emailpkg.Attachment{
+			Filename:    a.Filename,
+			Content:     a.Content,
+			ContentType: a.ContentType,
+		})
+	}
+
+	emailService, err := emailpkg.NewEmailService(s.GetEmailConfig(ctx))
+	if err != nil {
+		return "", err
+	}
+	resp, err := emailService.SendEmail(ctx, message)
+	if err != nil {
+		return "", err
+	}
+	return resp.MessageID, nil
+}
+
+// sendSMS sends an SMS notification, returning the provider's message ID
+func (s *sNotification) sendSMS(ctx context.Context, n *entity.Notifications) (string, error) {
+	smsService, err := smspkg.NewSMSService(s.GetSMSConfig(ctx))
+	if err != nil {
+		return "", err
+	}
+	resp, err := smsService.SendSMS(ctx, &smspkg.SMSMessage{To: n.PhoneNumber, Body: smsBody(n)})
+	if err != nil {
+		return "", err
+	}
+	return resp.MessageID, nil
+}
+
+// smsBody returns the text of an SMS notification: its title and message, shortened to the longest
+// body providers deliver
+func smsBody(n *entity.Notifications) string {
+	body := []rune(n.Title + ": " + n.Message)
+	if len(body) > smspkg.MaxBodyLength {
+		body = append(body[:smspkg.MaxBodyLength-1], '…')
+	}
+	return string(body)
+}
+
+// retryable reports whether a failed delivery may succeed if attempted again. Configuration and
+// message errors fail every attempt alike, as do messages the SMS provider rejects.
+func retryable(err error) bool {
+	var smsErr *smspkg.SMSError
+	switch {
+	case emailpkg.IsConfigError(err), emailpkg.IsValidationError(err), emailpkg.IsAuthError(err):
+		return false
+	case errors.As(err, &smsErr):
+		return smspkg.IsRetryableError(err)
+	}
+	return gerror.Code(err) != gcode.CodeInvalidParameter
+}
@@ -0,0 +1,188 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/msgtemplate"
+	"v1consortium/internal/pkg/smspkg"
+	"v1consortium/internal/workflow/notificationdelivery"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// digestText is the wording of digests in a locale
+type digestText struct {
+	subject string
+	intro   string // with the number of notifications
+}
+
+var digestTexts = map[string]digestText{
+	"en": {subject: "Your notification digest", intro: "You have %d new notifications"},
+	"es": {subject: "Su resumen de notificaciones", intro: "Tiene %d notificaciones nuevas"},
+}
+
+// digestGroup is the notifications due in one recipient's digest for one channel
+type digestGroup struct {
+	UserId           string
+	NotificationType string
+}
+
+// SendDueDigests sends up to limit digests whose time has come, one per recipient and channel with
+// every pending notification batched into it, and returns how many were sent. A digest that fails is
+// retried with the delivery backoff until the last delivery attempt, when its notifications are
+// marked failed.
+func (s *sNotification) SendDueDigests(ctx context.Context, limit int) (int, error) {
+	var groups []*digestGroup
+	cols := dao.Notifications.Columns()
+	err := dueDigests(ctx).
+		Fields(cols.UserId, cols.NotificationType).
+		Group(cols.UserId, cols.NotificationType).
+		Order(gdb.Raw("MIN(" + cols.DigestAt + ")")).
+		Limit(limit).
+		Scan(&groups)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, group := range groups {
+		ok, err := s.sendDigest(ctx, group)
+		if err != nil {
+			return sent, err
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// dueDigests selects the pending notifications whose digest is due
+func dueDigests(ctx context.Context) *gdb.Model {
+	cols := dao.Notifications.Columns()
+	return dao.Notifications.Ctx(ctx).
+		Where(cols.DeliveryStatus, string(consts.NotificationDeliveryPending)).
+		WhereNotNull(cols.UserId).
+		WhereLTE(cols.DigestAt, gtime.Now())
+}
+
+// sendDigest sends one digest and records the outcome on its notifications. It reports whether the
+// digest was sent; errors are returned only when the outcome cannot be recorded.
+func (s *sNotification) sendDigest(ctx context.Context, group *digestGroup) (bool, error) {
+	var items []*entity.Notifications
+	cols := dao.Notifications.Columns()
+	err := dueDigests(ctx).
+		Where(cols.UserId, group.UserId).
+		Where(cols.NotificationType, group.NotificationType).
+		OrderAsc(cols.CreatedAt).
+		Scan(&items)
+	if err != nil || len(items) == 0 {
+		return false, err
+	}
+	var user *entity.UserProfiles
+	if err = dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, group.UserId).Scan(&user); err != nil {
+		return false, err
+	}
+
+	// The digest goes to the most recent contact details the notifications were addressed to
+	latest := items[len(items)-1]
+	digest := &entity.Notifications{
+		Title:        digestTextFor(user).subject,
+		EmailAddress: latest.EmailAddress,
+		PhoneNumber:  latest.PhoneNumber,
+	}
+	var messageID string
+	switch consts.NotificationType(group.NotificationType) {
+	case consts.NotificationEmail:
+		digest.Message = digestBody(user, items, true)
+		delivery := &model.NotificationDelivery{}
+		if user != nil {
+			delivery.RecipientName = user.FirstName + " " + user.LastName
+		}
+		messageID, err = s.sendEmail(ctx, digest, delivery)
+	case consts.NotificationSMS:
+		digest.Message = digestBody(user, items, false)
+		messageID, err = s.sendSMS(ctx, digest)
+	default:
+		err = gerror.NewCodef(gcode.CodeInvalidParameter, "%s notifications are not sent in digests", group.NotificationType)
+	}
+
+	ids := make([]string, len(items))
+	for i, n := range items {
+		ids[i] = n.Id
+	}
+	now := gtime.Now()
+	update := do.Notifications{
+		DeliveryAttempts: gdb.Raw("delivery_attempts + 1"),
+		LastAttemptAt:    now,
+	}
+	attempt := latest.DeliveryAttempts + 1
+	switch {
+	case err == nil:
+		update.DeliveryStatus = string(consts.NotificationDeliverySent)
+		update.SentAt = now
+		update.ExternalMessageId = nilIfEmpty(messageID)
+	case attempt < notificationdelivery.MaxAttempts && retryable(err):
+		g.Log().Warningf(ctx, "Failed to send %s digest to user %s, will retry: %v", group.NotificationType, group.UserId, err)
+		update.DeliveryError = err.Error()
+		update.DigestAt = gtime.New(time.Now().Add(notificationdelivery.Backoff(attempt)))
+	default:
+		g.Log().Warningf(ctx, "Failed to send %s digest to user %s: %v", group.NotificationType, group.UserId, err)
+		update.DeliveryStatus = string(consts.NotificationDeliveryFailed)
+		update.DeliveryError = err.Error()
+	}
+
+	_, updateErr := dao.Notifications.Ctx(ctx).
+		WhereIn(cols.Id, ids).
+		Where(cols.DeliveryStatus, string(consts.NotificationDeliveryPending)).
+		Data(update).Update()
+	if updateErr != nil {
+		return false, updateErr
+	}
+	if smspkg.GetErrorCode(err) == smspkg.ErrCodeRecipientOptedOut {
+		if optErr := s.setSMSOptOut(ctx, digest.PhoneNumber, true); optErr != nil {
+			g.Log().Warningf(ctx, "Failed to record SMS opt-out of %s: %v", digest.PhoneNumber, optErr)
+		}
+	}
+	return err == nil, nil
+}
+
+// digestBody lists the notifications in a digest, in full for email and by title for SMS
+func digestBody(user *entity.UserProfiles, items []*entity.Notifications, full bool) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(digestTextFor(user).intro, len(items)))
+	if !full {
+		titles := make([]string, len(items))
+		for i, n := range items {
+			titles[i] = n.Title
+		}
+		b.WriteString(": " + strings.Join(titles, "; "))
+		return b.String()
+	}
+	b.WriteString(":\n")
+	for _, n := range items {
+		b.WriteString("\n" + n.Title + "\n" + n.Message + "\n")
+	}
+	return b.String()
+}
+
+// digestTextFor returns the digest wording in the user's locale
+func digestTextFor(user *entity.UserProfiles) digestText {
+	if user != nil {
+		if text, ok := digestTexts[user.PreferredLocale]; ok {
+			return text
+		}
+	}
+	return digestTexts[msgtemplate.DefaultLocale]
+}
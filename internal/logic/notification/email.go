@@ -0,0 +1,175 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/pkg/emailpkg"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// RecordEmailEvents records delivery events reported by an email provider on the notifications sent
+// as the reported messages. Hard bounces fail notifications not yet delivered; soft bounces are only
+// recorded. Only the first open and click are kept. Addresses that hard bounce or complain are
+// suppressed, and no further email is sent to them.
+func (s *sNotification) RecordEmailEvents(ctx context.Context, events []*emailpkg.DeliveryEvent) error {
+	for _, e := range events {
+		if err := recordEmailEvent(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordEmailEvent records one delivery event
+func recordEmailEvent(ctx context.Context, e *emailpkg.DeliveryEvent) error {
+	if e == nil || e.MessageID == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "message ID is required")
+	}
+	at := gtime.Now()
+	if !e.OccurredAt.IsZero() {
+		at = gtime.New(e.OccurredAt)
+	}
+	cols := dao.Notifications.Columns()
+	sent := func() *gdb.Model {
+		return dao.Notifications.Ctx(ctx).
+			Where(cols.ExternalMessageId, e.MessageID).
+			Where(cols.NotificationType, string(consts.NotificationEmail))
+	}
+
+	var err error
+	switch e.Type {
+	case emailpkg.EventDelivered:
+		err = markEmailDelivered(ctx, e.MessageID, at)
+	case emailpkg.EventBounced:
+		err = recordBounce(ctx, e, at)
+		if err == nil && e.BounceType == emailpkg.BounceHard {
+			err = suppressEmail(ctx, e, consts.EmailSuppressionHardBounce)
+		}
+	case emailpkg.EventComplained:
+		_, err = sent().WhereNull(cols.ComplainedAt).Data(do.Notifications{ComplainedAt: at}).Update()
+		if err == nil {
+			err = suppressEmail(ctx, e, consts.EmailSuppressionComplaint)
+		}
+	case emailpkg.EventOpened:
+		if err = markEmailDelivered(ctx, e.MessageID, at); err == nil {
+			_, err = sent().WhereNull(cols.OpenedAt).Data(do.Notifications{OpenedAt: at}).Update()
+		}
+	case emailpkg.EventClicked:
+		if err = markEmailDelivered(ctx, e.MessageID, at); err == nil {
+			_, err = sent().WhereNull(cols.ClickedAt).Data(do.Notifications{ClickedAt: at}).Update()
+		}
+	}
+	return err
+}
+
+// recordBounce records a bounce on the sent email notifications of a message. Events may arrive out
+// of order, so a hard bounce fails only notifications not yet delivered, and is recorded without
+// failing one reported delivered. A soft bounce is recorded without failing the notification, which
+// the provider may still deliver, and never replaces a hard bounce.
+func recordBounce(ctx context.Context, e *emailpkg.DeliveryEvent, at *gtime.Time) error {
+	cols := dao.Notifications.Columns()
+	sent := func() *gdb.Model {
+		return dao.Notifications.Ctx(ctx).
+			Where(cols.ExternalMessageId, e.MessageID).
+			Where(cols.NotificationType, string(consts.NotificationEmail))
+	}
+	if e.BounceType == emailpkg.BounceHard {
+		_, err := sent().
+			WhereIn(cols.DeliveryStatus, undelivered).
+			Data(do.Notifications{
+				DeliveryStatus: string(consts.NotificationDeliveryFailed),
+				DeliveryError:  fmt.Sprintf("bounced (%s): %s", e.BounceType, e.Reason),
+				BouncedAt:      at,
+				BounceType:     string(e.BounceType),
+			}).Update()
+		if err != nil {
+			return err
+		}
+	}
+	_, err := sent().
+		Where(fmt.Sprintf("(%s IS NULL OR %s = ?)", cols.BouncedAt, cols.BounceType), string(emailpkg.BounceSoft)).
+		Data(do.Notifications{BouncedAt: at, BounceType: string(e.BounceType)}).
+		Update()
+	return err
+}
+
+// undelivered are the delivery statuses of notifications not yet known to be delivered or failed
+var undelivered = []string{string(consts.NotificationDeliveryPending), string(consts.NotificationDeliverySent)}
+
+// markEmailDelivered marks the sent email notifications of a message delivered. Events may arrive
+// out of order, so an email that has hard bounced, and so failed, is not marked delivered by a later
+// report. One that soft bounced may still be delivered.
+func markEmailDelivered(ctx context.Context, messageID string, at *gtime.Time) error {
+	cols := dao.Notifications.Columns()
+	_, err := dao.Notifications.Ctx(ctx).
+		Where(cols.ExternalMessageId, messageID).
+		Where(cols.NotificationType, string(consts.NotificationEmail)).
+		WhereIn(cols.DeliveryStatus, undelivered).
+		Data(do.Notifications{
+			DeliveryStatus: string(consts.NotificationDeliveryDelivered),
+			DeliveredAt:    at,
+		}).Update()
+	return err
+}
+
+// suppressEmail suppresses further email to the recipient of an event
+func suppressEmail(ctx context.Context, e *emailpkg.DeliveryEvent, reason consts.EmailSuppressionReason) error {
+	address := normalizeEmail(e.Recipient)
+	if address == "" {
+		return nil
+	}
+	suppression := do.EmailSuppressions{
+		EmailAddress: address,
+		Reason:       string(reason),
+		Provider:     string(e.Provider),
+		Details:      e.Reason,
+	}
+	notificationID, err := dao.Notifications.Ctx(ctx).
+		Where(dao.Notifications.Columns().ExternalMessageId, e.MessageID).
+		Where(dao.Notifications.Columns().NotificationType, string(consts.NotificationEmail)).
+		Value(dao.Notifications.Columns().Id)
+	if err != nil {
+		return err
+	}
+	if !notificationID.IsEmpty() {
+		suppression.NotificationId = notificationID.String()
+	}
+	_, err = dao.EmailSuppressions.Ctx(ctx).
+		Data(suppression).
+		OnConflict(dao.EmailSuppressions.Columns().EmailAddress).
+		Save()
+	if err != nil {
+		return err
+	}
+	g.Log().Infof(ctx, "Suppressed email to %s after %s", address, reason)
+	return nil
+}
+
+// emailSuppressed returns why email to an address is suppressed, or an empty reason when it is not
+func emailSuppressed(ctx context.Context, address string) (consts.EmailSuppressionReason, error) {
+	address = normalizeEmail(address)
+	if address == "" {
+		return "", nil
+	}
+	reason, err := dao.EmailSuppressions.Ctx(ctx).
+		Where(dao.EmailSuppressions.Columns().EmailAddress, address).
+		Value(dao.EmailSuppressions.Columns().Reason)
+	if err != nil {
+		return "", err
+	}
+	return consts.EmailSuppressionReason(reason.String()), nil
+}
+
+// normalizeEmail returns an email address in the form suppressions are kept in
+func normalizeEmail(address string) string {
+	return strings.ToLower(strings.TrimSpace(address))
+}
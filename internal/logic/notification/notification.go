@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"v1consortium/internal/service"
+)
+
+type sNotification struct{}
+
+func init() {
+	service.RegisterNotification(new())
+}
+
+func new() service.INotification {
+	return &sNotification{}
+}
@@ -0,0 +1,358 @@
+package notification
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/deliverywindow"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// supportedChannels are the channels notifications can be delivered over
+var supportedChannels = map[consts.NotificationType]bool{
+	consts.NotificationInApp: true,
+	consts.NotificationEmail: true,
+	consts.NotificationSMS:   true,
+}
+
+var validPriorities = map[consts.NotificationPriority]bool{
+	consts.NotificationPriorityLow:    true,
+	consts.NotificationPriorityNormal: true,
+	consts.NotificationPriorityHigh:   true,
+	consts.NotificationPriorityUrgent: true,
+}
+
+// preferenceCategories are the categories users keep preferences for, in the order they are listed
+var preferenceCategories = []consts.NotificationCategory{
+	consts.NotificationCategoryAll,
+	consts.NotificationCategoryGeneral,
+	consts.NotificationCategoryTestReminder,
+	consts.NotificationCategoryCertificateExpiring,
+	consts.NotificationCategoryViolationAlert,
+	consts.NotificationCategoryAppointment,
+	consts.NotificationCategoryComplianceAlert,
+	consts.NotificationCategoryDocumentShared,
+}
+
+// defaultChannels are used for categories a user has no preference for. Text messages need the
+// recipient's consent, so SMS is off until the user turns it on.
+var defaultChannels = []consts.NotificationType{consts.NotificationInApp, consts.NotificationEmail}
+
+// allChannels are used for categories the user has set preferences for, and filtered by them
+var allChannels = []consts.NotificationType{consts.NotificationInApp, consts.NotificationEmail, consts.NotificationSMS}
+
+// deliveryPlan is how a notification reaches its recipient. Email and SMS wait for NotBefore when
+// it is set, or are batched into the digest sent at DigestAt; in-app notifications are never held.
+type deliveryPlan struct {
+	Channels  []consts.NotificationType
+	NotBefore time.Time
+	DigestAt  *gtime.Time
+}
+
+// GetNotificationPreferences returns the user's preferences for every category, with the defaults
+// for categories the user has not set, and the user's quiet hours. Users may view their own
+// preferences; internal users and the organization's administrators may view any of its users'.
+func (s *sNotification) GetNotificationPreferences(ctx context.Context, userID, requestedBy string) (*model.NotificationPreferences, error) {
+	if err := checkPreferencesAccess(ctx, userID, requestedBy); err != nil {
+		return nil, err
+	}
+	saved, err := loadPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	quiet, err := loadQuietHours(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &model.NotificationPreferences{UserID: userID, QuietHours: &model.NotificationQuietHours{}}
+	for _, category := range preferenceCategories {
+		p := saved[category]
+		if p == nil {
+			out.Preferences = append(out.Preferences, &model.NotificationPreference{
+				Category:     category,
+				EmailEnabled: true,
+				InAppEnabled: true,
+				Frequency:    deliverywindow.Immediate,
+			})
+			continue
+		}
+		out.Preferences = append(out.Preferences, &model.NotificationPreference{
+			Category:     category,
+			EmailEnabled: p.EmailEnabled,
+			SMSEnabled:   p.SmsEnabled,
+			InAppEnabled: p.InAppEnabled,
+			Frequency:    deliverywindow.Frequency(p.Frequency),
+		})
+	}
+	if quiet != nil {
+		out.QuietHours.Timezone = quiet.Timezone
+		if quiet.QuietHoursStart != "" {
+			start, _ := deliverywindow.ParseClock(quiet.QuietHoursStart)
+			end, _ := deliverywindow.ParseClock(quiet.QuietHoursEnd)
+			out.QuietHours.Enabled = true
+			out.QuietHours.Start, out.QuietHours.End = start.String(), end.String()
+		}
+	}
+	return out, nil
+}
+
+// UpdateNotificationPreferences saves the user's preferences for the listed categories and, when
+// given, the user's quiet hours, which cover every category. Turning SMS on for a category is the
+// user's consent to text messages of that category.
+func (s *sNotification) UpdateNotificationPreferences(ctx context.Context, in *model.NotificationPreferencesUpdateInput) error {
+	if err := checkPreferencesAccess(ctx, in.UserID, in.RequestedBy); err != nil {
+		return err
+	}
+
+	known := make(map[consts.NotificationCategory]bool, len(preferenceCategories))
+	for _, category := range preferenceCategories {
+		known[category] = true
+	}
+	for _, p := range in.Preferences {
+		if !known[p.Category] {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "unknown notification type: %s", p.Category)
+		}
+		if p.Frequency == "" {
+			p.Frequency = deliverywindow.Immediate
+		}
+		if !p.Frequency.Valid() {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "invalid notification frequency: %s", p.Frequency)
+		}
+	}
+
+	var quiet *do.NotificationQuietHours
+	if q := in.QuietHours; q != nil {
+		quiet = &do.NotificationQuietHours{UserId: in.UserID, Timezone: gdb.Raw("NULL")}
+		if q.Timezone != "" {
+			quiet.Timezone = q.Timezone
+			if _, err := time.LoadLocation(q.Timezone); err != nil {
+				return gerror.NewCodef(gcode.CodeInvalidParameter, "unknown time zone: %s", q.Timezone)
+			}
+		}
+		if q.Enabled {
+			start, err := deliverywindow.ParseClock(q.Start)
+			if err != nil {
+				return gerror.WrapCode(gcode.CodeInvalidParameter, err, "invalid quiet hours start")
+			}
+			end, err := deliverywindow.ParseClock(q.End)
+			if err != nil {
+				return gerror.WrapCode(gcode.CodeInvalidParameter, err, "invalid quiet hours end")
+			}
+			if start == end {
+				return gerror.NewCode(gcode.CodeInvalidParameter, "quiet hours must start and end at different times")
+			}
+			quiet.QuietHoursStart, quiet.QuietHoursEnd = start.String(), end.String()
+		} else {
+			quiet.QuietHoursStart, quiet.QuietHoursEnd = gdb.Raw("NULL"), gdb.Raw("NULL")
+		}
+	}
+
+	cols := dao.NotificationPreferences.Columns()
+	return dao.NotificationPreferences.Transaction(ctx, func(ctx context.Context, tx gdb.TX) error {
+		for _, p := range in.Preferences {
+			_, err := dao.NotificationPreferences.Ctx(ctx).TX(tx).
+				Data(do.NotificationPreferences{
+					UserId:       in.UserID,
+					Category:     string(p.Category),
+					EmailEnabled: p.EmailEnabled,
+					SmsEnabled:   p.SMSEnabled,
+					InAppEnabled: p.InAppEnabled,
+					Frequency:    string(p.Frequency),
+				}).
+				OnConflict(cols.UserId, cols.Category).
+				Save()
+			if err != nil {
+				return err
+			}
+		}
+		if quiet == nil {
+			return nil
+		}
+		_, err := dao.NotificationQuietHours.Ctx(ctx).TX(tx).
+			Data(quiet).
+			OnConflict(dao.NotificationQuietHours.Columns().UserId).
+			Save()
+		return err
+	})
+}
+
+// checkPreferencesAccess checks that the user may view and change the preferences of userID
+func checkPreferencesAccess(ctx context.Context, userID, requestedBy string) error {
+	if userID == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "user ID is required")
+	}
+	if requestedBy != "" && requestedBy == userID {
+		return nil
+	}
+	var user *entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, userID).Scan(&user)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return gerror.NewCodef(gcode.CodeNotFound, "user %s not found", userID)
+	}
+	return checkSender(ctx, requestedBy, user.OrganizationId)
+}
+
+// loadPreferences returns the user's saved preferences, by category
+func loadPreferences(ctx context.Context, userID string, categories ...consts.NotificationCategory) (map[consts.NotificationCategory]*entity.NotificationPreferences, error) {
+	var prefs []*entity.NotificationPreferences
+	cols := dao.NotificationPreferences.Columns()
+	m := dao.NotificationPreferences.Ctx(ctx).Where(cols.UserId, userID)
+	if len(categories) > 0 {
+		m = m.WhereIn(cols.Category, categories)
+	}
+	if err := m.Scan(&prefs); err != nil {
+		return nil, err
+	}
+	byCategory := make(map[consts.NotificationCategory]*entity.NotificationPreferences, len(prefs))
+	for _, p := range prefs {
+		byCategory[consts.NotificationCategory(p.Category)] = p
+	}
+	return byCategory, nil
+}
+
+// loadQuietHours returns the user's quiet hours, or nil if the user has never set them
+func loadQuietHours(ctx context.Context, userID string) (*entity.NotificationQuietHours, error) {
+	var quiet *entity.NotificationQuietHours
+	err := dao.NotificationQuietHours.Ctx(ctx).
+		Where(dao.NotificationQuietHours.Columns().UserId, userID).
+		Scan(&quiet)
+	return quiet, err
+}
+
+// planDelivery decides the channels to notify the recipient over, and when. Requested channels are
+// kept unless the user has turned them off for the category or for all categories; without
+// requested channels, the channels the user has turned on are used. Email and SMS wait out the
+// user's quiet hours, and low-priority notifications of categories the user receives as a digest
+// are batched into it. Critical compliance alerts ignore all of this, except that text messages
+// still need the user's consent. Recipients who are not users are notified by email, straight away.
+func (s *sNotification) planDelivery(ctx context.Context, in *model.NotificationInput) (*deliveryPlan, error) {
+	if in.UserID == "" {
+		if len(in.Channels) == 0 {
+			return &deliveryPlan{Channels: []consts.NotificationType{consts.NotificationEmail}}, nil
+		}
+		return &deliveryPlan{Channels: in.Channels}, nil
+	}
+
+	prefs, err := loadPreferences(ctx, in.UserID, in.Category, consts.NotificationCategoryAll)
+	if err != nil {
+		return nil, err
+	}
+	pref, all := prefs[in.Category], prefs[consts.NotificationCategoryAll]
+	if in.Category == consts.NotificationCategoryAll {
+		pref = nil
+	}
+
+	if isCritical(in) {
+		return &deliveryPlan{Channels: criticalChannels(in, pref, all)}, nil
+	}
+
+	requested := in.Channels
+	if len(requested) == 0 {
+		requested = defaultChannels
+		if pref != nil {
+			requested = allChannels
+		}
+	}
+	plan := &deliveryPlan{}
+	for _, channel := range requested {
+		if (pref == nil || channelEnabled(pref, channel)) && (all == nil || channelEnabled(all, channel)) {
+			plan.Channels = append(plan.Channels, channel)
+		}
+	}
+	frequency := deliverywindow.Immediate
+	if pref != nil {
+		frequency = deliverywindow.Frequency(pref.Frequency)
+	} else if all != nil {
+		frequency = deliverywindow.Frequency(all.Frequency)
+	}
+	saved, err := loadQuietHours(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil && frequency == deliverywindow.Immediate {
+		return plan, nil
+	}
+
+	loc := time.UTC
+	quiet := deliverywindow.QuietHours{Location: loc}
+	if saved != nil {
+		if saved.Timezone != "" {
+			if loc, err = time.LoadLocation(saved.Timezone); err != nil {
+				g.Log().Warningf(ctx, "Ignoring unknown time zone %q of user %s: %v", saved.Timezone, in.UserID, err)
+				loc = time.UTC
+			}
+		}
+		quiet.Location = loc
+		if saved.QuietHoursStart != "" && saved.QuietHoursEnd != "" {
+			quiet.Start, _ = deliverywindow.ParseClock(saved.QuietHoursStart)
+			quiet.End, _ = deliverywindow.ParseClock(saved.QuietHoursEnd)
+		}
+	}
+
+	now := time.Now()
+	// Attachments are not stored with the notification, so only notifications without them can wait
+	// for a digest
+	if in.Priority == consts.NotificationPriorityLow && frequency != deliverywindow.Immediate && len(in.Attachments) == 0 {
+		plan.DigestAt = gtime.New(quiet.Next(deliverywindow.NextDigest(now, frequency, loc)))
+	} else if next := quiet.Next(now); next.After(now) {
+		plan.NotBefore = next
+	}
+	return plan, nil
+}
+
+// isCritical reports whether a notification is a critical compliance alert, which reaches the
+// recipient whatever their preferences
+func isCritical(in *model.NotificationInput) bool {
+	return in.Category == consts.NotificationCategoryComplianceAlert &&
+		(in.Priority == consts.NotificationPriorityHigh || in.Priority == consts.NotificationPriorityUrgent)
+}
+
+// criticalChannels returns the channels a critical alert is sent over: those requested, or else
+// every channel. SMS is kept only when the user has not opted out of text messages, and without a
+// request only when the user has turned text messages on.
+func criticalChannels(in *model.NotificationInput, pref, all *entity.NotificationPreferences) []consts.NotificationType {
+	optedIn := (pref != nil && pref.SmsEnabled) || (all != nil && all.SmsEnabled)
+	optedOut := all != nil && !all.SmsEnabled
+
+	requested := in.Channels
+	if len(requested) == 0 {
+		requested = defaultChannels
+		if optedIn {
+			requested = allChannels
+		}
+	}
+	var channels []consts.NotificationType
+	for _, channel := range requested {
+		if channel == consts.NotificationSMS && optedOut {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
+
+// channelEnabled reports whether a preference leaves a channel turned on
+func channelEnabled(pref *entity.NotificationPreferences, channel consts.NotificationType) bool {
+	switch channel {
+	case consts.NotificationInApp:
+		return pref.InAppEnabled
+	case consts.NotificationEmail:
+		return pref.EmailEnabled
+	case consts.NotificationSMS:
+		return pref.SmsEnabled
+	}
+	return false
+}
@@ -0,0 +1,279 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/service"
+	"v1consortium/internal/workflow/notificationdelivery"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// maxScheduledListed is the most scheduled sends listed at once
+const maxScheduledListed = 1000
+
+// pendingJobStates are the states of scheduled send jobs that have not finished
+var pendingJobStates = []rivertype.JobState{
+	rivertype.JobStateAvailable,
+	rivertype.JobStatePending,
+	rivertype.JobStateRetryable,
+	rivertype.JobStateRunning,
+	rivertype.JobStateScheduled,
+}
+
+var validRecurrences = map[consts.NotificationRecurrence]bool{
+	consts.NotificationRecurrenceNone:    true,
+	consts.NotificationRecurrenceDaily:   true,
+	consts.NotificationRecurrenceWeekly:  true,
+	consts.NotificationRecurrenceMonthly: true,
+}
+
+// scheduledMetadata is the River job metadata scheduled sends are found by
+type scheduledMetadata struct {
+	ScheduledNotificationID string `json:"scheduled_notification_id,omitempty"`
+	OrganizationID          string `json:"organization_id,omitempty"`
+	UserID                  string `json:"user_id,omitempty"`
+}
+
+// ScheduleNotification schedules a notification to be sent later, as a job on the job queue, and
+// returns its ID. Without a user or additional recipients it is sent as a bulk notification to the
+// organization's users matching the filter when the time comes. Only internal users and the
+// organization's administrators may schedule notifications, and they must still be permitted to
+// send them when they are sent.
+func (s *sNotification) ScheduleNotification(ctx context.Context, in *model.ScheduledNotificationInput) (string, error) {
+	if in.OrganizationID == "" {
+		return "", gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := checkSender(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return "", err
+	}
+	if err := checkContent(&in.NotificationInput); err != nil {
+		return "", err
+	}
+	if in.SendAt == nil {
+		return "", gerror.NewCode(gcode.CodeMissingParameter, "send time is required")
+	}
+	if !in.SendAt.After(gtime.Now()) {
+		return "", gerror.NewCode(gcode.CodeInvalidParameter, "send time must be in the future")
+	}
+	if !validRecurrences[in.Recurrence] {
+		return "", gerror.NewCodef(gcode.CodeInvalidParameter, "invalid recurrence: %s", in.Recurrence)
+	}
+	if in.RecurrenceEnd != nil && in.RecurrenceEnd.Before(in.SendAt) {
+		return "", gerror.NewCode(gcode.CodeInvalidParameter, "recurrence end must not be before the send time")
+	}
+
+	if !isBulk(in) && in.UserID != "" {
+		cols := dao.UserProfiles.Columns()
+		count, err := dao.UserProfiles.Ctx(ctx).
+			Where(cols.Id, in.UserID).
+			Where(cols.OrganizationId, in.OrganizationID).
+			Count()
+		if err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return "", gerror.NewCodef(gcode.CodeNotFound, "user %s not found in organization", in.UserID)
+		}
+	} else if isBulk(in) {
+		// The recipients are chosen when the notification is sent; this only checks the filter
+		_, err := bulkRecipients(ctx, &model.BulkNotificationInput{NotificationInput: in.NotificationInput, Filter: in.Filter})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	id := uuid.New().String()
+	if err := insertScheduled(ctx, id, in); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// SendScheduledNotification sends a scheduled notification whose time has come, first scheduling
+// its next send when it recurs
+func (s *sNotification) SendScheduledNotification(ctx context.Context, scheduledNotificationID string, in *model.ScheduledNotificationInput) error {
+	if in == nil || in.SendAt == nil {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "scheduled notification %s has no send time", scheduledNotificationID)
+	}
+	if next := nextOccurrence(in.SendAt, in.Recurrence); next != nil && (in.RecurrenceEnd == nil || !next.After(in.RecurrenceEnd)) {
+		following := *in
+		following.SendAt = next
+		if err := insertScheduled(ctx, scheduledNotificationID, &following); err != nil {
+			return err
+		}
+	}
+
+	if !isBulk(in) {
+		_, err := s.SendNotification(ctx, &in.SendNotificationInput)
+		return err
+	}
+	_, err := s.SendBulkNotification(ctx, &model.BulkNotificationInput{
+		NotificationInput: in.NotificationInput,
+		Filter:            in.Filter,
+		RequestedBy:       in.RequestedBy,
+	})
+	return err
+}
+
+// ListScheduledNotifications lists an organization's scheduled sends, soonest first. Internal users
+// and the organization's administrators may list the organization's scheduled notifications.
+func (s *sNotification) ListScheduledNotifications(ctx context.Context, in *model.ScheduledNotificationListInput) ([]*model.ScheduledNotification, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := checkSender(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+	params := river.NewJobListParams().
+		OrderBy(river.JobListOrderByScheduledAt, river.SortOrderAsc).
+		First(maxScheduledListed)
+	if in.PendingOnly {
+		params = params.States(pendingJobStates...)
+	}
+	jobs, err := scheduledJobs(ctx, params, scheduledMetadata{OrganizationID: in.OrganizationID, UserID: in.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	scheduled := make([]*model.ScheduledNotification, 0, len(jobs))
+	for _, job := range jobs {
+		var args notificationdelivery.ScheduledArgs
+		if err = json.Unmarshal(job.EncodedArgs, &args); err != nil || args.Notification == nil {
+			return nil, gerror.NewCodef(gcode.CodeInternalError, "invalid scheduled notification job %d", job.ID)
+		}
+		scheduled = append(scheduled, &model.ScheduledNotification{
+			ID:                         args.ScheduledNotificationID,
+			ScheduledNotificationInput: *args.Notification,
+			Status:                     scheduledStatus(job.State),
+			CreatedAt:                  gtime.New(job.CreatedAt),
+		})
+	}
+	return scheduled, nil
+}
+
+// CancelScheduledNotification cancels the sends of a scheduled notification that have not happened,
+// including every further send of a recurring notification. Internal users and the organization's
+// administrators may cancel the organization's scheduled notifications.
+func (s *sNotification) CancelScheduledNotification(ctx context.Context, scheduledNotificationID, requestedBy string) error {
+	if scheduledNotificationID == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "scheduled notification ID is required")
+	}
+	jobs, err := scheduledJobs(ctx, river.NewJobListParams().First(maxScheduledListed), scheduledMetadata{ScheduledNotificationID: scheduledNotificationID})
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return gerror.NewCodef(gcode.CodeNotFound, "scheduled notification %s not found", scheduledNotificationID)
+	}
+	var args notificationdelivery.ScheduledArgs
+	if err = json.Unmarshal(jobs[0].EncodedArgs, &args); err != nil || args.Notification == nil {
+		return gerror.NewCodef(gcode.CodeInternalError, "invalid scheduled notification job %d", jobs[0].ID)
+	}
+	if err = checkSender(ctx, requestedBy, args.Notification.OrganizationID); err != nil {
+		return err
+	}
+
+	cancelled := 0
+	client := service.RiverClient()
+	for _, job := range jobs {
+		if scheduledStatus(job.State) != string(consts.NotificationDeliveryPending) {
+			continue
+		}
+		if _, err = client.JobCancel(ctx, job.ID); err != nil {
+			return gerror.WrapCodef(gcode.CodeInternalError, err, "failed to cancel scheduled notification %s", scheduledNotificationID)
+		}
+		cancelled++
+	}
+	if cancelled == 0 {
+		return gerror.NewCodef(gcode.CodeInvalidOperation, "scheduled notification %s has already been sent or cancelled", scheduledNotificationID)
+	}
+	return nil
+}
+
+// isBulk reports whether a scheduled notification goes to the organization's users, having neither
+// a user nor additional recipients of its own
+func isBulk(in *model.ScheduledNotificationInput) bool {
+	return in.UserID == "" && len(in.AdditionalRecipients) == 0
+}
+
+// insertScheduled queues one send of a scheduled notification. A send already queued for the same
+// time is not queued again, so a retried send does not schedule its next send twice.
+func insertScheduled(ctx context.Context, id string, in *model.ScheduledNotificationInput) error {
+	client := service.RiverClient()
+	if client == nil {
+		return gerror.NewCode(gcode.CodeNotSupported, "scheduling notifications requires the job queue")
+	}
+	metadata, err := json.Marshal(scheduledMetadata{
+		ScheduledNotificationID: id,
+		OrganizationID:          in.OrganizationID,
+		UserID:                  in.UserID,
+	})
+	if err != nil {
+		return gerror.WrapCode(gcode.CodeInternalError, err, "failed to encode scheduled notification metadata")
+	}
+	_, err = client.Insert(ctx, notificationdelivery.ScheduledArgs{
+		ScheduledNotificationID: id,
+		Notification:            in,
+	}, &river.InsertOpts{
+		ScheduledAt: in.SendAt.Time,
+		Metadata:    metadata,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	})
+	if err != nil {
+		return gerror.WrapCodef(gcode.CodeInternalError, err, "failed to schedule notification %s", id)
+	}
+	return nil
+}
+
+// scheduledJobs returns the scheduled send jobs whose metadata matches
+func scheduledJobs(ctx context.Context, params *river.JobListParams, match scheduledMetadata) ([]*rivertype.JobRow, error) {
+	client := service.RiverClient()
+	if client == nil {
+		return nil, gerror.NewCode(gcode.CodeNotSupported, "scheduled notifications require the job queue")
+	}
+	fragment, err := json.Marshal(match)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to encode scheduled notification metadata")
+	}
+	result, err := client.JobList(ctx, params.Kinds(notificationdelivery.ScheduledKind).Metadata(string(fragment)))
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInternalError, err, "failed to list scheduled notifications")
+	}
+	return result.Jobs, nil
+}
+
+// scheduledStatus describes a scheduled send by the state of its job
+func scheduledStatus(state rivertype.JobState) string {
+	switch state {
+	case rivertype.JobStateCompleted:
+		return string(consts.NotificationDeliverySent)
+	case rivertype.JobStateDiscarded:
+		return string(consts.NotificationDeliveryFailed)
+	case rivertype.JobStateCancelled:
+		return "cancelled"
+	}
+	return string(consts.NotificationDeliveryPending)
+}
+
+// nextOccurrence returns when a recurring notification sent at t is next sent, or nil when it does
+// not recur
+func nextOccurrence(t *gtime.Time, recurrence consts.NotificationRecurrence) *gtime.Time {
+	switch recurrence {
+	case consts.NotificationRecurrenceDaily:
+		return t.AddDate(0, 0, 1)
+	case consts.NotificationRecurrenceWeekly:
+		return t.AddDate(0, 0, 7)
+	case consts.NotificationRecurrenceMonthly:
+		return t.AddDate(0, 1, 0)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package notification
+
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/emailpkg"
+	"v1consortium/internal/pkg/smspkg"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+	"github.com/google/uuid"
+)
+
+// Notify records a notification for each channel and delivers it. In-app notifications are
+// delivered on insert; email and SMS notifications are queued for delivery in the background, where
+// failed attempts are retried with backoff. Without channels, the channels are resolved from the
+// recipient's preferences for the category, and channels the recipient has turned off are skipped.
+// Email and SMS wait out the recipient's quiet hours, and low-priority notifications the recipient
+// receives as a digest wait for it; critical compliance alerts are always sent straight away. With a
+// template, the title and messages are rendered from it in the recipient's locale.
+func (s *sNotification) Notify(ctx context.Context, in *model.NotificationInput) ([]*entity.Notifications, error) {
+	if in.OrganizationID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := s.fillRecipient(ctx, in); err != nil {
+		return nil, err
+	}
+	if in.TemplateID != "" {
+		if err := s.renderTemplate(ctx, in); err != nil {
+			return nil, err
+		}
+	}
+	if in.Title == "" || in.Message == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "notification title and message are required")
+	}
+	for _, channel := range in.Channels {
+		if !supportedChannels[channel] {
+			return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported notification channel: %s", channel)
+		}
+	}
+	if in.Priority == "" {
+		in.Priority = consts.NotificationPriorityNormal
+	}
+	if !validPriorities[in.Priority] {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "invalid notification priority: %s", in.Priority)
+	}
+	if in.Category == "" {
+		in.Category = consts.NotificationCategoryGeneral
+	}
+	plan, err := s.planDelivery(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, channel := range plan.Channels {
+		id, err := s.record(ctx, in, channel, plan)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			continue
+		}
+		if channel != consts.NotificationInApp && plan.DigestAt == nil {
+			if err = s.enqueueDelivery(ctx, id, in, plan.NotBefore); err != nil {
+				return nil, err
+			}
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var sent []*entity.Notifications
+	err = dao.Notifications.Ctx(ctx).
+		WhereIn(dao.Notifications.Columns().Id, ids).
+		OrderAsc(dao.Notifications.Columns().CreatedAt).
+		Scan(&sent)
+	return sent, err
+}
+
+// GetEmailConfig returns the email provider configuration
+func (s *sNotification) GetEmailConfig(ctx context.Context) *emailpkg.EmailConfig {
+	return &emailpkg.EmailConfig{
+		Provider:           emailpkg.EmailProvider(g.Cfg().MustGet(ctx, "email.provider").String()),
+		AWSRegion:          g.Cfg().MustGet(ctx, "email.awsRegion").String(),
+		AWSAccessKeyID:     g.Cfg().MustGet(ctx, "email.awsAccessKeyId").String(),
+		AWSSecretAccessKey: g.Cfg().MustGet(ctx, "email.awsSecretAccessKey").String(),
+		BrevoAPIKey:        g.Cfg().MustGet(ctx, "email.brevoApiKey").String(),
+		BrevoAPIURL:        g.Cfg().MustGet(ctx, "email.brevoApiUrl").String(),
+		DefaultFromEmail:   g.Cfg().MustGet(ctx, "email.defaultFromEmail").String(),
+		DefaultFromName:    g.Cfg().MustGet(ctx, "email.defaultFromName").String(),
+		Timeout:            g.Cfg().MustGet(ctx, "email.timeout").Duration(),
+		WebhookBaseURL:     g.Cfg().MustGet(ctx, "email.webhookBaseUrl").String(),
+		SESEventsTopicARN:  g.Cfg().MustGet(ctx, "email.sesEventsTopicArn").String(),
+		BrevoWebhookToken:  g.Cfg().MustGet(ctx, "email.brevoWebhookToken").String(),
+	}
+}
+
+// GetSMSConfig returns the SMS provider configuration
+func (s *sNotification) GetSMSConfig(ctx context.Context) *smspkg.SMSConfig {
+	return &smspkg.SMSConfig{
+		Provider:                  smspkg.SMSProvider(g.Cfg().MustGet(ctx, "sms.provider").String()),
+		TwilioAccountSID:          g.Cfg().MustGet(ctx, "sms.twilioAccountSid").String(),
+		TwilioAuthToken:           g.Cfg().MustGet(ctx, "sms.twilioAuthToken").String(),
+		TwilioMessagingServiceSID: g.Cfg().MustGet(ctx, "sms.twilioMessagingServiceSid").String(),
+		TwilioAPIURL:              g.Cfg().MustGet(ctx, "sms.twilioApiUrl").String(),
+		DefaultFromNumber:         g.Cfg().MustGet(ctx, "sms.defaultFromNumber").String(),
+		Timeout:                   g.Cfg().MustGet(ctx, "sms.timeout").Duration(),
+		CallbackBaseURL:           g.Cfg().MustGet(ctx, "sms.callbackBaseUrl").String(),
+	}
+}
+
+// fillRecipient completes the recipient's name, contact details and locale from their profile
+func (s *sNotification) fillRecipient(ctx context.Context, in *model.NotificationInput) error {
+	if in.UserID == "" {
+		return nil
+	}
+	var user *entity.UserProfiles
+	err := dao.UserProfiles.Ctx(ctx).Where(dao.UserProfiles.Columns().Id, in.UserID).Scan(&user)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return gerror.NewCodef(gcode.CodeNotFound, "user %s not found", in.UserID)
+	}
+	if in.RecipientName == "" {
+		in.RecipientName = user.FirstName + " " + user.LastName
+	}
+	if in.EmailAddress == "" {
+		in.EmailAddress = user.Email
+	}
+	if in.PhoneNumber == "" {
+		in.PhoneNumber = user.Phone
+	}
+	if in.Locale == "" {
+		in.Locale = user.PreferredLocale
+	}
+	return nil
+}
+
+// record inserts the notification row for one channel. In-app notifications are delivered by the
+// insert; the others are pending delivery, or pending the digest they are batched into. It returns
+// an empty ID when the recipient cannot be reached on the channel, or their email is suppressed, or
+// the bulk send's recipient already has a notification on the channel.
+func (s *sNotification) record(ctx context.Context, in *model.NotificationInput, channel consts.NotificationType, plan *deliveryPlan) (string, error) {
+	data := do.Notifications{
+		Id:               uuid.New().String(),
+		OrganizationId:   in.OrganizationID,
+		UserId:           nilIfEmpty(in.UserID),
+		Title:            in.Title,
+		Message:          in.Message,
+		NotificationType: string(channel),
+		Category:         string(in.Category),
+		Priority:         string(in.Priority),
+		DeliveryStatus:   string(consts.NotificationDeliveryPending),
+		TestId:           nilIfEmpty(in.TestID),
+		MvrReportId:      nilIfEmpty(in.MvrReportID),
+		PhysicalId:       nilIfEmpty(in.PhysicalID),
+		BulkRecipientId:  nilIfEmpty(in.BulkRecipientID),
+	}
+
+	switch channel {
+	case consts.NotificationInApp:
+		if in.UserID == "" {
+			return "", nil
+		}
+		now := gtime.Now()
+		data.SentAt, data.DeliveredAt = now, now
+		data.DeliveryStatus = string(consts.NotificationDeliveryDelivered)
+	case consts.NotificationEmail:
+		if in.EmailAddress == "" {
+			return "", nil
+		}
+		if reason, err := emailSuppressed(ctx, in.EmailAddress); err != nil || reason != "" {
+			return "", err
+		}
+		data.EmailAddress = in.EmailAddress
+		data.DigestAt = plan.DigestAt
+	case consts.NotificationSMS:
+		if in.PhoneNumber == "" {
+			return "", nil
+		}
+		data.PhoneNumber = in.PhoneNumber
+		data.DigestAt = plan.DigestAt
+	}
+
+	if in.BulkRecipientID == "" {
+		if _, err := dao.Notifications.Ctx(ctx).Data(data).Insert(); err != nil {
+			return "", err
+		}
+		return data.Id.(string), nil
+	}
+	result, err := dao.Notifications.Ctx(ctx).Data(data).InsertIgnore()
+	if err != nil {
+		return "", err
+	}
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return "", err
+	}
+	return data.Id.(string), nil
+}
+
+func nilIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// checkContent checks a notification that is sent later, so that a scheduled or bulk notification
+// that could never be sent is refused up front. Templates are checked when they are rendered, for
+// each recipient's locale.
+func checkContent(in *model.NotificationInput) error {
+	if in.TemplateID == "" && (in.Title == "" || in.Message == "") {
+		return gerror.NewCode(gcode.CodeMissingParameter, "notification title and message, or a template, are required")
+	}
+	for _, channel := range in.Channels {
+		if !supportedChannels[channel] {
+			return gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported notification channel: %s", channel)
+		}
+	}
+	if in.Priority != "" && !validPriorities[in.Priority] {
+		return gerror.NewCodef(gcode.CodeInvalidParameter, "invalid notification priority: %s", in.Priority)
+	}
+	if len(in.Attachments) > 0 {
+		return gerror.NewCode(gcode.CodeNotSupported, "attachments cannot be sent later")
+	}
+	return nil
+}
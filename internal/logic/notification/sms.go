@@ -0,0 +1,119 @@
+package notification
+
+import (
+	"context"
+	"strings"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/pkg/smspkg"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// RecordSMSStatus records a delivery status report from the SMS provider on the notification sent
+// as the reported message. Reports may arrive out of order, so a delivered notification is never
+// moved back to sent or failed.
+func (s *sNotification) RecordSMSStatus(ctx context.Context, cb *smspkg.StatusCallback) error {
+	if cb == nil || cb.MessageID == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "message ID is required")
+	}
+	cols := dao.Notifications.Columns()
+	m := dao.Notifications.Ctx(ctx).
+		Where(cols.ExternalMessageId, cb.MessageID).
+		Where(cols.NotificationType, string(consts.NotificationSMS))
+
+	var err error
+	switch cb.Status {
+	case smspkg.StatusDelivered:
+		_, err = m.WhereNot(cols.DeliveryStatus, string(consts.NotificationDeliveryDelivered)).
+			Data(do.Notifications{
+				DeliveryStatus: string(consts.NotificationDeliveryDelivered),
+				DeliveredAt:    gtime.Now(),
+			}).Update()
+	case smspkg.StatusSent:
+		_, err = m.Where(cols.DeliveryStatus, string(consts.NotificationDeliveryPending)).
+			Data(do.Notifications{DeliveryStatus: string(consts.NotificationDeliverySent)}).Update()
+	case smspkg.StatusFailed:
+		deliveryError := "undelivered: " + cb.ProviderStatus
+		if cb.ErrorCode != "" {
+			deliveryError += " (error " + cb.ErrorCode + ")"
+		}
+		_, err = m.WhereNot(cols.DeliveryStatus, string(consts.NotificationDeliveryDelivered)).
+			Data(do.Notifications{
+				DeliveryStatus: string(consts.NotificationDeliveryFailed),
+				DeliveryError:  deliveryError,
+			}).Update()
+	}
+	if err != nil {
+		return err
+	}
+
+	if cb.OptedOut && cb.To != "" {
+		return s.setSMSOptOut(ctx, cb.To, true)
+	}
+	return nil
+}
+
+// ReceiveSMS handles a text message received from a phone. Opt-out keywords such as STOP turn text
+// messages off for the users with the phone number, and opt-in keywords such as START turn them
+// back on; other messages are ignored.
+func (s *sNotification) ReceiveSMS(ctx context.Context, msg *smspkg.InboundMessage) error {
+	if msg == nil || msg.From == "" {
+		return gerror.NewCode(gcode.CodeMissingParameter, "sender's phone number is required")
+	}
+	switch smspkg.ParseKeyword(msg.Body) {
+	case smspkg.KeywordOptOut:
+		return s.setSMSOptOut(ctx, msg.From, true)
+	case smspkg.KeywordOptIn:
+		return s.setSMSOptOut(ctx, msg.From, false)
+	}
+	return nil
+}
+
+// setSMSOptOut records whether the users with a phone number have opted out of text messages, on
+// their preferences for all categories
+func (s *sNotification) setSMSOptOut(ctx context.Context, phone string, optedOut bool) error {
+	number, err := smspkg.NormalizePhoneNumber(phone)
+	if err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidParameter, err, "invalid phone number %s", phone)
+	}
+
+	// Profiles store phone numbers as entered, so compare digits only, with and without the North
+	// American country code
+	digits := strings.TrimPrefix(number, "+")
+	candidates := []string{digits}
+	if strings.HasPrefix(digits, "1") && len(digits) == 11 {
+		candidates = append(candidates, digits[1:])
+	}
+	userIDs, err := dao.UserProfiles.Ctx(ctx).
+		Where(`regexp_replace(phone, '\D', '', 'g') IN (?)`, candidates).
+		Array(dao.UserProfiles.Columns().Id)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 {
+		g.Log().Infof(ctx, "No users found for SMS opt-out change from %s", number)
+		return nil
+	}
+
+	cols := dao.NotificationPreferences.Columns()
+	for _, userID := range userIDs {
+		_, err = dao.NotificationPreferences.Ctx(ctx).
+			Data(do.NotificationPreferences{
+				UserId:     userID.String(),
+				Category:   string(consts.NotificationCategoryAll),
+				SmsEnabled: !optedOut,
+			}).
+			OnConflict(cols.UserId, cols.Category).
+			Save()
+		if err != nil {
+			return err
+		}
+	}
+	g.Log().Infof(ctx, "Recorded SMS opt-out=%v for %d users with phone %s", optedOut, len(userIDs), number)
+	return nil
+}
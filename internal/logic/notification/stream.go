@@ -0,0 +1,97 @@
+package notification
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/pkg/notifystream"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// streamHeartbeat is how long a stream may go without events before a heartbeat is sent, so that
+// proxies do not close it as idle
+const streamHeartbeat = 30 * time.Second
+
+// StreamNotifications streams a user's new in-app notifications and read-state changes to send until
+// the context is done or sending fails. A heartbeat with the unread count is sent first, and again
+// whenever the stream has been idle. A resync event means events may have been missed and the
+// notifications should be reloaded. Users may only stream their own notifications.
+func (s *sNotification) StreamNotifications(ctx context.Context, in *model.NotificationStreamInput, send func(*model.NotificationEvent) error) error {
+	if _, err := service.Authorization().ActiveProfile(ctx, in.UserID); err != nil {
+		return err
+	}
+	events := service.NotificationEvents()
+	if events == nil {
+		return gerror.NewCode(gcode.CodeNotSupported, "notification streaming is not available")
+	}
+	sub := events.Subscribe(in.UserID, in.OrganizationID)
+	defer sub.Close()
+
+	event := &model.NotificationEvent{Type: notifystream.EventHeartbeat}
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		unread, err := unreadCount(ctx, in)
+		if err != nil {
+			return err
+		}
+		event.UnreadCount = unread
+		event.OccurredAt = gtime.Now()
+		if err = send(event); err != nil {
+			return err
+		}
+		heartbeat.Reset(streamHeartbeat)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			event = &model.NotificationEvent{Type: notifystream.EventHeartbeat}
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if event, err = streamEvent(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamEvent describes an event for the stream, loading the notification it created
+func streamEvent(ctx context.Context, e notifystream.Event) (*model.NotificationEvent, error) {
+	event := &model.NotificationEvent{Type: e.Type, NotificationID: e.NotificationID}
+	if e.Type != notifystream.EventCreated {
+		return event, nil
+	}
+	err := dao.Notifications.Ctx(ctx).
+		Where(dao.Notifications.Columns().Id, e.NotificationID).
+		Scan(&event.Notification)
+	if err != nil {
+		return nil, err
+	}
+	if event.Notification == nil {
+		// Removed since it was created; clients reload to drop it if they already have it
+		return &model.NotificationEvent{Type: notifystream.EventResync}, nil
+	}
+	return event, nil
+}
+
+// unreadCount counts the user's unread in-app notifications in the stream's scope
+func unreadCount(ctx context.Context, in *model.NotificationStreamInput) (int, error) {
+	cols := dao.Notifications.Columns()
+	m := dao.Notifications.Ctx(ctx).
+		Where(cols.UserId, in.UserID).
+		Where(cols.NotificationType, string(consts.NotificationInApp)).
+		WhereNull(cols.ReadAt)
+	if in.OrganizationID != "" {
+		m = m.Where(cols.OrganizationId, in.OrganizationID)
+	}
+	return m.Count()
+}
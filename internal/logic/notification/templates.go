@@ -0,0 +1,426 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/dao"
+	"v1consortium/internal/model"
+	"v1consortium/internal/model/do"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/msgtemplate"
+
+	"github.com/gogf/gf/v2/database/gdb"
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/google/uuid"
+)
+
+// CreateNotificationTemplate creates a notification template, or a locale variant of the template
+// with the same key. System templates, without an organization, may only be created by internal
+// users.
+func (s *sNotification) CreateNotificationTemplate(ctx context.Context, in *model.NotificationTemplateInput) (*entity.NotificationTemplates, error) {
+	if in.TemplateKey == "" || in.TemplateName == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "template key and name are required")
+	}
+	locale, err := msgtemplate.NormalizeLocale(in.Locale)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err)
+	}
+	if in.Channel != "" && !supportedChannels[in.Channel] {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "unsupported notification channel: %s", in.Channel)
+	}
+	if in.Category == "" {
+		in.Category = consts.NotificationCategoryGeneral
+	}
+	if err = checkTemplateEditor(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+	variables, err := compileTemplate(in.SubjectTemplate, in.BodyTemplate, in.HTMLTemplate, in.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := dao.NotificationTemplates.Columns()
+	count, err := templatesOf(ctx, in.OrganizationID).
+		Where(cols.TemplateKey, in.TemplateKey).
+		Where(cols.Locale, locale).
+		Count()
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, gerror.NewCodef(gcode.CodeInvalidParameter, "template %s already has a %s variant", in.TemplateKey, locale)
+	}
+
+	id := uuid.New().String()
+	_, err = dao.NotificationTemplates.Ctx(ctx).Data(do.NotificationTemplates{
+		Id:              id,
+		OrganizationId:  nilIfEmpty(in.OrganizationID),
+		TemplateKey:     in.TemplateKey,
+		Locale:          locale,
+		TemplateName:    in.TemplateName,
+		Category:        string(in.Category),
+		Channel:         nilIfEmpty(string(in.Channel)),
+		SubjectTemplate: in.SubjectTemplate,
+		BodyTemplate:    in.BodyTemplate,
+		HtmlTemplate:    nilIfEmpty(in.HTMLTemplate),
+		Variables:       variables,
+		IsActive:        true,
+		CreatedBy:       nilIfEmpty(in.RequestedBy),
+	}).Insert()
+	if err != nil {
+		return nil, err
+	}
+	return getTemplate(ctx, id)
+}
+
+// GetNotificationTemplate returns a notification template. System templates may be viewed by any
+// user; an organization's templates by internal users and the organization's administrators.
+func (s *sNotification) GetNotificationTemplate(ctx context.Context, templateID string, requestedBy string) (*entity.NotificationTemplates, error) {
+	if templateID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "template ID is required")
+	}
+	t, err := getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTemplateViewer(ctx, requestedBy, t.OrganizationId); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ListNotificationTemplates lists an organization's notification templates, and the system
+// templates when asked, ordered by key and locale
+func (s *sNotification) ListNotificationTemplates(ctx context.Context, in *model.NotificationTemplateListInput) ([]*entity.NotificationTemplates, error) {
+	if in.OrganizationID == "" && !in.IncludeSystem {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "organization ID is required")
+	}
+	if err := checkTemplateViewer(ctx, in.RequestedBy, in.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	cols := dao.NotificationTemplates.Columns()
+	m := dao.NotificationTemplates.Ctx(ctx)
+	switch {
+	case in.OrganizationID == "":
+		m = m.WhereNull(cols.OrganizationId)
+	case in.IncludeSystem:
+		m = m.Where(m.Builder().Where(cols.OrganizationId, in.OrganizationID).WhereOrNull(cols.OrganizationId))
+	default:
+		m = m.Where(cols.OrganizationId, in.OrganizationID)
+	}
+	if in.Category != "" {
+		m = m.Where(cols.Category, string(in.Category))
+	}
+	if in.Channel != "" {
+		m = m.Where(cols.Channel, string(in.Channel))
+	}
+	if in.Locale != "" {
+		m = m.Where(cols.Locale, in.Locale)
+	}
+	if in.TemplateKey != "" {
+		m = m.Where(cols.TemplateKey, in.TemplateKey)
+	}
+	if in.ActiveOnly {
+		m = m.Where(cols.IsActive, true)
+	}
+
+	var templates []*entity.NotificationTemplates
+	err := m.OrderAsc(cols.TemplateKey).OrderAsc(cols.Locale).OrderAsc(cols.OrganizationId).Scan(&templates)
+	return templates, err
+}
+
+// UpdateNotificationTemplate changes a notification template. The changed template must still
+// compile with its variables. System templates are read-only except to internal users.
+func (s *sNotification) UpdateNotificationTemplate(ctx context.Context, in *model.NotificationTemplateUpdateInput) (*entity.NotificationTemplates, error) {
+	if in.TemplateID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "template ID is required")
+	}
+	t, err := getTemplate(ctx, in.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTemplateEditor(ctx, in.RequestedBy, t.OrganizationId); err != nil {
+		return nil, err
+	}
+
+	subject, body, html := t.SubjectTemplate, t.BodyTemplate, t.HtmlTemplate
+	if in.SubjectTemplate != "" {
+		subject = in.SubjectTemplate
+	}
+	if in.BodyTemplate != "" {
+		body = in.BodyTemplate
+	}
+	if in.HTMLTemplate != "" {
+		html = in.HTMLTemplate
+	}
+	vars := in.Variables
+	if vars == nil {
+		if vars, err = templateVariables(t); err != nil {
+			return nil, err
+		}
+	}
+	variables, err := compileTemplate(subject, body, html, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	update := do.NotificationTemplates{
+		SubjectTemplate: subject,
+		BodyTemplate:    body,
+		HtmlTemplate:    nilIfEmpty(html),
+		Variables:       variables,
+	}
+	if in.TemplateName != "" {
+		update.TemplateName = in.TemplateName
+	}
+	if in.IsActive != nil {
+		update.IsActive = *in.IsActive
+	}
+	_, err = dao.NotificationTemplates.Ctx(ctx).
+		Where(dao.NotificationTemplates.Columns().Id, t.Id).
+		Data(update).Update()
+	if err != nil {
+		return nil, err
+	}
+	return getTemplate(ctx, t.Id)
+}
+
+// PreviewNotificationTemplate renders a template with sample data, resolving the template variant
+// as a notification to the organization in the locale would. Variables missing from the sample data
+// are filled with placeholders.
+func (s *sNotification) PreviewNotificationTemplate(ctx context.Context, in *model.NotificationTemplatePreviewInput) (*model.NotificationTemplatePreview, error) {
+	if in.TemplateID == "" {
+		return nil, gerror.NewCode(gcode.CodeMissingParameter, "template ID is required")
+	}
+	requested, err := getTemplate(ctx, in.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTemplateViewer(ctx, in.RequestedBy, requested.OrganizationId); err != nil {
+		return nil, err
+	}
+	organizationID := in.OrganizationID
+	if organizationID == "" {
+		organizationID = requested.OrganizationId
+	} else if err = checkTemplateViewer(ctx, in.RequestedBy, organizationID); err != nil {
+		return nil, err
+	}
+	locale := in.Locale
+	if locale == "" {
+		locale = requested.Locale
+	}
+
+	t, locale, err := resolveTemplate(ctx, in.TemplateID, organizationID, locale)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := compileEntity(t)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(in.SampleData))
+	for name, value := range in.SampleData {
+		data[name] = value
+	}
+	preview := &model.NotificationTemplatePreview{TemplateID: t.Id, Locale: locale}
+	for name, placeholder := range compiled.SampleData() {
+		if data[name] == "" {
+			data[name] = placeholder
+			preview.Placeholders = append(preview.Placeholders, name)
+		}
+	}
+	sort.Strings(preview.Placeholders)
+
+	msg, err := compiled.Render(locale, data)
+	if err != nil {
+		return nil, gerror.WrapCode(gcode.CodeInvalidParameter, err)
+	}
+	preview.Subject, preview.Body, preview.HTML = msg.Subject, msg.Body, msg.HTML
+	return preview, nil
+}
+
+// renderTemplate renders a notification's title and messages from its template in the recipient's
+// locale. The template's category and channel apply when the notification names none.
+func (s *sNotification) renderTemplate(ctx context.Context, in *model.NotificationInput) error {
+	t, locale, err := resolveTemplate(ctx, in.TemplateID, in.OrganizationID, in.Locale)
+	if err != nil {
+		return err
+	}
+	compiled, err := compileEntity(t)
+	if err != nil {
+		return err
+	}
+	msg, err := compiled.Render(locale, in.TemplateData)
+	if err != nil {
+		return gerror.WrapCodef(gcode.CodeInvalidParameter, err, "failed to render template %s", t.TemplateKey)
+	}
+
+	in.Title, in.Message, in.HTMLMessage = msg.Subject, msg.Body, msg.HTML
+	if in.Category == "" {
+		in.Category = consts.NotificationCategory(t.Category)
+	}
+	if len(in.Channels) == 0 && t.Channel != "" {
+		in.Channels = []consts.NotificationType{consts.NotificationType(t.Channel)}
+	}
+	return nil
+}
+
+// resolveTemplate returns the active template variant to render for an organization in a locale,
+// and the locale it is rendered in. The template is given by ID or by key. The organization's own
+// template is preferred to the system default, and the locale's variant to the default locale's:
+// the organization's variant in the locale comes first, then the system's, then the default
+// locale's of each.
+func resolveTemplate(ctx context.Context, templateID, organizationID, locale string) (*entity.NotificationTemplates, string, error) {
+	key := templateID
+	if _, err := uuid.Parse(templateID); err == nil {
+		t, err := getTemplate(ctx, templateID)
+		if err != nil {
+			return nil, "", err
+		}
+		if t.OrganizationId != "" && t.OrganizationId != organizationID {
+			return nil, "", gerror.NewCodef(gcode.CodeNotFound, "template %s not found", templateID)
+		}
+		key = t.TemplateKey
+	}
+	locale, err := msgtemplate.NormalizeLocale(locale)
+	if err != nil {
+		// Recipients in other locales get the default
+		locale = msgtemplate.DefaultLocale
+	}
+
+	var variants []*entity.NotificationTemplates
+	cols := dao.NotificationTemplates.Columns()
+	m := dao.NotificationTemplates.Ctx(ctx)
+	owners := m.Builder().WhereNull(cols.OrganizationId)
+	if organizationID != "" {
+		owners = owners.WhereOr(cols.OrganizationId, organizationID)
+	}
+	err = m.Where(cols.TemplateKey, key).
+		Where(cols.IsActive, true).
+		WhereIn(cols.Locale, []string{locale, msgtemplate.DefaultLocale}).
+		Where(owners).
+		Scan(&variants)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var best *entity.NotificationTemplates
+	rank := func(t *entity.NotificationTemplates) int {
+		r := 0
+		if t.Locale != locale {
+			r += 2
+		}
+		if t.OrganizationId == "" {
+			r++
+		}
+		return r
+	}
+	for _, t := range variants {
+		if best == nil || rank(t) < rank(best) {
+			best = t
+		}
+	}
+	if best == nil {
+		return nil, "", gerror.NewCodef(gcode.CodeNotFound, "no active template %s", key)
+	}
+	return best, best.Locale, nil
+}
+
+// compileTemplate checks that a template compiles with its variables and returns the variables as
+// stored
+func compileTemplate(subject, body, html string, variables []msgtemplate.Variable) (string, error) {
+	if variables == nil {
+		variables = []msgtemplate.Variable{}
+	}
+	_, err := msgtemplate.Compile(msgtemplate.Template{
+		Subject:   subject,
+		Body:      body,
+		HTML:      html,
+		Variables: variables,
+	})
+	if err != nil {
+		return "", gerror.WrapCode(gcode.CodeInvalidParameter, err)
+	}
+	encoded, err := json.Marshal(variables)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// compileEntity compiles a stored template
+func compileEntity(t *entity.NotificationTemplates) (*msgtemplate.Compiled, error) {
+	variables, err := templateVariables(t)
+	if err != nil {
+		return nil, err
+	}
+	compiled, err := msgtemplate.Compile(msgtemplate.Template{
+		Subject:   t.SubjectTemplate,
+		Body:      t.BodyTemplate,
+		HTML:      t.HtmlTemplate,
+		Variables: variables,
+	})
+	if err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "stored template %s does not compile", t.Id)
+	}
+	return compiled, nil
+}
+
+// templateVariables returns a stored template's variables
+func templateVariables(t *entity.NotificationTemplates) ([]msgtemplate.Variable, error) {
+	var variables []msgtemplate.Variable
+	if t.Variables == "" {
+		return variables, nil
+	}
+	if err := json.Unmarshal([]byte(t.Variables), &variables); err != nil {
+		return nil, gerror.WrapCodef(gcode.CodeInternalError, err, "invalid variables on template %s", t.Id)
+	}
+	return variables, nil
+}
+
+func getTemplate(ctx context.Context, templateID string) (*entity.NotificationTemplates, error) {
+	var t *entity.NotificationTemplates
+	err := dao.NotificationTemplates.Ctx(ctx).Where(dao.NotificationTemplates.Columns().Id, templateID).Scan(&t)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, gerror.NewCodef(gcode.CodeNotFound, "template %s not found", templateID)
+	}
+	return t, nil
+}
+
+// templatesOf selects the templates of an organization, or the system templates
+func templatesOf(ctx context.Context, organizationID string) *gdb.Model {
+	cols := dao.NotificationTemplates.Columns()
+	if organizationID == "" {
+		return dao.NotificationTemplates.Ctx(ctx).WhereNull(cols.OrganizationId)
+	}
+	return dao.NotificationTemplates.Ctx(ctx).Where(cols.OrganizationId, organizationID)
+}
+
+// checkTemplateEditor checks that the user may change an organization's templates, or the system
+// templates when there is no organization
+func checkTemplateEditor(ctx context.Context, userID, organizationID string) error {
+	if organizationID == "" {
+		return checkInternal(ctx, userID)
+	}
+	return checkSender(ctx, userID, organizationID)
+}
+
+// checkTemplateViewer checks that the user may view an organization's templates; system templates
+// may be viewed by any user
+func checkTemplateViewer(ctx context.Context, userID, organizationID string) error {
+	if organizationID == "" {
+		if userID == "" {
+			return gerror.NewCode(gcode.CodeNotAuthorized, "requesting user is required")
+		}
+		return nil
+	}
+	return checkSender(ctx, userID, organizationID)
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"v1consortium/internal/consts"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// Background Check Analytics Models
+
+// BackgroundCheckAnalyticsInput represents the filters for background check analytics.
+// An empty OrganizationID reports on the requesting user's organization, or across all
+// organizations for internal users.
+type BackgroundCheckAnalyticsInput struct {
+	OrganizationID string                 `json:"organization_id"`
+	StartDate      *gtime.Time            `json:"start_date"`
+	EndDate        *gtime.Time            `json:"end_date"`
+	Bucket         consts.AnalyticsBucket `json:"bucket"`
+	RequestedBy    string                 `json:"requested_by"`
+}
+
+// BackgroundCheckStats holds the turnaround, hit rate and cost figures for a set of checks
+type BackgroundCheckStats struct {
+	TotalChecks            int     `json:"total_checks"`
+	CompletedChecks        int     `json:"completed_checks"`
+	RequiresReviewChecks   int     `json:"requires_review_checks"`
+	AdverseActionChecks    int     `json:"adverse_action_checks"`
+	AverageTurnaroundHours float64 `json:"average_turnaround_hours"`
+	RequiresReviewRate     float64 `json:"requires_review_rate"`
+	AdverseActionRate      float64 `json:"adverse_action_rate"`
+	TotalCost              float64 `json:"total_cost"`
+}
+
+// BackgroundCheckTurnaround is the breakdown for one provider and check type
+type BackgroundCheckTurnaround struct {
+	Provider  string `json:"provider"`
+	CheckType string `json:"check_type"`
+	BackgroundCheckStats
+}
+
+// BackgroundCheckAnalyticsBucket is the breakdown for one organization and time bucket
+type BackgroundCheckAnalyticsBucket struct {
+	OrganizationID string      `json:"organization_id"`
+	BucketStart    *gtime.Time `json:"bucket_start"`
+	BackgroundCheckStats
+	Turnaround []*BackgroundCheckTurnaround `json:"turnaround"`
+}
+
+// BackgroundCheckPackageCost is the cost of the checks ordered under one catalog package. Checks
+// whose package is not in the catalog are counted under an empty PackageID.
+type BackgroundCheckPackageCost struct {
+	PackageID   string  `json:"package_id"`
+	PackageCode string  `json:"package_code"`
+	Name        string  `json:"name"`
+	Provider    string  `json:"provider"`
+	CheckType   string  `json:"check_type"`
+	TotalChecks int     `json:"total_checks"`
+	TotalCost   float64 `json:"total_cost"`
+}
+
+// BackgroundCheckFindingCount counts findings by type and severity
+type BackgroundCheckFindingCount struct {
+	FindingType string `json:"finding_type"`
+	Severity    string `json:"severity"`
+	Count       int    `json:"count"`
+}
+
+// BackgroundCheckAnalyticsOutput represents the result of background check analytics
+type BackgroundCheckAnalyticsOutput struct {
+	BackgroundCheckStats
+	PendingChecks           int                               `json:"pending_checks"`
+	ClearResults            int                               `json:"clear_results"`
+	ConsiderResults         int                               `json:"consider_results"`
+	EngagedResults          int                               `json:"engaged_results"`
+	AdverseActionsInitiated int                               `json:"adverse_actions_initiated"`
+	Findings                []*BackgroundCheckFindingCount    `json:"findings"`
+	Turnaround              []*BackgroundCheckTurnaround      `json:"turnaround"`
+	Buckets                 []*BackgroundCheckAnalyticsBucket `json:"buckets"`
+	PackageCosts            []*BackgroundCheckPackageCost     `json:"package_costs"`
+}
@@ -33,4 +33,5 @@ type BackgroundChecks struct {
 	Notes                     interface{} //
 	CreatedAt                 *gtime.Time //
 	UpdatedAt                 *gtime.Time //
+	PackageId                 interface{} //
 }
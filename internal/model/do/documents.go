@@ -41,4 +41,9 @@ type Documents struct {
 	IsCurrentVersion     interface{} //
 	CreatedAt            *gtime.Time //
 	UpdatedAt            *gtime.Time //
+	ChecksumSha256       interface{} //
+	IsEncrypted          interface{} //
+	EncryptionKeyId      interface{} //
+	VersionNote          interface{} //
+	RestoredFromVersion  interface{} //
 }
@@ -40,4 +40,13 @@ type DotPhysicals struct {
 	Notes                     interface{} //
 	CreatedAt                 *gtime.Time //
 	UpdatedAt                 *gtime.Time //
+	ClinicId                  interface{} //
+	MedicalExaminerId         interface{} //
+	ExaminationType           interface{} //
+	SpecialRequirements       interface{} //
+	AppointmentSequence       interface{} //
+	CancelledAt               *gtime.Time //
+	CancellationReason        interface{} //
+	NoShowAt                  *gtime.Time //
+	CertificationPeriodMonths interface{} //
 }
@@ -36,4 +36,6 @@ type MvrReports struct {
 	ActionNotes           interface{} //
 	CreatedAt             *gtime.Time //
 	UpdatedAt             *gtime.Time //
+	LicenseClass          interface{} //
+	LicenseEndorsements   interface{} //
 }
@@ -34,4 +34,13 @@ type Notifications struct {
 	DeliveryError     interface{} //
 	CreatedAt         *gtime.Time //
 	UpdatedAt         *gtime.Time //
+	Category          interface{} //
+	DeliveryStatus    interface{} //
+	DigestAt          *gtime.Time //
+	BouncedAt         *gtime.Time //
+	BounceType        interface{} //
+	ComplainedAt      *gtime.Time //
+	OpenedAt          *gtime.Time //
+	ClickedAt         *gtime.Time //
+	BulkRecipientId   interface{} //
 }
@@ -42,4 +42,5 @@ type UserProfiles struct {
 	UpdatedAt                    *gtime.Time //
 	LastLoginAt                  *gtime.Time //
 	Settings                     interface{} //
+	PreferredLocale              interface{} //
 }
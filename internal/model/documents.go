@@ -0,0 +1,236 @@
+package model
+
+import (
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/blobstore"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// Document Storage Models
+
+// DocumentUploadInput describes a document whose content is streamed to the configured blob store.
+// RelatedEntityType is one of drug_test, mvr_report, dot_physical or background_check.
+type DocumentUploadInput struct {
+	OrganizationID       string                         `json:"organization_id"`
+	UserID               string                         `json:"user_id"`
+	DocumentType         consts.DocumentType            `json:"document_type"`
+	Title                string                         `json:"title"`
+	Description          string                         `json:"description"`
+	FileName             string                         `json:"file_name"`
+	ContentType          string                         `json:"content_type"`
+	RelatedEntityType    string                         `json:"related_entity_type"`
+	RelatedEntityID      string                         `json:"related_entity_id"`
+	IsHipaaProtected     bool                           `json:"is_hipaa_protected"`
+	ConfidentialityLevel consts.DocumentConfidentiality `json:"confidentiality_level"`
+	AutoDeleteAt         *gtime.Time                    `json:"auto_delete_at"`
+	UploadedBy           string                         `json:"uploaded_by"`
+}
+
+// StoredContent is document content written to the blob store. Size is the size of the content
+// before encryption; EncryptionKeyID is empty when the content is stored unencrypted.
+type StoredContent struct {
+	Object          *blobstore.ObjectInfo `json:"object"`
+	Size            int64                 `json:"size"`
+	EncryptionKeyID string                `json:"encryption_key_id"`
+}
+
+// DocumentUploadHeader starts or resumes a chunked upload. The session ID is generated by the
+// client; Upload is required when starting and ignored when resuming.
+type DocumentUploadHeader struct {
+	SessionID  string               `json:"session_id"`
+	Offset     int64                `json:"offset"`
+	TotalSize  int64                `json:"total_size"`
+	UploadedBy string               `json:"uploaded_by"`
+	Upload     *DocumentUploadInput `json:"upload"`
+}
+
+// DocumentUploadProgress is an upload session and, once it is complete, the stored document
+type DocumentUploadProgress struct {
+	Session  *entity.DocumentUploadSessions `json:"session"`
+	Document *entity.Documents              `json:"document"`
+}
+
+// DocumentUploadLimits are the size and MIME type limits applied to an organization's uploads.
+// A MIME type ending in /* allows every subtype.
+type DocumentUploadLimits struct {
+	OrganizationID   string   `json:"organization_id"`
+	MaxFileSizeBytes int64    `json:"max_file_size_bytes"`
+	AllowedMimeTypes []string `json:"allowed_mime_types"`
+	UpdatedBy        string   `json:"updated_by"`
+}
+
+// Document Version Models
+
+// DocumentUpdateInput changes a document's details. Empty fields are left unchanged. New content is
+// passed alongside and creates a new version; FileName and ContentType default to the current
+// version's.
+type DocumentUpdateInput struct {
+	DocumentID           string                         `json:"document_id"`
+	Title                string                         `json:"title"`
+	Description          string                         `json:"description"`
+	ConfidentialityLevel consts.DocumentConfidentiality `json:"confidentiality_level"`
+	AutoDeleteAt         *gtime.Time                    `json:"auto_delete_at"`
+	FileName             string                         `json:"file_name"`
+	ContentType          string                         `json:"content_type"`
+	ChangeNote           string                         `json:"change_note"`
+	UpdatedBy            string                         `json:"updated_by"`
+}
+
+// DocumentRollbackInput restores a prior version of a document as its new current version
+type DocumentRollbackInput struct {
+	DocumentID   string `json:"document_id"`
+	Version      int    `json:"version"`
+	ChangeNote   string `json:"change_note"`
+	RolledBackBy string `json:"rolled_back_by"`
+}
+
+// DocumentFieldChange is a documents column whose value differs between two versions
+type DocumentFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// DocumentVersionDiff lists the changes from one version of a document to the next
+type DocumentVersionDiff struct {
+	FromVersion int                    `json:"from_version"`
+	ToVersion   int                    `json:"to_version"`
+	Changes     []*DocumentFieldChange `json:"changes"`
+}
+
+// DocumentVersionHistory is every version of a document, oldest first, with the changes between
+// consecutive versions
+type DocumentVersionHistory struct {
+	Versions []*entity.Documents    `json:"versions"`
+	Diffs    []*DocumentVersionDiff `json:"diffs"`
+}
+
+// Document Sharing Models
+
+// DocumentShareInput shares a document with users. A nil ExpiresAt shares it until revoked.
+type DocumentShareInput struct {
+	DocumentID      string                         `json:"document_id"`
+	SharedWith      []string                       `json:"shared_with"`
+	PermissionLevel consts.DocumentSharePermission `json:"permission_level"`
+	ExpiresAt       *gtime.Time                    `json:"expires_at"`
+	SharedBy        string                         `json:"shared_by"`
+	Message         string                         `json:"message"`
+}
+
+// SharedDocument is a share with the current version of the shared document. URL is the signed
+// share link, set only for the recipient of an active share.
+type SharedDocument struct {
+	Share    *entity.DocumentShares `json:"share"`
+	Document *entity.Documents      `json:"document"`
+	Active   bool                   `json:"active"`
+	URL      string                 `json:"url"`
+}
+
+// Document Search Models
+
+// DocumentSearchInput searches an organization's current document versions. An empty Query lists
+// the matching documents newest first. Confidentiality levels match on the document's confidential
+// flag, so public and internal select the same documents, as do confidential and restricted.
+type DocumentSearchInput struct {
+	OrganizationID        string                           `json:"organization_id"`
+	Query                 string                           `json:"query"`
+	DocumentTypes         []string                         `json:"document_types"`
+	ConfidentialityLevels []consts.DocumentConfidentiality `json:"confidentiality_levels"`
+	UserID                string                           `json:"user_id"`
+	DateFrom              *gtime.Time                      `json:"date_from"`
+	DateTo                *gtime.Time                      `json:"date_to"`
+	Offset                int                              `json:"offset"`
+	Limit                 int                              `json:"limit"`
+	SearchedBy            string                           `json:"searched_by"`
+}
+
+// DocumentSearchResult is a page of matching documents, best match first, with the total number
+// of matches. Highlights holds an excerpt with the matched terms marked, keyed by document ID.
+type DocumentSearchResult struct {
+	Documents  []*entity.Documents `json:"documents"`
+	Highlights map[string]string   `json:"highlights"`
+	TotalCount int                 `json:"total_count"`
+}
+
+// Document Retention Models
+
+// DocumentRetentionPolicyInput sets how long an organization keeps documents of a type. An empty
+// DocumentType sets the policy for types without their own. Documents that already have a deletion
+// date keep it unless ForceApply is set.
+type DocumentRetentionPolicyInput struct {
+	OrganizationID string `json:"organization_id"`
+	DocumentType   string `json:"document_type"`
+	RetentionDays  int    `json:"retention_days"`
+	ForceApply     bool   `json:"force_apply"`
+	AppliedBy      string `json:"applied_by"`
+}
+
+// DocumentRetentionInfo is when a document will be deleted. DeleteAt is the later of its deletion
+// date and RetainUntil, the end of its minimum retention period; held documents are not deleted
+// until their holds are released.
+type DocumentRetentionInfo struct {
+	Document      *entity.Documents `json:"document"`
+	RetainUntil   *gtime.Time       `json:"retain_until"`
+	DeleteAt      *gtime.Time       `json:"delete_at"`
+	PolicyApplied bool              `json:"policy_applied"`
+	OnLegalHold   bool              `json:"on_legal_hold"`
+}
+
+// DocumentRetentionStatus lists an organization's documents scheduled for deletion, soonest first.
+// ScheduledForDeletion counts those not held.
+type DocumentRetentionStatus struct {
+	Documents            []*DocumentRetentionInfo `json:"documents"`
+	ScheduledForDeletion int                      `json:"scheduled_for_deletion"`
+}
+
+// LegalHoldInput places a hold for a case on an organization's documents, or only on a user's
+// documents or a single document when UserID or DocumentID is set
+type LegalHoldInput struct {
+	OrganizationID string `json:"organization_id"`
+	CaseReference  string `json:"case_reference"`
+	Reason         string `json:"reason"`
+	UserID         string `json:"user_id"`
+	DocumentID     string `json:"document_id"`
+	PlacedBy       string `json:"placed_by"`
+}
+
+// Driver Qualification File Models
+
+// DQFileItem is a record or document in a driver's qualification file. DocumentID is the document
+// whose content is included in the bundle; Withheld is set when the requesting user may not view
+// it, in which case only the item's details are listed.
+type DQFileItem struct {
+	Requirement consts.DQRequirement `json:"requirement"`
+	Source      consts.DQSource      `json:"source"`
+	SourceID    string               `json:"source_id"`
+	Title       string               `json:"title"`
+	Date        *gtime.Time          `json:"date"`
+	Details     []string             `json:"details"`
+	DocumentID  string               `json:"document_id"`
+	Withheld    bool                 `json:"withheld"`
+}
+
+// DQFileMissingItem is a record the driver's qualification file should hold but does not
+type DQFileMissingItem struct {
+	Requirement consts.DQRequirement `json:"requirement"`
+	Description string               `json:"description"`
+}
+
+// DQFile is the contents of a driver's qualification file, in requirement order, and what is
+// missing from it
+type DQFile struct {
+	UserID         string               `json:"user_id"`
+	OrganizationID string               `json:"organization_id"`
+	DriverName     string               `json:"driver_name"`
+	Items          []*DQFileItem        `json:"items"`
+	Missing        []*DQFileMissingItem `json:"missing"`
+	GeneratedAt    *gtime.Time          `json:"generated_at"`
+}
+
+// DQFileDownload is a signed URL a driver qualification file bundle is downloaded from
+type DQFileDownload struct {
+	URL       string      `json:"url"`
+	ExpiresAt *gtime.Time `json:"expires_at"`
+}
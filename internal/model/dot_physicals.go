@@ -0,0 +1,261 @@
+package model
+
+import (
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// Medical Examiner Registry Models
+
+// MedicalExaminerInput represents a medical examiner to add to the registry
+type MedicalExaminerInput struct {
+	FirstName               string                        `json:"first_name"`
+	LastName                string                        `json:"last_name"`
+	Email                   string                        `json:"email"`
+	Phone                   string                        `json:"phone"`
+	LicenseNumber           string                        `json:"license_number"`
+	LicenseState            string                        `json:"license_state"`
+	LicenseExpiration       *gtime.Time                   `json:"license_expiration"`
+	RegistryNumber          string                        `json:"registry_number"`
+	CertificationDate       *gtime.Time                   `json:"certification_date"`
+	CertificationExpiration *gtime.Time                   `json:"certification_expiration"`
+	RegistryStatus          consts.ExaminerRegistryStatus `json:"registry_status"`
+	PracticeName            string                        `json:"practice_name"`
+	AddressLine1            string                        `json:"address_line1"`
+	AddressLine2            string                        `json:"address_line2"`
+	City                    string                        `json:"city"`
+	State                   string                        `json:"state"`
+	ZipCode                 string                        `json:"zip_code"`
+	AcceptsNewPatients      bool                          `json:"accepts_new_patients"`
+	Specializations         []string                      `json:"specializations"`
+	Clinics                 []*MedicalExaminerClinicInput `json:"clinics"`
+}
+
+// MedicalExaminerClinicInput represents a clinic served by a medical examiner
+type MedicalExaminerClinicInput struct {
+	Name         string `json:"name"`
+	AddressLine1 string `json:"address_line1"`
+	AddressLine2 string `json:"address_line2"`
+	City         string `json:"city"`
+	State        string `json:"state"`
+	ZipCode      string `json:"zip_code"`
+	Phone        string `json:"phone"`
+	Timezone     string `json:"timezone"`
+}
+
+// MedicalExaminerListInput represents the filters for searching the registry
+type MedicalExaminerListInput struct {
+	State              string `json:"state"`
+	City               string `json:"city"`
+	ZipCode            string `json:"zip_code"`
+	AcceptsNewPatients bool   `json:"accepts_new_patients"`
+}
+
+// MedicalExaminerDetail is a registry entry with its clinics and examination count
+type MedicalExaminerDetail struct {
+	Examiner              *entity.MedicalExaminers         `json:"examiner"`
+	Clinics               []*entity.MedicalExaminerClinics `json:"clinics"`
+	ExaminationsCompleted int                              `json:"examinations_completed"`
+	RegistrationCurrent   bool                             `json:"registration_current"`
+}
+
+// MedicalExaminerImportError describes a registry export row that could not be imported
+type MedicalExaminerImportError struct {
+	Line           int    `json:"line"`
+	RegistryNumber string `json:"registry_number"`
+	Message        string `json:"message"`
+}
+
+// MedicalExaminerImportOutput summarizes a registry CSV import
+type MedicalExaminerImportOutput struct {
+	Created int                           `json:"created"`
+	Updated int                           `json:"updated"`
+	Errors  []*MedicalExaminerImportError `json:"errors"`
+}
+
+// Clinic Availability Models
+
+// ClinicAvailabilityInput represents a recurring block of appointment slots to open at a clinic.
+// Day start and end are wall-clock times in the clinic's timezone.
+type ClinicAvailabilityInput struct {
+	ClinicID    string      `json:"clinic_id"`
+	StartDate   *gtime.Time `json:"start_date"`
+	EndDate     *gtime.Time `json:"end_date"`
+	Weekdays    []int       `json:"weekdays"`
+	DayStart    string      `json:"day_start"`
+	DayEnd      string      `json:"day_end"`
+	SlotMinutes int         `json:"slot_minutes"`
+}
+
+// ClinicAvailabilityListInput represents the filters for searching appointment slots
+type ClinicAvailabilityListInput struct {
+	ClinicID      string      `json:"clinic_id"`
+	ExaminerID    string      `json:"examiner_id"`
+	State         string      `json:"state"`
+	City          string      `json:"city"`
+	ZipCode       string      `json:"zip_code"`
+	StartTime     *gtime.Time `json:"start_time"`
+	EndTime       *gtime.Time `json:"end_time"`
+	IncludeBooked bool        `json:"include_booked"`
+	Offset        int         `json:"offset"`
+	Limit         int         `json:"limit"`
+}
+
+// ClinicAvailabilitySlot is an appointment slot with its clinic and examiner
+type ClinicAvailabilitySlot struct {
+	Slot     *entity.ClinicAvailabilitySlots `json:"slot"`
+	Clinic   *entity.MedicalExaminerClinics  `json:"clinic"`
+	Examiner *entity.MedicalExaminers        `json:"examiner"`
+}
+
+// DOT Physical Models
+
+// DOTPhysicalScheduleInput represents a request to book a DOT physical
+type DOTPhysicalScheduleInput struct {
+	OrganizationID      string                 `json:"organization_id"`
+	UserID              string                 `json:"user_id"`
+	ScheduledBy         string                 `json:"scheduled_by"`
+	SlotID              string                 `json:"slot_id"`
+	ExaminerID          string                 `json:"examiner_id"`
+	PreferredDate       *gtime.Time            `json:"preferred_date"`
+	ExaminationType     consts.ExaminationType `json:"examination_type"`
+	SpecialRequirements string                 `json:"special_requirements"`
+}
+
+// DOTPhysicalRescheduleInput represents a request to move a DOT physical to another slot
+type DOTPhysicalRescheduleInput struct {
+	PhysicalID string `json:"physical_id"`
+	SlotID     string `json:"slot_id"`
+	Reason     string `json:"reason"`
+}
+
+// DOTPhysicalAppointment is a booked or cancelled DOT physical with its calendar invite
+type DOTPhysicalAppointment struct {
+	Physical       *entity.DotPhysicals    `json:"physical"`
+	Slot           *ClinicAvailabilitySlot `json:"slot"`
+	CalendarInvite string                  `json:"calendar_invite"`
+}
+
+// DOTPhysicalUpdateInput represents the results recorded against a DOT physical
+type DOTPhysicalUpdateInput struct {
+	PhysicalID                string      `json:"physical_id"`
+	Status                    string      `json:"status"`
+	ExaminationDate           *gtime.Time `json:"examination_date"`
+	QualificationStatus       string      `json:"qualification_status"`
+	CertificateIssueDate      *gtime.Time `json:"certificate_issue_date"`
+	CertificateExpirationDate *gtime.Time `json:"certificate_expiration_date"`
+	CertificateNumber         string      `json:"certificate_number"`
+	Restrictions              string      `json:"restrictions"`
+	ExaminerNotes             string      `json:"examiner_notes"`
+	RequiresMonitoring        bool        `json:"requires_monitoring"`
+	MonitoringRequirements    string      `json:"monitoring_requirements"`
+	ExemptionType             string      `json:"exemption_type"`
+	ExemptionExpiration       *gtime.Time `json:"exemption_expiration"`
+	ExaminerRegistryNumber    string      `json:"examiner_registry_number"`
+	// Structured findings; the free-text fields above are parsed when these are empty
+	RestrictionCodes          []consts.MedicalRestriction     `json:"restriction_codes"`
+	Monitoring                []*MedicalMonitoringRequirement `json:"monitoring"`
+	Exemptions                []*MedicalExemptionInput        `json:"exemptions"`
+	CertificationPeriodMonths int                             `json:"certification_period_months"`
+}
+
+// Medical Restriction and Monitoring Models
+
+// MedicalMonitoringRequirement is a condition the driver must be monitored for, stored as JSON in
+// dot_physicals.monitoring_requirements. IntervalMonths is how often evidence of control is due.
+type MedicalMonitoringRequirement struct {
+	Condition      consts.MonitoringCondition `json:"condition"`
+	IntervalMonths int                        `json:"interval_months"`
+	Notes          string                     `json:"notes,omitempty"`
+}
+
+// MedicalExemptionInput represents an exemption or SPE certificate held by the driver
+type MedicalExemptionInput struct {
+	ExemptionType    consts.MedicalExemptionType `json:"exemption_type"`
+	ExemptionNumber  string                      `json:"exemption_number"`
+	IssuingAuthority string                      `json:"issuing_authority"`
+	DocumentID       string                      `json:"document_id"`
+	IssuedDate       *gtime.Time                 `json:"issued_date"`
+	ExpirationDate   *gtime.Time                 `json:"expiration_date"`
+}
+
+// DOTPhysicalDetail is a DOT physical with its structured restrictions, monitoring requirements,
+// exemptions and follow-up tasks
+type DOTPhysicalDetail struct {
+	Physical      *entity.DotPhysicals            `json:"physical"`
+	Restrictions  []consts.MedicalRestriction     `json:"restrictions"`
+	Monitoring    []*MedicalMonitoringRequirement `json:"monitoring"`
+	Exemptions    []*entity.DotPhysicalExemptions `json:"exemptions"`
+	FollowUpTasks []*entity.MedicalFollowUpTasks  `json:"follow_up_tasks"`
+}
+
+// MedicalFollowUpTaskListInput represents the filters for listing medical follow-up tasks
+type MedicalFollowUpTaskListInput struct {
+	OrganizationID string                    `json:"organization_id"`
+	UserID         string                    `json:"user_id"`
+	Status         consts.FollowUpTaskStatus `json:"status"`
+	TaskType       consts.FollowUpTaskType   `json:"task_type"`
+	DueBefore      *gtime.Time               `json:"due_before"`
+	Offset         int                       `json:"offset"`
+	Limit          int                       `json:"limit"`
+}
+
+// MedicalFollowUpTaskCompleteInput represents closing out a medical follow-up task
+type MedicalFollowUpTaskCompleteInput struct {
+	TaskID      string `json:"task_id"`
+	CompletedBy string `json:"completed_by"`
+	Notes       string `json:"notes"`
+}
+
+// Medical Examiner's Certificate Models
+
+// MedicalCertificateInput represents the fields captured from a medical examiner's certificate
+// (MCSA-5876). With only a certificate number it looks up a certificate already on file.
+type MedicalCertificateInput struct {
+	UserID                 string                      `json:"user_id"`
+	DocumentID             string                      `json:"document_id"`
+	CertificateNumber      string                      `json:"certificate_number"`
+	ExaminerRegistryNumber string                      `json:"examiner_registry_number"`
+	DriverLicenseNumber    string                      `json:"driver_license_number"`
+	DriverLicenseState     string                      `json:"driver_license_state"`
+	DriverDateOfBirth      *gtime.Time                 `json:"driver_date_of_birth"`
+	IssueDate              *gtime.Time                 `json:"issue_date"`
+	ExpirationDate         *gtime.Time                 `json:"expiration_date"`
+	RestrictionCodes       []consts.MedicalRestriction `json:"restriction_codes"`
+	RequestedBy            string                      `json:"requested_by"`
+}
+
+// MedicalCertificateValidation is the outcome of validating a medical examiner's certificate
+type MedicalCertificateValidation struct {
+	Status          consts.CertificateValidationStatus `json:"status"`
+	Errors          []string                           `json:"errors"`
+	Physical        *entity.DotPhysicals               `json:"physical"`
+	HolderName      string                             `json:"holder_name"`
+	IssuingExaminer string                             `json:"issuing_examiner"`
+}
+
+// Medical Certificate Reminder Models
+
+// ExpirationReminderInput represents an organization's medical certificate reminder ladder
+type ExpirationReminderInput struct {
+	OrganizationID         string   `json:"organization_id"`
+	UpdatedBy              string   `json:"updated_by"`
+	ReminderDays           []int    `json:"reminder_days"`
+	EmailEnabled           bool     `json:"email_enabled"`
+	SmsEnabled             bool     `json:"sms_enabled"`
+	NotificationRecipients []string `json:"notification_recipients"`
+}
+
+// ExpiringCertificate is a driver's current medical examiner's certificate nearing expiration
+type ExpiringCertificate struct {
+	PhysicalID          string      `json:"physical_id"`
+	OrganizationID      string      `json:"organization_id"`
+	UserID              string      `json:"user_id"`
+	UserName            string      `json:"user_name"`
+	CertificateNumber   string      `json:"certificate_number"`
+	ExpirationDate      *gtime.Time `json:"expiration_date"`
+	DaysUntilExpiration int         `json:"days_until_expiration"`
+	QualificationStatus string      `json:"qualification_status"`
+}
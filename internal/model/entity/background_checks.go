@@ -31,4 +31,5 @@ type BackgroundChecks struct {
 	Notes                     string      `json:"notes"                     orm:"notes"                       description:""` //
 	CreatedAt                 *gtime.Time `json:"createdAt"                 orm:"created_at"                  description:""` //
 	UpdatedAt                 *gtime.Time `json:"updatedAt"                 orm:"updated_at"                  description:""` //
+	PackageId                 string      `json:"packageId"                 orm:"package_id"                  description:""` //
 }
@@ -39,4 +39,9 @@ type Documents struct {
 	IsCurrentVersion     bool        `json:"isCurrentVersion"     orm:"is_current_version"     description:""` //
 	CreatedAt            *gtime.Time `json:"createdAt"            orm:"created_at"             description:""` //
 	UpdatedAt            *gtime.Time `json:"updatedAt"            orm:"updated_at"             description:""` //
+	ChecksumSha256       string      `json:"checksumSha256"       orm:"checksum_sha256"        description:""` //
+	IsEncrypted          bool        `json:"isEncrypted"          orm:"is_encrypted"           description:""` //
+	EncryptionKeyId      string      `json:"encryptionKeyId"      orm:"encryption_key_id"      description:""` //
+	VersionNote          string      `json:"versionNote"          orm:"version_note"           description:""` //
+	RestoredFromVersion  int         `json:"restoredFromVersion"  orm:"restored_from_version"  description:""` //
 }
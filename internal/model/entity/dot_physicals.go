@@ -38,4 +38,13 @@ type DotPhysicals struct {
 	Notes                     string      `json:"notes"                     orm:"notes"                       description:""` //
 	CreatedAt                 *gtime.Time `json:"createdAt"                 orm:"created_at"                  description:""` //
 	UpdatedAt                 *gtime.Time `json:"updatedAt"                 orm:"updated_at"                  description:""` //
+	ClinicId                  string      `json:"clinicId"                  orm:"clinic_id"                   description:""` //
+	MedicalExaminerId         string      `json:"medicalExaminerId"         orm:"medical_examiner_id"         description:""` //
+	ExaminationType           string      `json:"examinationType"           orm:"examination_type"            description:""` //
+	SpecialRequirements       string      `json:"specialRequirements"       orm:"special_requirements"        description:""` //
+	AppointmentSequence       int         `json:"appointmentSequence"       orm:"appointment_sequence"        description:""` //
+	CancelledAt               *gtime.Time `json:"cancelledAt"               orm:"cancelled_at"                description:""` //
+	CancellationReason        string      `json:"cancellationReason"        orm:"cancellation_reason"         description:""` //
+	NoShowAt                  *gtime.Time `json:"noShowAt"                  orm:"no_show_at"                  description:""` //
+	CertificationPeriodMonths int         `json:"certificationPeriodMonths" orm:"certification_period_months" description:""` //
 }
@@ -34,4 +34,6 @@ type MvrReports struct {
 	ActionNotes           string      `json:"actionNotes"           orm:"action_notes"            description:""` //
 	CreatedAt             *gtime.Time `json:"createdAt"             orm:"created_at"              description:""` //
 	UpdatedAt             *gtime.Time `json:"updatedAt"             orm:"updated_at"              description:""` //
+	LicenseClass          string      `json:"licenseClass"          orm:"license_class"           description:""` //
+	LicenseEndorsements   string      `json:"licenseEndorsements"   orm:"license_endorsements"    description:""` //
 }
@@ -32,4 +32,13 @@ type Notifications struct {
 	DeliveryError     string      `json:"deliveryError"     orm:"delivery_error"      description:""` //
 	CreatedAt         *gtime.Time `json:"createdAt"         orm:"created_at"          description:""` //
 	UpdatedAt         *gtime.Time `json:"updatedAt"         orm:"updated_at"          description:""` //
+	Category          string      `json:"category"          orm:"category"            description:""` //
+	DeliveryStatus    string      `json:"deliveryStatus"    orm:"delivery_status"     description:""` //
+	DigestAt          *gtime.Time `json:"digestAt"          orm:"digest_at"           description:""` //
+	BouncedAt         *gtime.Time `json:"bouncedAt"         orm:"bounced_at"          description:""` //
+	BounceType        string      `json:"bounceType"        orm:"bounce_type"         description:""` //
+	ComplainedAt      *gtime.Time `json:"complainedAt"      orm:"complained_at"       description:""` //
+	OpenedAt          *gtime.Time `json:"openedAt"          orm:"opened_at"           description:""` //
+	ClickedAt         *gtime.Time `json:"clickedAt"         orm:"clicked_at"          description:""` //
+	BulkRecipientId   string      `json:"bulkRecipientId"   orm:"bulk_recipient_id"   description:""` //
 }
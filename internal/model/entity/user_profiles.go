@@ -40,4 +40,5 @@ type UserProfiles struct {
 	UpdatedAt                    *gtime.Time `json:"updatedAt"                    orm:"updated_at"                     description:""` //
 	LastLoginAt                  *gtime.Time `json:"lastLoginAt"                  orm:"last_login_at"                  description:""` //
 	Settings                     string      `json:"settings"                     orm:"settings"                       description:""` //
+	PreferredLocale              string      `json:"preferredLocale"              orm:"preferred_locale"               description:""` //
 }
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// MVR Monitoring Models
+
+// MVRMonitoringInput represents a request to enroll or update a driver's continuous monitoring
+type MVRMonitoringInput struct {
+	OrganizationID string                     `json:"organization_id"`
+	UserID         string                     `json:"user_id"`
+	EnrolledBy     string                     `json:"enrolled_by"`
+	Frequency      consts.MonitoringFrequency `json:"frequency"`
+	Provider       string                     `json:"provider"`
+	Enabled        bool                       `json:"enabled"`
+}
+
+// MVRMonitoringStatus represents a driver's monitoring enrollment and violation counts
+type MVRMonitoringStatus struct {
+	Enrollment       *entity.MvrMonitoringEnrollments `json:"enrollment"`
+	TotalViolations  int                              `json:"total_violations"`
+	ActiveViolations int                              `json:"active_violations"`
+}
+
+// MVRReportDiff is the difference between a driver's latest MVR and the one before it
+type MVRReportDiff struct {
+	NewViolations         []*entity.MvrViolations `json:"new_violations"`
+	PreviousLicenseStatus string                  `json:"previous_license_status"`
+	CurrentLicenseStatus  string                  `json:"current_license_status"`
+	LicenseStatusChanged  bool                    `json:"license_status_changed"`
+}
+
+// HasChanges reports whether the diff warrants an alert
+func (d *MVRReportDiff) HasChanges() bool {
+	return len(d.NewViolations) > 0 || d.LicenseStatusChanged
+}
+
+// MVR Scoring Models
+
+// MVRViolationInput represents a violation to add to an MVR report
+type MVRViolationInput struct {
+	ReportID                     string                   `json:"report_id"`
+	ViolationCode                string                   `json:"violation_code"`
+	ViolationType                string                   `json:"violation_type"`
+	ViolationDate                *gtime.Time              `json:"violation_date"`
+	ConvictionDate               *gtime.Time              `json:"conviction_date"`
+	State                        string                   `json:"state"`
+	CourtName                    string                   `json:"court_name"`
+	Description                  string                   `json:"description"`
+	Severity                     consts.ViolationSeverity `json:"severity"`
+	FineAmount                   float64                  `json:"fine_amount"`
+	Points                       int                      `json:"points"`
+	CaseNumber                   string                   `json:"case_number"`
+	AffectsCdl                   bool                     `json:"affects_cdl"`
+	RequiresEmployerNotification bool                     `json:"requires_employer_notification"`
+}
+
+// MVRReportScore is the result of scoring a report's violations against the organization thresholds
+type MVRReportScore struct {
+	TotalViolations int      `json:"total_violations"`
+	MajorViolations int      `json:"major_violations"`
+	MinorViolations int      `json:"minor_violations"`
+	Points          int      `json:"points"`
+	RequiresAction  bool     `json:"requires_action"`
+	Reasons         []string `json:"reasons"`
+}
+
+// MVR Parsing Models
+
+// MVRParseOutput is the result of parsing a report's raw provider data
+type MVRParseOutput struct {
+	Format            string                  `json:"format"`
+	Report            *entity.MvrReports      `json:"report"`
+	Violations        []*entity.MvrViolations `json:"violations"`
+	DuplicatesSkipped int                     `json:"duplicates_skipped"`
+}
+
+// MVR Annual Review Models
+
+// MVRReviewListInput represents the filters for listing annual MVR reviews
+type MVRReviewListInput struct {
+	OrganizationID string                 `json:"organization_id"`
+	UserID         string                 `json:"user_id"`
+	Status         consts.MVRReviewStatus `json:"status"`
+	Offset         int                    `json:"offset"`
+	Limit          int                    `json:"limit"`
+}
+
+// MVRReviewSignOffInput represents a reviewer's sign-off on an annual MVR review
+type MVRReviewSignOffInput struct {
+	ReviewID      string                        `json:"review_id"`
+	ReviewerID    string                        `json:"reviewer_id"`
+	Determination consts.MVRReviewDetermination `json:"determination"`
+	Notes         string                        `json:"notes"`
+}
+
+// MVRReviewSignOffOutput represents the completed review and its generated review record
+type MVRReviewSignOffOutput struct {
+	Review   *entity.MvrAnnualReviews `json:"review"`
+	Document *entity.Documents        `json:"document"`
+}
@@ -0,0 +1,292 @@
+package model
+
+import (
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+	"v1consortium/internal/pkg/deliverywindow"
+	"v1consortium/internal/pkg/msgtemplate"
+	"v1consortium/internal/pkg/notifystream"
+
+	"github.com/gogf/gf/v2/os/gtime"
+)
+
+// Notification Models
+
+// NotificationInput represents a notification to deliver to one recipient over one or more channels.
+// When UserID is set, missing contact details are taken from the user's profile, and the channels
+// are resolved from the user's preferences for the category when none are given. With a TemplateID,
+// the title and messages are rendered from the template with TemplateData, in Locale or else the
+// user's preferred locale.
+type NotificationInput struct {
+	OrganizationID  string                      `json:"organization_id"`
+	UserID          string                      `json:"user_id"`
+	RecipientName   string                      `json:"recipient_name"`
+	EmailAddress    string                      `json:"email_address"`
+	PhoneNumber     string                      `json:"phone_number"`
+	Category        consts.NotificationCategory `json:"category"`
+	Title           string                      `json:"title"`
+	Message         string                      `json:"message"`
+	HTMLMessage     string                      `json:"html_message"` // email only
+	TemplateID      string                      `json:"template_id"`  // template ID or key
+	TemplateData    map[string]string           `json:"template_data"`
+	Locale          string                      `json:"locale"`
+	Priority        consts.NotificationPriority `json:"priority"`
+	Channels        []consts.NotificationType   `json:"channels"`
+	TestID          string                      `json:"test_id"`
+	MvrReportID     string                      `json:"mvr_report_id"`
+	PhysicalID      string                      `json:"physical_id"`
+	Attachments     []*NotificationAttachment   `json:"attachments"`
+	BulkRecipientID string                      `json:"bulk_recipient_id"` // at most one notification per channel for a bulk send's recipient
+}
+
+// NotificationAttachment is a file attached to email notifications
+type NotificationAttachment struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Content     []byte `json:"content"`
+}
+
+// NotificationDelivery is an attempt to deliver a recorded email or SMS notification. Attachments are
+// carried with the attempt since they are not stored with the notification.
+type NotificationDelivery struct {
+	NotificationID string                    `json:"notification_id"`
+	RecipientName  string                    `json:"recipient_name"`
+	HTMLMessage    string                    `json:"html_message"`
+	Attachments    []*NotificationAttachment `json:"attachments"`
+	FinalAttempt   bool                      `json:"final_attempt"` // failures are not retried after this attempt
+}
+
+// SendNotificationInput is a notification sent on a user's request, to a user and to any additional
+// email addresses
+type SendNotificationInput struct {
+	NotificationInput
+	AdditionalRecipients []string `json:"additional_recipients"`
+	RequestedBy          string   `json:"requested_by"`
+}
+
+// Notification Template Models
+
+// NotificationTemplateInput creates a notification template, or a locale variant of one when a
+// template with the key exists. Templates without an organization are system templates.
+type NotificationTemplateInput struct {
+	OrganizationID  string                      `json:"organization_id"`
+	TemplateKey     string                      `json:"template_key"`
+	Locale          string                      `json:"locale"`
+	TemplateName    string                      `json:"template_name"`
+	Category        consts.NotificationCategory `json:"category"`
+	Channel         consts.NotificationType     `json:"channel"`
+	SubjectTemplate string                      `json:"subject_template"`
+	BodyTemplate    string                      `json:"body_template"`
+	HTMLTemplate    string                      `json:"html_template"`
+	Variables       []msgtemplate.Variable      `json:"variables"`
+	RequestedBy     string                      `json:"requested_by"`
+}
+
+// NotificationTemplateUpdateInput changes a notification template. Empty fields and a nil Variables
+// or IsActive are left unchanged.
+type NotificationTemplateUpdateInput struct {
+	TemplateID      string                 `json:"template_id"`
+	TemplateName    string                 `json:"template_name"`
+	SubjectTemplate string                 `json:"subject_template"`
+	BodyTemplate    string                 `json:"body_template"`
+	HTMLTemplate    string                 `json:"html_template"`
+	Variables       []msgtemplate.Variable `json:"variables"`
+	IsActive        *bool                  `json:"is_active"`
+	RequestedBy     string                 `json:"requested_by"`
+}
+
+// NotificationTemplateListInput lists the templates of an organization, and the system templates
+// when IncludeSystem is set. Empty filters match every template.
+type NotificationTemplateListInput struct {
+	OrganizationID string                      `json:"organization_id"`
+	Category       consts.NotificationCategory `json:"category"`
+	Channel        consts.NotificationType     `json:"channel"`
+	Locale         string                      `json:"locale"`
+	TemplateKey    string                      `json:"template_key"`
+	IncludeSystem  bool                        `json:"include_system"`
+	ActiveOnly     bool                        `json:"active_only"`
+	RequestedBy    string                      `json:"requested_by"`
+}
+
+// NotificationTemplatePreviewInput renders a template with sample data. The template is resolved as
+// for sending to the organization in the locale, so the preview shows the variant and fallback a
+// notification would use.
+type NotificationTemplatePreviewInput struct {
+	TemplateID     string            `json:"template_id"`
+	OrganizationID string            `json:"organization_id"`
+	Locale         string            `json:"locale"`
+	SampleData     map[string]string `json:"sample_data"`
+	RequestedBy    string            `json:"requested_by"`
+}
+
+// NotificationTemplatePreview is a template rendered with sample data. Placeholders lists the
+// variables missing from the sample data, which were filled with placeholder values.
+type NotificationTemplatePreview struct {
+	TemplateID   string   `json:"template_id"`
+	Locale       string   `json:"locale"`
+	Subject      string   `json:"subject"`
+	Body         string   `json:"body"`
+	HTML         string   `json:"html"`
+	Placeholders []string `json:"placeholders"`
+}
+
+// Notification Preference Models
+
+// NotificationPreference is a user's channels and frequency for a notification category. The "all"
+// category applies to every category: a channel turned off there is off for all notifications, and
+// its frequency is used for categories without a preference of their own.
+type NotificationPreference struct {
+	Category     consts.NotificationCategory `json:"category"`
+	EmailEnabled bool                        `json:"email_enabled"`
+	SMSEnabled   bool                        `json:"sms_enabled"`
+	InAppEnabled bool                        `json:"in_app_enabled"`
+	Frequency    deliverywindow.Frequency    `json:"frequency"`
+}
+
+// NotificationQuietHours is the daily period, as HH:MM in Timezone, during which email and SMS
+// notifications wait to be delivered
+type NotificationQuietHours struct {
+	Enabled  bool   `json:"enabled"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"`
+}
+
+// NotificationPreferences are a user's preferences for every category, defaults included
+type NotificationPreferences struct {
+	UserID      string                    `json:"user_id"`
+	Preferences []*NotificationPreference `json:"preferences"`
+	QuietHours  *NotificationQuietHours   `json:"quiet_hours"`
+}
+
+// NotificationPreferencesUpdateInput changes the preferences of the listed categories, and the quiet
+// hours unless QuietHours is nil
+type NotificationPreferencesUpdateInput struct {
+	UserID      string                    `json:"user_id"`
+	Preferences []*NotificationPreference `json:"preferences"`
+	QuietHours  *NotificationQuietHours   `json:"quiet_hours"`
+	RequestedBy string                    `json:"requested_by"`
+}
+
+// Scheduled and Bulk Notification Models
+
+// BulkRecipientFilter selects active users of an organization: those listed, or else the current
+// members of a random testing pool and users with one of the roles. An empty filter selects every
+// active user.
+type BulkRecipientFilter struct {
+	UserIDs      []string          `json:"user_ids,omitempty"`
+	RandomPoolID string            `json:"random_pool_id,omitempty"`
+	Roles        []consts.UserRole `json:"roles,omitempty"`
+}
+
+// BulkNotificationRecipient is template data for one recipient of a bulk notification, merged over
+// the notification's own
+type BulkNotificationRecipient struct {
+	UserID       string            `json:"user_id"`
+	TemplateData map[string]string `json:"template_data"`
+}
+
+// BulkNotificationInput sends a notification to the organization's users selected by Filter and
+// Recipients. The recipients are notified in the background at a throttled rate.
+type BulkNotificationInput struct {
+	NotificationInput
+	Recipients  []*BulkNotificationRecipient `json:"recipients"`
+	Filter      BulkRecipientFilter          `json:"filter"`
+	RequestedBy string                       `json:"requested_by"`
+}
+
+// BulkNotificationStatus is the progress of a bulk notification
+type BulkNotificationStatus struct {
+	ID              string      `json:"id"`
+	OrganizationID  string      `json:"organization_id"`
+	Status          string      `json:"status"`
+	TotalRecipients int         `json:"total_recipients"`
+	Sent            int         `json:"sent"`
+	Skipped         int         `json:"skipped"` // every requested channel turned off
+	Failed          int         `json:"failed"`
+	Pending         int         `json:"pending"`
+	FailedUserIDs   []string    `json:"failed_user_ids"`
+	CreatedAt       *gtime.Time `json:"created_at"`
+	StartedAt       *gtime.Time `json:"started_at"`
+	CompletedAt     *gtime.Time `json:"completed_at"`
+}
+
+// ScheduledNotificationInput is a notification to send at SendAt: to a user and additional
+// recipients as SendNotification does, or without either, as a bulk notification to the
+// organization's users matching Filter.
+// Recurring notifications are sent again each day, week or month until RecurrenceEnd.
+type ScheduledNotificationInput struct {
+	SendNotificationInput
+	Filter        BulkRecipientFilter           `json:"filter"`
+	SendAt        *gtime.Time                   `json:"send_at"`
+	Recurrence    consts.NotificationRecurrence `json:"recurrence"`
+	RecurrenceEnd *gtime.Time                   `json:"recurrence_end"`
+}
+
+// ScheduledNotification is one scheduled send of a notification. The sends of a recurring
+// notification share its ID.
+type ScheduledNotification struct {
+	ID string `json:"id"`
+	ScheduledNotificationInput
+	Status    string      `json:"status"` // pending, sent, failed or cancelled
+	CreatedAt *gtime.Time `json:"created_at"`
+}
+
+// ScheduledNotificationListInput lists an organization's scheduled notifications, optionally those
+// of one user, or only those still to be sent
+type ScheduledNotificationListInput struct {
+	OrganizationID string `json:"organization_id"`
+	UserID         string `json:"user_id"`
+	PendingOnly    bool   `json:"pending_only"`
+	RequestedBy    string `json:"requested_by"`
+}
+
+// NotificationStreamInput streams a user's in-app notification events, optionally only those of one
+// organization
+type NotificationStreamInput struct {
+	UserID         string `json:"user_id"`
+	OrganizationID string `json:"organization_id"`
+}
+
+// NotificationEvent is a change to a user's in-app notifications, with their unread count after it
+type NotificationEvent struct {
+	Type           notifystream.EventType `json:"event"`
+	NotificationID string                 `json:"notification_id"`
+	Notification   *entity.Notifications  `json:"notification"` // for created events
+	UnreadCount    int                    `json:"unread_count"`
+	OccurredAt     *gtime.Time            `json:"occurred_at"`
+}
+
+// NotificationAnalyticsInput selects the notifications analysed: an organization's created in the
+// period, optionally only those of a category or sent over a channel
+type NotificationAnalyticsInput struct {
+	OrganizationID string                      `json:"organization_id"`
+	StartDate      *gtime.Time                 `json:"start_date"`
+	EndDate        *gtime.Time                 `json:"end_date"`
+	Category       consts.NotificationCategory `json:"category"`
+	Channel        consts.NotificationType     `json:"channel"`
+	RequestedBy    string                      `json:"requested_by"`
+}
+
+// NotificationAnalytics counts notifications by what became of them. The delivery rate is the share
+// of sent notifications delivered and the read rate the share of delivered in-app notifications
+// read. The bounce rate is the share of sent email that bounced; the open and click rates are shares
+// of delivered email.
+type NotificationAnalytics struct {
+	Total        int            `json:"total"`
+	Sent         int            `json:"sent"`
+	Delivered    int            `json:"delivered"`
+	Failed       int            `json:"failed"`
+	Read         int            `json:"read"`
+	Bounced      int            `json:"bounced"`
+	Complained   int            `json:"complained"`
+	Opened       int            `json:"opened"`
+	Clicked      int            `json:"clicked"`
+	DeliveryRate float64        `json:"delivery_rate"`
+	ReadRate     float64        `json:"read_rate"`
+	BounceRate   float64        `json:"bounce_rate"`
+	OpenRate     float64        `json:"open_rate"`
+	ClickRate    float64        `json:"click_rate"`
+	ByChannel    map[string]int `json:"by_channel"`
+	ByCategory   map[string]int `json:"by_category"`
+}
@@ -0,0 +1,173 @@
+// Package blobstore stores document content behind a provider-neutral BlobStore interface.
+//
+// The local driver keeps objects on the filesystem and serves presigned downloads itself; the S3
+// driver works against AWS S3 or any S3-compatible server such as MinIO or Supabase Storage.
+// Uploads are streamed, and every Put returns the SHA-256 checksum of what was stored.
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when the object does not exist
+	ErrNotFound = errors.New("blobstore: object not found")
+	// ErrInvalidKey is returned for an empty key or one that escapes the bucket
+	ErrInvalidKey = errors.New("blobstore: invalid object key")
+	// ErrChecksumMismatch is returned when content read back does not match its checksum
+	ErrChecksumMismatch = errors.New("blobstore: checksum mismatch")
+	// ErrInvalidSignature is returned for a presigned URL that is expired or has been altered
+	ErrInvalidSignature = errors.New("blobstore: invalid or expired signature")
+	// ErrUnsupportedProvider is returned by New for an unknown provider
+	ErrUnsupportedProvider = errors.New("blobstore: unsupported provider")
+)
+
+// Provider names a storage backend
+type Provider string
+
+const (
+	ProviderLocal Provider = "local"
+	ProviderS3    Provider = "s3"
+)
+
+// Config holds the storage backend settings
+type Config struct {
+	Provider Provider `json:"provider" yaml:"provider"`
+	Bucket   string   `json:"bucket" yaml:"bucket"`
+
+	// Local filesystem driver
+	LocalRoot    string `json:"local_root,omitempty" yaml:"local_root,omitempty"`
+	LocalBaseURL string `json:"local_base_url,omitempty" yaml:"local_base_url,omitempty"` // URL the download handler is mounted at
+	SigningKey   string `json:"signing_key,omitempty" yaml:"signing_key,omitempty"`       // HMAC key for local presigned URLs
+
+	// S3-compatible driver
+	S3Endpoint        string `json:"s3_endpoint,omitempty" yaml:"s3_endpoint,omitempty"` // e.g. http://localhost:9000 for MinIO; empty for AWS
+	S3Region          string `json:"s3_region,omitempty" yaml:"s3_region,omitempty"`
+	S3AccessKeyID     string `json:"s3_access_key_id,omitempty" yaml:"s3_access_key_id,omitempty"`
+	S3SecretAccessKey string `json:"s3_secret_access_key,omitempty" yaml:"s3_secret_access_key,omitempty"`
+	S3UsePathStyle    bool   `json:"s3_use_path_style,omitempty" yaml:"s3_use_path_style,omitempty"` // required by MinIO
+	S3PartSize        int64  `json:"s3_part_size,omitempty" yaml:"s3_part_size,omitempty"`           // multipart chunk size in bytes
+}
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Bucket      string    `json:"bucket"`
+	Key         string    `json:"key"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	Checksum    string    `json:"checksum"` // hex SHA-256, empty if the backend did not record one
+	ModTime     time.Time `json:"mod_time"`
+}
+
+// PutOptions describe the content being stored
+type PutOptions struct {
+	ContentType string
+	Metadata    map[string]string
+}
+
+// PresignOptions control a presigned download
+type PresignOptions struct {
+	// Filename is sent as the attachment name in Content-Disposition
+	Filename string
+}
+
+// BlobStore stores and retrieves objects by key within a bucket
+type BlobStore interface {
+	// Provider returns the backend type
+	Provider() Provider
+	// Bucket returns the bucket objects are stored in
+	Bucket() string
+	// Put streams r to the key, replacing any existing object, and returns what was stored
+	Put(ctx context.Context, key string, r io.Reader, opts *PutOptions) (*ObjectInfo, error)
+	// Get opens the object for reading; the caller must close it
+	Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+	// Stat returns the object's details without reading it
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// Delete removes the object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+	// PresignGet returns a URL the object can be downloaded from without credentials until it expires
+	PresignGet(ctx context.Context, key string, expires time.Duration, opts *PresignOptions) (string, error)
+}
+
+// New creates the BlobStore for the configured provider
+func New(config *Config) (BlobStore, error) {
+	if config == nil {
+		return nil, fmt.Errorf("blobstore: configuration is required")
+	}
+	switch config.Provider {
+	case ProviderLocal:
+		return NewLocalStore(config)
+	case ProviderS3:
+		return NewS3Store(config)
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnsupportedProvider, config.Provider)
+}
+
+// cleanKey validates an object key, rejecting empty keys and path traversal
+func cleanKey(key string) (string, error) {
+	key = strings.TrimPrefix(key, "/")
+	if key == "" || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+	return key, nil
+}
+
+// checksumReader hashes and counts what is read through it
+type checksumReader struct {
+	r    io.Reader
+	hash hash.Hash
+	n    int64
+}
+
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{r: r, hash: sha256.New()}
+}
+
+func (c *checksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.hash.Write(p[:n])
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *checksumReader) Sum() string {
+	return hex.EncodeToString(c.hash.Sum(nil))
+}
+
+// verifyingReader returns ErrChecksumMismatch at the end of the content if it does not hash to
+// the expected checksum
+type verifyingReader struct {
+	io.ReadCloser
+	sum      *checksumReader
+	expected string
+}
+
+// VerifyChecksum wraps rc so reading it to the end fails with ErrChecksumMismatch unless the
+// content hashes to the expected hex SHA-256. An empty checksum disables the check.
+func VerifyChecksum(rc io.ReadCloser, expected string) io.ReadCloser {
+	if expected == "" {
+		return rc
+	}
+	return &verifyingReader{ReadCloser: rc, sum: newChecksumReader(rc), expected: strings.ToLower(expected)}
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.sum.Read(p)
+	if err == io.EOF && v.sum.Sum() != v.expected {
+		return n, ErrChecksumMismatch
+	}
+	return n, err
+}
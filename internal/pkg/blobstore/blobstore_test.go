@@ -0,0 +1,117 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestLocalStore(t *testing.T) *LocalStore {
+	t.Helper()
+	store, err := NewLocalStore(&Config{
+		Provider:     ProviderLocal,
+		Bucket:       "documents",
+		LocalRoot:    t.TempDir(),
+		LocalBaseURL: "http://localhost/files",
+		SigningKey:   "test-key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestLocalStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := newTestLocalStore(t)
+	content := "medical examiner's certificate"
+	sum := sha256.Sum256([]byte(content))
+
+	info, err := store.Put(ctx, "org/user/doc/cert.pdf", strings.NewReader(content), &PutOptions{ContentType: "application/pdf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Size != int64(len(content)) || info.Checksum != hex.EncodeToString(sum[:]) || info.ContentType != "application/pdf" {
+		t.Fatalf("unexpected object info: %+v", info)
+	}
+
+	rc, _, err := store.Get(ctx, "org/user/doc/cert.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(VerifyChecksum(rc, info.Checksum))
+	rc.Close()
+	if err != nil || string(got) != content {
+		t.Fatalf("read back %q, %v", got, err)
+	}
+
+	rc, _, _ = store.Get(ctx, "org/user/doc/cert.pdf")
+	_, err = io.ReadAll(VerifyChecksum(rc, strings.Repeat("0", 64)))
+	rc.Close()
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected checksum mismatch, got %v", err)
+	}
+
+	if err := store.Delete(ctx, "org/user/doc/cert.pdf"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Stat(ctx, "org/user/doc/cert.pdf"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestLocalStoreRejectsTraversal(t *testing.T) {
+	store := newTestLocalStore(t)
+	for _, key := range []string{"", "../secret", "org/../../secret", "org//doc", `org\doc`} {
+		if _, err := store.Put(context.Background(), key, strings.NewReader("x"), nil); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("key %q: expected ErrInvalidKey, got %v", key, err)
+		}
+	}
+}
+
+func TestLocalStorePresignedDownload(t *testing.T) {
+	ctx := context.Background()
+	store := newTestLocalStore(t)
+	if _, err := store.Put(ctx, "org/doc/report.pdf", strings.NewReader("report"), &PutOptions{ContentType: "application/pdf"}); err != nil {
+		t.Fatal(err)
+	}
+	signed, err := store.PresignGet(ctx, "org/doc/report.pdf", time.Minute, &PresignOptions{Filename: "report.pdf"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serve := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		store.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, strings.TrimPrefix(target, "/files"), nil))
+		return rec
+	}
+
+	rec := serve(u.RequestURI())
+	if rec.Code != http.StatusOK || rec.Body.String() != "report" {
+		t.Fatalf("signed download: %d %q", rec.Code, rec.Body.String())
+	}
+	if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "report.pdf") {
+		t.Errorf("Content-Disposition = %q", cd)
+	}
+
+	tampered := strings.Replace(u.RequestURI(), "report.pdf?", "other.pdf?", 1)
+	if rec := serve(tampered); rec.Code != http.StatusForbidden {
+		t.Errorf("tampered URL: expected 403, got %d", rec.Code)
+	}
+
+	store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if rec := serve(u.RequestURI()); rec.Code != http.StatusForbidden {
+		t.Errorf("expired URL: expected 403, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,280 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// metaSuffix names the sidecar file holding an object's content type and checksum
+const metaSuffix = ".meta.json"
+
+// LocalStore keeps objects under Root/Bucket on the local filesystem. It serves its own presigned
+// downloads: mount it as an http.Handler at BaseURL.
+type LocalStore struct {
+	root       string
+	bucket     string
+	baseURL    string
+	signingKey []byte
+	now        func() time.Time
+}
+
+type localMeta struct {
+	ContentType string            `json:"content_type"`
+	Checksum    string            `json:"checksum"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// NewLocalStore creates a filesystem-backed store
+func NewLocalStore(config *Config) (*LocalStore, error) {
+	if config.LocalRoot == "" {
+		return nil, fmt.Errorf("blobstore: local root directory is required")
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("blobstore: bucket is required")
+	}
+	if config.SigningKey == "" {
+		return nil, fmt.Errorf("blobstore: signing key is required for local presigned URLs")
+	}
+	dir := filepath.Join(config.LocalRoot, config.Bucket)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("blobstore: create bucket directory: %w", err)
+	}
+	return &LocalStore{
+		root:       config.LocalRoot,
+		bucket:     config.Bucket,
+		baseURL:    strings.TrimSuffix(config.LocalBaseURL, "/"),
+		signingKey: []byte(config.SigningKey),
+		now:        time.Now,
+	}, nil
+}
+
+func (s *LocalStore) Provider() Provider { return ProviderLocal }
+
+func (s *LocalStore) Bucket() string { return s.bucket }
+
+// BaseURL returns the URL prefix presigned downloads are served from
+func (s *LocalStore) BaseURL() string { return s.baseURL }
+
+func (s *LocalStore) path(key string) (string, string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	return key, filepath.Join(s.root, s.bucket, filepath.FromSlash(key)), nil
+}
+
+// Put writes the object to a temporary file and renames it into place once fully written
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader, opts *PutOptions) (*ObjectInfo, error) {
+	key, path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &PutOptions{}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	sum := newChecksumReader(r)
+	if _, err := io.Copy(tmp, contextReader{ctx: ctx, r: sum}); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	meta := localMeta{ContentType: opts.ContentType, Checksum: sum.Sum(), Metadata: opts.Metadata}
+	raw, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path+metaSuffix, raw, 0o640); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	return s.Stat(ctx, key)
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	info, err := s.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, path, _ := s.path(key)
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	return f, info, nil
+}
+
+func (s *LocalStore) Stat(_ context.Context, key string) (*ObjectInfo, error) {
+	key, path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if fi.IsDir() {
+		return nil, ErrNotFound
+	}
+	info := &ObjectInfo{Bucket: s.bucket, Key: key, Size: fi.Size(), ModTime: fi.ModTime()}
+	if raw, err := os.ReadFile(path + metaSuffix); err == nil {
+		var meta localMeta
+		if err := json.Unmarshal(raw, &meta); err == nil {
+			info.ContentType = meta.ContentType
+			info.Checksum = meta.Checksum
+		}
+	}
+	return info, nil
+}
+
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	_, path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	for _, p := range []string{path, path + metaSuffix} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// PresignGet returns an HMAC-signed URL under BaseURL that ServeHTTP accepts until it expires
+func (s *LocalStore) PresignGet(_ context.Context, key string, expires time.Duration, opts *PresignOptions) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	filename := ""
+	if opts != nil {
+		filename = opts.Filename
+	}
+	exp := strconv.FormatInt(s.now().Add(expires).Unix(), 10)
+	q := url.Values{}
+	q.Set("expires", exp)
+	if filename != "" {
+		q.Set("filename", filename)
+	}
+	q.Set("signature", s.sign(key, exp, filename))
+	return fmt.Sprintf("%s/%s/%s?%s", s.baseURL, url.PathEscape(s.bucket), escapeKey(key), q.Encode()), nil
+}
+
+// ServeHTTP streams an object for a presigned URL issued by PresignGet. The handler expects the
+// request path relative to BaseURL, i.e. /{bucket}/{key}.
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key, ok := strings.Cut(path, "/")
+	if !ok || bucket != s.bucket {
+		http.NotFound(w, r)
+		return
+	}
+	q := r.URL.Query()
+	if err := s.Verify(key, q.Get("expires"), q.Get("filename"), q.Get("signature")); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	rc, info, err := s.Get(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidKey) {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	defer rc.Close()
+
+	if info.ContentType != "" {
+		w.Header().Set("Content-Type", info.ContentType)
+	}
+	if filename := q.Get("filename"); filename != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	}
+	if info.Checksum != "" {
+		w.Header().Set("ETag", strconv.Quote(info.Checksum))
+	}
+	if f, ok := rc.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, "", info.ModTime, f)
+		return
+	}
+	_, _ = io.Copy(w, rc)
+}
+
+// Verify checks a presigned URL's expiry and signature
+func (s *LocalStore) Verify(key, expires, filename, signature string) error {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || s.now().Unix() > exp {
+		return ErrInvalidSignature
+	}
+	expected := s.sign(key, expires, filename)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (s *LocalStore) sign(key, expires, filename string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(s.bucket + "\n" + key + "\n" + expires + "\n" + filename))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// escapeKey path-escapes each segment of a key, keeping the separators
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+// contextReader stops a copy once the context is cancelled
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,307 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	// defaultPartSize is the multipart upload chunk size when none is configured
+	defaultPartSize = 8 << 20
+	// minPartSize is the smallest part S3 accepts other than the last
+	minPartSize = 5 << 20
+	// checksumMetadataKey holds the hex SHA-256 of the content in the object's user metadata
+	checksumMetadataKey = "sha256"
+)
+
+// S3Store stores objects in an S3 bucket or an S3-compatible server such as MinIO
+type S3Store struct {
+	client   *s3.Client
+	presign  *s3.PresignClient
+	bucket   string
+	partSize int64
+}
+
+// NewS3Store creates an S3-backed store
+func NewS3Store(config *Config) (*S3Store, error) {
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("blobstore: bucket is required")
+	}
+	region := config.S3Region
+	if region == "" {
+		region = "us-east-1" // MinIO ignores the region but the signer needs one
+	}
+
+	// Load AWS config
+	ctx := context.Background()
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if config.S3AccessKeyID != "" && config.S3SecretAccessKey != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
+			config.S3AccessKeyID,
+			config.S3SecretAccessKey,
+			"",
+		)))
+	}
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("blobstore: load AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if config.S3Endpoint != "" {
+			o.BaseEndpoint = aws.String(config.S3Endpoint)
+		}
+		o.UsePathStyle = config.S3UsePathStyle
+	})
+	partSize := config.S3PartSize
+	if partSize < minPartSize {
+		partSize = defaultPartSize
+	}
+	return &S3Store{
+		client:   client,
+		presign:  s3.NewPresignClient(client),
+		bucket:   config.Bucket,
+		partSize: partSize,
+	}, nil
+}
+
+func (s *S3Store) Provider() Provider { return ProviderS3 }
+
+func (s *S3Store) Bucket() string { return s.bucket }
+
+// Put uploads content that fits in one part with a single PutObject and streams anything larger as
+// a multipart upload, so the whole body is never held in memory. The SHA-256 of the content is
+// stored in the object's metadata.
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, opts *PutOptions) (*ObjectInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &PutOptions{}
+	}
+	sum := newChecksumReader(r)
+	first := make([]byte, s.partSize)
+	n, err := io.ReadFull(sum, first)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if n < len(first) {
+		metadata := s.metadata(opts, sum.Sum())
+		_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(s.bucket),
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(first[:n]),
+			ContentLength: aws.Int64(int64(n)),
+			ContentType:   contentType(opts),
+			Metadata:      metadata,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return s.Stat(ctx, key)
+	}
+	return s.putMultipart(ctx, key, sum, first, opts)
+}
+
+// putMultipart uploads the already-read first part and the rest of the stream. The checksum is
+// only known once the stream is consumed, so it is written to the metadata with a final
+// self-copy of the assembled object.
+func (s *S3Store) putMultipart(ctx context.Context, key string, sum *checksumReader, first []byte, opts *PutOptions) (*ObjectInfo, error) {
+	created, err := s.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		ContentType: contentType(opts),
+		Metadata:    opts.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	abort := func(cause error) (*ObjectInfo, error) {
+		_, _ = s.client.AbortMultipartUpload(context.WithoutCancel(ctx), &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(s.bucket),
+			Key:      aws.String(key),
+			UploadId: created.UploadId,
+		})
+		return nil, cause
+	}
+
+	var parts []types.CompletedPart
+	buf := first
+	for partNumber := int32(1); ; partNumber++ {
+		out, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(s.bucket),
+			Key:           aws.String(key),
+			UploadId:      created.UploadId,
+			PartNumber:    aws.Int32(partNumber),
+			Body:          bytes.NewReader(buf),
+			ContentLength: aws.Int64(int64(len(buf))),
+		})
+		if err != nil {
+			return abort(err)
+		}
+		parts = append(parts, types.CompletedPart{ETag: out.ETag, PartNumber: aws.Int32(partNumber)})
+
+		if len(buf) < int(s.partSize) {
+			break
+		}
+		buf = first[:cap(first)]
+		n, err := io.ReadFull(sum, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return abort(err)
+		}
+		if n == 0 {
+			break
+		}
+		buf = buf[:n]
+	}
+
+	_, err = s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(s.bucket),
+		Key:             aws.String(key),
+		UploadId:        created.UploadId,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: parts},
+	})
+	if err != nil {
+		return abort(err)
+	}
+
+	_, err = s.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:            aws.String(s.bucket),
+		Key:               aws.String(key),
+		CopySource:        aws.String(s.bucket + "/" + escapeKey(key)),
+		ContentType:       contentType(opts),
+		Metadata:          s.metadata(opts, sum.Sum()),
+		MetadataDirective: types.MetadataDirectiveReplace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("blobstore: record checksum: %w", err)
+	}
+	return s.Stat(ctx, key)
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, nil, s.mapError(err)
+	}
+	info := &ObjectInfo{
+		Bucket:      s.bucket,
+		Key:         key,
+		Size:        aws.ToInt64(out.ContentLength),
+		ContentType: aws.ToString(out.ContentType),
+		Checksum:    out.Metadata[checksumMetadataKey],
+		ModTime:     aws.ToTime(out.LastModified),
+	}
+	return out.Body, info, nil
+}
+
+func (s *S3Store) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, s.mapError(err)
+	}
+	return &ObjectInfo{
+		Bucket:      s.bucket,
+		Key:         key,
+		Size:        aws.ToInt64(out.ContentLength),
+		ContentType: aws.ToString(out.ContentType),
+		Checksum:    out.Metadata[checksumMetadataKey],
+		ModTime:     aws.ToTime(out.LastModified),
+	}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err = s.mapError(err); errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// PresignGet returns a SigV4 presigned GET URL
+func (s *S3Store) PresignGet(ctx context.Context, key string, expires time.Duration, opts *PresignOptions) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	in := &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}
+	if opts != nil && opts.Filename != "" {
+		in.ResponseContentDisposition = aws.String(mime.FormatMediaType("attachment", map[string]string{"filename": opts.Filename}))
+	}
+	req, err := s.presign.PresignGetObject(ctx, in, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", err
+	}
+	return req.URL, nil
+}
+
+func (s *S3Store) metadata(opts *PutOptions, checksum string) map[string]string {
+	metadata := make(map[string]string, len(opts.Metadata)+1)
+	for k, v := range opts.Metadata {
+		metadata[k] = v
+	}
+	metadata[checksumMetadataKey] = checksum
+	return metadata
+}
+
+// mapError converts missing-object responses to ErrNotFound
+func (s *S3Store) mapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var noSuchKey *types.NoSuchKey
+	var notFound *types.NotFound
+	if errors.As(err, &noSuchKey) || errors.As(err, &notFound) {
+		return ErrNotFound
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && strings.EqualFold(apiErr.ErrorCode(), "NotFound") {
+		return ErrNotFound
+	}
+	return err
+}
+
+func contentType(opts *PutOptions) *string {
+	if opts.ContentType == "" {
+		return nil
+	}
+	return aws.String(opts.ContentType)
+}
@@ -0,0 +1,129 @@
+// Package deliverywindow decides when a notification may be delivered to a user: outside the user's
+// quiet hours, and for notifications batched into digests, at the next digest time. Times are
+// worked out in the user's time zone.
+package deliverywindow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Clock is a time of day, in minutes after midnight
+type Clock int
+
+// ErrInvalidClock is returned for a time of day not written as HH:MM
+var ErrInvalidClock = errors.New("deliverywindow: time of day must be HH:MM")
+
+// ParseClock parses a 24-hour time of day such as "22:00". Seconds, as in Postgres TIME values, are
+// accepted and ignored.
+func ParseClock(s string) (Clock, error) {
+	var h, m, sec int
+	n, _ := fmt.Sscanf(s, "%d:%d:%d", &h, &m, &sec)
+	if n < 2 || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClock, s)
+	}
+	return Clock(h*60 + m), nil
+}
+
+// String returns the time of day as HH:MM
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", int(c)/60, int(c)%60)
+}
+
+// on returns the time at the clock on t's day, in t's location
+func (c Clock) on(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), int(c)/60, int(c)%60, 0, 0, t.Location())
+}
+
+// QuietHours is the daily period in which a user receives no notifications. The period may run past
+// midnight, as from 22:00 to 07:00; when Start equals End there are no quiet hours.
+type QuietHours struct {
+	Start    Clock
+	End      Clock
+	Location *time.Location
+}
+
+// Contains reports whether t falls in the quiet hours
+func (q QuietHours) Contains(t time.Time) bool {
+	if q.Start == q.End {
+		return false
+	}
+	local := t.In(q.location())
+	now := Clock(local.Hour()*60 + local.Minute())
+	if q.Start < q.End {
+		return now >= q.Start && now < q.End
+	}
+	return now >= q.Start || now < q.End
+}
+
+// Next returns the earliest time from t outside the quiet hours: t itself, or the end of the quiet
+// hours t falls in
+func (q QuietHours) Next(t time.Time) time.Time {
+	if !q.Contains(t) {
+		return t
+	}
+	local := t.In(q.location())
+	end := q.End.on(local)
+	if !end.After(local) {
+		end = q.End.on(local.AddDate(0, 0, 1))
+	}
+	return end
+}
+
+func (q QuietHours) location() *time.Location {
+	if q.Location == nil {
+		return time.UTC
+	}
+	return q.Location
+}
+
+// Frequency is how often a user receives notifications of a kind
+type Frequency string
+
+const (
+	Immediate    Frequency = "immediate"
+	DailyDigest  Frequency = "daily_digest"
+	WeeklyDigest Frequency = "weekly_digest"
+)
+
+// DigestTime is the time of day digests are sent, in the user's time zone
+const DigestTime = Clock(8 * 60)
+
+// DigestDay is the day weekly digests are sent
+const DigestDay = time.Monday
+
+// Valid reports whether the frequency is one of the known frequencies
+func (f Frequency) Valid() bool {
+	switch f {
+	case Immediate, DailyDigest, WeeklyDigest:
+		return true
+	}
+	return false
+}
+
+// NextDigest returns when the digest a notification at t is batched into is sent: the next
+// DigestTime, on DigestDay for weekly digests. Immediate notifications are not batched and are due
+// at t.
+func NextDigest(t time.Time, f Frequency, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	next := DigestTime.on(local)
+	switch f {
+	case DailyDigest:
+		if !next.After(local) {
+			next = DigestTime.on(local.AddDate(0, 0, 1))
+		}
+	case WeeklyDigest:
+		days := (int(DigestDay) - int(local.Weekday()) + 7) % 7
+		next = DigestTime.on(local.AddDate(0, 0, days))
+		if !next.After(local) {
+			next = DigestTime.on(local.AddDate(0, 0, days+7))
+		}
+	default:
+		return t
+	}
+	return next
+}
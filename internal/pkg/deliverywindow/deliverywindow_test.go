@@ -0,0 +1,79 @@
+package deliverywindow
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseClock(t *testing.T) {
+	for in, want := range map[string]Clock{"00:00": 0, "07:30": 450, "22:00": 1320, "23:59:00": 1439} {
+		if got, err := ParseClock(in); err != nil || got != want {
+			t.Errorf("ParseClock(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "7", "24:00", "12:60", "noon"} {
+		if _, err := ParseClock(in); !errors.Is(err, ErrInvalidClock) {
+			t.Errorf("ParseClock(%q) error = %v", in, err)
+		}
+	}
+	if s := Clock(450).String(); s != "07:30" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestQuietHours(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Skip("time zone database unavailable")
+	}
+	overnight := QuietHours{Start: 22 * 60, End: 7 * 60, Location: chicago}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.March, day, hour, minute, 0, 0, chicago)
+	}
+
+	for _, tc := range []struct {
+		t    time.Time
+		want time.Time
+	}{
+		{at(10, 21, 59), at(10, 21, 59)},
+		{at(10, 22, 0), at(11, 7, 0)},
+		{at(11, 3, 15), at(11, 7, 0)},
+		{at(11, 7, 0), at(11, 7, 0)},
+	} {
+		// Times are given in UTC, as the dispatcher sees them
+		if got := overnight.Next(tc.t.UTC()); !got.Equal(tc.want) {
+			t.Errorf("overnight Next(%v) = %v, want %v", tc.t, got, tc.want)
+		}
+	}
+
+	lunch := QuietHours{Start: 12 * 60, End: 13 * 60, Location: chicago}
+	if got := lunch.Next(at(10, 12, 30)); !got.Equal(at(10, 13, 0)) {
+		t.Errorf("daytime Next = %v", got)
+	}
+	if (QuietHours{Start: 600, End: 600}).Contains(at(10, 10, 0)) {
+		t.Error("equal start and end should mean no quiet hours")
+	}
+}
+
+func TestNextDigest(t *testing.T) {
+	// 2026-03-11 is a Wednesday
+	wed := time.Date(2026, time.March, 11, 9, 0, 0, 0, time.UTC)
+	if got, want := NextDigest(wed, DailyDigest, nil), time.Date(2026, time.March, 12, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("daily after 08:00 = %v, want %v", got, want)
+	}
+	early := time.Date(2026, time.March, 11, 6, 0, 0, 0, time.UTC)
+	if got, want := NextDigest(early, DailyDigest, nil), time.Date(2026, time.March, 11, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("daily before 08:00 = %v, want %v", got, want)
+	}
+	if got, want := NextDigest(wed, WeeklyDigest, nil), time.Date(2026, time.March, 16, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekly = %v, want %v", got, want)
+	}
+	monday := time.Date(2026, time.March, 16, 8, 0, 0, 0, time.UTC)
+	if got, want := NextDigest(monday, WeeklyDigest, nil), time.Date(2026, time.March, 23, 8, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekly at digest time = %v, want %v", got, want)
+	}
+	if got := NextDigest(wed, Immediate, nil); !got.Equal(wed) {
+		t.Errorf("immediate = %v", got)
+	}
+}
@@ -0,0 +1,168 @@
+// Package docbundle assembles documents into a single downloadable bundle with an index of its
+// contents.
+//
+// A bundle is written as a PDF or a ZIP archive. A PDF bundle opens with index pages listing every
+// section, entry and missing item, and carries the documents as embedded file attachments, so the
+// bundle is one self-contained file. A ZIP bundle holds the same index as index.pdf followed by the
+// documents. Entries without content, such as database records, appear in the index only.
+package docbundle
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// Format is the file format a bundle is written in
+type Format string
+
+const (
+	FormatPDF Format = "pdf"
+	FormatZIP Format = "zip"
+)
+
+// ErrUnsupportedFormat is returned for a bundle format other than PDF or ZIP
+var ErrUnsupportedFormat = errors.New("docbundle: unsupported bundle format")
+
+// ParseFormat returns the bundle format with the given name; an empty name is a PDF
+func ParseFormat(name string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(name))); f {
+	case "":
+		return FormatPDF, nil
+	case FormatPDF, FormatZIP:
+		return f, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// ContentType returns the MIME type of bundles in the format
+func (f Format) ContentType() string {
+	if f == FormatZIP {
+		return "application/zip"
+	}
+	return "application/pdf"
+}
+
+// Entry is an item of a bundle section. Entries with Open have their content included in the
+// bundle under FileName; the others are listed in the index with their Details only.
+type Entry struct {
+	Title    string
+	Date     time.Time
+	Details  []string
+	FileName string
+	Open     func() (io.ReadCloser, error)
+}
+
+// Section is a titled group of bundle entries
+type Section struct {
+	Title   string
+	Entries []Entry
+}
+
+// Bundle is a set of documents in sections, with the items that should be in the bundle but are
+// missing
+type Bundle struct {
+	Title    string
+	Subtitle []string
+	Sections []Section
+	Missing  []string
+}
+
+// Write writes the bundle in the given format. The content of each entry is opened, copied and
+// closed in turn, so only one entry is open at a time.
+func Write(w io.Writer, b *Bundle, format Format) error {
+	switch format {
+	case FormatPDF:
+		list, names := attachments(b)
+		return writePDF(w, b.Title, indexLines(b, names), list)
+	case FormatZIP:
+		return writeZIP(w, b)
+	}
+	return ErrUnsupportedFormat
+}
+
+// attachment is an entry whose content is included in the bundle
+type attachment struct {
+	name  string
+	entry *Entry
+}
+
+// attachments returns the bundle's entries with content, named in bundle order so that names sort
+// in the order the entries are listed
+func attachments(b *Bundle) ([]attachment, map[*Entry]string) {
+	var list []attachment
+	names := make(map[*Entry]string)
+	for i := range b.Sections {
+		for j := range b.Sections[i].Entries {
+			entry := &b.Sections[i].Entries[j]
+			if entry.Open == nil {
+				continue
+			}
+			name := fmt.Sprintf("%03d-%s", len(list)+1, safeFileName(entry.FileName))
+			list = append(list, attachment{name: name, entry: entry})
+			names[entry] = name
+		}
+	}
+	return list, names
+}
+
+// safeFileName returns the base name of a file name with characters unsafe in archive and
+// attachment names replaced
+func safeFileName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e || strings.ContainsRune(`/\:*?"<>|()`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return "document"
+	}
+	return name
+}
+
+// copyEntry copies an entry's content to w
+func copyEntry(w io.Writer, entry *Entry) (int64, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return 0, fmt.Errorf("docbundle: open %q: %w", entry.Title, err)
+	}
+	defer rc.Close()
+	n, err := io.Copy(w, rc)
+	if err != nil {
+		return n, fmt.Errorf("docbundle: read %q: %w", entry.Title, err)
+	}
+	return n, nil
+}
+
+// writeZIP writes the bundle as a ZIP archive of index.pdf followed by the entries' content
+func writeZIP(w io.Writer, b *Bundle) error {
+	list, names := attachments(b)
+	zw := zip.NewWriter(w)
+	index, err := zw.CreateHeader(&zip.FileHeader{Name: "index.pdf", Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return err
+	}
+	if err = writePDF(index, b.Title, indexLines(b, names), nil); err != nil {
+		return err
+	}
+	for _, a := range list {
+		modified := a.entry.Date
+		if modified.IsZero() {
+			modified = time.Now()
+		}
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: a.name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		if _, err = copyEntry(f, a.entry); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
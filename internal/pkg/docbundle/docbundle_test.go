@@ -0,0 +1,169 @@
+package docbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"v1consortium/internal/pkg/textextract"
+)
+
+func content(s string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(s)), nil
+	}
+}
+
+func testBundle() *Bundle {
+	return &Bundle{
+		Title:    "Driver Qualification File",
+		Subtitle: []string{"Jane Driver (Acme Freight)"},
+		Sections: []Section{
+			{
+				Title: "Employment application",
+				Entries: []Entry{{
+					Title:    "Application (signed)",
+					Date:     time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+					FileName: "application.pdf",
+					Open:     content("application content"),
+				}},
+			},
+			{
+				Title: "Motor vehicle records",
+				Entries: []Entry{{
+					Title:   "MVR report TX",
+					Details: []string{"License TX 12345678, status valid", "2 violations (0 major)"},
+				}},
+			},
+			{Title: "Road test"},
+		},
+		Missing: []string{"Road test certificate (49 CFR 391.31)"},
+	}
+}
+
+func extractText(t *testing.T, pdf []byte) string {
+	t.Helper()
+	text, err := textextract.Extract(bytes.NewReader(pdf), "application/pdf")
+	if err != nil {
+		t.Fatalf("index is not a readable PDF: %v", err)
+	}
+	return text
+}
+
+func TestWritePDF(t *testing.T) {
+	var b bytes.Buffer
+	if err := Write(&b, testBundle(), FormatPDF); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	out := b.Bytes()
+	if !bytes.HasPrefix(out, []byte("%PDF-")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("bundle is not a PDF")
+	}
+
+	text := extractText(t, out)
+	for _, want := range []string{
+		"Driver Qualification File", "1. Employment application", "Application (signed) - 2026-03-02",
+		"Attached as 001-application.pdf", "License TX 12345678", "None on file",
+		"Missing items", "Road test certificate (49 CFR 391.31)", "Page 1 of 1",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("index is missing %q:\n%s", want, text)
+		}
+	}
+	for _, want := range []string{"/EmbeddedFiles", "(001-application.pdf)", "application content", "/PageMode /UseAttachments"} {
+		if !bytes.Contains(out, []byte(want)) {
+			t.Errorf("PDF is missing %q", want)
+		}
+	}
+}
+
+func TestWritePDFPaginatesLongIndex(t *testing.T) {
+	bundle := testBundle()
+	for i := 0; i < 120; i++ {
+		bundle.Sections[1].Entries = append(bundle.Sections[1].Entries, Entry{
+			Title:   fmt.Sprintf("MVR report %d", i),
+			Details: []string{strings.Repeat("violation ", 30)},
+		})
+	}
+	var b bytes.Buffer
+	if err := Write(&b, bundle, FormatPDF); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	text := extractText(t, b.Bytes())
+	if !strings.Contains(text, "Page 2 of") || !strings.Contains(text, "MVR report 119") {
+		t.Errorf("long index was not split across pages:\n%s", text[len(text)-200:])
+	}
+}
+
+func TestWriteZIP(t *testing.T) {
+	var b bytes.Buffer
+	if err := Write(&b, testBundle(), FormatZIP); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatalf("bundle is not a ZIP: %v", err)
+	}
+	if len(zr.File) != 2 || zr.File[0].Name != "index.pdf" || zr.File[1].Name != "001-application.pdf" {
+		t.Fatalf("unexpected archive contents: %v", zr.File)
+	}
+	read := func(f *zip.File) []byte {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		return data
+	}
+	if text := extractText(t, read(zr.File[0])); !strings.Contains(text, "Road test certificate") {
+		t.Errorf("index is missing the missing items:\n%s", text)
+	}
+	if got := string(read(zr.File[1])); got != "application content" {
+		t.Errorf("attachment content = %q", got)
+	}
+}
+
+func TestWriteFailsWhenContentCannotBeOpened(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	bundle := testBundle()
+	bundle.Sections[0].Entries[0].Open = func() (io.ReadCloser, error) { return nil, errUnavailable }
+	for _, format := range []Format{FormatPDF, FormatZIP} {
+		if err := Write(io.Discard, bundle, format); !errors.Is(err, errUnavailable) {
+			t.Errorf("%s: Write error = %v, want %v", format, err, errUnavailable)
+		}
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	for name, want := range map[string]Format{"": FormatPDF, "PDF": FormatPDF, "zip": FormatZIP} {
+		if got, err := ParseFormat(name); err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", name, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("tar"); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("ParseFormat(tar) error = %v", err)
+	}
+}
+
+func TestSafeFileName(t *testing.T) {
+	for in, want := range map[string]string{
+		"report.pdf":            "report.pdf",
+		"../../etc/passwd":      "passwd",
+		`C:\scans\cert (1).pdf`: "cert _1_.pdf",
+		"":                      "document",
+		"résumé.pdf":            "r_sum_.pdf",
+	} {
+		if got := safeFileName(in); got != want {
+			t.Errorf("safeFileName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
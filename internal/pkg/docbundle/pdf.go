@@ -0,0 +1,287 @@
+package docbundle
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Page layout of the index, in points on a US Letter page
+const (
+	pageWidth    = 612
+	pageHeight   = 792
+	pageMargin   = 54
+	footerSize   = 8
+	indentWidth  = 18
+	maxLineChars = 95 // characters of 10pt Helvetica that fit the text width
+)
+
+// line is a line of index text
+type line struct {
+	text   string
+	size   float64
+	bold   bool
+	indent int
+}
+
+// indexLines returns the index of a bundle: its title, each section with its entries and the name
+// each entry is attached under, and the missing items
+func indexLines(b *Bundle, names map[*Entry]string) []line {
+	lines := []line{{text: b.Title, size: 16, bold: true}}
+	for _, s := range b.Subtitle {
+		lines = append(lines, line{text: s, size: 10})
+	}
+	for i := range b.Sections {
+		section := &b.Sections[i]
+		lines = append(lines, line{}, line{text: fmt.Sprintf("%d. %s", i+1, section.Title), size: 12, bold: true})
+		if len(section.Entries) == 0 {
+			lines = append(lines, line{text: "None on file", size: 10, indent: 1})
+		}
+		for j := range section.Entries {
+			entry := &section.Entries[j]
+			heading := fmt.Sprintf("%d.%d %s", i+1, j+1, entry.Title)
+			if !entry.Date.IsZero() {
+				heading += " - " + entry.Date.Format("2006-01-02")
+			}
+			lines = append(lines, line{text: heading, size: 10, bold: true, indent: 1})
+			for _, d := range entry.Details {
+				lines = append(lines, line{text: d, size: 10, indent: 2})
+			}
+			if name, ok := names[entry]; ok {
+				lines = append(lines, line{text: "Attached as " + name, size: 10, indent: 2})
+			}
+		}
+	}
+	lines = append(lines, line{}, line{text: "Missing items", size: 12, bold: true})
+	if len(b.Missing) == 0 {
+		lines = append(lines, line{text: "None", size: 10, indent: 1})
+	}
+	for _, m := range b.Missing {
+		lines = append(lines, line{text: "- " + m, size: 10, indent: 1})
+	}
+	return wrapLines(lines)
+}
+
+// wrapLines splits lines too long for the page width at spaces, keeping their style
+func wrapLines(lines []line) []line {
+	var out []line
+	for _, l := range lines {
+		limit := maxLineChars - l.indent*4
+		if l.size > 10 {
+			limit = int(float64(limit) * 10 / l.size)
+		}
+		text := []rune(l.text)
+		for len(text) > limit {
+			cut := limit
+			for i := limit; i > 0; i-- {
+				if text[i] == ' ' {
+					cut = i
+					break
+				}
+			}
+			out = append(out, line{text: string(text[:cut]), size: l.size, bold: l.bold, indent: l.indent})
+			for cut < len(text) && text[cut] == ' ' {
+				cut++
+			}
+			text = text[cut:]
+		}
+		l.text = string(text)
+		out = append(out, l)
+	}
+	return out
+}
+
+// paginate splits index lines into pages
+func paginate(lines []line) [][]line {
+	var pages [][]line
+	var page []line
+	y := float64(pageHeight - pageMargin)
+	for _, l := range lines {
+		height := leading(l)
+		if y-height < pageMargin+2*footerSize && len(page) > 0 {
+			pages = append(pages, page)
+			page, y = nil, pageHeight-pageMargin
+		}
+		page = append(page, l)
+		y -= height
+	}
+	return append(pages, page)
+}
+
+// leading returns the vertical space a line takes
+func leading(l line) float64 {
+	if l.size == 0 {
+		return 10
+	}
+	return l.size * 1.45
+}
+
+// pageContent returns the content stream drawing a page of index lines and its footer
+func pageContent(lines []line, page, pages int) string {
+	var b strings.Builder
+	y := float64(pageHeight - pageMargin)
+	for _, l := range lines {
+		y -= leading(l)
+		if l.text == "" {
+			continue
+		}
+		font := "F1"
+		if l.bold {
+			font = "F2"
+		}
+		fmt.Fprintf(&b, "BT /%s %g Tf %d %.2f Td (%s) Tj ET\n", font, l.size, pageMargin+l.indent*indentWidth, y, pdfText(l.text))
+	}
+	fmt.Fprintf(&b, "BT /F1 %d Tf %d %d Td (Page %d of %d) Tj ET\n", footerSize, pageMargin, pageMargin, page, pages)
+	return b.String()
+}
+
+// pdfText encodes text as the body of a PDF literal string in WinAnsiEncoding, replacing characters
+// the encoding lacks
+func pdfText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r <= 0x7e:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		case r == '–' || r == '—':
+			b.WriteByte('-')
+		case r == '\t' || r == '\n' || r == '\r':
+			b.WriteByte(' ')
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// pdfDate formats a time as a PDF date string
+func pdfDate(t time.Time) string {
+	return t.UTC().Format("D:20060102150405Z")
+}
+
+// pdfWriter writes numbered PDF objects, recording their offsets for the cross-reference table
+type pdfWriter struct {
+	w       io.Writer
+	n       int64
+	offsets map[int]int64
+	next    int
+	err     error
+}
+
+func (p *pdfWriter) Write(b []byte) (int, error) {
+	if p.err != nil {
+		return 0, p.err
+	}
+	n, err := p.w.Write(b)
+	p.n += int64(n)
+	p.err = err
+	return n, err
+}
+
+func (p *pdfWriter) printf(format string, args ...any) {
+	fmt.Fprintf(p, format, args...)
+}
+
+// reserve returns the number of a new object, to be written later
+func (p *pdfWriter) reserve() int {
+	p.next++
+	return p.next
+}
+
+// object writes an object with the given number and body
+func (p *pdfWriter) object(num int, body string) {
+	p.offsets[num] = p.n
+	p.printf("%d 0 obj\n%s\nendobj\n", num, body)
+}
+
+// stream writes a stream object whose length is written afterwards as its own object, so the
+// content can be copied without knowing its size in advance
+func (p *pdfWriter) stream(num int, dict string, write func(io.Writer) (int64, error)) error {
+	length := p.reserve()
+	p.offsets[num] = p.n
+	p.printf("%d 0 obj\n<< %s /Length %d 0 R >>\nstream\n", num, dict, length)
+	if p.err != nil {
+		return p.err
+	}
+	n, err := write(p)
+	if err != nil {
+		return err
+	}
+	p.printf("\nendstream\nendobj\n")
+	p.object(length, fmt.Sprint(n))
+	return p.err
+}
+
+// finish writes the cross-reference table and trailer
+func (p *pdfWriter) finish(root, info int) error {
+	xref := p.n
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", p.next+1)
+	for num := 1; num <= p.next; num++ {
+		p.printf("%010d 00000 n \n", p.offsets[num])
+	}
+	p.printf("trailer\n<< /Size %d /Root %d 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", p.next+1, root, info, xref)
+	return p.err
+}
+
+// writePDF writes a PDF of index lines with the attachments embedded
+func writePDF(w io.Writer, title string, lines []line, list []attachment) error {
+	p := &pdfWriter{w: w, offsets: make(map[int]int64)}
+	p.printf("%%PDF-1.7\n%%\xe2\xe3\xcf\xd3\n")
+	pagesNum := p.writePages(lines)
+
+	refs := make([]string, 0, len(list))
+	for _, a := range list {
+		file := p.reserve()
+		dict := "/Type /EmbeddedFile"
+		if !a.entry.Date.IsZero() {
+			dict += fmt.Sprintf(" /Params << /ModDate (%s) >>", pdfDate(a.entry.Date))
+		}
+		entry := a.entry
+		if err := p.stream(file, dict, func(w io.Writer) (int64, error) { return copyEntry(w, entry) }); err != nil {
+			return err
+		}
+		spec := p.reserve()
+		p.object(spec, fmt.Sprintf("<< /Type /Filespec /F (%[1]s) /UF (%[1]s) /Desc (%[2]s) /EF << /F %[3]d 0 R /UF %[3]d 0 R >> >>",
+			pdfText(a.name), pdfText(a.entry.Title), file))
+		refs = append(refs, fmt.Sprintf("(%s) %d 0 R", pdfText(a.name), spec))
+	}
+	sort.Strings(refs)
+
+	info := p.reserve()
+	p.object(info, fmt.Sprintf("<< /Title (%s) /Producer (docbundle) /CreationDate (%s) >>", pdfText(title), pdfDate(time.Now())))
+	catalog := p.reserve()
+	body := fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R", pagesNum)
+	if len(refs) > 0 {
+		body += fmt.Sprintf(" /Names << /EmbeddedFiles << /Names [%s] >> >> /PageMode /UseAttachments", strings.Join(refs, " "))
+	}
+	p.object(catalog, body+" >>")
+	return p.finish(catalog, info)
+}
+
+// writePages writes the page tree of index lines and its fonts, returning the page tree's number
+func (p *pdfWriter) writePages(lines []line) int {
+	pagesNum := p.reserve()
+	regular, bold := p.reserve(), p.reserve()
+	p.object(regular, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	p.object(bold, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	pages := paginate(lines)
+	kids := make([]string, len(pages))
+	for i, page := range pages {
+		pageNum, contentNum := p.reserve(), p.reserve()
+		kids[i] = fmt.Sprintf("%d 0 R", pageNum)
+		p.object(pageNum, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> /Contents %d 0 R >>",
+			pagesNum, pageWidth, pageHeight, regular, bold, contentNum))
+		content := pageContent(page, i+1, len(pages))
+		p.object(contentNum, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+	p.object(pagesNum, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	return pagesNum
+}
@@ -60,6 +60,11 @@ func NewAWSSESSender(config *EmailConfig) (*AWSSESSender, error) {
 
 // SendEmail sends a basic email using AWS SES
 func (s *AWSSESSender) SendEmail(ctx context.Context, message *EmailMessage) (*EmailResponse, error) {
+	// SendEmail has no attachment support, so messages with attachments are sent as raw MIME
+	if len(message.Attachments) > 0 {
+		return s.sendRawEmail(ctx, message)
+	}
+
 	// Build destination
 	destination := &types.Destination{}
 
@@ -134,6 +139,37 @@ func (s *AWSSESSender) SendEmail(ctx context.Context, message *EmailMessage) (*E
 	}, nil
 }
 
+// sendRawEmail sends a message with attachments as a raw MIME message
+func (s *AWSSESSender) sendRawEmail(ctx context.Context, message *EmailMessage) (*EmailResponse, error) {
+	raw, err := buildRawMessage(message, s.formatEmailAddress)
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidMessage, MsgRawMessageFailed, err)
+	}
+
+	var destinations []string
+	for _, list := range [][]EmailAddress{message.To, message.CC, message.BCC} {
+		for _, addr := range list {
+			destinations = append(destinations, addr.Email)
+		}
+	}
+
+	result, err := s.client.SendRawEmail(ctx, &ses.SendRawEmailInput{
+		Source:       aws.String(s.formatEmailAddress(message.From)),
+		Destinations: destinations,
+		RawMessage:   &types.RawMessage{Data: raw},
+	})
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeAWSSendError, MsgAWSSendFailed, err)
+	}
+
+	return &EmailResponse{
+		MessageID: aws.ToString(result.MessageId),
+		Status:    "sent",
+		Provider:  ProviderAWSSES,
+		SentAt:    time.Now(),
+	}, nil
+}
+
 // SendTemplateEmail sends a template-based email using AWS SES
 func (s *AWSSESSender) SendTemplateEmail(ctx context.Context, message *TemplateEmailMessage) (*EmailResponse, error) {
 	// Build destination
@@ -3,6 +3,7 @@ package emailpkg
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,6 +28,13 @@ type BrevoEmailRequest struct {
 	TextContent string              `json:"textContent,omitempty"`
 	HTMLContent string              `json:"htmlContent,omitempty"`
 	Headers     map[string]string   `json:"headers,omitempty"`
+	Attachment  []BrevoAttachment   `json:"attachment,omitempty"`
+}
+
+// BrevoAttachment represents a base64-encoded attachment in Brevo format
+type BrevoAttachment struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
 }
 
 // BrevoTemplateEmailRequest represents the request structure for Brevo template API
@@ -97,6 +105,12 @@ func (s *BrevoSender) SendEmail(ctx context.Context, message *EmailMessage) (*Em
 	if len(message.BCC) > 0 {
 		brevoRequest.BCC = s.convertToBrevoAddresses(message.BCC)
 	}
+	for _, a := range message.Attachments {
+		brevoRequest.Attachment = append(brevoRequest.Attachment, BrevoAttachment{
+			Name:    a.Filename,
+			Content: base64.StdEncoding.EncodeToString(a.Content),
+		})
+	}
 
 	// Send request
 	response, err := s.sendBrevoRequest(ctx, "/smtp/email", brevoRequest)
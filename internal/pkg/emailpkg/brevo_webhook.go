@@ -0,0 +1,99 @@
+package emailpkg
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// brevoEvent is a transactional email event Brevo posts to a webhook
+type brevoEvent struct {
+	Event     string `json:"event"`
+	Email     string `json:"email"`
+	MessageID string `json:"message-id"`
+	Reason    string `json:"reason"`
+	Link      string `json:"link"`
+	TsEvent   int64  `json:"ts_event"`
+}
+
+// brevoEventTypes maps the Brevo events recorded to delivery events. Blocked emails were not sent
+// because Brevo refuses the address, which is treated as a hard bounce.
+var brevoEventTypes = map[string]DeliveryEventType{
+	"delivered":     EventDelivered,
+	"hard_bounce":   EventBounced,
+	"soft_bounce":   EventBounced,
+	"invalid_email": EventBounced,
+	"blocked":       EventBounced,
+	"spam":          EventComplained,
+	"opened":        EventOpened,
+	"unique_opened": EventOpened,
+	"click":         EventClicked,
+}
+
+// ParseBrevoWebhook authenticates a Brevo webhook call by its bearer token and returns the delivery
+// events it carries. Batched calls carry several events; events of other types are ignored.
+func (p *WebhookParser) ParseBrevoWebhook(r *http.Request) ([]*DeliveryEvent, error) {
+	if p.config.BrevoWebhookToken == "" {
+		return nil, NewEmailError(ProviderBrevo, ErrCodeWebhooksDisabled, MsgWebhooksDisabled)
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(p.config.BrevoWebhookToken)) != 1 {
+		return nil, NewEmailError(ProviderBrevo, ErrCodeInvalidSignature, MsgInvalidSignature)
+	}
+	body, err := readWebhookBody(ProviderBrevo, r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBrevoEvents(body)
+}
+
+// ParseBrevoEvents returns the delivery events in the body of a Brevo webhook call, which holds one
+// event or, for batched webhooks, an array of them
+func ParseBrevoEvents(body []byte) ([]*DeliveryEvent, error) {
+	var raw []brevoEvent
+	var err error
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &raw)
+	} else {
+		raw = make([]brevoEvent, 1)
+		err = json.Unmarshal(body, &raw[0])
+	}
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderBrevo, ErrCodeInvalidWebhook, MsgInvalidWebhook, err)
+	}
+
+	var events []*DeliveryEvent
+	for _, e := range raw {
+		eventType, ok := brevoEventTypes[e.Event]
+		if !ok {
+			continue
+		}
+		if e.MessageID == "" {
+			return nil, NewEmailError(ProviderBrevo, ErrCodeInvalidWebhook, MsgInvalidWebhook+": message ID is missing")
+		}
+		event := &DeliveryEvent{
+			Provider:   ProviderBrevo,
+			MessageID:  e.MessageID,
+			Type:       eventType,
+			Recipient:  e.Email,
+			Reason:     e.Reason,
+			OccurredAt: time.Now(),
+		}
+		if e.TsEvent > 0 {
+			event.OccurredAt = time.Unix(e.TsEvent, 0)
+		}
+		switch e.Event {
+		case "soft_bounce":
+			event.BounceType = BounceSoft
+		case "hard_bounce", "invalid_email", "blocked":
+			event.BounceType = BounceHard
+		case "click":
+			event.Reason = e.Link
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
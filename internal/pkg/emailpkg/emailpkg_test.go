@@ -1,7 +1,23 @@
 package emailpkg
 
 import (
+	"bytes"
 	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
 	"testing"
 	"time"
 )
@@ -281,6 +297,77 @@ func TestEmailError(t *testing.T) {
 	}
 }
 
+// TestBuildRawMessage tests rendering a message with an attachment as MIME
+func TestBuildRawMessage(t *testing.T) {
+	invite := []byte("BEGIN:VCALENDAR\r\nMETHOD:REQUEST\r\nEND:VCALENDAR\r\n")
+	message := &EmailMessage{
+		From:     EmailAddress{Email: "noreply@example.com", Name: "Compliance Team"},
+		To:       []EmailAddress{{Email: "driver@example.com", Name: "José Driver"}},
+		BCC:      []EmailAddress{{Email: "audit@example.com"}},
+		Subject:  "DOT physical scheduled",
+		TextBody: "Your appointment is confirmed.",
+		HTMLBody: "<p>Your appointment is confirmed.</p>",
+		Attachments: []Attachment{{
+			Filename:    "invite.ics",
+			Content:     invite,
+			ContentType: "text/calendar; method=REQUEST; charset=UTF-8",
+		}},
+	}
+
+	raw, err := buildRawMessage(message, (&AWSSESSender{}).formatEmailAddress)
+	if err != nil {
+		t.Fatalf("buildRawMessage: %v", err)
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	if got := parsed.Header.Get("Subject"); got != message.Subject {
+		t.Errorf("expected subject %q, got %q", message.Subject, got)
+	}
+	to, err := parsed.Header.AddressList("To")
+	if err != nil || len(to) != 1 || to[0].Name != "José Driver" {
+		t.Errorf("expected decoded To address, got %v (%v)", to, err)
+	}
+	if parsed.Header.Get("Bcc") != "" {
+		t.Error("BCC recipients must not appear in the headers")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/mixed" {
+		t.Fatalf("expected multipart/mixed, got %q (%v)", mediaType, err)
+	}
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+
+	body, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("body part: %v", err)
+	}
+	if mediaType, _, _ = mime.ParseMediaType(body.Header.Get("Content-Type")); mediaType != "multipart/alternative" {
+		t.Errorf("expected multipart/alternative body, got %q", mediaType)
+	}
+
+	attachment, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("attachment part: %v", err)
+	}
+	if attachment.FileName() != "invite.ics" {
+		t.Errorf("expected filename invite.ics, got %q", attachment.FileName())
+	}
+	content, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, attachment))
+	if err != nil {
+		t.Fatalf("decode attachment: %v", err)
+	}
+	if !bytes.Equal(content, invite) {
+		t.Errorf("attachment content mismatch: %q", content)
+	}
+
+	if _, err = reader.NextPart(); err != io.EOF {
+		t.Errorf("expected two parts, got %v", err)
+	}
+}
+
 // BenchmarkEmailValidation benchmarks the email validation function
 func BenchmarkEmailValidation(t *testing.B) {
 	emails := []string{
@@ -299,3 +386,225 @@ func BenchmarkEmailValidation(t *testing.B) {
 		}
 	}
 }
+
+// snsTestCertURL is an SNS signing certificate URL the test parser has the certificate of
+const snsTestCertURL = "https://sns.us-east-1.amazonaws.com/SimpleNotificationService-test.pem"
+
+// newSNSTestParser returns a webhook parser trusting a test signing certificate, and the key that
+// signs with it
+func newSNSTestParser(t *testing.T, topicARN string) (*WebhookParser, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotAfter: time.Now().Add(time.Hour)}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := NewWebhookParser(&EmailConfig{SESEventsTopicARN: topicARN})
+	p.certs[snsTestCertURL] = cert
+	return p, key
+}
+
+// signSNS signs an SNS message as SNS does with signature version 2
+func signSNS(t *testing.T, key *rsa.PrivateKey, msg *SNSMessage) {
+	t.Helper()
+	msg.SignatureVersion = "2"
+	msg.SigningCertURL = snsTestCertURL
+	digest := sha256.Sum256([]byte(SNSStringToSign(msg)))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.Signature = base64.StdEncoding.EncodeToString(signature)
+}
+
+func snsRequest(t *testing.T, msg *SNSMessage) *http.Request {
+	t.Helper()
+	body, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewRequest(http.MethodPost, "/webhooks/email/ses", bytes.NewReader(body))
+}
+
+func TestParseSESWebhook(t *testing.T) {
+	const topic = "arn:aws:sns:us-east-1:123456789012:ses-events"
+	p, key := newSNSTestParser(t, topic)
+	p.now = func() time.Time { return time.Date(2026, time.October, 19, 14, 5, 0, 0, time.UTC) }
+	bounce := `{"notificationType":"Bounce","mail":{"messageId":"ses-1","destination":["a@example.com"]},` +
+		`"bounce":{"bounceType":"Permanent","bounceSubType":"General","timestamp":"2026-10-19T14:00:00.000Z",` +
+		`"bouncedRecipients":[{"emailAddress":"a@example.com","diagnosticCode":"smtp; 550 5.1.1 user unknown"}]}}`
+	newMessage := func() *SNSMessage {
+		return &SNSMessage{
+			Type:      "Notification",
+			MessageId: "sns-1",
+			TopicArn:  topic,
+			Message:   bounce,
+			Timestamp: "2026-10-19T14:00:01.000Z",
+		}
+	}
+
+	msg := newMessage()
+	signSNS(t, key, msg)
+	events, err := p.ParseSESWebhook(snsRequest(t, msg))
+	if err != nil {
+		t.Fatalf("ParseSESWebhook() error = %v", err)
+	}
+	want := DeliveryEvent{
+		Provider:   ProviderAWSSES,
+		MessageID:  "ses-1",
+		Type:       EventBounced,
+		Recipient:  "a@example.com",
+		BounceType: BounceHard,
+		Reason:     "smtp; 550 5.1.1 user unknown",
+		OccurredAt: time.Date(2026, time.October, 19, 14, 0, 0, 0, time.UTC),
+	}
+	if len(events) != 1 || *events[0] != want {
+		t.Fatalf("ParseSESWebhook() = %+v; want [%+v]", events, want)
+	}
+
+	tampered := newMessage()
+	signSNS(t, key, tampered)
+	tampered.Message = strings.Replace(tampered.Message, "Permanent", "Transient", 1)
+	if _, err = p.ParseSESWebhook(snsRequest(t, tampered)); GetErrorCode(err) != ErrCodeInvalidSignature {
+		t.Errorf("tampered message error = %v", err)
+	}
+
+	untrusted := newMessage()
+	signSNS(t, key, untrusted)
+	untrusted.SigningCertURL = "https://example.com/cert.pem"
+	if _, err = p.ParseSESWebhook(snsRequest(t, untrusted)); GetErrorCode(err) != ErrCodeInvalidSignature {
+		t.Errorf("untrusted certificate error = %v", err)
+	}
+
+	stale := newMessage()
+	stale.Timestamp = "2026-10-19T13:45:00.000Z"
+	signSNS(t, key, stale)
+	if _, err = p.ParseSESWebhook(snsRequest(t, stale)); GetErrorCode(err) != ErrCodeInvalidWebhook {
+		t.Errorf("stale message error = %v", err)
+	}
+
+	future := newMessage()
+	future.Timestamp = "2026-10-19T14:10:00.000Z"
+	signSNS(t, key, future)
+	if _, err = p.ParseSESWebhook(snsRequest(t, future)); GetErrorCode(err) != ErrCodeInvalidWebhook {
+		t.Errorf("future message error = %v", err)
+	}
+
+	otherTopic := newMessage()
+	otherTopic.TopicArn = "arn:aws:sns:us-east-1:123456789012:other"
+	signSNS(t, key, otherTopic)
+	if _, err = p.ParseSESWebhook(snsRequest(t, otherTopic)); GetErrorCode(err) != ErrCodeInvalidWebhook {
+		t.Errorf("other topic error = %v", err)
+	}
+
+	disabled := NewWebhookParser(&EmailConfig{})
+	if _, err = disabled.ParseSESWebhook(snsRequest(t, msg)); GetErrorCode(err) != ErrCodeWebhooksDisabled {
+		t.Errorf("disabled webhook error = %v", err)
+	}
+}
+
+func TestParseSESEvent(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		message string
+		want    []DeliveryEventType
+		bounce  BounceType
+	}{
+		{
+			name:    "soft bounce",
+			message: `{"eventType":"Bounce","mail":{"messageId":"m"},"bounce":{"bounceType":"Transient","bouncedRecipients":[{"emailAddress":"a@example.com"}]}}`,
+			want:    []DeliveryEventType{EventBounced},
+			bounce:  BounceSoft,
+		},
+		{
+			name:    "complaint",
+			message: `{"notificationType":"Complaint","mail":{"messageId":"m"},"complaint":{"complaintFeedbackType":"abuse","complainedRecipients":[{"emailAddress":"a@example.com"}]}}`,
+			want:    []DeliveryEventType{EventComplained},
+		},
+		{
+			name:    "delivery",
+			message: `{"notificationType":"Delivery","mail":{"messageId":"m"},"delivery":{"recipients":["a@example.com","b@example.com"]}}`,
+			want:    []DeliveryEventType{EventDelivered, EventDelivered},
+		},
+		{
+			name:    "open",
+			message: `{"eventType":"Open","mail":{"messageId":"m","destination":["a@example.com"]},"open":{"timestamp":"2026-10-19T14:00:00Z"}}`,
+			want:    []DeliveryEventType{EventOpened},
+		},
+		{
+			name:    "ignored",
+			message: `{"eventType":"Send","mail":{"messageId":"m"}}`,
+		},
+	} {
+		events, err := ParseSESEvent(tc.message)
+		if err != nil {
+			t.Errorf("%s: error = %v", tc.name, err)
+			continue
+		}
+		if len(events) != len(tc.want) {
+			t.Errorf("%s: got %d events; want %d", tc.name, len(events), len(tc.want))
+			continue
+		}
+		for i, e := range events {
+			if e.Type != tc.want[i] || e.MessageID != "m" || e.BounceType != tc.bounce {
+				t.Errorf("%s: event %d = %+v", tc.name, i, e)
+			}
+		}
+	}
+	if _, err := ParseSESEvent(`{"eventType":"Open","mail":{}}`); GetErrorCode(err) != ErrCodeInvalidWebhook {
+		t.Errorf("event without message ID error = %v", err)
+	}
+}
+
+func TestParseBrevoWebhook(t *testing.T) {
+	p := NewWebhookParser(&EmailConfig{BrevoWebhookToken: "secret"})
+	request := func(token, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/webhooks/email/brevo", strings.NewReader(body))
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		return r
+	}
+
+	body := `[{"event":"hard_bounce","email":"a@example.com","message-id":"<1@smtp-relay.mailin.fr>","reason":"unknown user","ts_event":1792418400},
+		{"event":"request","email":"b@example.com","message-id":"<2@smtp-relay.mailin.fr>"},
+		{"event":"spam","email":"c@example.com","message-id":"<3@smtp-relay.mailin.fr>"}]`
+	events, err := p.ParseBrevoWebhook(request("secret", body))
+	if err != nil {
+		t.Fatalf("ParseBrevoWebhook() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("ParseBrevoWebhook() = %d events; want 2", len(events))
+	}
+	bounce := events[0]
+	if bounce.Type != EventBounced || bounce.BounceType != BounceHard || bounce.MessageID != "<1@smtp-relay.mailin.fr>" ||
+		bounce.Reason != "unknown user" || !bounce.OccurredAt.Equal(time.Unix(1792418400, 0)) {
+		t.Errorf("bounce event = %+v", bounce)
+	}
+	if events[1].Type != EventComplained || events[1].Recipient != "c@example.com" {
+		t.Errorf("complaint event = %+v", events[1])
+	}
+
+	single := `{"event":"unique_opened","email":"a@example.com","message-id":"<1@smtp-relay.mailin.fr>"}`
+	if events, err = p.ParseBrevoWebhook(request("secret", single)); err != nil || len(events) != 1 || events[0].Type != EventOpened {
+		t.Errorf("single event = %+v, %v", events, err)
+	}
+
+	for _, token := range []string{"", "wrong"} {
+		if _, err = p.ParseBrevoWebhook(request(token, single)); GetErrorCode(err) != ErrCodeInvalidSignature {
+			t.Errorf("token %q error = %v", token, err)
+		}
+	}
+	disabled := NewWebhookParser(&EmailConfig{})
+	if _, err = disabled.ParseBrevoWebhook(request("secret", single)); GetErrorCode(err) != ErrCodeWebhooksDisabled {
+		t.Errorf("disabled webhook error = %v", err)
+	}
+}
@@ -67,6 +67,11 @@ const (
 	ErrCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
 	ErrCodeInternalError      = "INTERNAL_ERROR"
 	ErrCodeUnknownError       = "UNKNOWN_ERROR"
+
+	// Webhook errors
+	ErrCodeWebhooksDisabled = "WEBHOOKS_DISABLED"
+	ErrCodeInvalidSignature = "INVALID_SIGNATURE"
+	ErrCodeInvalidWebhook   = "INVALID_WEBHOOK"
 )
 
 // Error messages for common scenarios
@@ -79,6 +84,7 @@ const (
 	MsgAWSConfigLoad     = "failed to load AWS configuration"
 	MsgAWSSendFailed     = "failed to send email via AWS SES"
 	MsgAWSTemplateFailed = "failed to send template email via AWS SES"
+	MsgRawMessageFailed  = "failed to build MIME message"
 
 	MsgBrevoMissingAPIKey     = "Brevo API key is required"
 	MsgBrevoInvalidTemplateID = "template ID must be a number for Brevo"
@@ -114,6 +120,10 @@ const (
 	MsgServiceUnavailable = "email service unavailable"
 	MsgInternalError      = "internal server error"
 	MsgUnknownError       = "unknown error occurred"
+
+	MsgWebhooksDisabled = "email provider webhooks are not configured"
+	MsgInvalidSignature = "invalid webhook signature"
+	MsgInvalidWebhook   = "invalid webhook request"
 )
 
 // Predefined error variables for common scenarios
@@ -316,6 +326,16 @@ func IsBrevoError(err error) bool {
 	return false
 }
 
+// IsWebhookError checks if the error is a rejected provider webhook
+func IsWebhookError(err error) bool {
+	if emailErr, ok := err.(*EmailError); ok {
+		return emailErr.Code == ErrCodeWebhooksDisabled ||
+			emailErr.Code == ErrCodeInvalidSignature ||
+			emailErr.Code == ErrCodeInvalidWebhook
+	}
+	return false
+}
+
 // GetErrorCode extracts the error code from an EmailError
 func GetErrorCode(err error) string {
 	if emailErr, ok := err.(*EmailError); ok {
@@ -0,0 +1,117 @@
+package emailpkg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+)
+
+// base64LineLength is the maximum encoded line length allowed by RFC 2045
+const base64LineLength = 76
+
+// buildRawMessage renders a message as multipart/mixed MIME: the text and HTML bodies as a
+// multipart/alternative part followed by each attachment. BCC recipients are left out of the
+// headers; the caller passes them to the provider as envelope recipients.
+func buildRawMessage(message *EmailMessage, format func(EmailAddress) string) ([]byte, error) {
+	var buf bytes.Buffer
+	mixed := multipart.NewWriter(&buf)
+
+	header := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+	addresses := func(list []EmailAddress) string {
+		out := make([]string, len(list))
+		for i, addr := range list {
+			out[i] = format(EmailAddress{Email: addr.Email, Name: mime.QEncoding.Encode("utf-8", addr.Name)})
+		}
+		return strings.Join(out, ", ")
+	}
+
+	header("From", addresses([]EmailAddress{message.From}))
+	header("To", addresses(message.To))
+	if len(message.CC) > 0 {
+		header("Cc", addresses(message.CC))
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", message.Subject))
+	for name, value := range message.Headers {
+		header(name, value)
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", mixed.Boundary()))
+	buf.WriteString("\r\n")
+
+	var body bytes.Buffer
+	alternative := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", message.TextBody},
+		{"text/html; charset=UTF-8", message.HTMLBody},
+	} {
+		if part.content == "" {
+			continue
+		}
+		w, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(encodeBase64Lines([]byte(part.content))); err != nil {
+			return nil, err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return nil, err
+	}
+
+	w, err := mixed.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("multipart/alternative; boundary=%q", alternative.Boundary())},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = w.Write(body.Bytes()); err != nil {
+		return nil, err
+	}
+
+	for _, a := range message.Attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w, err = mixed.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {contentType},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(encodeBase64Lines(a.Content)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeBase64Lines base64-encodes content wrapped at the MIME line length
+func encodeBase64Lines(content []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	var out bytes.Buffer
+	for len(encoded) > base64LineLength {
+		out.WriteString(encoded[:base64LineLength])
+		out.WriteString("\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	out.WriteString(encoded)
+	out.WriteString("\r\n")
+	return out.Bytes()
+}
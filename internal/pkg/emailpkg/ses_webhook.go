@@ -0,0 +1,314 @@
+package emailpkg
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// snsMaxMessageAge is how long after SNS signs a message it is accepted, so that a captured message
+// cannot be replayed later. It leaves room for SNS to retry a failed delivery.
+const snsMaxMessageAge = 15 * time.Minute
+
+// snsClockSkew is how far in the future an SNS message timestamp may be
+const snsClockSkew = time.Minute
+
+// snsHost matches the hosts SNS serves signing certificates and subscription confirmations from
+var snsHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// SNSMessage is a message SNS posts to an HTTPS subscription
+type SNSMessage struct {
+	Type             string `json:"Type"`
+	MessageId        string `json:"MessageId"`
+	Token            string `json:"Token,omitempty"`
+	TopicArn         string `json:"TopicArn"`
+	Subject          string `json:"Subject,omitempty"`
+	Message          string `json:"Message"`
+	Timestamp        string `json:"Timestamp"`
+	SignatureVersion string `json:"SignatureVersion"`
+	Signature        string `json:"Signature"`
+	SigningCertURL   string `json:"SigningCertURL"`
+	SubscribeURL     string `json:"SubscribeURL,omitempty"`
+}
+
+// sesEvent is an SES notification or published event carried in an SNS message
+type sesEvent struct {
+	NotificationType string `json:"notificationType"` // notifications
+	EventType        string `json:"eventType"`        // event publishing
+	Mail             struct {
+		MessageID   string   `json:"messageId"`
+		Destination []string `json:"destination"`
+	} `json:"mail"`
+	Bounce *struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplainedRecipients []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+		ComplaintFeedbackType string    `json:"complaintFeedbackType"`
+		Timestamp             time.Time `json:"timestamp"`
+	} `json:"complaint"`
+	Delivery *struct {
+		Recipients []string  `json:"recipients"`
+		Timestamp  time.Time `json:"timestamp"`
+	} `json:"delivery"`
+	Open *struct {
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"open"`
+	Click *struct {
+		Timestamp time.Time `json:"timestamp"`
+		Link      string    `json:"link"`
+	} `json:"click"`
+}
+
+// ParseSESWebhook authenticates an SNS message from the configured SES events topic and returns the
+// delivery events it carries. Messages signed more than snsMaxMessageAge ago are refused. A
+// subscription confirmation is confirmed and carries no events.
+func (p *WebhookParser) ParseSESWebhook(r *http.Request) ([]*DeliveryEvent, error) {
+	if p.config.SESEventsTopicARN == "" {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeWebhooksDisabled, MsgWebhooksDisabled)
+	}
+	body, err := readWebhookBody(ProviderAWSSES, r)
+	if err != nil {
+		return nil, err
+	}
+	var msg SNSMessage
+	if err = json.Unmarshal(body, &msg); err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidWebhook, MsgInvalidWebhook, err)
+	}
+	if msg.TopicArn != p.config.SESEventsTopicARN {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeInvalidWebhook,
+			fmt.Sprintf("%s: unexpected topic %s", MsgInvalidWebhook, msg.TopicArn))
+	}
+	if err = p.verifySNSMessage(r.Context(), &msg); err != nil {
+		return nil, err
+	}
+	if err = p.checkSNSTimestamp(&msg); err != nil {
+		return nil, err
+	}
+
+	switch msg.Type {
+	case "SubscriptionConfirmation":
+		return nil, p.confirmSubscription(r.Context(), msg.SubscribeURL)
+	case "Notification":
+		return ParseSESEvent(msg.Message)
+	}
+	return nil, nil
+}
+
+// ParseSESEvent returns the delivery events, one per recipient, of an SES notification or published
+// event. Events of other types are ignored.
+func ParseSESEvent(message string) ([]*DeliveryEvent, error) {
+	var e sesEvent
+	if err := json.Unmarshal([]byte(message), &e); err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidWebhook, MsgInvalidWebhook, err)
+	}
+	if e.Mail.MessageID == "" {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeInvalidWebhook, MsgInvalidWebhook+": message ID is missing")
+	}
+	event := func(t DeliveryEventType, recipient string, at time.Time) *DeliveryEvent {
+		return &DeliveryEvent{Provider: ProviderAWSSES, MessageID: e.Mail.MessageID, Type: t, Recipient: recipient, OccurredAt: at}
+	}
+
+	var events []*DeliveryEvent
+	kind := e.NotificationType
+	if kind == "" {
+		kind = e.EventType
+	}
+	switch {
+	case kind == "Bounce" && e.Bounce != nil:
+		bounceType := BounceSoft
+		if e.Bounce.BounceType == "Permanent" {
+			bounceType = BounceHard
+		}
+		for _, rcpt := range e.Bounce.BouncedRecipients {
+			ev := event(EventBounced, rcpt.EmailAddress, e.Bounce.Timestamp)
+			ev.BounceType = bounceType
+			ev.Reason = rcpt.DiagnosticCode
+			if ev.Reason == "" {
+				ev.Reason = e.Bounce.BounceType + "/" + e.Bounce.BounceSubType
+			}
+			events = append(events, ev)
+		}
+	case kind == "Complaint" && e.Complaint != nil:
+		for _, rcpt := range e.Complaint.ComplainedRecipients {
+			ev := event(EventComplained, rcpt.EmailAddress, e.Complaint.Timestamp)
+			ev.Reason = e.Complaint.ComplaintFeedbackType
+			events = append(events, ev)
+		}
+	case kind == "Delivery" && e.Delivery != nil:
+		for _, rcpt := range e.Delivery.Recipients {
+			events = append(events, event(EventDelivered, rcpt, e.Delivery.Timestamp))
+		}
+	case kind == "Open" && e.Open != nil:
+		for _, rcpt := range e.Mail.Destination {
+			events = append(events, event(EventOpened, rcpt, e.Open.Timestamp))
+		}
+	case kind == "Click" && e.Click != nil:
+		for _, rcpt := range e.Mail.Destination {
+			ev := event(EventClicked, rcpt, e.Click.Timestamp)
+			ev.Reason = e.Click.Link
+			events = append(events, ev)
+		}
+	}
+	return events, nil
+}
+
+// verifySNSMessage checks an SNS message's signature against the SNS certificate it names
+func (p *WebhookParser) verifySNSMessage(ctx context.Context, msg *SNSMessage) error {
+	var hash crypto.Hash
+	switch msg.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return NewEmailError(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature)
+	}
+	signature, err := base64.StdEncoding.DecodeString(msg.Signature)
+	if err != nil {
+		return NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature, err)
+	}
+	cert, err := p.signingCert(ctx, msg.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return NewEmailError(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature)
+	}
+
+	var digest []byte
+	if hash == crypto.SHA1 {
+		sum := sha1.Sum([]byte(SNSStringToSign(msg)))
+		digest = sum[:]
+	} else {
+		sum := sha256.Sum256([]byte(SNSStringToSign(msg)))
+		digest = sum[:]
+	}
+	if err = rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+		return NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature, err)
+	}
+	return nil
+}
+
+// checkSNSTimestamp checks that an SNS message was signed recently
+func (p *WebhookParser) checkSNSTimestamp(msg *SNSMessage) error {
+	signedAt, err := time.Parse(time.RFC3339, msg.Timestamp)
+	if err != nil {
+		return NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidWebhook, MsgInvalidWebhook+": invalid timestamp", err)
+	}
+	now := p.now()
+	if signedAt.Before(now.Add(-snsMaxMessageAge)) || signedAt.After(now.Add(snsClockSkew)) {
+		return NewEmailError(ProviderAWSSES, ErrCodeInvalidWebhook,
+			fmt.Sprintf("%s: message timestamp %s is outside the accepted window", MsgInvalidWebhook, msg.Timestamp))
+	}
+	return nil
+}
+
+// SNSStringToSign returns the text SNS signs for a message: the message's signed fields, each name
+// and value on its own line, in name order
+func SNSStringToSign(msg *SNSMessage) string {
+	fields := []string{"Message", msg.Message, "MessageId", msg.MessageId}
+	if msg.Type == "Notification" {
+		if msg.Subject != "" {
+			fields = append(fields, "Subject", msg.Subject)
+		}
+	} else {
+		fields = append(fields, "SubscribeURL", msg.SubscribeURL)
+	}
+	fields = append(fields, "Timestamp", msg.Timestamp)
+	if msg.Type != "Notification" {
+		fields = append(fields, "Token", msg.Token)
+	}
+	fields = append(fields, "TopicArn", msg.TopicArn, "Type", msg.Type)
+	return strings.Join(fields, "\n") + "\n"
+}
+
+// signingCert returns the SNS signing certificate at a URL, which must be on an SNS host
+func (p *WebhookParser) signingCert(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	if !isSNSURL(certURL) {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeInvalidSignature,
+			fmt.Sprintf("%s: untrusted signing certificate URL %s", MsgInvalidSignature, certURL))
+	}
+	p.mu.Lock()
+	cert, ok := p.certs[certURL]
+	p.mu.Unlock()
+	if ok {
+		return cert, nil
+	}
+
+	body, err := p.get(ctx, certURL)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(body)
+	if block == nil {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature+": invalid signing certificate")
+	}
+	cert, err = x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeInvalidSignature, MsgInvalidSignature, err)
+	}
+	p.mu.Lock()
+	p.certs[certURL] = cert
+	p.mu.Unlock()
+	return cert, nil
+}
+
+// confirmSubscription confirms the subscription of the webhook to the SES events topic
+func (p *WebhookParser) confirmSubscription(ctx context.Context, subscribeURL string) error {
+	if !isSNSURL(subscribeURL) {
+		return NewEmailError(ProviderAWSSES, ErrCodeInvalidWebhook,
+			fmt.Sprintf("%s: untrusted subscription URL %s", MsgInvalidWebhook, subscribeURL))
+	}
+	_, err := p.get(ctx, subscribeURL)
+	return err
+}
+
+// get fetches a URL from SNS
+func (p *WebhookParser) get(ctx context.Context, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeRequestCreateError, MsgRequestCreateError, err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, NewNetworkError(ProviderAWSSES, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookBody))
+	if err != nil {
+		return nil, NewEmailErrorWithCause(ProviderAWSSES, ErrCodeResponseReadError, MsgResponseReadError, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, NewEmailError(ProviderAWSSES, ErrCodeHTTPError, fmt.Sprintf("SNS returned HTTP %d for %s", resp.StatusCode, u))
+	}
+	return body, nil
+}
+
+// isSNSURL reports whether a URL is an HTTPS URL on an SNS host
+func isSNSURL(u string) bool {
+	parsed, err := url.Parse(u)
+	return err == nil && parsed.Scheme == "https" && snsHost.MatchString(parsed.Host)
+}
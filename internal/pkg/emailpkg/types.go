@@ -30,6 +30,12 @@ type EmailConfig struct {
 	DefaultFromEmail string        `json:"default_from_email" yaml:"default_from_email"`
 	DefaultFromName  string        `json:"default_from_name" yaml:"default_from_name"`
 	Timeout          time.Duration `json:"timeout" yaml:"timeout"`
+
+	// Delivery event webhooks, served under WebhookBaseURL. SES events arrive through the SNS topic,
+	// and Brevo calls with the token as a bearer token.
+	WebhookBaseURL    string `json:"webhook_base_url,omitempty" yaml:"webhook_base_url,omitempty"`
+	SESEventsTopicARN string `json:"ses_events_topic_arn,omitempty" yaml:"ses_events_topic_arn,omitempty"`
+	BrevoWebhookToken string `json:"brevo_webhook_token,omitempty" yaml:"brevo_webhook_token,omitempty"`
 }
 
 // EmailAddress represents an email address with optional name
@@ -80,6 +86,38 @@ type EmailResponse struct {
 	Error     string        `json:"error,omitempty"`
 }
 
+// DeliveryEventType is what happened to a sent email
+type DeliveryEventType string
+
+const (
+	EventDelivered  DeliveryEventType = "delivered"
+	EventBounced    DeliveryEventType = "bounced"
+	EventComplained DeliveryEventType = "complained"
+	EventOpened     DeliveryEventType = "opened"
+	EventClicked    DeliveryEventType = "clicked"
+)
+
+// BounceType tells whether a bounce is permanent
+type BounceType string
+
+const (
+	// BounceHard means the address cannot receive email, and sending to it again will fail
+	BounceHard BounceType = "hard"
+	// BounceSoft means delivery failed for now, as for a full mailbox
+	BounceSoft BounceType = "soft"
+)
+
+// DeliveryEvent is a provider's report of what happened to a sent email for one recipient
+type DeliveryEvent struct {
+	Provider   EmailProvider     `json:"provider"`
+	MessageID  string            `json:"message_id"`
+	Type       DeliveryEventType `json:"type"`
+	Recipient  string            `json:"recipient"`
+	BounceType BounceType        `json:"bounce_type,omitempty"`
+	Reason     string            `json:"reason,omitempty"` // bounce diagnostic or complaint feedback type
+	OccurredAt time.Time         `json:"occurred_at"`
+}
+
 // EmailSender interface defines the contract for email sending services
 type EmailSender interface {
 	// SendEmail sends a basic email message
@@ -0,0 +1,53 @@
+package emailpkg
+
+import (
+	"crypto/x509"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Paths of the delivery event webhooks, under the configured webhook base URL
+const (
+	SESWebhookPath   = "/ses"
+	BrevoWebhookPath = "/brevo"
+)
+
+// maxWebhookBody is the largest webhook request body read
+const maxWebhookBody = 1 << 20
+
+// WebhookParser authenticates and parses the delivery event webhooks of the email providers. The
+// webhooks of both providers are accepted whichever one is sending, so that events for email sent
+// before a change of provider are still received.
+type WebhookParser struct {
+	config *EmailConfig
+	client *http.Client
+
+	mu    sync.Mutex
+	certs map[string]*x509.Certificate // SNS signing certificates by URL
+	now   func() time.Time             // the time SNS message timestamps are checked against
+}
+
+// NewWebhookParser creates a webhook parser for the configuration
+func NewWebhookParser(config *EmailConfig) *WebhookParser {
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = 30 * time.Second
+	}
+	return &WebhookParser{
+		config: config,
+		client: &http.Client{Timeout: timeout},
+		certs:  make(map[string]*x509.Certificate),
+		now:    time.Now,
+	}
+}
+
+// readWebhookBody reads a webhook request body
+func readWebhookBody(provider EmailProvider, r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBody))
+	if err != nil {
+		return nil, NewEmailErrorWithCause(provider, ErrCodeInvalidWebhook, MsgInvalidWebhook, err)
+	}
+	return body, nil
+}
@@ -0,0 +1,266 @@
+// Package envelope encrypts stored documents with data keys that are themselves encrypted
+// ("wrapped") by a master key.
+//
+// Content is encrypted with AES-256-GCM in fixed-size segments so documents of any size can be
+// streamed through without buffering. Each segment's nonce combines a random per-stream prefix with
+// the segment counter, and the final segment is marked in its additional data so a truncated or
+// reordered ciphertext fails to decrypt. Rotating the master key only re-wraps data keys; content
+// encrypted under a data key never needs to be re-encrypted.
+package envelope
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// KeySize is the size in bytes of master and data keys (AES-256)
+const KeySize = 32
+
+const (
+	// segmentSize is the plaintext size of every segment but the last
+	segmentSize = 64 << 10
+	// prefixSize is the size of the random nonce prefix written at the start of a stream
+	prefixSize = 8
+)
+
+// magic identifies the stream format and version
+var magic = []byte("V1E1")
+
+var (
+	// ErrInvalidKey is returned for a key that is not KeySize bytes
+	ErrInvalidKey = errors.New("envelope: key must be 32 bytes")
+	// ErrDecrypt is returned when content or a wrapped key fails authentication
+	ErrDecrypt = errors.New("envelope: decryption failed")
+	// ErrFormat is returned when the ciphertext is not in the expected format
+	ErrFormat = errors.New("envelope: unrecognized ciphertext format")
+)
+
+// GenerateKey returns a new random data key
+func GenerateKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// WrapKey encrypts a data key with the master key
+func WrapKey(masterKey, dataKey []byte) ([]byte, error) {
+	if len(dataKey) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, magic), nil
+}
+
+// UnwrapKey decrypts a data key wrapped by WrapKey
+func UnwrapKey(masterKey, wrapped []byte) ([]byte, error) {
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize()+aead.Overhead() {
+		return nil, ErrFormat
+	}
+	nonce, sealed := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, sealed, magic)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	if len(dataKey) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	return dataKey, nil
+}
+
+// NewEncryptReader returns a reader of the encrypted form of r
+func NewEncryptReader(r io.Reader, dataKey []byte) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, len(magic)+prefixSize)
+	copy(header, magic)
+	if _, err := rand.Read(header[len(magic):]); err != nil {
+		return nil, err
+	}
+	return &encryptReader{
+		segmenter: newSegmenter(aead, header[len(magic):]),
+		src:       bufio.NewReaderSize(r, segmentSize),
+		buf:       make([]byte, segmentSize),
+		sealed:    make([]byte, 0, segmentSize+aead.Overhead()),
+		out:       header,
+	}, nil
+}
+
+// NewDecryptReader returns a reader of the plaintext of r, which must have been produced by an
+// encrypt reader with the same data key. Reads fail with ErrDecrypt if the content has been altered
+// or truncated.
+func NewDecryptReader(r io.Reader, dataKey []byte) (io.Reader, error) {
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{
+		aead:  aead,
+		src:   bufio.NewReaderSize(r, segmentSize+aead.Overhead()+1),
+		buf:   make([]byte, segmentSize+aead.Overhead()),
+		plain: make([]byte, 0, segmentSize),
+	}, nil
+}
+
+// PlaintextSize returns the size of the content that encrypts to ciphertextSize bytes, or -1 if no
+// content encrypts to that size
+func PlaintextSize(ciphertextSize int64) int64 {
+	const overhead = 16 // GCM tag
+	body := ciphertextSize - int64(len(magic)+prefixSize)
+	if body < overhead {
+		return -1
+	}
+	segments := (body + segmentSize + overhead - 1) / (segmentSize + overhead)
+	return body - segments*overhead
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// segmenter derives per-segment nonces and additional data
+type segmenter struct {
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+}
+
+func newSegmenter(aead cipher.AEAD, prefix []byte) segmenter {
+	return segmenter{aead: aead, prefix: prefix}
+}
+
+func (s *segmenter) next(final bool) (nonce, additional []byte, err error) {
+	if s.counter == ^uint32(0) {
+		return nil, nil, errors.New("envelope: stream too long")
+	}
+	nonce = make([]byte, s.aead.NonceSize())
+	copy(nonce, s.prefix)
+	binary.BigEndian.PutUint32(nonce[len(nonce)-4:], s.counter)
+	s.counter++
+	additional = []byte{0}
+	if final {
+		additional[0] = 1
+	}
+	return nonce, additional, nil
+}
+
+// atEOF reports whether r has no more data without consuming any
+func atEOF(r *bufio.Reader) (bool, error) {
+	_, err := r.Peek(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
+
+type encryptReader struct {
+	segmenter
+	src    *bufio.Reader
+	buf    []byte
+	sealed []byte
+	out    []byte
+	done   bool
+}
+
+func (e *encryptReader) Read(p []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(e.src, e.buf)
+		final := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !final {
+			return 0, err
+		}
+		if !final {
+			if final, err = atEOF(e.src); err != nil {
+				return 0, err
+			}
+		}
+		nonce, additional, err := e.next(final)
+		if err != nil {
+			return 0, err
+		}
+		e.out = e.aead.Seal(e.sealed[:0], nonce, e.buf[:n], additional)
+		e.done = final
+	}
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+type decryptReader struct {
+	aead  cipher.AEAD
+	seg   *segmenter
+	src   *bufio.Reader
+	buf   []byte
+	plain []byte
+	out   []byte
+	done  bool
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if d.seg == nil {
+			header := make([]byte, len(magic)+prefixSize)
+			if _, err := io.ReadFull(d.src, header); err != nil {
+				return 0, ErrFormat
+			}
+			if string(header[:len(magic)]) != string(magic) {
+				return 0, ErrFormat
+			}
+			seg := newSegmenter(d.aead, header[len(magic):])
+			d.seg = &seg
+		}
+		n, err := io.ReadFull(d.src, d.buf)
+		final := err == io.EOF || err == io.ErrUnexpectedEOF
+		if err != nil && !final {
+			return 0, err
+		}
+		if !final {
+			if final, err = atEOF(d.src); err != nil {
+				return 0, err
+			}
+		}
+		nonce, additional, err := d.seg.next(final)
+		if err != nil {
+			return 0, err
+		}
+		if d.out, err = d.aead.Open(d.plain[:0], nonce, d.buf[:n], additional); err != nil {
+			return 0, ErrDecrypt
+		}
+		d.done = final
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
@@ -0,0 +1,108 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+func mustKey(t *testing.T) []byte {
+	t.Helper()
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func encrypt(t *testing.T, key, plaintext []byte) []byte {
+	t.Helper()
+	r, err := NewEncryptReader(bytes.NewReader(plaintext), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ciphertext
+}
+
+func decrypt(key, ciphertext []byte) ([]byte, error) {
+	r, err := NewDecryptReader(bytes.NewReader(ciphertext), key)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	key := mustKey(t)
+	// Sizes around the segment boundary exercise the final-segment detection
+	for _, size := range []int{0, 1, segmentSize - 1, segmentSize, segmentSize + 1, 3*segmentSize + 17} {
+		plaintext := make([]byte, size)
+		if _, err := rand.Read(plaintext); err != nil {
+			t.Fatal(err)
+		}
+		ciphertext := encrypt(t, key, plaintext)
+		if got := PlaintextSize(int64(len(ciphertext))); got != int64(size) {
+			t.Errorf("size %d: PlaintextSize = %d", size, got)
+		}
+		got, err := decrypt(key, ciphertext)
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Fatalf("size %d: plaintext mismatch", size)
+		}
+	}
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	key := mustKey(t)
+	plaintext := bytes.Repeat([]byte("drug test result "), segmentSize/8)
+	ciphertext := encrypt(t, key, plaintext)
+
+	flipped := bytes.Clone(ciphertext)
+	flipped[len(flipped)/2] ^= 1
+	if _, err := decrypt(key, flipped); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("altered ciphertext: expected ErrDecrypt, got %v", err)
+	}
+
+	// Truncating at a segment boundary must not look like a complete shorter document
+	boundary := len(magic) + prefixSize + segmentSize + 16
+	if _, err := decrypt(key, ciphertext[:boundary]); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("truncated ciphertext: expected ErrDecrypt, got %v", err)
+	}
+
+	if _, err := decrypt(mustKey(t), ciphertext); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("wrong key: expected ErrDecrypt, got %v", err)
+	}
+}
+
+func TestWrapKey(t *testing.T) {
+	master, dataKey := mustKey(t), mustKey(t)
+	wrapped, err := WrapKey(master, dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := UnwrapKey(master, wrapped)
+	if err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap: %v", err)
+	}
+
+	// Re-wrapping under a new master key leaves the data key, and so the content, unchanged
+	rotated := mustKey(t)
+	rewrapped, err := WrapKey(rotated, got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err = UnwrapKey(rotated, rewrapped); err != nil || !bytes.Equal(got, dataKey) {
+		t.Fatalf("unwrap after rotation: %v", err)
+	}
+	if _, err := UnwrapKey(master, rewrapped); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("old master key: expected ErrDecrypt, got %v", err)
+	}
+}
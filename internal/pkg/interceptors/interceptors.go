@@ -79,104 +79,134 @@ type JWTConfig struct {
 	RequiredRole    string   // Optional: required role for access
 }
 
-// AuthInterceptor validates JWT tokens for Connect RPC calls
-func AuthInterceptor(config JWTConfig) connect.UnaryInterceptorFunc {
-	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
-		return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			procedure := req.Spec().Procedure
+// AuthInterceptor validates JWT tokens for Connect RPC calls, including streaming calls
+func AuthInterceptor(config JWTConfig) connect.Interceptor {
+	return &authInterceptor{config: config}
+}
 
-			// Check if endpoint is public
-			for _, publicEndpoint := range config.PublicEndpoints {
-				if procedure == publicEndpoint {
-					log.Printf("Auth interceptor: allowing public endpoint %s", procedure)
-					return next(ctx, req)
-				}
-			}
+type authInterceptor struct {
+	config JWTConfig
+}
 
-			log.Printf("Auth interceptor: checking auth for procedure %s", procedure)
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := a.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	})
+}
 
-			// Extract token from Authorization header
-			authHeader := req.Header().Get("Authorization")
-			if authHeader == "" {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					fmt.Errorf("authorization header required"),
-				)
-			}
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
 
-			// Check Bearer prefix
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					fmt.Errorf("invalid authorization header format"),
-				)
-			}
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return connect.StreamingHandlerFunc(func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := a.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	})
+}
 
-			tokenString := parts[1]
+// authenticate returns the context with the user the request's bearer token belongs to, leaving
+// it as is for public endpoints
+func (a *authInterceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	config := a.config
 
-			user, err := service.SupabaseService().GetUserFromToken(ctx, tokenString)
-			if err != nil {
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					fmt.Errorf("invalid token: %w", err),
-				)
-			}
+	// Check if endpoint is public
+	for _, publicEndpoint := range config.PublicEndpoints {
+		if procedure == publicEndpoint {
+			log.Printf("Auth interceptor: allowing public endpoint %s", procedure)
+			return ctx, nil
+		}
+	}
 
-			ctx = service.BizCtx().SetSupabaseUser(ctx, user)
-
-			g.Log().Debugf(ctx, "Auth interceptor: authenticated user %s with email %s", user.User.ID, user.User.Email)
-
-			// // Parse and validate token
-			// token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// 	// Validate signing method
-			// 	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			// 		return nil, connect.NewError(
-			// 			connect.CodeUnauthenticated,
-			// 			fmt.Errorf("invalid token signing method"),
-			// 		)
-			// 	}
-			// 	return config.SecretKey, nil
-			// })
-
-			// if err != nil || !token.Valid {
-			// 	return nil, connect.NewError(
-			// 		connect.CodeUnauthenticated,
-			// 		fmt.Errorf("invalid token: %w", err),
-			// 	)
-			// }
-
-			// // Extract claims
-			// claims, ok := token.Claims.(jwt.MapClaims)
-			// if !ok {
-			// 	return nil, connect.NewError(
-			// 		connect.CodeUnauthenticated,
-			// 		fmt.Errorf("invalid token claims"),
-			// 	)
-			// }
-
-			// // Check required role if specified
-			// if config.RequiredRole != "" {
-			// 	role, exists := claims["role"]
-			// 	if !exists || role != config.RequiredRole {
-			// 		return nil, connect.NewError(
-			// 			connect.CodePermissionDenied,
-			// 			fmt.Errorf("insufficient permissions"),
-			// 		)
-			// 	}
-			// }
-
-			// // Add user info to context for downstream handlers
-			// ctx = context.WithValue(ctx, "user_id", claims["sub"])
-			// ctx = context.WithValue(ctx, "user_email", claims["email"])
-			// if role, exists := claims["role"]; exists {
-			// 	ctx = context.WithValue(ctx, "user_role", role)
-			// }
-
-			// Continue with authenticated context
-			return next(ctx, req)
-		})
-	})
+	log.Printf("Auth interceptor: checking auth for procedure %s", procedure)
+
+	// Extract token from Authorization header
+	authHeader := header.Get("Authorization")
+	if authHeader == "" {
+		return ctx, connect.NewError(
+			connect.CodeUnauthenticated,
+			fmt.Errorf("authorization header required"),
+		)
+	}
+
+	// Check Bearer prefix
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return ctx, connect.NewError(
+			connect.CodeUnauthenticated,
+			fmt.Errorf("invalid authorization header format"),
+		)
+	}
+
+	tokenString := parts[1]
+
+	user, err := service.SupabaseService().GetUserFromToken(ctx, tokenString)
+	if err != nil {
+		return ctx, connect.NewError(
+			connect.CodeUnauthenticated,
+			fmt.Errorf("invalid token: %w", err),
+		)
+	}
+
+	ctx = service.BizCtx().SetSupabaseUser(ctx, user)
+
+	g.Log().Debugf(ctx, "Auth interceptor: authenticated user %s with email %s", user.User.ID, user.User.Email)
+
+	// // Parse and validate token
+	// token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+	// 	// Validate signing method
+	// 	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+	// 		return nil, connect.NewError(
+	// 			connect.CodeUnauthenticated,
+	// 			fmt.Errorf("invalid token signing method"),
+	// 		)
+	// 	}
+	// 	return config.SecretKey, nil
+	// })
+
+	// if err != nil || !token.Valid {
+	// 	return ctx, connect.NewError(
+	// 		connect.CodeUnauthenticated,
+	// 		fmt.Errorf("invalid token: %w", err),
+	// 	)
+	// }
+
+	// // Extract claims
+	// claims, ok := token.Claims.(jwt.MapClaims)
+	// if !ok {
+	// 	return ctx, connect.NewError(
+	// 		connect.CodeUnauthenticated,
+	// 		fmt.Errorf("invalid token claims"),
+	// 	)
+	// }
+
+	// // Check required role if specified
+	// if config.RequiredRole != "" {
+	// 	role, exists := claims["role"]
+	// 	if !exists || role != config.RequiredRole {
+	// 		return ctx, connect.NewError(
+	// 			connect.CodePermissionDenied,
+	// 			fmt.Errorf("insufficient permissions"),
+	// 		)
+	// 	}
+	// }
+
+	// // Add user info to context for downstream handlers
+	// ctx = context.WithValue(ctx, "user_id", claims["sub"])
+	// ctx = context.WithValue(ctx, "user_email", claims["email"])
+	// if role, exists := claims["role"]; exists {
+	// 	ctx = context.WithValue(ctx, "user_role", role)
+	// }
+
+	// Continue with authenticated context
+	return ctx, nil
 }
 
 // RateLimitConfig holds rate limiting configuration
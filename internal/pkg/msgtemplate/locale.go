@@ -0,0 +1,76 @@
+package msgtemplate
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// DefaultLocale is the locale templates fall back to when there is no variant for the recipient's
+const DefaultLocale = "en"
+
+// SupportedLocales are the locales templates may be written in
+var SupportedLocales = []string{"en", "es"}
+
+// ErrUnsupportedLocale is returned for a locale other than the supported ones
+var ErrUnsupportedLocale = errors.New("msgtemplate: unsupported locale")
+
+var spanishMonths = [...]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio",
+	"agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+
+// NormalizeLocale returns the supported locale for a language tag such as "es-MX"; an empty tag is
+// the default locale
+func NormalizeLocale(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return DefaultLocale, nil
+	}
+	lang, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	for _, locale := range SupportedLocales {
+		if lang == locale {
+			return locale, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnsupportedLocale, tag)
+}
+
+// FormatDate writes a date the way it is written in the locale, e.g. "March 5, 2026" or
+// "5 de marzo de 2026"
+func FormatDate(locale string, d time.Time) string {
+	if d.IsZero() {
+		return ""
+	}
+	if locale == "es" {
+		return fmt.Sprintf("%d de %s de %d", d.Day(), spanishMonths[d.Month()-1], d.Year())
+	}
+	return d.Format("January 2, 2006")
+}
+
+// funcs returns the functions templates may use, for rendering in the locale:
+//
+//	date     the date as written in the locale
+//	datefmt  the date in a Go time layout
+//	upper    the text in upper case
+//	lower    the text in lower case
+//	default  the text, or the fallback when it is empty
+func funcs(locale string) template.FuncMap {
+	return template.FuncMap{
+		"date": func(d time.Time) string { return FormatDate(locale, d) },
+		"datefmt": func(layout string, d time.Time) string {
+			if d.IsZero() {
+				return ""
+			}
+			return d.Format(layout)
+		},
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"default": func(fallback string, s any) string {
+			if str := fmt.Sprint(s); s != nil && str != "" {
+				return str
+			}
+			return fallback
+		},
+	}
+}
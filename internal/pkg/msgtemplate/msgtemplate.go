@@ -0,0 +1,320 @@
+// Package msgtemplate renders notification messages from Go templates with typed variables.
+//
+// A template has a subject and a plain text body, written with text/template, and an optional HTML
+// body for email, written with html/template so values are escaped. Templates declare the variables
+// they use; values arrive as strings and are converted to the declared type before rendering, so a
+// date can be formatted and a number compared. Referring to a variable that is not declared is an
+// error when the template is compiled, and leaving out a required one is an error when it is
+// rendered.
+package msgtemplate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// VarType is the type of a template variable
+type VarType string
+
+const (
+	TypeString  VarType = "string"
+	TypeNumber  VarType = "number"
+	TypeDate    VarType = "date"
+	TypeBoolean VarType = "boolean"
+)
+
+// Variable is a value a template is rendered with
+type Variable struct {
+	Name        string  `json:"name"`
+	Type        VarType `json:"type"`
+	Required    bool    `json:"required"`
+	Description string  `json:"description,omitempty"`
+}
+
+// Template is the source of a message template
+type Template struct {
+	Subject   string
+	Body      string
+	HTML      string
+	Variables []Variable
+}
+
+// Message is a rendered message
+type Message struct {
+	Subject string
+	Body    string
+	HTML    string
+}
+
+// Compiled is a template that has been checked, ready to render
+type Compiled struct {
+	source Template
+}
+
+var (
+	// ErrInvalidTemplate is returned for a template that does not parse or uses undeclared variables
+	ErrInvalidTemplate = errors.New("msgtemplate: invalid template")
+	// ErrInvalidVariable is returned for a variable declaration, or a value, that is not valid
+	ErrInvalidVariable = errors.New("msgtemplate: invalid variable")
+	// ErrMissingVariable is returned when rendering without a required variable
+	ErrMissingVariable = errors.New("msgtemplate: missing required variable")
+)
+
+var variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// dateLayouts are the accepted formats of date values
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"}
+
+// Compile parses a template and checks its variables. The subject and body are required.
+func Compile(t Template) (*Compiled, error) {
+	if strings.TrimSpace(t.Subject) == "" || strings.TrimSpace(t.Body) == "" {
+		return nil, fmt.Errorf("%w: subject and body are required", ErrInvalidTemplate)
+	}
+	declared := make(map[string]bool, len(t.Variables))
+	for _, v := range t.Variables {
+		if !variableName.MatchString(v.Name) {
+			return nil, fmt.Errorf("%w: invalid name %q", ErrInvalidVariable, v.Name)
+		}
+		if declared[v.Name] {
+			return nil, fmt.Errorf("%w: %s is declared twice", ErrInvalidVariable, v.Name)
+		}
+		switch v.Type {
+		case TypeString, TypeNumber, TypeDate, TypeBoolean:
+		default:
+			return nil, fmt.Errorf("%w: %s has unknown type %q", ErrInvalidVariable, v.Name, v.Type)
+		}
+		declared[v.Name] = true
+	}
+
+	c := &Compiled{source: t}
+	if _, err := c.parse(DefaultLocale, declared); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// parsed holds a template's parts parsed with the functions of one locale
+type parsed struct {
+	subject *template.Template
+	body    *template.Template
+	html    *htmltemplate.Template
+}
+
+// parse parses the template's parts. Templates are parsed for each rendering since the functions,
+// which depend on the locale, cannot be changed once an HTML template has been executed. When
+// declared is given, the parts are checked to use only declared variables.
+func (c *Compiled) parse(locale string, declared map[string]bool) (*parsed, error) {
+	f := funcs(locale)
+	var p parsed
+	var err error
+	if p.subject, err = parseText("subject", c.source.Subject, f, declared); err != nil {
+		return nil, err
+	}
+	if p.body, err = parseText("body", c.source.Body, f, declared); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(c.source.HTML) != "" {
+		p.html, err = htmltemplate.New("html").Funcs(htmltemplate.FuncMap(f)).Option("missingkey=error").Parse(c.source.HTML)
+		if err != nil {
+			return nil, fmt.Errorf("%w: html: %v", ErrInvalidTemplate, err)
+		}
+		if declared != nil {
+			if err = checkFields("html", p.html.Tree, declared); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &p, nil
+}
+
+// Variables returns the template's declared variables
+func (c *Compiled) Variables() []Variable {
+	return c.source.Variables
+}
+
+// Render renders the template with the given values for the locale, which decides how dates are
+// written. Values are converted to their variables' declared types; values for undeclared
+// variables are ignored, and optional variables left out are empty.
+func (c *Compiled) Render(locale string, data map[string]string) (*Message, error) {
+	values, err := c.values(data)
+	if err != nil {
+		return nil, err
+	}
+	p, err := c.parse(locale, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg Message
+	if msg.Subject, err = execute(p.subject, values); err != nil {
+		return nil, err
+	}
+	// A subject is one line
+	msg.Subject = strings.Join(strings.Fields(msg.Subject), " ")
+	if msg.Body, err = execute(p.body, values); err != nil {
+		return nil, err
+	}
+	if p.html != nil {
+		var buf bytes.Buffer
+		if err = p.html.Execute(&buf, values); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+		}
+		msg.HTML = buf.String()
+	}
+	return &msg, nil
+}
+
+// SampleData returns placeholder values for the template's variables, for previews without sample
+// data
+func (c *Compiled) SampleData() map[string]string {
+	data := make(map[string]string, len(c.source.Variables))
+	for _, v := range c.source.Variables {
+		switch v.Type {
+		case TypeNumber:
+			data[v.Name] = "1"
+		case TypeDate:
+			data[v.Name] = time.Now().Format("2006-01-02")
+		case TypeBoolean:
+			data[v.Name] = "true"
+		default:
+			data[v.Name] = "[" + v.Name + "]"
+		}
+	}
+	return data
+}
+
+// values converts the rendering data to the declared variables' types
+func (c *Compiled) values(data map[string]string) (map[string]any, error) {
+	values := make(map[string]any, len(c.source.Variables))
+	for _, v := range c.source.Variables {
+		raw, ok := data[v.Name]
+		if !ok || strings.TrimSpace(raw) == "" {
+			if v.Required {
+				return nil, fmt.Errorf("%w: %s", ErrMissingVariable, v.Name)
+			}
+			values[v.Name] = zero(v.Type)
+			continue
+		}
+		value, err := ParseValue(v.Type, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidVariable, v.Name, err)
+		}
+		values[v.Name] = value
+	}
+	return values, nil
+}
+
+// ParseValue converts a variable's value from its string form
+func ParseValue(t VarType, raw string) (any, error) {
+	raw = strings.TrimSpace(raw)
+	switch t {
+	case TypeNumber:
+		return strconv.ParseFloat(raw, 64)
+	case TypeBoolean:
+		return strconv.ParseBool(raw)
+	case TypeDate:
+		for _, layout := range dateLayouts {
+			if d, err := time.Parse(layout, raw); err == nil {
+				return d, nil
+			}
+		}
+		return nil, fmt.Errorf("%q is not a date", raw)
+	}
+	return raw, nil
+}
+
+func zero(t VarType) any {
+	switch t {
+	case TypeNumber:
+		return float64(0)
+	case TypeBoolean:
+		return false
+	case TypeDate:
+		return time.Time{}
+	}
+	return ""
+}
+
+func parseText(name, text string, f template.FuncMap, declared map[string]bool) (*template.Template, error) {
+	t, err := template.New(name).Funcs(f).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidTemplate, name, err)
+	}
+	if declared != nil {
+		if err = checkFields(name, t.Tree, declared); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func execute(t *template.Template, values map[string]any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, values); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// checkFields checks that a template refers only to declared variables. Inside range and with
+// blocks the dot is no longer the variables, so fields there are not checked.
+func checkFields(name string, tree *parse.Tree, declared map[string]bool) error {
+	if tree == nil {
+		return nil
+	}
+	var undeclared []string
+	var walk func(node parse.Node)
+	walk = func(node parse.Node) {
+		switch n := node.(type) {
+		case *parse.ListNode:
+			if n != nil {
+				for _, child := range n.Nodes {
+					walk(child)
+				}
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n != nil {
+				for _, cmd := range n.Cmds {
+					walk(cmd)
+				}
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.ChainNode:
+			walk(n.Node)
+		case *parse.FieldNode:
+			if !declared[n.Ident[0]] {
+				undeclared = append(undeclared, n.Ident[0])
+			}
+		}
+	}
+	walk(tree.Root)
+	if len(undeclared) > 0 {
+		return fmt.Errorf("%w: %s uses undeclared variables %s", ErrInvalidTemplate, name, strings.Join(undeclared, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package msgtemplate
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var expiring = Template{
+	Subject: "{{.name}}, your certificate expires {{date .expiresOn}}",
+	Body:    "Hello {{.name}},\n{{if gt .daysLeft 7.0}}You have {{.daysLeft}} days.{{else}}Renew now.{{end}}",
+	HTML:    "<p>Hello {{.name}}</p>",
+	Variables: []Variable{
+		{Name: "name", Type: TypeString, Required: true},
+		{Name: "expiresOn", Type: TypeDate, Required: true},
+		{Name: "daysLeft", Type: TypeNumber},
+	},
+}
+
+func TestRender(t *testing.T) {
+	c, err := Compile(expiring)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	msg, err := c.Render("en", map[string]string{"name": "<Ana>", "expiresOn": "2026-03-05", "daysLeft": "30"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if msg.Subject != "<Ana>, your certificate expires March 5, 2026" {
+		t.Errorf("subject = %q", msg.Subject)
+	}
+	if msg.Body != "Hello <Ana>,\nYou have 30 days." {
+		t.Errorf("body = %q", msg.Body)
+	}
+	if msg.HTML != "<p>Hello &lt;Ana&gt;</p>" {
+		t.Errorf("html = %q, want escaped values", msg.HTML)
+	}
+
+	msg, err = c.Render("es", map[string]string{"name": "Ana", "expiresOn": "2026-03-05"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !strings.HasSuffix(msg.Subject, "5 de marzo de 2026") || msg.Body != "Hello Ana,\nRenew now." {
+		t.Errorf("es message = %+v", msg)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	c, err := Compile(expiring)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	if _, err = c.Render("en", map[string]string{"name": "Ana"}); !errors.Is(err, ErrMissingVariable) {
+		t.Errorf("missing date: error = %v", err)
+	}
+	if _, err = c.Render("en", map[string]string{"name": "Ana", "expiresOn": "soon"}); !errors.Is(err, ErrInvalidVariable) {
+		t.Errorf("invalid date: error = %v", err)
+	}
+	if _, err = c.Render("en", c.SampleData()); err != nil {
+		t.Errorf("sample data: %v", err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		template Template
+		want     error
+	}{
+		"no body":        {Template{Subject: "Hi"}, ErrInvalidTemplate},
+		"syntax":         {Template{Subject: "Hi {{.name", Body: "x", Variables: []Variable{{Name: "name", Type: TypeString}}}, ErrInvalidTemplate},
+		"undeclared":     {Template{Subject: "Hi", Body: "{{.name}}"}, ErrInvalidTemplate},
+		"html":           {Template{Subject: "Hi", Body: "x", HTML: "{{if .name}}"}, ErrInvalidTemplate},
+		"unknown type":   {Template{Subject: "Hi", Body: "x", Variables: []Variable{{Name: "n", Type: "money"}}}, ErrInvalidVariable},
+		"invalid name":   {Template{Subject: "Hi", Body: "x", Variables: []Variable{{Name: "first name", Type: TypeString}}}, ErrInvalidVariable},
+		"declared twice": {Template{Subject: "Hi", Body: "x", Variables: []Variable{{Name: "n", Type: TypeString}, {Name: "n", Type: TypeString}}}, ErrInvalidVariable},
+	} {
+		if _, err := Compile(tc.template); !errors.Is(err, tc.want) {
+			t.Errorf("%s: error = %v, want %v", name, err, tc.want)
+		}
+	}
+
+	// Fields inside range and with blocks belong to the block's value
+	_, err := Compile(Template{Subject: "Hi", Body: "{{with .name}}{{.}}{{end}}", Variables: []Variable{{Name: "name", Type: TypeString}}})
+	if err != nil {
+		t.Errorf("with block: %v", err)
+	}
+}
+
+func TestNormalizeLocale(t *testing.T) {
+	for tag, want := range map[string]string{"": "en", "en-US": "en", "ES": "es", "es_MX": "es"} {
+		if got, err := NormalizeLocale(tag); err != nil || got != want {
+			t.Errorf("NormalizeLocale(%q) = %q, %v; want %q", tag, got, err, want)
+		}
+	}
+	if _, err := NormalizeLocale("fr"); !errors.Is(err, ErrUnsupportedLocale) {
+		t.Errorf("NormalizeLocale(fr) error = %v", err)
+	}
+}
@@ -0,0 +1,171 @@
+package mvrparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Field is a zero-based, end-exclusive column range within a fixed-width record
+type Field struct {
+	Start int
+	End   int
+}
+
+// FixedWidthLayout describes a state's fixed-width MVR format. Each line is one record whose
+// type is identified by its leading characters.
+type FixedWidthLayout struct {
+	// Format is the parser's format name
+	Format string
+	// State is the issuing state, used when the records do not carry it
+	State string
+	// DateLayout is the Go time layout used for every date field
+	DateLayout string
+
+	// LicenseRecord identifies the header record carrying license details
+	LicenseRecord     string
+	LicenseNumber     Field
+	LicenseState      Field
+	LicenseClass      Field
+	LicenseStatus     Field
+	LicenseExpiration Field
+	ReportDate        Field
+	// Endorsements holds one code per character, unless EndorsementSeparator is set
+	Endorsements         Field
+	EndorsementSeparator string
+
+	// ViolationRecord identifies the records carrying violations
+	ViolationRecord     string
+	ViolationDate       Field
+	ConvictionDate      Field
+	ViolationCode       Field
+	ViolationState      Field
+	ViolationPoints     Field
+	ViolationCaseNumber Field
+	ViolationDesc       Field
+}
+
+// fixedWidthParser parses reports in a FixedWidthLayout
+type fixedWidthParser struct {
+	layout FixedWidthLayout
+}
+
+// NewFixedWidthParser returns a Parser for the layout
+func NewFixedWidthParser(layout FixedWidthLayout) Parser {
+	return &fixedWidthParser{layout: layout}
+}
+
+func (p *fixedWidthParser) Format() string { return p.layout.Format }
+
+// Detect matches when the first record is this layout's license record and carries a license
+// number where the layout expects it.
+func (p *fixedWidthParser) Detect(raw []byte) bool {
+	line, _, _ := bytes.Cut(bytes.TrimLeft(raw, "\r\n"), []byte("\n"))
+	first := strings.TrimRight(string(line), "\r")
+	if !strings.HasPrefix(first, p.layout.LicenseRecord) {
+		return false
+	}
+	return strings.TrimSpace(p.field(first, p.layout.LicenseNumber)) != ""
+}
+
+func (p *fixedWidthParser) Parse(raw []byte) (*Report, error) {
+	l := p.layout
+	report := &Report{}
+	sawLicense := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var err error
+		switch {
+		case strings.HasPrefix(line, l.LicenseRecord):
+			sawLicense = true
+			err = p.parseLicense(line, report)
+		case strings.HasPrefix(line, l.ViolationRecord):
+			var v *Violation
+			if v, err = p.parseViolation(line); err == nil {
+				report.Violations = append(report.Violations, v)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !sawLicense {
+		return nil, fmt.Errorf("missing %q license record", l.LicenseRecord)
+	}
+	return report, nil
+}
+
+func (p *fixedWidthParser) parseLicense(line string, report *Report) (err error) {
+	l := p.layout
+	report.LicenseNumber = strings.TrimSpace(p.field(line, l.LicenseNumber))
+	report.LicenseState = l.State
+	if s := strings.TrimSpace(p.field(line, l.LicenseState)); s != "" {
+		report.LicenseState = strings.ToUpper(s)
+	}
+	report.LicenseClass = strings.ToUpper(strings.TrimSpace(p.field(line, l.LicenseClass)))
+	report.LicenseStatus = normalizeStatus(p.field(line, l.LicenseStatus))
+
+	endorsements := strings.TrimSpace(p.field(line, l.Endorsements))
+	if l.EndorsementSeparator != "" {
+		report.Endorsements = normalizeEndorsements(strings.Split(endorsements, l.EndorsementSeparator))
+	} else {
+		report.Endorsements = normalizeEndorsements(strings.Split(endorsements, ""))
+	}
+
+	if report.LicenseExpiration, err = parseDate(p.field(line, l.LicenseExpiration), l.DateLayout); err != nil {
+		return err
+	}
+	report.ReportDate, err = parseDate(p.field(line, l.ReportDate), l.DateLayout)
+	return err
+}
+
+func (p *fixedWidthParser) parseViolation(line string) (v *Violation, err error) {
+	l := p.layout
+	v = &Violation{
+		Code:        strings.ToUpper(strings.TrimSpace(p.field(line, l.ViolationCode))),
+		State:       strings.ToUpper(strings.TrimSpace(p.field(line, l.ViolationState))),
+		CaseNumber:  strings.TrimSpace(p.field(line, l.ViolationCaseNumber)),
+		Description: strings.TrimSpace(p.field(line, l.ViolationDesc)),
+	}
+	if v.State == "" {
+		v.State = l.State
+	}
+	if v.Date, err = parseDate(p.field(line, l.ViolationDate), l.DateLayout); err != nil {
+		return nil, err
+	}
+	if v.ConvictionDate, err = parseDate(p.field(line, l.ConvictionDate), l.DateLayout); err != nil {
+		return nil, err
+	}
+	if s := strings.TrimSpace(p.field(line, l.ViolationPoints)); s != "" {
+		if v.Points, err = strconv.Atoi(s); err != nil {
+			return nil, fmt.Errorf("invalid points %q", s)
+		}
+	}
+	return v, nil
+}
+
+// field returns the column range from the line. Ranges past the end of a short line are
+// treated as blank, since trailing spaces are often trimmed in transit.
+func (p *fixedWidthParser) field(line string, f Field) string {
+	if f == (Field{}) || f.Start >= len(line) {
+		return ""
+	}
+	end := f.End
+	if end > len(line) {
+		end = len(line)
+	}
+	return line[f.Start:end]
+}
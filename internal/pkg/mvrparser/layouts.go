@@ -0,0 +1,49 @@
+package mvrparser
+
+// stateLayouts are the fixed-width formats registered by default. Add a layout here, with a
+// fixture under testdata, to support another state.
+var stateLayouts = []FixedWidthLayout{
+	{
+		// Multi-state layout used by clearinghouse providers; records carry the state.
+		Format:            "fixed-width",
+		DateLayout:        "20060102",
+		LicenseRecord:     "01",
+		LicenseState:      Field{2, 4},
+		LicenseNumber:     Field{4, 24},
+		LicenseClass:      Field{24, 26},
+		LicenseStatus:     Field{26, 36},
+		LicenseExpiration: Field{36, 44},
+		ReportDate:        Field{44, 52},
+		Endorsements:      Field{52, 62},
+
+		ViolationRecord:     "03",
+		ViolationDate:       Field{2, 10},
+		ConvictionDate:      Field{10, 18},
+		ViolationCode:       Field{18, 21},
+		ViolationState:      Field{21, 23},
+		ViolationPoints:     Field{23, 25},
+		ViolationCaseNumber: Field{25, 40},
+		ViolationDesc:       Field{40, 80},
+	},
+	{
+		// Pennsylvania PennDOT driver history extract.
+		Format:               "fixed-width-pa",
+		State:                "PA",
+		DateLayout:           "01022006",
+		LicenseRecord:        "DL",
+		LicenseNumber:        Field{2, 10},
+		LicenseClass:         Field{10, 11},
+		LicenseStatus:        Field{11, 14},
+		LicenseExpiration:    Field{14, 22},
+		ReportDate:           Field{22, 30},
+		Endorsements:         Field{30, 40},
+		EndorsementSeparator: ",",
+
+		ViolationRecord:     "CV",
+		ViolationDate:       Field{2, 10},
+		ConvictionDate:      Field{10, 18},
+		ViolationCode:       Field{18, 21},
+		ViolationCaseNumber: Field{21, 33},
+		ViolationDesc:       Field{33, 73},
+	},
+}
@@ -0,0 +1,201 @@
+// Package mvrparser extracts license details and violations from raw provider MVR payloads.
+//
+// Each provider or state format is handled by a Parser. Parsers are registered with Register
+// and tried in registration order by Parse, so a new state format only needs a Parser (or a
+// FixedWidthLayout) and a fixture under testdata.
+package mvrparser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownFormat is returned when no registered parser recognises the payload
+	ErrUnknownFormat = errors.New("mvrparser: unknown report format")
+	// ErrEmptyReport is returned for an empty payload
+	ErrEmptyReport = errors.New("mvrparser: empty report")
+)
+
+// Report is the structured content of a raw MVR
+type Report struct {
+	Format            string       `json:"format"`
+	LicenseNumber     string       `json:"license_number"`
+	LicenseState      string       `json:"license_state"`
+	LicenseClass      string       `json:"license_class"`
+	LicenseStatus     string       `json:"license_status"`
+	LicenseExpiration *time.Time   `json:"license_expiration"`
+	Endorsements      []string     `json:"endorsements"`
+	ReportDate        *time.Time   `json:"report_date"`
+	Violations        []*Violation `json:"violations"`
+}
+
+// Violation is a single conviction or withdrawal on the driving record
+type Violation struct {
+	Date           *time.Time `json:"date"`
+	ConvictionDate *time.Time `json:"conviction_date"`
+	Code           string     `json:"code"`
+	Description    string     `json:"description"`
+	State          string     `json:"state"`
+	Points         int        `json:"points"`
+	FineAmount     float64    `json:"fine_amount"`
+	CourtName      string     `json:"court_name"`
+	CaseNumber     string     `json:"case_number"`
+}
+
+// Parser converts one raw MVR format into a Report
+type Parser interface {
+	// Format names the format, e.g. "xml" or "fixed-width-pa"
+	Format() string
+	// Detect reports whether the payload looks like this format
+	Detect(raw []byte) bool
+	// Parse extracts the report from the payload
+	Parse(raw []byte) (*Report, error)
+}
+
+var (
+	mu      sync.RWMutex
+	parsers []Parser
+)
+
+// Register adds a parser. Parsers registered earlier take precedence during detection.
+func Register(p Parser) {
+	mu.Lock()
+	defer mu.Unlock()
+	parsers = append(parsers, p)
+}
+
+// Lookup returns the registered parser for a format name
+func Lookup(format string) (Parser, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, p := range parsers {
+		if p.Format() == format {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Parse detects the payload format and parses it with the matching parser
+func Parse(raw []byte) (*Report, error) {
+	raw = Unwrap(raw)
+	if len(raw) == 0 {
+		return nil, ErrEmptyReport
+	}
+
+	mu.RLock()
+	candidates := append([]Parser(nil), parsers...)
+	mu.RUnlock()
+
+	for _, p := range candidates {
+		if p.Detect(raw) {
+			return ParseAs(p, raw)
+		}
+	}
+	return nil, ErrUnknownFormat
+}
+
+// ParseAs parses the payload with a specific parser, skipping detection
+func ParseAs(p Parser, raw []byte) (*Report, error) {
+	raw = Unwrap(raw)
+	if len(raw) == 0 {
+		return nil, ErrEmptyReport
+	}
+	report, err := p.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("mvrparser: %s: %w", p.Format(), err)
+	}
+	report.Format = p.Format()
+	return report, nil
+}
+
+// Unwrap returns the provider payload from the stored raw report data. Payloads are kept in a
+// JSONB column, so text formats arrive as a JSON string and are decoded here.
+func Unwrap(raw []byte) []byte {
+	trimmed := strings.TrimSpace(strings.TrimPrefix(string(raw), "\ufeff"))
+	if strings.HasPrefix(trimmed, `"`) {
+		var s string
+		if err := json.Unmarshal([]byte(trimmed), &s); err == nil {
+			return []byte(s)
+		}
+	}
+	return []byte(trimmed)
+}
+
+// dateLayouts are the date formats seen in provider payloads
+var dateLayouts = []string{"2006-01-02", "01/02/2006", "20060102", "2006-01-02T15:04:05Z07:00"}
+
+// parseDate parses a date in any of the known layouts. Blank and all-zero dates are nil.
+func parseDate(s string, layouts ...string) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.Trim(s, "0") == "" {
+		return nil, nil
+	}
+	if len(layouts) == 0 {
+		layouts = dateLayouts
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid date %q", s)
+}
+
+// licenseStatuses maps provider status codes to the values stored in mvr_reports.license_status
+var licenseStatuses = map[string]string{
+	"VALID":        "valid",
+	"VAL":          "valid",
+	"ACTIVE":       "valid",
+	"ACT":          "valid",
+	"LICENSED":     "valid",
+	"SUSPENDED":    "suspended",
+	"SUS":          "suspended",
+	"SUSP":         "suspended",
+	"REVOKED":      "revoked",
+	"REV":          "revoked",
+	"EXPIRED":      "expired",
+	"EXP":          "expired",
+	"CANCELLED":    "cancelled",
+	"CANCELED":     "cancelled",
+	"CAN":          "cancelled",
+	"DISQUALIFIED": "disqualified",
+	"DSQ":          "disqualified",
+}
+
+// normalizeStatus maps a provider license status to its stored value. Unknown statuses are
+// kept, lower-cased, so they are not silently dropped.
+func normalizeStatus(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if v, ok := licenseStatuses[s]; ok {
+		return v
+	}
+	return strings.ToLower(s)
+}
+
+// normalizeEndorsements upper-cases, de-duplicates and drops blank endorsement codes
+func normalizeEndorsements(in []string) []string {
+	seen := make(map[string]bool, len(in))
+	out := make([]string, 0, len(in))
+	for _, e := range in {
+		e = strings.ToUpper(strings.TrimSpace(e))
+		if e == "" || seen[e] {
+			continue
+		}
+		seen[e] = true
+		out = append(out, e)
+	}
+	return out
+}
+
+func init() {
+	Register(&xmlParser{})
+	for _, layout := range stateLayouts {
+		Register(NewFixedWidthParser(layout))
+	}
+}
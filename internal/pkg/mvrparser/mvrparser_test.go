@@ -0,0 +1,215 @@
+package mvrparser
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func date(s string) *time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return &t
+}
+
+// fixtures maps each file in testdata to the report it must parse into. Every fixture must
+// have an entry, so a new state format cannot be added without its expected output.
+var fixtures = map[string]*Report{
+	"xml_report.xml": {
+		Format:            "xml",
+		LicenseNumber:     "D1234567",
+		LicenseState:      "TX",
+		LicenseClass:      "A",
+		LicenseStatus:     "valid",
+		LicenseExpiration: date("2028-04-15"),
+		Endorsements:      []string{"H", "N", "T"},
+		ReportDate:        date("2026-09-30"),
+		Violations: []*Violation{
+			{
+				Date:           date("2025-06-12"),
+				ConvictionDate: date("2025-07-20"),
+				Code:           "S92",
+				Description:    "SPEEDING 15 OVER",
+				State:          "TX",
+				Points:         2,
+				FineAmount:     185.50,
+				CourtName:      "HARRIS COUNTY JP 4",
+				CaseNumber:     "TR-2025-0412",
+			},
+			{
+				Date:        date("2024-06-01"),
+				Code:        "A21",
+				Description: "DRIVING UNDER THE INFLUENCE",
+				State:       "OK",
+			},
+		},
+	},
+	"fixed_width.txt": {
+		Format:            "fixed-width",
+		LicenseNumber:     "B7654321",
+		LicenseState:      "CA",
+		LicenseClass:      "A",
+		LicenseStatus:     "suspended",
+		LicenseExpiration: date("2027-08-31"),
+		Endorsements:      []string{"H", "N", "X"},
+		ReportDate:        date("2026-09-15"),
+		Violations: []*Violation{
+			{
+				Date:           date("2025-03-03"),
+				ConvictionDate: date("2025-04-10"),
+				Code:           "M84",
+				Description:    "FOLLOWING TOO CLOSELY",
+				State:          "CA",
+				Points:         1,
+				CaseNumber:     "CA-25-77812",
+			},
+			{
+				Date:        date("2024-01-11"),
+				Code:        "B01",
+				Description: "LEAVING SCENE OF ACCIDENT",
+				State:       "NV",
+				Points:      2,
+				CaseNumber:  "NV-24-1001",
+			},
+		},
+	},
+	"fixed_width_pa.txt": {
+		Format:            "fixed-width-pa",
+		LicenseNumber:     "29384756",
+		LicenseState:      "PA",
+		LicenseClass:      "B",
+		LicenseStatus:     "valid",
+		LicenseExpiration: date("2029-11-30"),
+		Endorsements:      []string{"P", "S"},
+		ReportDate:        date("2026-10-01"),
+		Violations: []*Violation{
+			{
+				Date:           date("2026-02-14"),
+				ConvictionDate: date("2026-03-02"),
+				Code:           "U07",
+				Description:    "FAIL TO OBEY TRAFFIC CONTROL DEVICE",
+				State:          "PA",
+				CaseNumber:     "MJ-0522-2026",
+			},
+		},
+	},
+}
+
+// TestParseFixtures parses every fixture with format detection and compares the result
+func TestParseFixtures(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no fixtures found")
+	}
+
+	for _, file := range files {
+		name := filepath.Base(file)
+		t.Run(name, func(t *testing.T) {
+			want, ok := fixtures[name]
+			if !ok {
+				t.Fatalf("fixture %s has no expected report", name)
+			}
+			raw, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Parse(raw)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			assertReport(t, got, want)
+
+			// Raw report data is stored in a JSONB column, so payloads usually arrive JSON-encoded.
+			wrapped, err := json.Marshal(string(raw))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err = Parse(wrapped)
+			if err != nil {
+				t.Fatalf("Parse wrapped: %v", err)
+			}
+			assertReport(t, got, want)
+		})
+	}
+}
+
+// TestParseAs tests parsing with an explicitly selected format
+func TestParseAs(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "fixed_width_pa.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, ok := Lookup("fixed-width-pa")
+	if !ok {
+		t.Fatal("fixed-width-pa parser not registered")
+	}
+	got, err := ParseAs(p, raw)
+	if err != nil {
+		t.Fatalf("ParseAs: %v", err)
+	}
+	assertReport(t, got, fixtures["fixed_width_pa.txt"])
+
+	xmlParser, _ := Lookup("xml")
+	if _, err := ParseAs(xmlParser, raw); err == nil {
+		t.Error("expected error parsing fixed-width payload as xml")
+	}
+}
+
+// TestParseErrors tests payloads that cannot be parsed
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want error
+	}{
+		{name: "empty", raw: "", want: ErrEmptyReport},
+		{name: "empty json string", raw: `""`, want: ErrEmptyReport},
+		{name: "json object", raw: `{"status":"clear"}`, want: ErrUnknownFormat},
+		{name: "unknown text", raw: "ZZ header\nZZ detail", want: ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.raw))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := Parse([]byte("DL29384756BACT13302029"))
+		if err == nil {
+			t.Error("expected error for invalid expiration date")
+		}
+	})
+}
+
+func assertReport(t *testing.T, got, want *Report) {
+	t.Helper()
+	gotViolations, wantViolations := got.Violations, want.Violations
+	g, w := *got, *want
+	g.Violations, w.Violations = nil, nil
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("report mismatch\n got: %+v\nwant: %+v", g, w)
+	}
+	if len(gotViolations) != len(wantViolations) {
+		t.Fatalf("expected %d violations, got %d", len(wantViolations), len(gotViolations))
+	}
+	for i := range wantViolations {
+		if !reflect.DeepEqual(gotViolations[i], wantViolations[i]) {
+			t.Errorf("violation %d mismatch\n got: %+v\nwant: %+v", i, *gotViolations[i], *wantViolations[i])
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package mvrparser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// xmlReport is the common provider XML layout
+type xmlReport struct {
+	XMLName    xml.Name `xml:"MVRReport"`
+	ReportDate string   `xml:"ReportDate"`
+	License    struct {
+		Number         string   `xml:"Number"`
+		State          string   `xml:"State"`
+		Class          string   `xml:"Class"`
+		Status         string   `xml:"Status"`
+		ExpirationDate string   `xml:"ExpirationDate"`
+		Endorsements   []string `xml:"Endorsements>Endorsement"`
+	} `xml:"Driver>License"`
+	Violations []struct {
+		Date           string `xml:"Date"`
+		ConvictionDate string `xml:"ConvictionDate"`
+		ACDCode        string `xml:"ACDCode"`
+		Description    string `xml:"Description"`
+		State          string `xml:"State"`
+		Points         string `xml:"Points"`
+		FineAmount     string `xml:"FineAmount"`
+		CourtName      string `xml:"CourtName"`
+		CaseNumber     string `xml:"CaseNumber"`
+	} `xml:"Violations>Violation"`
+}
+
+// xmlParser handles providers that deliver the common MVRReport XML document
+type xmlParser struct{}
+
+func (p *xmlParser) Format() string { return "xml" }
+
+func (p *xmlParser) Detect(raw []byte) bool {
+	raw = bytes.TrimSpace(raw)
+	return bytes.HasPrefix(raw, []byte("<")) && bytes.Contains(raw, []byte("<MVRReport"))
+}
+
+func (p *xmlParser) Parse(raw []byte) (*Report, error) {
+	var doc xmlReport
+	if err := xml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		LicenseNumber: strings.TrimSpace(doc.License.Number),
+		LicenseState:  strings.ToUpper(strings.TrimSpace(doc.License.State)),
+		LicenseClass:  strings.ToUpper(strings.TrimSpace(doc.License.Class)),
+		LicenseStatus: normalizeStatus(doc.License.Status),
+		Endorsements:  normalizeEndorsements(doc.License.Endorsements),
+	}
+	var err error
+	if report.LicenseExpiration, err = parseDate(doc.License.ExpirationDate); err != nil {
+		return nil, err
+	}
+	if report.ReportDate, err = parseDate(doc.ReportDate); err != nil {
+		return nil, err
+	}
+
+	for _, v := range doc.Violations {
+		violation := &Violation{
+			Code:        strings.ToUpper(strings.TrimSpace(v.ACDCode)),
+			Description: strings.TrimSpace(v.Description),
+			State:       strings.ToUpper(strings.TrimSpace(v.State)),
+			CourtName:   strings.TrimSpace(v.CourtName),
+			CaseNumber:  strings.TrimSpace(v.CaseNumber),
+		}
+		if violation.Date, err = parseDate(v.Date); err != nil {
+			return nil, err
+		}
+		if violation.ConvictionDate, err = parseDate(v.ConvictionDate); err != nil {
+			return nil, err
+		}
+		if s := strings.TrimSpace(v.Points); s != "" {
+			if violation.Points, err = strconv.Atoi(s); err != nil {
+				return nil, err
+			}
+		}
+		if s := strings.TrimSpace(v.FineAmount); s != "" {
+			if violation.FineAmount, err = strconv.ParseFloat(s, 64); err != nil {
+				return nil, err
+			}
+		}
+		report.Violations = append(report.Violations, violation)
+	}
+	return report, nil
+}
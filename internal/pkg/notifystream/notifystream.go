@@ -0,0 +1,219 @@
+// Package notifystream carries in-app notification events from Postgres to the clients streaming
+// them. A trigger on the notifications table publishes each event with pg_notify; every API
+// instance listens on the channel and hands the events to the subscriptions of the user they are
+// for, so a client sees events whichever instance it is connected to.
+package notifystream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Channel is the Postgres notification channel events are published on
+const Channel = "notification_events"
+
+// SubscriptionBuffer is how many events a subscription holds for a client that has not caught up
+const SubscriptionBuffer = 64
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// EventType is what happened to a notification
+type EventType string
+
+const (
+	EventCreated EventType = "created"
+	EventRead    EventType = "read"
+	EventUnread  EventType = "unread"
+	// EventResync tells clients events may have been missed and their notifications should be
+	// reloaded
+	EventResync EventType = "resync"
+	// EventHeartbeat is sent by an idle stream to keep its connection open; it is never published
+	EventHeartbeat EventType = "heartbeat"
+)
+
+// ErrInvalidEvent is returned for a payload that is not a notification event
+var ErrInvalidEvent = errors.New("notifystream: invalid event payload")
+
+// Event is a change to one user's in-app notifications. Only IDs are carried; the notification
+// itself is loaded by whoever streams the event.
+type Event struct {
+	Type           EventType `json:"event"`
+	NotificationID string    `json:"notification_id,omitempty"`
+	UserID         string    `json:"user_id,omitempty"`
+	OrganizationID string    `json:"organization_id,omitempty"`
+}
+
+// ParseEvent parses the payload of a notification published by the trigger
+func ParseEvent(payload string) (Event, error) {
+	var e Event
+	if err := json.Unmarshal([]byte(payload), &e); err != nil {
+		return Event{}, fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	switch e.Type {
+	case EventCreated, EventRead, EventUnread:
+		if e.NotificationID == "" || e.UserID == "" {
+			return Event{}, fmt.Errorf("%w: %s event without notification or user", ErrInvalidEvent, e.Type)
+		}
+	default:
+		return Event{}, fmt.Errorf("%w: unknown event %q", ErrInvalidEvent, e.Type)
+	}
+	return e, nil
+}
+
+// Hub hands events to the subscriptions they are for
+type Hub struct {
+	mu   sync.Mutex
+	subs map[*Subscription]struct{}
+}
+
+// NewHub returns a hub without subscriptions
+func NewHub() *Hub {
+	return &Hub{subs: make(map[*Subscription]struct{})}
+}
+
+// Subscription receives the events for one user, optionally limited to one organization
+type Subscription struct {
+	UserID         string
+	OrganizationID string
+
+	hub    *Hub
+	events chan Event
+	once   sync.Once
+}
+
+// Subscribe subscribes to a user's events. An empty organization ID subscribes to the events of
+// all the user's organizations. The subscription must be closed when no longer read.
+func (h *Hub) Subscribe(userID, organizationID string) *Subscription {
+	sub := &Subscription{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		hub:            h,
+		events:         make(chan Event, SubscriptionBuffer),
+	}
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+// Events returns the subscription's events. The channel is closed when the subscription is.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close unsubscribes; it may be called more than once
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.hub.mu.Lock()
+		delete(s.hub.subs, s)
+		s.hub.mu.Unlock()
+		close(s.events)
+	})
+}
+
+// matches reports whether the event is for the subscription. Resync events are for everyone.
+func (s *Subscription) matches(e Event) bool {
+	if e.Type == EventResync {
+		return true
+	}
+	if e.UserID != s.UserID {
+		return false
+	}
+	return s.OrganizationID == "" || e.OrganizationID == "" || e.OrganizationID == s.OrganizationID
+}
+
+// Publish hands an event to the subscriptions it is for without waiting on them. A subscription
+// whose buffer is full has its pending events replaced by a resync event, so a slow client reloads
+// rather than silently missing events.
+func (h *Hub) Publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if !sub.matches(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+			continue
+		default:
+		}
+		drain(sub.events)
+		sub.events <- Event{Type: EventResync}
+	}
+}
+
+// drain empties a buffered channel without blocking
+func drain(events chan Event) {
+	for {
+		select {
+		case <-events:
+		default:
+			return
+		}
+	}
+}
+
+// Listen listens for events on the pool's database and publishes them to the hub until the context
+// is done. The connection is re-established after failures, and a resync event is published once
+// it is, since events sent meanwhile are lost.
+func Listen(ctx context.Context, pool *pgxpool.Pool, hub *Hub) error {
+	delay := minReconnectDelay
+	connected := false
+	for {
+		err := listen(ctx, pool, hub, func() {
+			if connected {
+				hub.Publish(Event{Type: EventResync})
+			}
+			connected = true
+			delay = minReconnectDelay
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		g.Log().Warningf(ctx, "Notification event listener disconnected, reconnecting in %s: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// listen publishes events from one connection until it fails, calling ready once it is listening
+func listen(ctx context.Context, pool *pgxpool.Pool, hub *Hub, ready func()) error {
+	pooled, err := pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// A listening connection is not fit for reuse, so it is taken out of the pool and closed after
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+Channel); err != nil {
+		return err
+	}
+	ready()
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		e, err := ParseEvent(n.Payload)
+		if err != nil {
+			g.Log().Warningf(ctx, "Ignoring notification event: %v", err)
+			continue
+		}
+		hub.Publish(e)
+	}
+}
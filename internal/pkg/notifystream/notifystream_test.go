@@ -0,0 +1,93 @@
+package notifystream
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseEvent(t *testing.T) {
+	e, err := ParseEvent(`{"event":"created","notification_id":"n1","user_id":"u1","organization_id":"o1"}`)
+	want := Event{Type: EventCreated, NotificationID: "n1", UserID: "u1", OrganizationID: "o1"}
+	if err != nil || e != want {
+		t.Errorf("ParseEvent() = %+v, %v; want %+v", e, err, want)
+	}
+	for _, payload := range []string{
+		"",
+		"not json",
+		`{"event":"deleted","notification_id":"n1","user_id":"u1"}`,
+		`{"event":"read","user_id":"u1"}`,
+		`{"event":"unread","notification_id":"n1"}`,
+		`{"event":"resync"}`,
+	} {
+		if _, err := ParseEvent(payload); !errors.Is(err, ErrInvalidEvent) {
+			t.Errorf("ParseEvent(%q) error = %v", payload, err)
+		}
+	}
+}
+
+func TestPublish(t *testing.T) {
+	hub := NewHub()
+	anyOrg := hub.Subscribe("u1", "")
+	defer anyOrg.Close()
+	oneOrg := hub.Subscribe("u1", "o1")
+	defer oneOrg.Close()
+	other := hub.Subscribe("u2", "")
+	defer other.Close()
+
+	hub.Publish(Event{Type: EventCreated, NotificationID: "n1", UserID: "u1", OrganizationID: "o1"})
+	hub.Publish(Event{Type: EventRead, NotificationID: "n2", UserID: "u1", OrganizationID: "o2"})
+	hub.Publish(Event{Type: EventResync})
+
+	for _, tc := range []struct {
+		name string
+		sub  *Subscription
+		want []string
+	}{
+		{"any organization", anyOrg, []string{"n1", "n2", ""}},
+		{"one organization", oneOrg, []string{"n1", ""}},
+		{"other user", other, []string{""}},
+	} {
+		var got []string
+		for len(tc.sub.Events()) > 0 {
+			got = append(got, (<-tc.sub.Events()).NotificationID)
+		}
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: got events %q; want %q", tc.name, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s: got events %q; want %q", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestPublishToSlowSubscriber(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("u1", "")
+	defer sub.Close()
+
+	for i := 0; i <= SubscriptionBuffer; i++ {
+		hub.Publish(Event{Type: EventCreated, NotificationID: "n", UserID: "u1"})
+	}
+	if n := len(sub.Events()); n != 1 {
+		t.Fatalf("%d events pending; want 1", n)
+	}
+	if e := <-sub.Events(); e.Type != EventResync {
+		t.Errorf("pending event = %+v; want resync", e)
+	}
+}
+
+func TestClose(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("u1", "")
+	sub.Close()
+	sub.Close()
+
+	hub.Publish(Event{Type: EventCreated, NotificationID: "n1", UserID: "u1"})
+	if _, ok := <-sub.Events(); ok {
+		t.Error("closed subscription received an event")
+	}
+}
@@ -0,0 +1,149 @@
+package smspkg
+
+import "errors"
+
+// Error codes for different types of SMS errors
+const (
+	// Configuration errors
+	ErrCodeInvalidConfig          = "INVALID_CONFIG"
+	ErrCodeUnsupportedProvider    = "UNSUPPORTED_PROVIDER"
+	ErrCodeConfigValidationFailed = "CONFIG_VALIDATION_FAILED"
+
+	// Message validation errors
+	ErrCodeInvalidMessage     = "INVALID_MESSAGE"
+	ErrCodeInvalidFromNumber  = "INVALID_FROM_NUMBER"
+	ErrCodeInvalidPhoneNumber = "INVALID_PHONE_NUMBER"
+	ErrCodeInvalidBody        = "INVALID_BODY"
+	ErrCodeBodyTooLong        = "BODY_TOO_LONG"
+
+	// Recipient and provider rejections
+	ErrCodeRecipientOptedOut = "RECIPIENT_OPTED_OUT"
+	ErrCodeMessageRejected   = "MESSAGE_REJECTED"
+
+	// Callback errors
+	ErrCodeCallbacksDisabled = "CALLBACKS_DISABLED"
+	ErrCodeInvalidSignature  = "INVALID_SIGNATURE"
+	ErrCodeInvalidCallback   = "INVALID_CALLBACK"
+
+	// Network and communication errors
+	ErrCodeNetworkError       = "NETWORK_ERROR"
+	ErrCodeTimeoutError       = "TIMEOUT_ERROR"
+	ErrCodeHTTPError          = "HTTP_ERROR"
+	ErrCodeRequestCreateError = "REQUEST_CREATE_ERROR"
+	ErrCodeResponseParseError = "RESPONSE_PARSE_ERROR"
+
+	// Rate limiting errors
+	ErrCodeRateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+
+	// Authentication errors
+	ErrCodeUnauthorized = "UNAUTHORIZED"
+
+	// Service specific errors
+	ErrCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	ErrCodeUnknownError       = "UNKNOWN_ERROR"
+)
+
+// Error messages for common scenarios
+const (
+	MsgInvalidConfig          = "SMS configuration is required"
+	MsgUnsupportedProvider    = "unsupported SMS provider"
+	MsgConfigValidationFailed = "SMS service configuration validation failed"
+
+	MsgInvalidMessage     = "SMS message is required"
+	MsgInvalidFromNumber  = "from phone number is required"
+	MsgInvalidPhoneNumber = "invalid phone number"
+	MsgInvalidBody        = "SMS body is required"
+	MsgBodyTooLong        = "SMS body is too long"
+
+	MsgRecipientOptedOut = "recipient has opted out of SMS messages"
+	MsgMessageRejected   = "message rejected by the SMS provider"
+
+	MsgCallbacksDisabled = "SMS provider callbacks are not configured"
+	MsgInvalidSignature  = "invalid callback signature"
+	MsgInvalidCallback   = "invalid callback request"
+
+	MsgTwilioMissingAccountSID = "Twilio account SID is required"
+	MsgTwilioMissingAuthToken  = "Twilio auth token is required"
+	MsgTwilioMissingSender     = "a default from number or Twilio messaging service SID is required"
+	MsgTwilioSendFailed        = "failed to send SMS via Twilio"
+
+	MsgNetworkError       = "network error occurred"
+	MsgRequestCreateError = "failed to create HTTP request"
+	MsgResponseParseError = "failed to parse response"
+	MsgRateLimitExceeded  = "rate limit exceeded"
+	MsgUnauthorized       = "unauthorized access"
+	MsgServiceUnavailable = "SMS service unavailable"
+)
+
+// Predefined error variables for common scenarios
+var (
+	ErrInvalidConfig       = errors.New("invalid SMS configuration")
+	ErrUnsupportedProvider = errors.New("unsupported SMS provider")
+	ErrInvalidPhoneNumber  = errors.New("invalid phone number format")
+)
+
+// NewSMSError creates a new SMSError with the specified provider, code, and message
+func NewSMSError(provider SMSProvider, code, message string) *SMSError {
+	return &SMSError{
+		Provider: provider,
+		Code:     code,
+		Message:  message,
+	}
+}
+
+// NewSMSErrorWithCause creates a new SMSError with an underlying cause
+func NewSMSErrorWithCause(provider SMSProvider, code, message string, cause error) *SMSError {
+	return &SMSError{
+		Provider: provider,
+		Code:     code,
+		Message:  message,
+		Original: cause,
+	}
+}
+
+// GetErrorCode extracts the error code from an SMSError
+func GetErrorCode(err error) string {
+	var smsErr *SMSError
+	if errors.As(err, &smsErr) {
+		return smsErr.Code
+	}
+	return ErrCodeUnknownError
+}
+
+// IsConfigError checks if the error is a configuration error
+func IsConfigError(err error) bool {
+	switch GetErrorCode(err) {
+	case ErrCodeInvalidConfig, ErrCodeUnsupportedProvider, ErrCodeConfigValidationFailed:
+		return true
+	}
+	return false
+}
+
+// IsValidationError checks if the error is a message validation error
+func IsValidationError(err error) bool {
+	switch GetErrorCode(err) {
+	case ErrCodeInvalidMessage, ErrCodeInvalidFromNumber, ErrCodeInvalidPhoneNumber,
+		ErrCodeInvalidBody, ErrCodeBodyTooLong:
+		return true
+	}
+	return false
+}
+
+// IsCallbackError checks if the error is a rejected provider callback
+func IsCallbackError(err error) bool {
+	switch GetErrorCode(err) {
+	case ErrCodeCallbacksDisabled, ErrCodeInvalidSignature, ErrCodeInvalidCallback:
+		return true
+	}
+	return false
+}
+
+// IsRetryableError checks if the error is retryable
+func IsRetryableError(err error) bool {
+	switch GetErrorCode(err) {
+	case ErrCodeNetworkError, ErrCodeTimeoutError, ErrCodeHTTPError,
+		ErrCodeRateLimitExceeded, ErrCodeServiceUnavailable:
+		return true
+	}
+	return false
+}
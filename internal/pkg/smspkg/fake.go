@@ -0,0 +1,102 @@
+package smspkg
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// LocalFake receives the messages sent with the fake provider, for local development without an
+// SMS account
+var LocalFake = NewFakeSender()
+
+// FakeSender is an in-memory SMSSender for tests and local development. It keeps the messages it is
+// asked to send instead of sending them, and accepts unsigned callbacks with Twilio's parameter
+// names.
+type FakeSender struct {
+	mu       sync.Mutex
+	messages []SMSMessage
+	err      error
+}
+
+// NewFakeSender creates a new in-memory SMS sender
+func NewFakeSender() *FakeSender {
+	return &FakeSender{}
+}
+
+// SendSMS records a text message, or returns the error set with FailWith
+func (s *FakeSender) SendSMS(ctx context.Context, message *SMSMessage) (*SMSResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.messages = append(s.messages, *message)
+	return &SMSResponse{
+		MessageID: fmt.Sprintf("SMfake%06d", len(s.messages)),
+		Status:    "queued",
+		Provider:  ProviderFake,
+		SentAt:    time.Now(),
+	}, nil
+}
+
+// ValidateConfig always succeeds; the fake needs no configuration
+func (s *FakeSender) ValidateConfig() error {
+	return nil
+}
+
+// GetProvider returns the provider type
+func (s *FakeSender) GetProvider() SMSProvider {
+	return ProviderFake
+}
+
+// Messages returns the messages sent so far
+func (s *FakeSender) Messages() []SMSMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]SMSMessage(nil), s.messages...)
+}
+
+// FailWith makes sends fail with err until it is called again with nil
+func (s *FakeSender) FailWith(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Reset forgets the messages sent and any error set with FailWith
+func (s *FakeSender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages, s.err = nil, nil
+}
+
+// ParseStatusCallback parses a status callback with MessageSid, MessageStatus and ErrorCode
+// parameters. Statuses are the package's DeliveryStatus values.
+func (s *FakeSender) ParseStatusCallback(r *http.Request) (*StatusCallback, error) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("MessageSid") == "" {
+		return nil, NewSMSErrorWithCause(ProviderFake, ErrCodeInvalidCallback, MsgInvalidCallback, err)
+	}
+	return &StatusCallback{
+		MessageID:      r.PostForm.Get("MessageSid"),
+		Status:         DeliveryStatus(r.PostForm.Get("MessageStatus")),
+		ProviderStatus: r.PostForm.Get("MessageStatus"),
+		ErrorCode:      r.PostForm.Get("ErrorCode"),
+		To:             r.PostForm.Get("To"),
+	}, nil
+}
+
+// ParseInboundMessage parses an incoming message with From, To and Body parameters
+func (s *FakeSender) ParseInboundMessage(r *http.Request) (*InboundMessage, error) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("From") == "" {
+		return nil, NewSMSErrorWithCause(ProviderFake, ErrCodeInvalidCallback, MsgInvalidCallback, err)
+	}
+	return &InboundMessage{
+		MessageID: r.PostForm.Get("MessageSid"),
+		From:      r.PostForm.Get("From"),
+		To:        r.PostForm.Get("To"),
+		Body:      r.PostForm.Get("Body"),
+	}, nil
+}
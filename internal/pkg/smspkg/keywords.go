@@ -0,0 +1,37 @@
+package smspkg
+
+import "strings"
+
+// Keyword is a reply keyword that changes whether a phone receives messages
+type Keyword int
+
+const (
+	KeywordNone Keyword = iota
+	KeywordOptOut
+	KeywordOptIn
+)
+
+// Reply keywords carriers and providers treat as opting out of and back in to messages
+var keywords = map[string]Keyword{
+	"STOP":        KeywordOptOut,
+	"STOPALL":     KeywordOptOut,
+	"UNSUBSCRIBE": KeywordOptOut,
+	"CANCEL":      KeywordOptOut,
+	"END":         KeywordOptOut,
+	"QUIT":        KeywordOptOut,
+	"OPTOUT":      KeywordOptOut,
+	"REVOKE":      KeywordOptOut,
+	"START":       KeywordOptIn,
+	"UNSTOP":      KeywordOptIn,
+	"YES":         KeywordOptIn,
+	"OPTIN":       KeywordOptIn,
+}
+
+// ParseKeyword returns the opt-out or opt-in keyword an inbound message consists of. Keywords match
+// regardless of case, surrounding space and trailing punctuation; messages with other text are
+// KeywordNone.
+func ParseKeyword(body string) Keyword {
+	word := strings.ToUpper(strings.TrimRight(strings.TrimSpace(body), ".!"))
+	word = strings.ReplaceAll(word, " ", "")
+	return keywords[word]
+}
@@ -0,0 +1,164 @@
+// Package smspkg sends text messages through SMS service providers behind a common interface,
+// mirroring emailpkg.
+package smspkg
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxBodyLength is the longest message body, in characters, that providers deliver as one
+// concatenated message
+const MaxBodyLength = 1600
+
+// Callback paths under SMSConfig.CallbackBaseURL
+const (
+	StatusCallbackPath = "/status"
+	InboundMessagePath = "/inbound"
+)
+
+// senders creates the sender for each supported provider
+var senders = map[SMSProvider]func(config *SMSConfig) (SMSSender, error){
+	ProviderTwilio: func(config *SMSConfig) (SMSSender, error) { return NewTwilioSender(config) },
+	ProviderFake:   func(*SMSConfig) (SMSSender, error) { return LocalFake, nil },
+}
+
+// NewSMSService creates a new SMS service for the configured provider
+func NewSMSService(config *SMSConfig) (*SMSService, error) {
+	if config == nil {
+		return nil, NewSMSError("", ErrCodeInvalidConfig, MsgInvalidConfig)
+	}
+
+	newSender, ok := senders[config.Provider]
+	if !ok {
+		return nil, NewSMSError("", ErrCodeUnsupportedProvider,
+			fmt.Sprintf("%s: %s", MsgUnsupportedProvider, config.Provider))
+	}
+	sender, err := newSender(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSMSServiceWithSender(config, sender)
+}
+
+// NewSMSServiceWithSender creates a new SMS service that sends through the given sender
+func NewSMSServiceWithSender(config *SMSConfig, sender SMSSender) (*SMSService, error) {
+	if config == nil || sender == nil {
+		return nil, NewSMSError("", ErrCodeInvalidConfig, MsgInvalidConfig)
+	}
+	if err := sender.ValidateConfig(); err != nil {
+		return nil, NewSMSErrorWithCause(sender.GetProvider(), ErrCodeConfigValidationFailed,
+			MsgConfigValidationFailed, err)
+	}
+
+	return &SMSService{
+		sender: sender,
+		config: config,
+	}, nil
+}
+
+// SendSMS sends a text message. The recipient's number is normalized to E.164 format first.
+func (s *SMSService) SendSMS(ctx context.Context, message *SMSMessage) (*SMSResponse, error) {
+	provider := s.sender.GetProvider()
+	if message == nil {
+		return nil, NewSMSError(provider, ErrCodeInvalidMessage, MsgInvalidMessage)
+	}
+
+	// Set default from number if not provided
+	if message.From == "" {
+		message.From = s.config.DefaultFromNumber
+	}
+
+	to, err := NormalizePhoneNumber(message.To)
+	if err != nil {
+		return nil, NewSMSErrorWithCause(provider, ErrCodeInvalidPhoneNumber,
+			fmt.Sprintf("%s: %s", MsgInvalidPhoneNumber, message.To), err)
+	}
+	message.To = to
+
+	if strings.TrimSpace(message.Body) == "" {
+		return nil, NewSMSError(provider, ErrCodeInvalidBody, MsgInvalidBody)
+	}
+	if utf8.RuneCountInString(message.Body) > MaxBodyLength {
+		return nil, NewSMSError(provider, ErrCodeBodyTooLong,
+			fmt.Sprintf("%s: more than %d characters", MsgBodyTooLong, MaxBodyLength))
+	}
+
+	if s.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+	}
+	return s.sender.SendSMS(ctx, message)
+}
+
+// GetProvider returns the configured SMS provider
+func (s *SMSService) GetProvider() SMSProvider {
+	return s.sender.GetProvider()
+}
+
+// ParseStatusCallback authenticates and parses a delivery status report from the provider
+func (s *SMSService) ParseStatusCallback(r *http.Request) (*StatusCallback, error) {
+	parser, ok := s.sender.(CallbackParser)
+	if !ok {
+		return nil, NewSMSError(s.sender.GetProvider(), ErrCodeCallbacksDisabled, MsgCallbacksDisabled)
+	}
+	return parser.ParseStatusCallback(r)
+}
+
+// ParseInboundMessage authenticates and parses a message the provider received from a phone
+func (s *SMSService) ParseInboundMessage(r *http.Request) (*InboundMessage, error) {
+	parser, ok := s.sender.(CallbackParser)
+	if !ok {
+		return nil, NewSMSError(s.sender.GetProvider(), ErrCodeCallbacksDisabled, MsgCallbacksDisabled)
+	}
+	return parser.ParseInboundMessage(r)
+}
+
+// NormalizePhoneNumber returns a phone number in E.164 format. Spaces and punctuation are removed,
+// and numbers without a country code are taken as North American: ten digits, or eleven starting
+// with 1.
+func NormalizePhoneNumber(number string) (string, error) {
+	var digits strings.Builder
+	international := false
+	for i, r := range strings.TrimSpace(number) {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == '+' && i == 0:
+			international = true
+		case strings.ContainsRune(" -.()/", r):
+		default:
+			return "", ErrInvalidPhoneNumber
+		}
+	}
+
+	d := digits.String()
+	switch {
+	case international:
+	case len(d) == 10:
+		d = "1" + d
+	case len(d) == 11 && d[0] == '1':
+	default:
+		return "", ErrInvalidPhoneNumber
+	}
+	// E.164 numbers have at most 15 digits and country codes never start with 0
+	if len(d) < 8 || len(d) > 15 || d[0] == '0' {
+		return "", ErrInvalidPhoneNumber
+	}
+	return "+" + d, nil
+}
+
+// DefaultSMSConfig returns a default SMS configuration
+func DefaultSMSConfig() *SMSConfig {
+	return &SMSConfig{
+		Provider:     ProviderTwilio,
+		TwilioAPIURL: "https://api.twilio.com/2010-04-01",
+		Timeout:      30 * time.Second,
+	}
+}
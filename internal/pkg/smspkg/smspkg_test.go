@@ -0,0 +1,279 @@
+package smspkg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// stubSender records the messages it is asked to send
+type stubSender struct {
+	sent      []*SMSMessage
+	configErr error
+}
+
+func (s *stubSender) SendSMS(ctx context.Context, message *SMSMessage) (*SMSResponse, error) {
+	s.sent = append(s.sent, message)
+	return &SMSResponse{MessageID: "SM1", Status: "queued", Provider: "stub"}, nil
+}
+
+func (s *stubSender) ValidateConfig() error { return s.configErr }
+
+func (s *stubSender) GetProvider() SMSProvider { return "stub" }
+
+func TestNewSMSService(t *testing.T) {
+	if _, err := NewSMSService(nil); !IsConfigError(err) {
+		t.Errorf("nil config: error = %v, want a config error", err)
+	}
+	if _, err := NewSMSService(&SMSConfig{Provider: "carrier-pigeon"}); GetErrorCode(err) != ErrCodeUnsupportedProvider {
+		t.Errorf("unknown provider: error = %v, want %s", err, ErrCodeUnsupportedProvider)
+	}
+
+	invalid := errors.New("missing credentials")
+	_, err := NewSMSServiceWithSender(DefaultSMSConfig(), &stubSender{configErr: invalid})
+	if GetErrorCode(err) != ErrCodeConfigValidationFailed || !errors.Is(err, invalid) {
+		t.Errorf("invalid sender config: error = %v", err)
+	}
+}
+
+func TestSendSMS(t *testing.T) {
+	sender := &stubSender{}
+	config := DefaultSMSConfig()
+	config.DefaultFromNumber = "+15005550006"
+	service, err := NewSMSServiceWithSender(config, sender)
+	if err != nil {
+		t.Fatalf("NewSMSServiceWithSender: %v", err)
+	}
+
+	resp, err := service.SendSMS(context.Background(), &SMSMessage{To: "(512) 555-0142", Body: "Your test is due"})
+	if err != nil {
+		t.Fatalf("SendSMS: %v", err)
+	}
+	if resp.MessageID != "SM1" || len(sender.sent) != 1 {
+		t.Fatalf("message was not sent: %+v", resp)
+	}
+	if got := sender.sent[0]; got.To != "+15125550142" || got.From != "+15005550006" {
+		t.Errorf("sent message = %+v, want normalized recipient and default sender", got)
+	}
+
+	long := make([]rune, MaxBodyLength+1)
+	for i := range long {
+		long[i] = 'x'
+	}
+	for name, tc := range map[string]struct {
+		message *SMSMessage
+		code    string
+	}{
+		"nil message":    {nil, ErrCodeInvalidMessage},
+		"invalid number": {&SMSMessage{To: "call me", Body: "hi"}, ErrCodeInvalidPhoneNumber},
+		"empty body":     {&SMSMessage{To: "+15125550142", Body: "  "}, ErrCodeInvalidBody},
+		"long body":      {&SMSMessage{To: "+15125550142", Body: string(long)}, ErrCodeBodyTooLong},
+	} {
+		_, err := service.SendSMS(context.Background(), tc.message)
+		if GetErrorCode(err) != tc.code || !IsValidationError(err) {
+			t.Errorf("%s: error = %v, want %s", name, err, tc.code)
+		}
+	}
+	if len(sender.sent) != 1 {
+		t.Errorf("invalid messages were sent: %d", len(sender.sent)-1)
+	}
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	for in, want := range map[string]string{
+		"5125550142":       "+15125550142",
+		"1-512-555-0142":   "+15125550142",
+		"(512) 555.0142":   "+15125550142",
+		"+44 20 7946 0958": "+442079460958",
+	} {
+		if got, err := NormalizePhoneNumber(in); err != nil || got != want {
+			t.Errorf("NormalizePhoneNumber(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "555-0142", "25125550142", "+0123456789", "512555014x", "+1234567890123456"} {
+		if got, err := NormalizePhoneNumber(in); !errors.Is(err, ErrInvalidPhoneNumber) {
+			t.Errorf("NormalizePhoneNumber(%q) = %q, %v; want an error", in, got, err)
+		}
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	if !IsRetryableError(NewSMSError("stub", ErrCodeRateLimitExceeded, MsgRateLimitExceeded)) {
+		t.Error("rate limiting should be retryable")
+	}
+	if IsRetryableError(NewSMSError("stub", ErrCodeInvalidPhoneNumber, MsgInvalidPhoneNumber)) {
+		t.Error("an invalid number should not be retryable")
+	}
+	if IsRetryableError(errors.New("boom")) {
+		t.Error("errors from outside the package should not be retryable")
+	}
+}
+
+func newTwilioTestService(t *testing.T, handler http.HandlerFunc) *SMSService {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := DefaultSMSConfig()
+	config.TwilioAPIURL = server.URL
+	config.TwilioAccountSID = "AC123"
+	config.TwilioAuthToken = "secret"
+	config.DefaultFromNumber = "+15005550006"
+	config.CallbackBaseURL = "https://example.com/sms"
+	service, err := NewSMSService(config)
+	if err != nil {
+		t.Fatalf("NewSMSService: %v", err)
+	}
+	return service
+}
+
+func TestTwilioSendSMS(t *testing.T) {
+	service := newTwilioTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		if r.URL.Path != "/Accounts/AC123/Messages.json" || user != "AC123" || pass != "secret" {
+			t.Errorf("request to %s as %s:%s", r.URL.Path, user, pass)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("To") != "+15125550142" || r.PostForm.Get("From") != "+15005550006" ||
+			r.PostForm.Get("StatusCallback") != "https://example.com/sms/status" {
+			t.Errorf("form = %v", r.PostForm)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM42","status":"queued"}`))
+	})
+
+	resp, err := service.SendSMS(context.Background(), &SMSMessage{To: "5125550142", Body: "Your test is due"})
+	if err != nil {
+		t.Fatalf("SendSMS: %v", err)
+	}
+	if resp.MessageID != "SM42" || resp.Provider != ProviderTwilio {
+		t.Errorf("response = %+v", resp)
+	}
+}
+
+func TestTwilioSendErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status    int
+		body      string
+		code      string
+		retryable bool
+	}{
+		"opted out":   {400, `{"code":21610,"message":"Attempt to send to unsubscribed recipient"}`, ErrCodeRecipientOptedOut, false},
+		"not mobile":  {400, `{"code":21614,"message":"not a mobile number"}`, ErrCodeInvalidPhoneNumber, false},
+		"rejected":    {400, `{"code":21602,"message":"Message body is required"}`, ErrCodeMessageRejected, false},
+		"bad auth":    {401, `{"code":20003,"message":"Authenticate"}`, ErrCodeUnauthorized, false},
+		"rate limit":  {429, `{"code":20429,"message":"Too Many Requests"}`, ErrCodeRateLimitExceeded, true},
+		"unavailable": {503, ``, ErrCodeServiceUnavailable, true},
+	} {
+		service := newTwilioTestService(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			_, _ = w.Write([]byte(tc.body))
+		})
+		_, err := service.SendSMS(context.Background(), &SMSMessage{To: "+15125550142", Body: "hi"})
+		if GetErrorCode(err) != tc.code || IsRetryableError(err) != tc.retryable {
+			t.Errorf("%s: error = %v, want %s (retryable %v)", name, err, tc.code, tc.retryable)
+		}
+	}
+}
+
+func TestTwilioValidateConfig(t *testing.T) {
+	config := DefaultSMSConfig()
+	config.TwilioAccountSID = "AC123"
+	config.TwilioAuthToken = "secret"
+	if _, err := NewSMSService(config); GetErrorCode(err) != ErrCodeConfigValidationFailed {
+		t.Errorf("no sender number: error = %v", err)
+	}
+	config.TwilioMessagingServiceSID = "MG123"
+	if _, err := NewSMSService(config); err != nil {
+		t.Errorf("messaging service: %v", err)
+	}
+}
+
+func signedCallback(path string, form url.Values, authToken string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("X-Twilio-Signature", TwilioSignature(authToken, "https://example.com/sms"+path, form))
+	return r
+}
+
+func TestTwilioCallbacks(t *testing.T) {
+	service := newTwilioTestService(t, func(http.ResponseWriter, *http.Request) {})
+
+	form := url.Values{"MessageSid": {"SM42"}, "MessageStatus": {"undelivered"}, "ErrorCode": {"21610"}}
+	cb, err := service.ParseStatusCallback(signedCallback(StatusCallbackPath, form, "secret"))
+	if err != nil {
+		t.Fatalf("ParseStatusCallback: %v", err)
+	}
+	if cb.MessageID != "SM42" || cb.Status != StatusFailed || !cb.OptedOut {
+		t.Errorf("callback = %+v", cb)
+	}
+
+	form = url.Values{"MessageSid": {"SM42"}, "MessageStatus": {"delivered"}}
+	if cb, err = service.ParseStatusCallback(signedCallback(StatusCallbackPath, form, "secret")); err != nil || cb.Status != StatusDelivered {
+		t.Errorf("delivered callback = %+v, %v", cb, err)
+	}
+
+	_, err = service.ParseStatusCallback(signedCallback(StatusCallbackPath, form, "wrong"))
+	if GetErrorCode(err) != ErrCodeInvalidSignature || !IsCallbackError(err) {
+		t.Errorf("forged callback: error = %v", err)
+	}
+	_, err = service.ParseStatusCallback(signedCallback(InboundMessagePath, form, "secret"))
+	if GetErrorCode(err) != ErrCodeInvalidSignature {
+		t.Errorf("callback signed for another path: error = %v", err)
+	}
+
+	form = url.Values{"MessageSid": {"SM43"}, "From": {"+15125550142"}, "Body": {"Stop"}}
+	msg, err := service.ParseInboundMessage(signedCallback(InboundMessagePath, form, "secret"))
+	if err != nil || msg.From != "+15125550142" || msg.Body != "Stop" {
+		t.Errorf("inbound message = %+v, %v", msg, err)
+	}
+}
+
+func TestFakeSender(t *testing.T) {
+	fake := NewFakeSender()
+	service, err := NewSMSServiceWithSender(&SMSConfig{Provider: ProviderFake, DefaultFromNumber: "+15005550006"}, fake)
+	if err != nil {
+		t.Fatalf("NewSMSServiceWithSender: %v", err)
+	}
+
+	resp, err := service.SendSMS(context.Background(), &SMSMessage{To: "5125550142", Body: "hi"})
+	if err != nil || resp.MessageID != "SMfake000001" {
+		t.Fatalf("SendSMS = %+v, %v", resp, err)
+	}
+	if sent := fake.Messages(); len(sent) != 1 || sent[0].To != "+15125550142" {
+		t.Errorf("messages = %+v", sent)
+	}
+
+	unavailable := NewSMSError(ProviderFake, ErrCodeServiceUnavailable, MsgServiceUnavailable)
+	fake.FailWith(unavailable)
+	if _, err = service.SendSMS(context.Background(), &SMSMessage{To: "5125550142", Body: "hi"}); !errors.Is(err, unavailable) {
+		t.Errorf("FailWith: error = %v", err)
+	}
+	fake.Reset()
+	if len(fake.Messages()) != 0 {
+		t.Error("Reset kept messages")
+	}
+}
+
+func TestParseKeyword(t *testing.T) {
+	for body, want := range map[string]Keyword{
+		"STOP":          KeywordOptOut,
+		" stop. ":       KeywordOptOut,
+		"Unsubscribe":   KeywordOptOut,
+		"stop all":      KeywordOptOut,
+		"START":         KeywordOptIn,
+		"yes!":          KeywordOptIn,
+		"please stop":   KeywordNone,
+		"see you at 10": KeywordNone,
+	} {
+		if got := ParseKeyword(body); got != want {
+			t.Errorf("ParseKeyword(%q) = %v, want %v", body, got, want)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package smspkg
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Twilio error codes with a meaning of their own
+const (
+	twilioErrInvalidTo      = 21211 // the To number is not a valid phone number
+	twilioErrUnsubscribed   = 21610 // the recipient replied STOP to the sending number
+	twilioErrNotMobile      = 21614 // the To number cannot receive text messages
+	twilioMaxResponseLength = 1 << 20
+)
+
+// TwilioSender implements SMSSender for the Twilio Messages API and providers compatible with it
+type TwilioSender struct {
+	client     *http.Client
+	config     *SMSConfig
+	apiBaseURL string
+}
+
+// TwilioMessage is a message resource returned by the Twilio API
+type TwilioMessage struct {
+	SID          string `json:"sid"`
+	Status       string `json:"status"`
+	ErrorCode    *int   `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// TwilioErrorResponse represents an error response from the Twilio API
+type TwilioErrorResponse struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	Status   int    `json:"status"`
+	MoreInfo string `json:"more_info"`
+}
+
+// NewTwilioSender creates a new Twilio SMS sender
+func NewTwilioSender(config *SMSConfig) (*TwilioSender, error) {
+	if config == nil {
+		return nil, NewSMSError(ProviderTwilio, ErrCodeInvalidConfig, "Twilio configuration is required")
+	}
+
+	apiBaseURL := config.TwilioAPIURL
+	if apiBaseURL == "" {
+		apiBaseURL = "https://api.twilio.com/2010-04-01"
+	}
+
+	return &TwilioSender{
+		client:     &http.Client{Timeout: config.Timeout},
+		config:     config,
+		apiBaseURL: strings.TrimRight(apiBaseURL, "/"),
+	}, nil
+}
+
+// SendSMS sends a text message through the Messages API. Messages are sent from the messaging
+// service when one is configured, otherwise from the message's number.
+func (s *TwilioSender) SendSMS(ctx context.Context, message *SMSMessage) (*SMSResponse, error) {
+	form := url.Values{"To": {message.To}, "Body": {message.Body}}
+	switch {
+	case s.config.TwilioMessagingServiceSID != "":
+		form.Set("MessagingServiceSid", s.config.TwilioMessagingServiceSID)
+	case message.From != "":
+		form.Set("From", message.From)
+	default:
+		return nil, NewSMSError(ProviderTwilio, ErrCodeInvalidFromNumber, MsgInvalidFromNumber)
+	}
+	if callback := s.callbackURL(StatusCallbackPath); callback != "" {
+		form.Set("StatusCallback", callback)
+	}
+
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Messages.json", s.apiBaseURL, url.PathEscape(s.config.TwilioAccountSID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, NewSMSErrorWithCause(ProviderTwilio, ErrCodeRequestCreateError, MsgRequestCreateError, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(s.config.TwilioAccountSID, s.config.TwilioAuthToken)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, NewSMSErrorWithCause(ProviderTwilio, ErrCodeNetworkError, MsgNetworkError, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, twilioMaxResponseLength))
+	if err != nil {
+		return nil, NewSMSErrorWithCause(ProviderTwilio, ErrCodeNetworkError, MsgNetworkError, err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, twilioError(resp.StatusCode, body)
+	}
+
+	var sent TwilioMessage
+	if err = json.Unmarshal(body, &sent); err != nil || sent.SID == "" {
+		return nil, NewSMSErrorWithCause(ProviderTwilio, ErrCodeResponseParseError, MsgResponseParseError, err)
+	}
+	return &SMSResponse{
+		MessageID: sent.SID,
+		Status:    sent.Status,
+		Provider:  ProviderTwilio,
+		SentAt:    time.Now(),
+	}, nil
+}
+
+// ValidateConfig validates the Twilio configuration
+func (s *TwilioSender) ValidateConfig() error {
+	if s.config.TwilioAccountSID == "" {
+		return NewSMSError(ProviderTwilio, ErrCodeInvalidConfig, MsgTwilioMissingAccountSID)
+	}
+	if s.config.TwilioAuthToken == "" {
+		return NewSMSError(ProviderTwilio, ErrCodeInvalidConfig, MsgTwilioMissingAuthToken)
+	}
+	if s.config.DefaultFromNumber == "" && s.config.TwilioMessagingServiceSID == "" {
+		return NewSMSError(ProviderTwilio, ErrCodeInvalidConfig, MsgTwilioMissingSender)
+	}
+	return nil
+}
+
+// GetProvider returns the provider type
+func (s *TwilioSender) GetProvider() SMSProvider {
+	return ProviderTwilio
+}
+
+// ParseStatusCallback parses a signed message status callback
+func (s *TwilioSender) ParseStatusCallback(r *http.Request) (*StatusCallback, error) {
+	params, err := s.verifyCallback(r, StatusCallbackPath)
+	if err != nil {
+		return nil, err
+	}
+	if params.Get("MessageSid") == "" {
+		return nil, NewSMSError(ProviderTwilio, ErrCodeInvalidCallback, MsgInvalidCallback)
+	}
+
+	providerStatus := params.Get("MessageStatus")
+	callback := &StatusCallback{
+		MessageID:      params.Get("MessageSid"),
+		ProviderStatus: providerStatus,
+		ErrorCode:      params.Get("ErrorCode"),
+		To:             params.Get("To"),
+	}
+	switch providerStatus {
+	case "delivered", "read":
+		callback.Status = StatusDelivered
+	case "sent":
+		callback.Status = StatusSent
+	case "failed", "undelivered":
+		callback.Status = StatusFailed
+		callback.OptedOut = callback.ErrorCode == strconv.Itoa(twilioErrUnsubscribed)
+	default:
+		callback.Status = StatusQueued
+	}
+	return callback, nil
+}
+
+// ParseInboundMessage parses a signed incoming message webhook
+func (s *TwilioSender) ParseInboundMessage(r *http.Request) (*InboundMessage, error) {
+	params, err := s.verifyCallback(r, InboundMessagePath)
+	if err != nil {
+		return nil, err
+	}
+	if params.Get("From") == "" {
+		return nil, NewSMSError(ProviderTwilio, ErrCodeInvalidCallback, MsgInvalidCallback)
+	}
+	return &InboundMessage{
+		MessageID: params.Get("MessageSid"),
+		From:      params.Get("From"),
+		To:        params.Get("To"),
+		Body:      params.Get("Body"),
+	}, nil
+}
+
+// callbackURL returns the public URL of a callback path, or an empty string when callbacks are not
+// configured
+func (s *TwilioSender) callbackURL(path string) string {
+	if s.config.CallbackBaseURL == "" {
+		return ""
+	}
+	return strings.TrimRight(s.config.CallbackBaseURL, "/") + path
+}
+
+// verifyCallback checks a callback's X-Twilio-Signature against the URL Twilio was asked to call and
+// returns its form parameters
+func (s *TwilioSender) verifyCallback(r *http.Request, path string) (url.Values, error) {
+	callbackURL := s.callbackURL(path)
+	if callbackURL == "" {
+		return nil, NewSMSError(ProviderTwilio, ErrCodeCallbacksDisabled, MsgCallbacksDisabled)
+	}
+	if err := r.ParseForm(); err != nil {
+		return nil, NewSMSErrorWithCause(ProviderTwilio, ErrCodeInvalidCallback, MsgInvalidCallback, err)
+	}
+	expected := TwilioSignature(s.config.TwilioAuthToken, callbackURL, r.PostForm)
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get("X-Twilio-Signature"))) {
+		return nil, NewSMSError(ProviderTwilio, ErrCodeInvalidSignature, MsgInvalidSignature)
+	}
+	return r.PostForm, nil
+}
+
+// TwilioSignature returns the signature Twilio sends with a callback: the base64 HMAC-SHA1, keyed by
+// the auth token, of the callback URL followed by each POST parameter's name and value in name order
+func TwilioSignature(authToken, callbackURL string, params url.Values) string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(callbackURL))
+	for _, name := range names {
+		for _, value := range params[name] {
+			mac.Write([]byte(name + value))
+		}
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// twilioError converts an error response from the Twilio API
+func twilioError(status int, body []byte) *SMSError {
+	var resp TwilioErrorResponse
+	_ = json.Unmarshal(body, &resp)
+	message := resp.Message
+	if message == "" {
+		message = fmt.Sprintf("HTTP %d", status)
+	}
+	cause := fmt.Errorf("twilio error %d: %s", resp.Code, message)
+
+	code := ErrCodeMessageRejected
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		code = ErrCodeUnauthorized
+	case status == http.StatusTooManyRequests:
+		code = ErrCodeRateLimitExceeded
+	case status >= 500:
+		code = ErrCodeServiceUnavailable
+	case resp.Code == twilioErrUnsubscribed:
+		code = ErrCodeRecipientOptedOut
+	case resp.Code == twilioErrInvalidTo || resp.Code == twilioErrNotMobile:
+		code = ErrCodeInvalidPhoneNumber
+	}
+	return NewSMSErrorWithCause(ProviderTwilio, code, MsgTwilioSendFailed, cause)
+}
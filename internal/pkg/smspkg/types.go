@@ -0,0 +1,127 @@
+package smspkg
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// SMSProvider represents the type of SMS service provider
+type SMSProvider string
+
+const (
+	ProviderTwilio SMSProvider = "twilio"
+	ProviderFake   SMSProvider = "fake"
+)
+
+// SMSConfig holds the configuration for SMS services
+type SMSConfig struct {
+	Provider SMSProvider `json:"provider" yaml:"provider"`
+
+	// Twilio Configuration, also used by providers with a Twilio-compatible API
+	TwilioAccountSID          string `json:"twilio_account_sid,omitempty" yaml:"twilio_account_sid,omitempty"`
+	TwilioAuthToken           string `json:"twilio_auth_token,omitempty" yaml:"twilio_auth_token,omitempty"`
+	TwilioMessagingServiceSID string `json:"twilio_messaging_service_sid,omitempty" yaml:"twilio_messaging_service_sid,omitempty"`
+	TwilioAPIURL              string `json:"twilio_api_url,omitempty" yaml:"twilio_api_url,omitempty"`
+
+	// Common settings
+	DefaultFromNumber string        `json:"default_from_number" yaml:"default_from_number"`
+	Timeout           time.Duration `json:"timeout" yaml:"timeout"`
+
+	// CallbackBaseURL is the public URL the provider calls back with delivery status reports, at
+	// StatusCallbackPath, and inbound messages, at InboundMessagePath. Without it no callbacks are
+	// requested or accepted.
+	CallbackBaseURL string `json:"callback_base_url,omitempty" yaml:"callback_base_url,omitempty"`
+}
+
+// SMSMessage represents a text message to a single recipient. Phone numbers are in E.164 format.
+type SMSMessage struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Body string `json:"body"`
+}
+
+// SMSResponse represents the response from sending a text message
+type SMSResponse struct {
+	MessageID string      `json:"message_id"`
+	Status    string      `json:"status"`
+	Provider  SMSProvider `json:"provider"`
+	SentAt    time.Time   `json:"sent_at"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// DeliveryStatus is the delivery state of a sent message reported by the provider
+type DeliveryStatus string
+
+const (
+	StatusQueued    DeliveryStatus = "queued"
+	StatusSent      DeliveryStatus = "sent"
+	StatusDelivered DeliveryStatus = "delivered"
+	StatusFailed    DeliveryStatus = "failed"
+)
+
+// StatusCallback is a provider's report of a change in a sent message's delivery status
+type StatusCallback struct {
+	MessageID      string         `json:"message_id"`
+	Status         DeliveryStatus `json:"status"`
+	ProviderStatus string         `json:"provider_status"`
+	ErrorCode      string         `json:"error_code,omitempty"`
+	To             string         `json:"to"`
+	// OptedOut is set when the message failed because the recipient has opted out of messages
+	OptedOut bool `json:"opted_out,omitempty"`
+}
+
+// InboundMessage is a text message received from a phone
+type InboundMessage struct {
+	MessageID string `json:"message_id"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Body      string `json:"body"`
+}
+
+// SMSSender interface defines the contract for SMS sending services
+type SMSSender interface {
+	// SendSMS sends a text message
+	SendSMS(ctx context.Context, message *SMSMessage) (*SMSResponse, error)
+
+	// ValidateConfig validates the SMS service configuration
+	ValidateConfig() error
+
+	// GetProvider returns the SMS provider type
+	GetProvider() SMSProvider
+}
+
+// CallbackParser is implemented by senders whose provider reports delivery status and inbound
+// messages by calling back over HTTP. Callbacks are authenticated before they are parsed.
+type CallbackParser interface {
+	// ParseStatusCallback parses a delivery status report
+	ParseStatusCallback(r *http.Request) (*StatusCallback, error)
+
+	// ParseInboundMessage parses a message received from a phone
+	ParseInboundMessage(r *http.Request) (*InboundMessage, error)
+}
+
+// SMSService is the main service that manages SMS sending
+type SMSService struct {
+	sender SMSSender
+	config *SMSConfig
+}
+
+// SMSError represents an SMS-specific error
+type SMSError struct {
+	Provider SMSProvider `json:"provider"`
+	Code     string      `json:"code"`
+	Message  string      `json:"message"`
+	Original error       `json:"-"`
+}
+
+func (e *SMSError) Error() string {
+	if e.Original != nil {
+		return e.Message + ": " + e.Original.Error()
+	}
+	return e.Message
+}
+
+func (e *SMSError) Unwrap() error {
+	return e.Original
+}
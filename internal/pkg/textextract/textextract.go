@@ -0,0 +1,134 @@
+// Package textextract extracts the plain text of uploaded documents so they can be indexed for
+// full-text search.
+//
+// Plain-text formats are read directly and PDFs are parsed page by page. Extracted text is
+// normalized to valid UTF-8 with runs of whitespace collapsed, and capped at MaxTextSize bytes so a
+// single large document cannot exceed the search index's limits.
+package textextract
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ledongthuc/pdf"
+)
+
+const (
+	// MaxTextSize is the most text extracted from a document, in bytes
+	MaxTextSize = 256 << 10
+	// MaxPDFSize is the largest PDF that is parsed; PDFs are read into memory to be parsed
+	MaxPDFSize = 32 << 20
+)
+
+var (
+	// ErrUnsupported is returned for content types text cannot be extracted from
+	ErrUnsupported = errors.New("textextract: unsupported content type")
+	// ErrTooLarge is returned for a PDF larger than MaxPDFSize
+	ErrTooLarge = errors.New("textextract: document is too large to extract")
+)
+
+// plainTypes are the non-text/* content types read as plain text
+var plainTypes = map[string]bool{
+	"application/json": true,
+	"application/xml":  true,
+	"application/csv":  true,
+}
+
+// pdfType is the content type of PDF documents
+const pdfType = "application/pdf"
+
+// Supported reports whether text can be extracted from content of the given type
+func Supported(contentType string) bool {
+	mediaType := mediaType(contentType)
+	return mediaType == pdfType || isPlain(mediaType)
+}
+
+// Extract returns the text of content of the given type, truncated to MaxTextSize bytes
+func Extract(r io.Reader, contentType string) (string, error) {
+	mediaType := mediaType(contentType)
+	switch {
+	case mediaType == pdfType:
+		return extractPDF(r)
+	case isPlain(mediaType):
+		return extractPlain(r)
+	}
+	return "", ErrUnsupported
+}
+
+func mediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mediaType
+}
+
+func isPlain(mediaType string) bool {
+	return strings.HasPrefix(mediaType, "text/") || plainTypes[mediaType]
+}
+
+func extractPlain(r io.Reader) (string, error) {
+	// read a little past the limit so whitespace collapsed by normalize still leaves a full text
+	data, err := io.ReadAll(io.LimitReader(r, 2*MaxTextSize))
+	if err != nil {
+		return "", err
+	}
+	return normalize(string(data)), nil
+}
+
+func extractPDF(r io.Reader) (text string, err error) {
+	data, err := io.ReadAll(io.LimitReader(r, MaxPDFSize+1))
+	if err != nil {
+		return "", err
+	}
+	if len(data) > MaxPDFSize {
+		return "", ErrTooLarge
+	}
+	// the parser reports some malformed content, including a bad cross-reference stream read while
+	// opening the document, by panicking
+	defer func() {
+		if p := recover(); p != nil {
+			text, err = "", fmt.Errorf("textextract: invalid PDF: %v", p)
+		}
+	}()
+	doc, err := pdf.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("textextract: invalid PDF: %w", err)
+	}
+
+	var b strings.Builder
+	for i := 1; i <= doc.NumPage() && b.Len() < 2*MaxTextSize; i++ {
+		page := doc.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		pageText, err := page.GetPlainText(nil)
+		if err != nil {
+			return "", fmt.Errorf("textextract: invalid PDF page %d: %w", i, err)
+		}
+		b.WriteString(pageText)
+		b.WriteByte('\n')
+	}
+	return normalize(b.String()), nil
+}
+
+// normalize makes text valid UTF-8 without NUL bytes, collapses whitespace runs to single spaces
+// and truncates it to MaxTextSize bytes on a character boundary
+func normalize(text string) string {
+	text = strings.ToValidUTF8(text, "")
+	text = strings.ReplaceAll(text, "\x00", "")
+	text = strings.Join(strings.Fields(text), " ")
+	if len(text) <= MaxTextSize {
+		return text
+	}
+	end := MaxTextSize
+	for end > 0 && !utf8.RuneStart(text[end]) {
+		end--
+	}
+	return text[:end]
+}
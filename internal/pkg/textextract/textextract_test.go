@@ -0,0 +1,123 @@
+package textextract
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// buildPDF returns a minimal single-font PDF with one page per entry of pages
+func buildPDF(pages ...string) []byte {
+	var objects []string
+	kids := make([]string, len(pages))
+	// 1 catalog, 2 page tree, 3 font, then a page and content stream per page
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+	)
+	for i, text := range pages {
+		content := fmt.Sprintf("BT /F1 12 Tf 72 720 Td (%s) Tj ET", text)
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", 5+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
+
+func TestExtractPDF(t *testing.T) {
+	data := buildPDF("Medical examiner certificate", "Drug test result negative")
+	text, err := Extract(bytes.NewReader(data), "application/pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"Medical examiner certificate", "Drug test result negative"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text %q does not contain %q", text, want)
+		}
+	}
+}
+
+func TestExtractInvalidPDF(t *testing.T) {
+	if _, err := Extract(strings.NewReader("not a pdf"), "application/pdf"); err == nil {
+		t.Fatal("expected an error for invalid PDF content")
+	}
+}
+
+func TestExtractMalformedXrefStream(t *testing.T) {
+	// a cross-reference stream whose FlateDecode data is not zlib makes the parser panic
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.5\n")
+	xref := b.Len()
+	b.WriteString("1 0 obj\n<< /Type /XRef /Size 2 /W [1 1 1] /Root 2 0 R /Filter /FlateDecode /Length 8 >>\nstream\nnot zlib\nendstream\nendobj\n")
+	fmt.Fprintf(&b, "startxref\n%d\n%%%%EOF\n", xref)
+
+	if _, err := Extract(bytes.NewReader(b.Bytes()), "application/pdf"); err == nil {
+		t.Fatal("expected an error for a malformed cross-reference stream")
+	}
+}
+
+func TestExtractPlain(t *testing.T) {
+	text, err := Extract(strings.NewReader("name,\tstatus\r\n\x00driver,  active\xff\n"), "text/csv; charset=utf-8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "name, status driver, active"; text != want {
+		t.Errorf("got %q, want %q", text, want)
+	}
+}
+
+func TestExtractTruncates(t *testing.T) {
+	// multi-byte characters straddle the limit, so truncation must back up to a rune boundary
+	text, err := Extract(strings.NewReader(strings.Repeat("é", MaxTextSize)), "text/plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(text) > MaxTextSize {
+		t.Errorf("text is %d bytes, want at most %d", len(text), MaxTextSize)
+	}
+	if !utf8.ValidString(text) {
+		t.Error("truncated text is not valid UTF-8")
+	}
+}
+
+func TestExtractUnsupported(t *testing.T) {
+	if Supported("image/png") {
+		t.Error("image/png should not be supported")
+	}
+	if !Supported("application/pdf") || !Supported("text/plain; charset=utf-8") {
+		t.Error("PDF and plain text should be supported")
+	}
+	_, err := Extract(strings.NewReader("data"), "image/png")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Errorf("got %v, want ErrUnsupported", err)
+	}
+}
+
+func TestExtractTooLarge(t *testing.T) {
+	_, err := Extract(bytes.NewReader(make([]byte, MaxPDFSize+1)), "application/pdf")
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("got %v, want ErrTooLarge", err)
+	}
+}
@@ -5,4 +5,35 @@
 
 package service
 
-type ()
+import (
+	"context"
+	"v1consortium/internal/consts"
+	"v1consortium/internal/model/entity"
+)
+
+type (
+	IAuthorization interface {
+		// ActiveProfile returns the profile of the requesting user, who must be active
+		ActiveProfile(ctx context.Context, userID string) (*entity.UserProfiles, error)
+		// IsInternal reports whether a user with the profile is an internal user
+		IsInternal(profile *entity.UserProfiles) bool
+		// ActsFor reports whether a user with the profile may act for the organization: internal users
+		// act for every organization, users with one of the roles only for their own
+		ActsFor(profile *entity.UserProfiles, organizationID string, roles map[consts.UserRole]bool) bool
+	}
+)
+
+var (
+	localAuthorization IAuthorization
+)
+
+func Authorization() IAuthorization {
+	if localAuthorization == nil {
+		panic("implement not found for interface IAuthorization, forgot register?")
+	}
+	return localAuthorization
+}
+
+func RegisterAuthorization(i IAuthorization) {
+	localAuthorization = i
+}
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"v1consortium/internal/pkg/notifystream"
+)
+
+type (
+	INotificationEvents interface {
+		Subscribe(userID, organizationID string) *notifystream.Subscription
+	}
+)
+
+var (
+	localNotificationEvents INotificationEvents
+)
+
+func NotificationEvents() INotificationEvents {
+	return localNotificationEvents
+}
+
+func RegisterNotificationEvents(events INotificationEvents) {
+	localNotificationEvents = events
+}
@@ -10,6 +10,8 @@ import (
 type (
 	IRiverClient interface {
 		Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) (*rivertype.JobInsertResult, error)
+		JobList(ctx context.Context, params *river.JobListParams) (*river.JobListResult, error)
+		JobCancel(ctx context.Context, jobID int64) (*rivertype.JobRow, error)
 	}
 )
 
@@ -0,0 +1,61 @@
+package documentindex
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+)
+
+// SweepInterval is how often documents awaiting search indexing are processed. New documents are
+// searchable by their details straight away; only their content waits for the sweep.
+const SweepInterval = 5 * time.Minute
+
+// BatchSize is the most documents indexed by one sweep
+const BatchSize = 200
+
+// SweepArgs are the River job arguments for the document indexing sweep
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "document_index_sweep" }
+
+// InsertOpts keeps a single sweep queued at a time
+func (SweepArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{ByPeriod: SweepInterval},
+	}
+}
+
+// SweepWorker extracts the text of newly stored documents for full-text search
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+}
+
+func (w *SweepWorker) Work(ctx context.Context, job *river.Job[SweepArgs]) error {
+	indexed, err := service.Document().IndexPendingDocuments(ctx, BatchSize)
+	if err != nil {
+		return err
+	}
+
+	if indexed > 0 {
+		g.Log().Infof(ctx, "Document index sweep complete: %d documents indexed", indexed)
+	}
+	return nil
+}
+
+func (w *SweepWorker) Timeout(job *river.Job[SweepArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// NewPeriodicJob returns the periodic job that enqueues the document indexing sweep
+func NewPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(SweepInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return SweepArgs{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
@@ -0,0 +1,74 @@
+package documentretention
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule runs the purge nightly at 03:00 UTC, outside business hours in US time zones
+const Schedule = "0 3 * * *"
+
+// BatchSize is how many expired documents are purged at a time
+const BatchSize = 500
+
+// PurgeArgs are the River job arguments for the nightly retention purge
+type PurgeArgs struct{}
+
+func (PurgeArgs) Kind() string { return "document_retention_purge" }
+
+// InsertOpts keeps a single purge queued at a time
+func (PurgeArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{ByPeriod: 24 * time.Hour},
+	}
+}
+
+// PurgeWorker deletes documents past their retention date and abandoned upload sessions
+type PurgeWorker struct {
+	river.WorkerDefaults[PurgeArgs]
+}
+
+func (w *PurgeWorker) Work(ctx context.Context, job *river.Job[PurgeArgs]) error {
+	total := 0
+	for {
+		purged, err := service.Document().PurgeExpiredDocuments(ctx, BatchSize)
+		if err != nil {
+			return err
+		}
+		total += purged
+		if purged < BatchSize {
+			break
+		}
+	}
+	uploads, err := service.Document().PurgeExpiredUploads(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.Log().Infof(ctx, "Document retention purge complete: %d documents and %d expired uploads removed", total, uploads)
+	return nil
+}
+
+func (w *PurgeWorker) Timeout(job *river.Job[PurgeArgs]) time.Duration {
+	return time.Hour
+}
+
+// NewPeriodicJob returns the periodic job that enqueues the nightly retention purge
+func NewPeriodicJob() *river.PeriodicJob {
+	schedule, err := cron.ParseStandard(Schedule)
+	if err != nil {
+		panic(err)
+	}
+	return river.NewPeriodicJob(
+		schedule,
+		func() (river.JobArgs, *river.InsertOpts) {
+			return PurgeArgs{}, nil
+		},
+		nil,
+	)
+}
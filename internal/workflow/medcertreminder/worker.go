@@ -0,0 +1,57 @@
+package medcertreminder
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+)
+
+// SweepInterval is how often due medical certificate reminders are sent. Reminder ladders are
+// measured in days, so a daily sweep sends each rung on the day it is reached.
+const SweepInterval = 24 * time.Hour
+
+// SweepArgs are the River job arguments for the medical certificate reminder sweep
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "medical_cert_reminder_sweep" }
+
+// InsertOpts keeps a single sweep queued at a time
+func (SweepArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:      "notifications",
+		UniqueOpts: river.UniqueOpts{ByPeriod: SweepInterval},
+	}
+}
+
+// SweepWorker sends expiration reminders for medical examiner's certificates nearing expiry
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+}
+
+func (w *SweepWorker) Work(ctx context.Context, job *river.Job[SweepArgs]) error {
+	sent, err := service.DotPhysical().SendExpirationReminders(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.Log().Infof(ctx, "Medical certificate reminder sweep complete: %d reminders sent", sent)
+	return nil
+}
+
+func (w *SweepWorker) Timeout(job *river.Job[SweepArgs]) time.Duration {
+	return 15 * time.Minute
+}
+
+// NewPeriodicJob returns the periodic job that enqueues the reminder sweep
+func NewPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(SweepInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return SweepArgs{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
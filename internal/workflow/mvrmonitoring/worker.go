@@ -0,0 +1,62 @@
+package mvrmonitoring
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+)
+
+// SweepInterval is how often the monitoring sweep runs. Enrollments are due monthly at the
+// most, so an hourly sweep picks up new enrollments and received reports promptly.
+const SweepInterval = time.Hour
+
+// SweepArgs are the River job arguments for the MVR monitoring sweep
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "mvr_monitoring_sweep" }
+
+// InsertOpts keeps a single sweep queued at a time
+func (SweepArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:      "external",
+		UniqueOpts: river.UniqueOpts{ByPeriod: SweepInterval},
+	}
+}
+
+// SweepWorker orders MVRs for enrollments that are due and alerts on changes in received reports
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+}
+
+func (w *SweepWorker) Work(ctx context.Context, job *river.Job[SweepArgs]) error {
+	ordered, err := service.Mvr().OrderDueMonitoringReports(ctx)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := service.Mvr().ProcessMonitoringResults(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.Log().Infof(ctx, "MVR monitoring sweep complete: %d reports ordered, %d alerts raised", ordered, alerts)
+	return nil
+}
+
+func (w *SweepWorker) Timeout(job *river.Job[SweepArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// NewPeriodicJob returns the periodic job that enqueues the monitoring sweep
+func NewPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(SweepInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return SweepArgs{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
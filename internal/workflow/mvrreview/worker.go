@@ -0,0 +1,56 @@
+package mvrreview
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+)
+
+// SweepInterval is how often annual review tasks are generated. Reviews are created a month
+// ahead of their due date, so a daily sweep is ample.
+const SweepInterval = 24 * time.Hour
+
+// SweepArgs are the River job arguments for the annual MVR review sweep
+type SweepArgs struct{}
+
+func (SweepArgs) Kind() string { return "mvr_annual_review_sweep" }
+
+// InsertOpts keeps a single sweep queued at a time
+func (SweepArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		UniqueOpts: river.UniqueOpts{ByPeriod: SweepInterval},
+	}
+}
+
+// SweepWorker creates annual review tasks for CDL drivers whose review is coming due
+type SweepWorker struct {
+	river.WorkerDefaults[SweepArgs]
+}
+
+func (w *SweepWorker) Work(ctx context.Context, job *river.Job[SweepArgs]) error {
+	created, err := service.Mvr().GenerateAnnualReviews(ctx)
+	if err != nil {
+		return err
+	}
+
+	g.Log().Infof(ctx, "MVR annual review sweep complete: %d reviews created", created)
+	return nil
+}
+
+func (w *SweepWorker) Timeout(job *river.Job[SweepArgs]) time.Duration {
+	return 10 * time.Minute
+}
+
+// NewPeriodicJob returns the periodic job that enqueues the annual review sweep
+func NewPeriodicJob() *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(SweepInterval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return SweepArgs{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
@@ -0,0 +1,58 @@
+package notificationdelivery
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/riverqueue/river"
+)
+
+// Bulk notifications are sent BulkChunkSize recipients at a time, BulkChunkInterval apart, so that a
+// send to a large organization neither floods the providers nor holds up other notifications
+const (
+	BulkChunkSize     = 50
+	BulkChunkInterval = 5 * time.Second
+)
+
+// BulkArgs are the River job arguments for sending a bulk notification
+type BulkArgs struct {
+	BulkSendID string `json:"bulk_send_id"`
+}
+
+func (BulkArgs) Kind() string { return "notification_bulk" }
+
+// InsertOpts runs bulk sends on the notifications queue, one job per bulk send
+func (BulkArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       Queue,
+		MaxAttempts: MaxAttempts,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	}
+}
+
+// BulkWorker notifies the next chunk of a bulk notification's recipients, and snoozes until the next
+// chunk is due while recipients remain
+type BulkWorker struct {
+	river.WorkerDefaults[BulkArgs]
+}
+
+func (w *BulkWorker) Work(ctx context.Context, job *river.Job[BulkArgs]) error {
+	remaining, err := service.Notification().ProcessBulkNotification(ctx, job.Args.BulkSendID, BulkChunkSize)
+	if gerror.Code(err) == gcode.CodeNotFound {
+		return river.JobCancel(err)
+	}
+	if err != nil {
+		return err
+	}
+	if remaining {
+		return river.JobSnooze(BulkChunkInterval)
+	}
+	return nil
+}
+
+func (w *BulkWorker) Timeout(job *river.Job[BulkArgs]) time.Duration {
+	return 5 * time.Minute
+}
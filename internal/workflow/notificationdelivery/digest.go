@@ -0,0 +1,73 @@
+package notificationdelivery
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/frame/g"
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+)
+
+// DigestSchedule checks for due digests every 15 minutes, so that digests due at the start of any
+// hour in any time zone go out on time
+const DigestSchedule = "*/15 * * * *"
+
+// DigestBatchSize is how many digests are sent at a time
+const DigestBatchSize = 200
+
+// DigestArgs are the River job arguments for sending due notification digests
+type DigestArgs struct{}
+
+func (DigestArgs) Kind() string { return "notification_digest" }
+
+// InsertOpts runs digests on the notifications queue, with a single run queued at a time
+func (DigestArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:      Queue,
+		UniqueOpts: river.UniqueOpts{ByPeriod: 15 * time.Minute},
+	}
+}
+
+// DigestWorker sends the daily and weekly digests whose time has come
+type DigestWorker struct {
+	river.WorkerDefaults[DigestArgs]
+}
+
+func (w *DigestWorker) Work(ctx context.Context, job *river.Job[DigestArgs]) error {
+	total := 0
+	for {
+		sent, err := service.Notification().SendDueDigests(ctx, DigestBatchSize)
+		if err != nil {
+			return err
+		}
+		total += sent
+		if sent < DigestBatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		g.Log().Infof(ctx, "Sent %d notification digests", total)
+	}
+	return nil
+}
+
+func (w *DigestWorker) Timeout(job *river.Job[DigestArgs]) time.Duration {
+	return 15 * time.Minute
+}
+
+// NewDigestPeriodicJob returns the periodic job that enqueues the digest run
+func NewDigestPeriodicJob() *river.PeriodicJob {
+	schedule, err := cron.ParseStandard(DigestSchedule)
+	if err != nil {
+		panic(err)
+	}
+	return river.NewPeriodicJob(
+		schedule,
+		func() (river.JobArgs, *river.InsertOpts) {
+			return DigestArgs{}, nil
+		},
+		nil,
+	)
+}
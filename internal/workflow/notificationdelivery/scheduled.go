@@ -0,0 +1,51 @@
+package notificationdelivery
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/model"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/riverqueue/river"
+)
+
+// ScheduledKind is the kind of scheduled notification jobs, which are listed and cancelled by it
+const ScheduledKind = "notification_scheduled"
+
+// ScheduledArgs are the River job arguments for a notification scheduled to be sent later. Each send
+// of a recurring notification is its own job, with the ID shared between them.
+type ScheduledArgs struct {
+	ScheduledNotificationID string                            `json:"scheduled_notification_id"`
+	Notification            *model.ScheduledNotificationInput `json:"notification"`
+}
+
+func (ScheduledArgs) Kind() string { return ScheduledKind }
+
+// InsertOpts runs scheduled sends on the notifications queue. Recording the notifications is retried
+// a few times; their deliveries are retried on their own.
+func (ScheduledArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       Queue,
+		MaxAttempts: 5,
+	}
+}
+
+// ScheduledWorker sends a scheduled notification and schedules its next send when it recurs
+type ScheduledWorker struct {
+	river.WorkerDefaults[ScheduledArgs]
+}
+
+func (w *ScheduledWorker) Work(ctx context.Context, job *river.Job[ScheduledArgs]) error {
+	err := service.Notification().SendScheduledNotification(ctx, job.Args.ScheduledNotificationID, job.Args.Notification)
+	switch gerror.Code(err) {
+	case gcode.CodeNotFound, gcode.CodeNotAuthorized, gcode.CodeInvalidParameter, gcode.CodeMissingParameter:
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+func (w *ScheduledWorker) Timeout(job *river.Job[ScheduledArgs]) time.Duration {
+	return 5 * time.Minute
+}
@@ -0,0 +1,80 @@
+package notificationdelivery
+
+import (
+	"context"
+	"time"
+	"v1consortium/internal/model"
+	"v1consortium/internal/service"
+
+	"github.com/gogf/gf/v2/errors/gcode"
+	"github.com/gogf/gf/v2/errors/gerror"
+	"github.com/riverqueue/river"
+)
+
+// Queue is the River queue notification deliveries run on
+const Queue = "notifications"
+
+// MaxAttempts is how many times delivery of a notification is attempted. With the backoff below the
+// last attempt comes about four hours after the first.
+const MaxAttempts = 10
+
+// Retry backoff: the delay doubles from InitialBackoff after each failed attempt, up to MaxBackoff
+const (
+	InitialBackoff = 30 * time.Second
+	MaxBackoff     = 2 * time.Hour
+)
+
+// DeliverArgs are the River job arguments for delivering an email or SMS notification
+type DeliverArgs struct {
+	NotificationID string                          `json:"notification_id"`
+	RecipientName  string                          `json:"recipient_name,omitempty"`
+	HTMLMessage    string                          `json:"html_message,omitempty"`
+	Attachments    []*model.NotificationAttachment `json:"attachments,omitempty"`
+}
+
+func (DeliverArgs) Kind() string { return "notification_delivery" }
+
+// InsertOpts runs deliveries on the notifications queue
+func (DeliverArgs) InsertOpts() river.InsertOpts {
+	return river.InsertOpts{
+		Queue:       Queue,
+		MaxAttempts: MaxAttempts,
+	}
+}
+
+// DeliverWorker attempts delivery of a notification through its channel's provider
+type DeliverWorker struct {
+	river.WorkerDefaults[DeliverArgs]
+}
+
+func (w *DeliverWorker) Work(ctx context.Context, job *river.Job[DeliverArgs]) error {
+	err := service.Notification().DeliverNotification(ctx, &model.NotificationDelivery{
+		NotificationID: job.Args.NotificationID,
+		RecipientName:  job.Args.RecipientName,
+		HTMLMessage:    job.Args.HTMLMessage,
+		Attachments:    job.Args.Attachments,
+		FinalAttempt:   job.Attempt >= job.MaxAttempts,
+	})
+	if gerror.Code(err) == gcode.CodeNotFound {
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+// NextRetry backs off exponentially so that a provider outage is not met with a burst of retries
+func (w *DeliverWorker) NextRetry(job *river.Job[DeliverArgs]) time.Time {
+	return time.Now().Add(Backoff(job.Attempt))
+}
+
+func (w *DeliverWorker) Timeout(job *river.Job[DeliverArgs]) time.Duration {
+	return 2 * time.Minute
+}
+
+// Backoff returns the delay before retrying after the given failed attempt
+func Backoff(attempt int) time.Duration {
+	delay := InitialBackoff
+	for i := 1; i < attempt && delay < MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxBackoff)
+}
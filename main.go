@@ -13,7 +13,7 @@ import (
 )
 
 func main() {
-	err := cmd.Main.AddCommand(&cmd.Combined, &cmd.RiverWorkerV2) // Temporarily commented out undefined commands: &cmd.DBOSWorker, &cmd.RiverWorker
+	err := cmd.Main.AddCommand(&cmd.Combined, &cmd.RiverWorkerV2, &cmd.RotateDocumentKeys) // Temporarily commented out undefined commands: &cmd.DBOSWorker, &cmd.RiverWorker
 	if err != nil {
 		panic(err)
 	}
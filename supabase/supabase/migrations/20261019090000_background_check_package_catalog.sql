@@ -0,0 +1,51 @@
+-- Migration: Background check package price catalog
+-- Created: 2026-10-19
+-- Purpose: Price list per provider and check type, used to cost background checks in analytics
+
+-- =============================================
+-- BACKGROUND CHECK PACKAGE CATALOG
+-- =============================================
+
+CREATE TABLE background_check_packages (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    -- NULL for the list price; set for organization-specific negotiated pricing
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    provider_name VARCHAR(100) NOT NULL, -- sterling, hireright, checkr
+    package_code VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    check_type background_check_type NOT NULL,
+
+    -- Pricing
+    price DECIMAL(10,2) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) DEFAULT 'USD',
+    effective_from DATE NOT NULL DEFAULT CURRENT_DATE,
+    effective_to DATE,
+
+    estimated_turnaround VARCHAR(100), -- "1-2 business days"
+    fcra_compliant BOOLEAN DEFAULT true,
+    is_active BOOLEAN DEFAULT true,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_background_check_packages_provider ON background_check_packages(provider_name, check_type);
+CREATE INDEX idx_background_check_packages_org ON background_check_packages(organization_id) WHERE organization_id IS NOT NULL;
+CREATE INDEX idx_background_check_packages_active ON background_check_packages(is_active);
+
+CREATE TRIGGER update_background_check_packages_updated_at BEFORE UPDATE ON background_check_packages
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE background_check_packages ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view list prices and their organization pricing" ON background_check_packages
+    FOR SELECT USING (
+        organization_id IS NULL OR
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage package pricing" ON background_check_packages
+    FOR ALL USING (is_internal_user());
+
@@ -0,0 +1,47 @@
+-- Migration: MVR continuous monitoring enrollments
+-- Created: 2026-10-19
+-- Purpose: Track per-driver MVR monitoring so the River scheduler can order reports when due
+
+-- =============================================
+-- MVR MONITORING ENROLLMENTS
+-- =============================================
+
+CREATE TABLE mvr_monitoring_enrollments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    enrolled_by UUID REFERENCES user_profiles(id),
+
+    -- Schedule
+    monitoring_frequency VARCHAR(20) NOT NULL DEFAULT 'annual',
+    provider_name VARCHAR(100),
+    is_enabled BOOLEAN DEFAULT true,
+    last_check_date TIMESTAMPTZ,
+    next_check_date TIMESTAMPTZ,
+
+    -- Last report that was compared against its predecessor
+    last_mvr_report_id UUID REFERENCES mvr_reports(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(user_id),
+    CONSTRAINT valid_monitoring_frequency CHECK (monitoring_frequency IN ('monthly', 'quarterly', 'annual'))
+);
+
+CREATE INDEX idx_mvr_monitoring_enrollments_org ON mvr_monitoring_enrollments(organization_id);
+CREATE INDEX idx_mvr_monitoring_enrollments_due ON mvr_monitoring_enrollments(next_check_date) WHERE is_enabled = true;
+
+CREATE TRIGGER update_mvr_monitoring_enrollments_updated_at BEFORE UPDATE ON mvr_monitoring_enrollments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE mvr_monitoring_enrollments ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization MVR monitoring" ON mvr_monitoring_enrollments
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage MVR monitoring" ON mvr_monitoring_enrollments
+    FOR ALL USING (is_internal_user());
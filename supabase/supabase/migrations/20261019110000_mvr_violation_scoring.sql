@@ -0,0 +1,103 @@
+-- Migration: MVR violation scoring
+-- Created: 2026-10-19
+-- Purpose: Map ACD (AAMVA conviction) codes to severity and points, with per-organization
+--          thresholds that decide when an MVR report requires action
+
+-- =============================================
+-- MVR VIOLATION CODES
+-- =============================================
+
+-- Rows with a NULL organization_id are the system defaults; an organization may override a code.
+-- A one-letter code is the fallback for every code in that ACD family.
+CREATE TABLE mvr_violation_codes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE,
+    code VARCHAR(10) NOT NULL,
+    description TEXT,
+    severity VARCHAR(20) NOT NULL DEFAULT 'minor', -- minor, major, serious
+    points INTEGER NOT NULL DEFAULT 0,
+    disqualifying BOOLEAN DEFAULT false,
+    affects_cdl BOOLEAN DEFAULT false,
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CONSTRAINT valid_violation_severity CHECK (severity IN ('minor', 'major', 'serious'))
+);
+
+CREATE UNIQUE INDEX idx_mvr_violation_codes_default ON mvr_violation_codes(code) WHERE organization_id IS NULL;
+CREATE UNIQUE INDEX idx_mvr_violation_codes_org ON mvr_violation_codes(organization_id, code) WHERE organization_id IS NOT NULL;
+
+-- =============================================
+-- MVR SCORING THRESHOLDS
+-- =============================================
+
+-- A report requires action when any threshold is reached. NULL organization_id is the default.
+CREATE TABLE mvr_scoring_thresholds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID UNIQUE REFERENCES organizations(id) ON DELETE CASCADE,
+    points_threshold INTEGER DEFAULT 8,
+    major_violations_threshold INTEGER DEFAULT 1,
+    total_violations_threshold INTEGER DEFAULT 4,
+    lookback_months INTEGER DEFAULT 36, -- Violations older than this (relative to the report date) are ignored
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TRIGGER update_mvr_violation_codes_updated_at BEFORE UPDATE ON mvr_violation_codes
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+CREATE TRIGGER update_mvr_scoring_thresholds_updated_at BEFORE UPDATE ON mvr_scoring_thresholds
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE mvr_violation_codes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE mvr_scoring_thresholds ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view default and organization violation codes" ON mvr_violation_codes
+    FOR SELECT USING (
+        organization_id IS NULL OR
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Client admins can manage organization violation codes" ON mvr_violation_codes
+    FOR ALL USING (
+        is_internal_user() OR (
+            organization_id = current_user_organization_id() AND
+            EXISTS (SELECT 1 FROM user_profiles WHERE id = auth.uid() AND role = 'client_admin')
+        )
+    );
+
+CREATE POLICY "Users can view default and organization thresholds" ON mvr_scoring_thresholds
+    FOR SELECT USING (
+        organization_id IS NULL OR
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Client admins can manage organization thresholds" ON mvr_scoring_thresholds
+    FOR ALL USING (
+        is_internal_user() OR (
+            organization_id = current_user_organization_id() AND
+            EXISTS (SELECT 1 FROM user_profiles WHERE id = auth.uid() AND role = 'client_admin')
+        )
+    );
+
+-- =============================================
+-- DEFAULT DATA
+-- =============================================
+
+INSERT INTO mvr_scoring_thresholds (organization_id) VALUES (NULL);
+
+INSERT INTO mvr_violation_codes (code, description, severity, points, disqualifying, affects_cdl) VALUES
+-- Family fallbacks
+('A', 'Alcohol and drug related', 'serious', 6, true, true),
+('B', 'Leaving the scene and failure to report', 'serious', 6, true, true),
+('D', 'Licensing and registration', 'minor', 1, false, false),
+('M', 'Moving violation', 'minor', 2, false, false),
+('S', 'Speeding', 'minor', 2, false, false),
+('U', 'Reckless and unsafe driving', 'major', 4, false, true),
+-- Specific codes
+('A20', 'Driving under the influence of alcohol or drugs', 'serious', 6, true, true),
+('A21', 'Driving under the influence of alcohol', 'serious', 6, true, true),
+('A22', 'Driving under the influence of drugs', 'serious', 6, true, true),
+('B01', 'Hit and run', 'serious', 6, true, true);
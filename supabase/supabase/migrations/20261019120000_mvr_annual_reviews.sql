@@ -0,0 +1,52 @@
+-- Migration: Annual MVR review
+-- Created: 2026-10-19
+-- Purpose: Annual review of each CDL driver's driving record by the motor carrier (49 CFR 391.25)
+
+-- =============================================
+-- MVR ANNUAL REVIEWS
+-- =============================================
+
+CREATE TABLE mvr_annual_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    mvr_report_id UUID REFERENCES mvr_reports(id) ON DELETE SET NULL,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, completed
+    due_date DATE NOT NULL,
+
+    -- Sign-off
+    determination VARCHAR(50), -- meets_requirements, disqualified
+    reviewed_by UUID REFERENCES user_profiles(id),
+    reviewed_at TIMESTAMPTZ,
+    notes TEXT,
+
+    -- Generated driver review record
+    document_id UUID REFERENCES documents(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CONSTRAINT valid_review_status CHECK (status IN ('pending', 'completed')),
+    CONSTRAINT valid_review_determination CHECK (determination IS NULL OR determination IN ('meets_requirements', 'disqualified'))
+);
+
+CREATE INDEX idx_mvr_annual_reviews_org ON mvr_annual_reviews(organization_id);
+CREATE INDEX idx_mvr_annual_reviews_user ON mvr_annual_reviews(user_id);
+CREATE INDEX idx_mvr_annual_reviews_due ON mvr_annual_reviews(due_date) WHERE status = 'pending';
+-- At most one open review per driver
+CREATE UNIQUE INDEX idx_mvr_annual_reviews_pending ON mvr_annual_reviews(user_id) WHERE status = 'pending';
+
+CREATE TRIGGER update_mvr_annual_reviews_updated_at BEFORE UPDATE ON mvr_annual_reviews
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE mvr_annual_reviews ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization MVR reviews" ON mvr_annual_reviews
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage MVR reviews" ON mvr_annual_reviews
+    FOR ALL USING (is_internal_user());
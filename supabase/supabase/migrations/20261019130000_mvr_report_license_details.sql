@@ -0,0 +1,11 @@
+-- Migration: MVR report license details
+-- Created: 2026-10-19
+-- Purpose: Store the license class and endorsements extracted when raw provider MVR data is parsed
+
+-- =============================================
+-- MVR REPORTS
+-- =============================================
+
+ALTER TABLE mvr_reports
+    ADD COLUMN license_class VARCHAR(10),
+    ADD COLUMN license_endorsements VARCHAR(50); -- comma-separated endorsement codes, e.g. H,N,T
@@ -0,0 +1,102 @@
+-- Migration: Medical examiner registry
+-- Created: 2026-10-19
+-- Purpose: Certified medical examiners from the FMCSA National Registry (NRCME) and the clinics
+--          they serve, so DOT physicals can be checked against an active registration
+
+-- =============================================
+-- MEDICAL EXAMINERS
+-- =============================================
+
+CREATE TABLE medical_examiners (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID REFERENCES user_profiles(id) ON DELETE SET NULL, -- set when the examiner has an account
+
+    first_name VARCHAR(100) NOT NULL,
+    last_name VARCHAR(100) NOT NULL,
+    email VARCHAR(255),
+    phone VARCHAR(20),
+
+    -- State medical license
+    license_number VARCHAR(100),
+    license_state VARCHAR(10),
+    license_expiration DATE,
+
+    -- National Registry certification
+    registry_number VARCHAR(10) NOT NULL,
+    certification_date DATE,
+    certification_expiration DATE,
+    registry_status VARCHAR(20) NOT NULL DEFAULT 'active', -- active, lapsed, suspended, revoked
+
+    practice_name VARCHAR(255),
+    address_line1 VARCHAR(255),
+    address_line2 VARCHAR(255),
+    city VARCHAR(100),
+    state VARCHAR(10),
+    zip_code VARCHAR(20),
+    accepts_new_patients BOOLEAN DEFAULT true,
+    specializations JSONB DEFAULT '[]',
+
+    is_active BOOLEAN DEFAULT true,
+    imported_at TIMESTAMPTZ, -- last refreshed from a registry export
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(registry_number),
+    CONSTRAINT valid_registry_number CHECK (registry_number ~ '^[0-9]{10}$'),
+    CONSTRAINT valid_registry_status CHECK (registry_status IN ('active', 'lapsed', 'suspended', 'revoked'))
+);
+
+CREATE INDEX idx_medical_examiners_user ON medical_examiners(user_id);
+CREATE INDEX idx_medical_examiners_location ON medical_examiners(state, city, zip_code);
+
+CREATE TRIGGER update_medical_examiners_updated_at BEFORE UPDATE ON medical_examiners
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- MEDICAL EXAMINER CLINICS
+-- =============================================
+
+CREATE TABLE medical_examiner_clinics (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    examiner_id UUID NOT NULL REFERENCES medical_examiners(id) ON DELETE CASCADE,
+
+    name VARCHAR(255) NOT NULL,
+    address_line1 VARCHAR(255),
+    address_line2 VARCHAR(255),
+    city VARCHAR(100),
+    state VARCHAR(10),
+    zip_code VARCHAR(20),
+    phone VARCHAR(20),
+    timezone VARCHAR(50) DEFAULT 'America/New_York',
+
+    is_active BOOLEAN DEFAULT true,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_medical_examiner_clinics_examiner ON medical_examiner_clinics(examiner_id);
+CREATE INDEX idx_medical_examiner_clinics_location ON medical_examiner_clinics(state, city, zip_code);
+
+CREATE TRIGGER update_medical_examiner_clinics_updated_at BEFORE UPDATE ON medical_examiner_clinics
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE medical_examiners ENABLE ROW LEVEL SECURITY;
+ALTER TABLE medical_examiner_clinics ENABLE ROW LEVEL SECURITY;
+
+-- The registry is public FMCSA data shared by every organization
+CREATE POLICY "Authenticated users can view medical examiners" ON medical_examiners
+    FOR SELECT USING (auth.uid() IS NOT NULL);
+
+CREATE POLICY "Internal users can manage medical examiners" ON medical_examiners
+    FOR ALL USING (is_internal_user());
+
+CREATE POLICY "Authenticated users can view medical examiner clinics" ON medical_examiner_clinics
+    FOR SELECT USING (auth.uid() IS NOT NULL);
+
+CREATE POLICY "Internal users can manage medical examiner clinics" ON medical_examiner_clinics
+    FOR ALL USING (is_internal_user());
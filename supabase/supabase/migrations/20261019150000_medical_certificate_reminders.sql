@@ -0,0 +1,77 @@
+-- Migration: Medical certificate expiration reminders
+-- Created: 2026-10-19
+-- Purpose: Per-organization reminder ladders for expiring DOT medical examiner's certificates,
+--          and a record of each reminder sent so a rung is only sent once per certificate.
+--          Reminders always go to the driver and the organization's DERs in-app; email and SMS
+--          are configurable.
+
+-- =============================================
+-- REMINDER SETTINGS
+-- =============================================
+
+CREATE TABLE medical_cert_reminder_settings (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+
+    reminder_days JSONB NOT NULL DEFAULT '[60, 30, 14, 1]', -- days before expiration
+    email_enabled BOOLEAN DEFAULT true,
+    sms_enabled BOOLEAN DEFAULT false,
+    notification_recipients JSONB DEFAULT '[]', -- additional email addresses
+
+    updated_by UUID REFERENCES user_profiles(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(organization_id)
+);
+
+CREATE TRIGGER update_medical_cert_reminder_settings_updated_at BEFORE UPDATE ON medical_cert_reminder_settings
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- REMINDERS SENT
+-- =============================================
+
+CREATE TABLE medical_cert_reminders_sent (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    physical_id UUID NOT NULL REFERENCES dot_physicals(id) ON DELETE CASCADE,
+    expiration_date DATE NOT NULL,
+    reminder_days INTEGER NOT NULL, -- the ladder rung this reminder was sent for
+    recipients INTEGER DEFAULT 0,
+    sent_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(physical_id, expiration_date, reminder_days)
+);
+
+CREATE INDEX idx_medical_cert_reminders_sent_org ON medical_cert_reminders_sent(organization_id);
+CREATE INDEX idx_medical_cert_reminders_sent_user ON medical_cert_reminders_sent(user_id);
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE medical_cert_reminder_settings ENABLE ROW LEVEL SECURITY;
+ALTER TABLE medical_cert_reminders_sent ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization reminder settings" ON medical_cert_reminder_settings
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Client admins can manage organization reminder settings" ON medical_cert_reminder_settings
+    FOR ALL USING (
+        is_internal_user() OR (
+            organization_id = current_user_organization_id() AND
+            EXISTS (SELECT 1 FROM user_profiles
+                    WHERE id = auth.uid() AND role IN ('client_admin', 'der'))
+        )
+    );
+
+CREATE POLICY "Users can view their organization reminders sent" ON medical_cert_reminders_sent
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
@@ -0,0 +1,74 @@
+-- Migration: DOT physical scheduling
+-- Created: 2026-10-19
+-- Purpose: Bookable availability slots per examiner clinic, and appointment tracking on DOT physicals
+--          including cancellation and no-shows
+
+-- =============================================
+-- PHYSICAL STATUS LIFECYCLE
+-- =============================================
+
+ALTER TYPE physical_status ADD VALUE IF NOT EXISTS 'cancelled';
+ALTER TYPE physical_status ADD VALUE IF NOT EXISTS 'no_show';
+
+-- =============================================
+-- CLINIC AVAILABILITY SLOTS
+-- =============================================
+
+CREATE TABLE clinic_availability_slots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    clinic_id UUID NOT NULL REFERENCES medical_examiner_clinics(id) ON DELETE CASCADE,
+    examiner_id UUID NOT NULL REFERENCES medical_examiners(id) ON DELETE CASCADE,
+
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+
+    -- Booking
+    physical_id UUID REFERENCES dot_physicals(id) ON DELETE SET NULL, -- NULL while the slot is open
+    booked_at TIMESTAMPTZ,
+    is_blocked BOOLEAN DEFAULT false, -- withdrawn by the clinic
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(clinic_id, starts_at),
+    CONSTRAINT valid_slot_window CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_clinic_availability_slots_open ON clinic_availability_slots(clinic_id, starts_at) WHERE physical_id IS NULL;
+CREATE INDEX idx_clinic_availability_slots_examiner ON clinic_availability_slots(examiner_id, starts_at);
+CREATE UNIQUE INDEX idx_clinic_availability_slots_physical ON clinic_availability_slots(physical_id) WHERE physical_id IS NOT NULL;
+
+CREATE TRIGGER update_clinic_availability_slots_updated_at BEFORE UPDATE ON clinic_availability_slots
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- DOT PHYSICAL APPOINTMENTS
+-- =============================================
+
+ALTER TABLE dot_physicals
+    ADD COLUMN clinic_id UUID REFERENCES medical_examiner_clinics(id),
+    ADD COLUMN medical_examiner_id UUID REFERENCES medical_examiners(id),
+    ADD COLUMN examination_type VARCHAR(20), -- initial, renewal, follow_up
+    ADD COLUMN special_requirements TEXT,
+    ADD COLUMN appointment_sequence INTEGER DEFAULT 0, -- calendar invite revision, bumped on each change
+    ADD COLUMN cancelled_at TIMESTAMPTZ,
+    ADD COLUMN cancellation_reason TEXT,
+    ADD COLUMN no_show_at TIMESTAMPTZ;
+
+CREATE INDEX idx_dot_physicals_medical_examiner ON dot_physicals(medical_examiner_id);
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE clinic_availability_slots ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Authenticated users can view clinic availability" ON clinic_availability_slots
+    FOR SELECT USING (auth.uid() IS NOT NULL);
+
+CREATE POLICY "Examiners and internal users can manage clinic availability" ON clinic_availability_slots
+    FOR ALL USING (
+        is_internal_user() OR
+        EXISTS (SELECT 1 FROM medical_examiners
+                WHERE id = clinic_availability_slots.examiner_id AND user_id = auth.uid())
+    );
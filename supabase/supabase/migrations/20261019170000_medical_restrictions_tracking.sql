@@ -0,0 +1,104 @@
+-- Migration: Medical restrictions and monitoring requirements
+-- Created: 2026-10-19
+-- Purpose: Structured exemption documents and short-term certification periods on DOT physicals, and
+--          follow-up tasks generated from restrictions, exemptions and monitored conditions
+
+-- =============================================
+-- SHORT-TERM CERTIFICATION
+-- =============================================
+
+-- restrictions and monitoring_requirements now hold JSON arrays of restriction codes and
+-- {condition, interval_months, notes} requirements respectively
+ALTER TABLE dot_physicals
+    ADD COLUMN certification_period_months INTEGER
+        CHECK (certification_period_months BETWEEN 1 AND 24); -- less than 24 for a short-term certificate
+
+-- =============================================
+-- EXEMPTION DOCUMENTS
+-- =============================================
+
+CREATE TABLE dot_physical_exemptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    physical_id UUID NOT NULL REFERENCES dot_physicals(id) ON DELETE CASCADE,
+
+    exemption_type VARCHAR(50) NOT NULL, -- vision, hearing, seizure, skill_performance_evaluation, other
+    exemption_number VARCHAR(100),
+    issuing_authority VARCHAR(255), -- e.g. FMCSA
+    document_id UUID REFERENCES documents(id) ON DELETE SET NULL,
+
+    issued_date DATE,
+    expiration_date DATE,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CONSTRAINT valid_exemption_period CHECK (expiration_date IS NULL OR issued_date IS NULL OR expiration_date > issued_date)
+);
+
+CREATE INDEX idx_dot_physical_exemptions_physical ON dot_physical_exemptions(physical_id);
+CREATE INDEX idx_dot_physical_exemptions_expiration ON dot_physical_exemptions(organization_id, expiration_date);
+
+CREATE TRIGGER update_dot_physical_exemptions_updated_at BEFORE UPDATE ON dot_physical_exemptions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- MEDICAL FOLLOW-UP TASKS
+-- =============================================
+
+CREATE TABLE medical_follow_up_tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    physical_id UUID NOT NULL REFERENCES dot_physicals(id) ON DELETE CASCADE,
+
+    task_type VARCHAR(30) NOT NULL, -- recertification, exemption_renewal, monitoring_check
+    reference_key VARCHAR(100) NOT NULL, -- what generated the task, e.g. the condition or exemption ID
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    due_date DATE NOT NULL,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'open', -- open, completed, cancelled
+    completed_at TIMESTAMPTZ,
+    completed_by UUID REFERENCES user_profiles(id),
+    notes TEXT,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(physical_id, task_type, reference_key)
+);
+
+CREATE INDEX idx_medical_follow_up_tasks_open ON medical_follow_up_tasks(organization_id, due_date) WHERE status = 'open';
+CREATE INDEX idx_medical_follow_up_tasks_user ON medical_follow_up_tasks(user_id);
+
+CREATE TRIGGER update_medical_follow_up_tasks_updated_at BEFORE UPDATE ON medical_follow_up_tasks
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE dot_physical_exemptions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE medical_follow_up_tasks ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization medical exemptions" ON dot_physical_exemptions
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        user_id = auth.uid() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage medical exemptions" ON dot_physical_exemptions
+    FOR ALL USING (is_internal_user());
+
+CREATE POLICY "Users can view their organization medical follow-up tasks" ON medical_follow_up_tasks
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        user_id = auth.uid() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage medical follow-up tasks" ON medical_follow_up_tasks
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,14 @@
+-- Migration: Document storage checksums
+-- Created: 2026-10-19
+-- Purpose: Record the SHA-256 of stored document content so downloads can be verified against
+--          what was uploaded, whichever storage backend (local filesystem or S3-compatible) holds it
+
+-- =============================================
+-- DOCUMENT CHECKSUMS
+-- =============================================
+
+-- storage_bucket and storage_path now address the object in the configured blob store
+ALTER TABLE documents
+    ADD COLUMN checksum_sha256 VARCHAR(64); -- hex SHA-256 of the stored content
+
+CREATE INDEX idx_documents_storage_object ON documents(storage_bucket, storage_path);
@@ -0,0 +1,99 @@
+-- Migration: Resumable document uploads
+-- Created: 2026-10-19
+-- Purpose: Per-organization document size and MIME type limits, and upload sessions for the
+--          client-streaming upload RPC so an interrupted upload can resume from the last stored offset
+
+-- =============================================
+-- UPLOAD LIMITS
+-- =============================================
+
+CREATE TABLE document_upload_limits (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+
+    max_file_size_bytes BIGINT NOT NULL CHECK (max_file_size_bytes > 0),
+    allowed_mime_types JSONB NOT NULL DEFAULT '[]', -- e.g. ["application/pdf", "image/*"]
+
+    updated_by UUID REFERENCES user_profiles(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(organization_id)
+);
+
+CREATE TRIGGER update_document_upload_limits_updated_at BEFORE UPDATE ON document_upload_limits
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- UPLOAD SESSIONS
+-- =============================================
+
+CREATE TABLE document_upload_sessions (
+    id UUID PRIMARY KEY, -- generated by the client so an interrupted stream can be resumed
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES user_profiles(id) ON DELETE CASCADE,
+    created_by UUID REFERENCES user_profiles(id),
+
+    -- Document details applied when the upload completes
+    document_type VARCHAR(50) NOT NULL,
+    title VARCHAR(255),
+    description TEXT,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100),
+    related_entity_type VARCHAR(50),
+    related_entity_id UUID,
+    is_hipaa_protected BOOLEAN DEFAULT false,
+    confidentiality_level VARCHAR(20),
+    auto_delete_at TIMESTAMPTZ,
+
+    -- Progress; received bytes are stored as numbered segments under uploads/{id}/
+    total_size BIGINT NOT NULL CHECK (total_size > 0),
+    received_bytes BIGINT NOT NULL DEFAULT 0,
+    segment_count INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active', -- active, completed
+    document_id UUID REFERENCES documents(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    completed_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CONSTRAINT valid_upload_progress CHECK (received_bytes BETWEEN 0 AND total_size)
+);
+
+CREATE INDEX idx_document_upload_sessions_org ON document_upload_sessions(organization_id);
+CREATE INDEX idx_document_upload_sessions_expiry ON document_upload_sessions(expires_at) WHERE status = 'active';
+
+CREATE TRIGGER update_document_upload_sessions_updated_at BEFORE UPDATE ON document_upload_sessions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE document_upload_limits ENABLE ROW LEVEL SECURITY;
+ALTER TABLE document_upload_sessions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization upload limits" ON document_upload_limits
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Client admins can manage organization upload limits" ON document_upload_limits
+    FOR ALL USING (
+        is_internal_user() OR (
+            organization_id = current_user_organization_id() AND
+            EXISTS (SELECT 1 FROM user_profiles
+                    WHERE id = auth.uid() AND role IN ('client_admin', 'der'))
+        )
+    );
+
+CREATE POLICY "Users can view their own upload sessions" ON document_upload_sessions
+    FOR SELECT USING (
+        created_by = auth.uid() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage upload sessions" ON document_upload_sessions
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,47 @@
+-- Migration: Encryption at rest for protected documents
+-- Created: 2026-10-19
+-- Purpose: Per-organization data keys for envelope encryption of HIPAA-protected and confidential
+--          documents. Data keys are stored wrapped by a master key held in configuration, so rotating
+--          the master key re-wraps the data keys without re-encrypting document content.
+
+-- =============================================
+-- ORGANIZATION DATA KEYS
+-- =============================================
+
+CREATE TABLE organization_data_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+
+    wrapped_key TEXT NOT NULL, -- base64 AES-256 data key encrypted with the master key
+    master_key_id VARCHAR(100) NOT NULL, -- configuration ID of the master key that wrapped it
+    status VARCHAR(20) NOT NULL DEFAULT 'active', -- active, retired
+    rewrapped_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_organization_data_keys_active ON organization_data_keys(organization_id) WHERE status = 'active';
+CREATE INDEX idx_organization_data_keys_master ON organization_data_keys(master_key_id);
+
+CREATE TRIGGER update_organization_data_keys_updated_at BEFORE UPDATE ON organization_data_keys
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ENCRYPTED DOCUMENTS
+-- =============================================
+
+-- checksum_sha256 and the stored object hold ciphertext for encrypted documents; file_size is the
+-- plaintext size
+ALTER TABLE documents
+    ADD COLUMN is_encrypted BOOLEAN DEFAULT false,
+    ADD COLUMN encryption_key_id UUID REFERENCES organization_data_keys(id);
+
+CREATE INDEX idx_documents_encryption_key ON documents(encryption_key_id) WHERE encryption_key_id IS NOT NULL;
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+-- No policies: data keys are only read by the backend with the service role
+ALTER TABLE organization_data_keys ENABLE ROW LEVEL SECURITY;
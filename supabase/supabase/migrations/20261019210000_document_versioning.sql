@@ -0,0 +1,22 @@
+-- Migration: Document version history
+-- Created: 2026-10-19
+-- Purpose: Every version of a document is a documents row. Later versions point at the first version
+--          through parent_document_id, and exactly one version of each document is current. A note
+--          records why each version was created and which version a rollback restored.
+
+-- =============================================
+-- VERSION DETAILS
+-- =============================================
+
+ALTER TABLE documents
+    ADD COLUMN version_note TEXT,
+    ADD COLUMN restored_from_version INTEGER; -- set on versions created by rolling back
+
+-- =============================================
+-- VERSION CHAIN CONSTRAINTS
+-- =============================================
+
+-- A document's versions share COALESCE(parent_document_id, id): the ID of its first version
+CREATE UNIQUE INDEX idx_documents_version_chain ON documents ((COALESCE(parent_document_id, id)), version);
+CREATE UNIQUE INDEX idx_documents_current_version ON documents ((COALESCE(parent_document_id, id))) WHERE is_current_version;
+CREATE INDEX idx_documents_parent ON documents(parent_document_id) WHERE parent_document_id IS NOT NULL;
@@ -0,0 +1,53 @@
+-- Migration: Document sharing
+-- Created: 2026-10-19
+-- Purpose: Records documents shared with individual users. Each share grants view, download or edit
+--          access to the current version of a document through a signed link until it expires or
+--          is revoked.
+
+-- =============================================
+-- DOCUMENT SHARES
+-- =============================================
+
+CREATE TABLE document_shares (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    document_id UUID NOT NULL REFERENCES documents(id) ON DELETE CASCADE, -- first version of the document
+    organization_id UUID NOT NULL REFERENCES organizations(id),
+
+    shared_by UUID REFERENCES user_profiles(id),
+    shared_with UUID NOT NULL REFERENCES user_profiles(id),
+    permission_level VARCHAR(20) NOT NULL CHECK (permission_level IN ('view', 'download', 'edit')),
+    message TEXT,
+
+    expires_at TIMESTAMPTZ, -- NULL: until revoked
+    revoked_at TIMESTAMPTZ,
+    revoked_by UUID REFERENCES user_profiles(id),
+
+    access_count INTEGER NOT NULL DEFAULT 0,
+    last_accessed_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_document_shares_document ON document_shares(document_id);
+CREATE INDEX idx_document_shares_shared_with ON document_shares(shared_with, created_at DESC);
+CREATE INDEX idx_document_shares_shared_by ON document_shares(shared_by, created_at DESC) WHERE shared_by IS NOT NULL;
+
+CREATE TRIGGER update_document_shares_updated_at BEFORE UPDATE ON document_shares
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE document_shares ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view shares they made or received" ON document_shares
+    FOR SELECT USING (
+        shared_with = auth.uid() OR
+        shared_by = auth.uid() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage document shares" ON document_shares
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,84 @@
+-- Migration: Document full-text search
+-- Created: 2026-10-19
+-- Purpose: Indexes each document's title, description, file name and extracted text for full-text
+--          search. Rows are created by trigger when a document is stored or its details change;
+--          text is extracted from PDFs and plain-text uploads by a background job. Encrypted and
+--          HIPAA-protected documents are indexed on their details only, so their content is never
+--          held in plaintext.
+
+-- =============================================
+-- DOCUMENT SEARCH INDEX
+-- =============================================
+
+CREATE TABLE document_search_index (
+    document_id UUID PRIMARY KEY REFERENCES documents(id) ON DELETE CASCADE,
+
+    extracted_text TEXT,
+    extraction_status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (extraction_status IN ('pending', 'indexed', 'unsupported', 'protected', 'failed')),
+    extraction_error TEXT,
+    extracted_at TIMESTAMPTZ,
+
+    search_vector TSVECTOR NOT NULL DEFAULT ''::tsvector,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_document_search_index_vector ON document_search_index USING GIN (search_vector);
+CREATE INDEX idx_document_search_index_pending ON document_search_index(created_at)
+    WHERE extraction_status = 'pending';
+
+CREATE TRIGGER update_document_search_index_updated_at BEFORE UPDATE ON document_search_index
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Weights the title highest, then the file name and description, then the extracted text
+CREATE OR REPLACE FUNCTION document_search_vector(
+    p_title TEXT, p_file_name TEXT, p_description TEXT, p_extracted_text TEXT
+) RETURNS TSVECTOR AS $$
+    SELECT setweight(to_tsvector('english', COALESCE(p_title, '')), 'A') ||
+           setweight(to_tsvector('english', translate(COALESCE(p_file_name, ''), '._-', '   ')), 'B') ||
+           setweight(to_tsvector('english', COALESCE(p_description, '')), 'B') ||
+           setweight(to_tsvector('english', COALESCE(p_extracted_text, '')), 'C');
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION refresh_document_search_vector()
+RETURNS TRIGGER AS $$
+BEGIN
+    SELECT document_search_vector(d.title, d.file_name, d.description, NEW.extracted_text)
+      INTO NEW.search_vector
+      FROM documents d
+     WHERE d.id = NEW.document_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER refresh_document_search_index_vector BEFORE INSERT OR UPDATE ON document_search_index
+    FOR EACH ROW EXECUTE FUNCTION refresh_document_search_vector();
+
+-- Creates the index row for a new document and refreshes it when the indexed details change
+CREATE OR REPLACE FUNCTION index_document_for_search()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO document_search_index (document_id)
+    VALUES (NEW.id)
+    ON CONFLICT (document_id) DO UPDATE SET updated_at = NOW();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql SECURITY DEFINER SET search_path = public;
+
+CREATE TRIGGER index_documents_for_search AFTER INSERT OR UPDATE OF title, description, file_name ON documents
+    FOR EACH ROW EXECUTE FUNCTION index_document_for_search();
+
+INSERT INTO document_search_index (document_id)
+SELECT id FROM documents
+ON CONFLICT (document_id) DO NOTHING;
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE document_search_index ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Internal users can manage the document search index" ON document_search_index
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,108 @@
+-- Migration: Document retention and legal holds
+-- Created: 2026-10-19
+-- Purpose: Per-organization retention policies that schedule documents for deletion by type, and
+--          legal holds that block deletion of an organization's, a user's or a single document's
+--          records. Expired documents are purged nightly, leaving a tombstone entry in audit_logs.
+
+-- =============================================
+-- RETENTION POLICIES
+-- =============================================
+
+CREATE TABLE document_retention_policies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    document_type VARCHAR(50) NOT NULL DEFAULT '', -- '': document types without their own policy
+
+    retention_days INTEGER NOT NULL CHECK (retention_days > 0),
+
+    applied_by UUID REFERENCES user_profiles(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(organization_id, document_type)
+);
+
+CREATE TRIGGER update_document_retention_policies_updated_at BEFORE UPDATE ON document_retention_policies
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+CREATE INDEX idx_documents_auto_delete ON documents(auto_delete_date)
+    WHERE auto_delete_date IS NOT NULL AND is_current_version = true;
+
+-- =============================================
+-- LEGAL HOLDS
+-- =============================================
+
+CREATE TABLE legal_holds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id),
+    case_reference VARCHAR(100) NOT NULL,
+    reason TEXT,
+
+    scope VARCHAR(20) NOT NULL CHECK (scope IN ('organization', 'user', 'document')),
+    user_id UUID REFERENCES user_profiles(id),
+    document_id UUID REFERENCES documents(id) ON DELETE SET NULL, -- first version of the document
+
+    placed_by UUID REFERENCES user_profiles(id),
+    placed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    released_by UUID REFERENCES user_profiles(id),
+    released_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CHECK (scope <> 'user' OR user_id IS NOT NULL),
+    CHECK (scope <> 'document' OR document_id IS NOT NULL OR released_at IS NOT NULL)
+);
+
+CREATE INDEX idx_legal_holds_active ON legal_holds(organization_id) WHERE released_at IS NULL;
+CREATE INDEX idx_legal_holds_case ON legal_holds(organization_id, case_reference);
+
+CREATE TRIGGER update_legal_holds_updated_at BEFORE UPDATE ON legal_holds
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Whether an active legal hold covers a document, given the first version's ID for document holds
+CREATE OR REPLACE FUNCTION document_on_legal_hold(
+    p_organization_id UUID, p_user_id UUID, p_root_document_id UUID
+) RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1 FROM legal_holds h
+         WHERE h.organization_id = p_organization_id
+           AND h.released_at IS NULL
+           AND (h.scope = 'organization'
+                OR (h.scope = 'user' AND h.user_id = p_user_id)
+                OR (h.scope = 'document' AND h.document_id = p_root_document_id))
+    );
+$$ LANGUAGE sql STABLE SECURITY DEFINER SET search_path = public;
+
+-- Refuses to delete any version of a document covered by an active legal hold, whatever deletes it
+CREATE OR REPLACE FUNCTION prevent_held_document_deletion()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF document_on_legal_hold(OLD.organization_id, OLD.user_id, COALESCE(OLD.parent_document_id, OLD.id)) THEN
+        RAISE EXCEPTION 'document % is under legal hold', OLD.id;
+    END IF;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER prevent_held_documents_deletion BEFORE DELETE ON documents
+    FOR EACH ROW EXECUTE FUNCTION prevent_held_document_deletion();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE document_retention_policies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE legal_holds ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization's retention policies" ON document_retention_policies
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage retention policies" ON document_retention_policies
+    FOR ALL USING (is_internal_user());
+
+CREATE POLICY "Internal users can manage legal holds" ON legal_holds
+    FOR ALL USING (is_internal_user());
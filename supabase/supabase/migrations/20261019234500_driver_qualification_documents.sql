@@ -0,0 +1,11 @@
+-- Migration: Driver qualification file document types
+-- Created: 2026-10-19
+-- Purpose: Document types for the parts of a driver qualification file (49 CFR 391.51) that are
+--          kept only as uploaded documents: the employment application and the road test
+--          certificate. Road tests recorded as certificates use certificate_type 'road_test'.
+
+ALTER TYPE document_type ADD VALUE IF NOT EXISTS 'driver_application';
+ALTER TYPE document_type ADD VALUE IF NOT EXISTS 'road_test_certificate';
+
+CREATE INDEX IF NOT EXISTS idx_documents_user_type ON documents(user_id, document_type)
+    WHERE is_current_version = true;
@@ -0,0 +1,55 @@
+-- Migration: Notification delivery tracking and channel preferences
+-- Created: 2026-10-19
+-- Purpose: Per-channel delivery status on notifications, so email and SMS deliveries retried in the
+--          background can be followed from pending to sent, delivered or failed, and per-user channel
+--          preferences by notification category that decide which channels a notification uses.
+
+-- =============================================
+-- DELIVERY STATUS
+-- =============================================
+
+ALTER TABLE notifications
+    ADD COLUMN category VARCHAR(50) NOT NULL DEFAULT 'general', -- e.g. 'test_reminder', 'certificate_expiring'
+    ADD COLUMN delivery_status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (delivery_status IN ('pending', 'sent', 'delivered', 'failed'));
+
+UPDATE notifications SET delivery_status = CASE
+    WHEN delivered_at IS NOT NULL THEN 'delivered'
+    WHEN sent_at IS NOT NULL THEN 'sent'
+    WHEN delivery_error IS NOT NULL THEN 'failed'
+    ELSE 'pending'
+END;
+
+CREATE INDEX idx_notifications_pending ON notifications(created_at) WHERE delivery_status = 'pending';
+CREATE INDEX idx_notifications_category ON notifications(organization_id, category);
+
+-- =============================================
+-- CHANNEL PREFERENCES
+-- =============================================
+
+CREATE TABLE notification_preferences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    category VARCHAR(50) NOT NULL,
+
+    email_enabled BOOLEAN NOT NULL DEFAULT true,
+    sms_enabled BOOLEAN NOT NULL DEFAULT false, -- text messages need the recipient's consent
+    in_app_enabled BOOLEAN NOT NULL DEFAULT true,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(user_id, category)
+);
+
+CREATE TRIGGER update_notification_preferences_updated_at BEFORE UPDATE ON notification_preferences
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE notification_preferences ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can manage their own notification preferences" ON notification_preferences
+    FOR ALL USING (user_id = auth.uid() OR is_internal_user());
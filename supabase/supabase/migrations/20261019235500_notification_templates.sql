@@ -0,0 +1,100 @@
+-- Migration: Notification templates
+-- Created: 2026-10-19
+-- Purpose: Locally stored notification templates with typed variables and per-locale variants, per
+--          organization with system-wide defaults, and the locale each user reads notifications in.
+
+-- =============================================
+-- USER LOCALE
+-- =============================================
+
+ALTER TABLE user_profiles
+    ADD COLUMN preferred_locale VARCHAR(10) NOT NULL DEFAULT 'en'
+        CHECK (preferred_locale IN ('en', 'es'));
+
+-- =============================================
+-- TEMPLATES
+-- =============================================
+
+-- A template is identified by its key; its locale variants are rows sharing the key. Templates
+-- without an organization are the system defaults the organizations' templates fall back to.
+CREATE TABLE notification_templates (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE, -- NULL for system templates
+    template_key VARCHAR(100) NOT NULL, -- e.g. 'certificate_expiring'
+    locale VARCHAR(10) NOT NULL DEFAULT 'en' CHECK (locale IN ('en', 'es')),
+    template_name VARCHAR(255) NOT NULL,
+    category VARCHAR(50) NOT NULL DEFAULT 'general',
+    channel VARCHAR(20) CHECK (channel IN ('in_app', 'email', 'sms')), -- channel used when a send names none
+
+    subject_template TEXT NOT NULL, -- text/template
+    body_template TEXT NOT NULL, -- text/template, for SMS, in-app and plain text email
+    html_template TEXT, -- html/template, for email
+    variables JSONB NOT NULL DEFAULT '[]'::jsonb, -- [{"name", "type", "required", "description"}]
+
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID REFERENCES user_profiles(id),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE NULLS NOT DISTINCT (organization_id, template_key, locale)
+);
+
+CREATE INDEX idx_notification_templates_key ON notification_templates(template_key, locale);
+CREATE INDEX idx_notification_templates_organization ON notification_templates(organization_id, category);
+
+CREATE TRIGGER update_notification_templates_updated_at BEFORE UPDATE ON notification_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- SYSTEM DEFAULTS
+-- =============================================
+
+INSERT INTO notification_templates (template_key, locale, template_name, category, channel, subject_template, body_template, variables) VALUES
+('certificate_expiring', 'en', 'Medical certificate expiring', 'certificate_expiring', NULL,
+ 'Your medical certificate expires {{date .expiresOn}}',
+ 'Hello {{.driverName}}, your DOT medical certificate expires on {{date .expiresOn}}. Please schedule your physical before then to stay qualified to drive.',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "expiresOn", "type": "date", "required": true}]'),
+('certificate_expiring', 'es', 'Certificado médico por vencer', 'certificate_expiring', NULL,
+ 'Su certificado médico vence el {{date .expiresOn}}',
+ 'Hola {{.driverName}}, su certificado médico del DOT vence el {{date .expiresOn}}. Programe su examen físico antes de esa fecha para seguir calificado para conducir.',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "expiresOn", "type": "date", "required": true}]'),
+('test_reminder', 'en', 'Drug and alcohol test reminder', 'test_reminder', NULL,
+ 'Reminder: {{.testType}} test {{if .dueDate.IsZero}}required{{else}}due {{date .dueDate}}{{end}}',
+ 'Hello {{.driverName}}, you have been selected for a {{.testType}} test.{{if .location}} Please report to {{.location}}.{{end}}{{if not .dueDate.IsZero}} Complete it by {{date .dueDate}}.{{end}}',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "testType", "type": "string", "required": true}, {"name": "dueDate", "type": "date", "required": false}, {"name": "location", "type": "string", "required": false}]'),
+('test_reminder', 'es', 'Recordatorio de prueba de drogas y alcohol', 'test_reminder', NULL,
+ 'Recordatorio: prueba de {{.testType}} {{if .dueDate.IsZero}}requerida{{else}}para el {{date .dueDate}}{{end}}',
+ 'Hola {{.driverName}}, ha sido seleccionado para una prueba de {{.testType}}.{{if .location}} Preséntese en {{.location}}.{{end}}{{if not .dueDate.IsZero}} Complétela antes del {{date .dueDate}}.{{end}}',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "testType", "type": "string", "required": true}, {"name": "dueDate", "type": "date", "required": false}, {"name": "location", "type": "string", "required": false}]'),
+('appointment', 'en', 'Appointment scheduled', 'appointment', NULL,
+ 'Appointment scheduled for {{date .appointmentDate}}',
+ 'Hello {{.driverName}}, your appointment{{if .location}} at {{.location}}{{end}} is scheduled for {{date .appointmentDate}}.',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "appointmentDate", "type": "date", "required": true}, {"name": "location", "type": "string", "required": false}]'),
+('appointment', 'es', 'Cita programada', 'appointment', NULL,
+ 'Cita programada para el {{date .appointmentDate}}',
+ 'Hola {{.driverName}}, su cita{{if .location}} en {{.location}}{{end}} está programada para el {{date .appointmentDate}}.',
+ '[{"name": "driverName", "type": "string", "required": true}, {"name": "appointmentDate", "type": "date", "required": true}, {"name": "location", "type": "string", "required": false}]'),
+('compliance_alert', 'en', 'Compliance alert', 'compliance_alert', NULL,
+ 'Compliance alert: {{.subject}}',
+ '{{.details}}',
+ '[{"name": "subject", "type": "string", "required": true}, {"name": "details", "type": "string", "required": true}]'),
+('compliance_alert', 'es', 'Alerta de cumplimiento', 'compliance_alert', NULL,
+ 'Alerta de cumplimiento: {{.subject}}',
+ '{{.details}}',
+ '[{"name": "subject", "type": "string", "required": true}, {"name": "details", "type": "string", "required": true}]');
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE notification_templates ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view system and their organization's notification templates" ON notification_templates
+    FOR SELECT USING (
+        organization_id IS NULL OR
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage notification templates" ON notification_templates
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,32 @@
+-- Migration: Notification frequency, quiet hours and digests
+-- Created: 2026-10-19
+-- Purpose: How often each user receives notifications of a category, the quiet hours in their time
+--          zone during which email and SMS deliveries wait, and the daily or weekly digests that
+--          low-priority notifications are batched into.
+
+-- =============================================
+-- PREFERENCES
+-- =============================================
+
+-- Quiet hours and the time zone are kept on the user's 'all' preference row; they cover every
+-- category.
+ALTER TABLE notification_preferences
+    ADD COLUMN frequency VARCHAR(20) NOT NULL DEFAULT 'immediate'
+        CHECK (frequency IN ('immediate', 'daily_digest', 'weekly_digest')),
+    ADD COLUMN quiet_hours_start TIME,
+    ADD COLUMN quiet_hours_end TIME,
+    ADD COLUMN timezone VARCHAR(64), -- IANA name, e.g. 'America/Chicago'
+    ADD CONSTRAINT notification_preferences_quiet_hours_check
+        CHECK ((quiet_hours_start IS NULL) = (quiet_hours_end IS NULL));
+
+-- =============================================
+-- DIGESTS
+-- =============================================
+
+-- A pending notification with a digest time waits to be sent with the recipient's other
+-- notifications due in the same digest.
+ALTER TABLE notifications
+    ADD COLUMN digest_at TIMESTAMPTZ;
+
+CREATE INDEX idx_notifications_digest ON notifications(digest_at, user_id)
+    WHERE delivery_status = 'pending' AND digest_at IS NOT NULL;
@@ -0,0 +1,82 @@
+-- Migration: Bulk notification sends
+-- Created: 2026-10-19
+-- Purpose: Notifications sent to many users of an organization at once, fanned out in the background
+--          at a throttled rate, with each recipient's outcome so progress can be followed.
+
+-- =============================================
+-- BULK SENDS
+-- =============================================
+
+CREATE TABLE notification_bulk_sends (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    notification JSONB NOT NULL, -- the notification sent to every recipient
+    recipient_filter JSONB NOT NULL DEFAULT '{}'::jsonb, -- how the recipients were chosen
+    status VARCHAR(20) NOT NULL DEFAULT 'queued'
+        CHECK (status IN ('queued', 'running', 'completed')),
+
+    total_recipients INTEGER NOT NULL DEFAULT 0,
+    sent_count INTEGER NOT NULL DEFAULT 0,
+    skipped_count INTEGER NOT NULL DEFAULT 0, -- recipients with every requested channel turned off
+    failed_count INTEGER NOT NULL DEFAULT 0,
+
+    requested_by UUID REFERENCES user_profiles(id),
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_notification_bulk_sends_organization ON notification_bulk_sends(organization_id, created_at DESC);
+
+CREATE TRIGGER update_notification_bulk_sends_updated_at BEFORE UPDATE ON notification_bulk_sends
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- RECIPIENTS
+-- =============================================
+
+CREATE TABLE notification_bulk_recipients (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bulk_send_id UUID NOT NULL REFERENCES notification_bulk_sends(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES user_profiles(id) ON DELETE CASCADE,
+    template_data JSONB, -- merged over the bulk send's template data
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'skipped', 'failed')),
+    error TEXT,
+    processed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+
+    UNIQUE(bulk_send_id, user_id)
+);
+
+CREATE INDEX idx_notification_bulk_recipients_pending ON notification_bulk_recipients(bulk_send_id, created_at)
+    WHERE status = 'pending';
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE notification_bulk_sends ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notification_bulk_recipients ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can view their organization's bulk notification sends" ON notification_bulk_sends
+    FOR SELECT USING (
+        organization_id = current_user_organization_id() OR
+        is_internal_user()
+    );
+
+CREATE POLICY "Internal users can manage bulk notification sends" ON notification_bulk_sends
+    FOR ALL USING (is_internal_user());
+
+CREATE POLICY "Users can view their organization's bulk notification recipients" ON notification_bulk_recipients
+    FOR SELECT USING (
+        EXISTS (
+            SELECT 1 FROM notification_bulk_sends b
+            WHERE b.id = bulk_send_id
+            AND (b.organization_id = current_user_organization_id() OR is_internal_user())
+        )
+    );
+
+CREATE POLICY "Internal users can manage bulk notification recipients" ON notification_bulk_recipients
+    FOR ALL USING (is_internal_user());
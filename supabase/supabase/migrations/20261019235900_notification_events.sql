@@ -0,0 +1,45 @@
+-- Migration: Real-time in-app notification events
+-- Created: 2026-10-19
+-- Purpose: Publish new in-app notifications and read-state changes on the notification_events
+--          channel, so that every API instance can push them to the clients streaming the
+--          recipient's notifications.
+
+-- =============================================
+-- EVENTS
+-- =============================================
+
+-- The payload carries IDs only; listeners load the notification themselves, which keeps it well
+-- under the pg_notify payload limit and out of the channel.
+CREATE OR REPLACE FUNCTION notify_notification_event()
+RETURNS TRIGGER AS $$
+DECLARE
+    event TEXT;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        event := 'created';
+    ELSIF NEW.read_at IS NULL THEN
+        event := 'unread';
+    ELSE
+        event := 'read';
+    END IF;
+
+    PERFORM pg_notify('notification_events', json_build_object(
+        'event', event,
+        'notification_id', NEW.id,
+        'user_id', NEW.user_id,
+        'organization_id', NEW.organization_id
+    )::text);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER notify_notification_created AFTER INSERT ON notifications
+    FOR EACH ROW
+    WHEN (NEW.notification_type = 'in_app' AND NEW.user_id IS NOT NULL)
+    EXECUTE FUNCTION notify_notification_event();
+
+CREATE TRIGGER notify_notification_read AFTER UPDATE OF read_at ON notifications
+    FOR EACH ROW
+    WHEN (NEW.notification_type = 'in_app' AND NEW.user_id IS NOT NULL
+          AND (OLD.read_at IS NULL) IS DISTINCT FROM (NEW.read_at IS NULL))
+    EXECUTE FUNCTION notify_notification_event();
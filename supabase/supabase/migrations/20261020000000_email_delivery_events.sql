@@ -0,0 +1,47 @@
+-- Migration: Email delivery events
+-- Created: 2026-10-19
+-- Purpose: Record the deliveries, bounces, complaints, opens and clicks the email providers report
+--          for sent email notifications, and suppress further email to addresses that hard bounced
+--          or complained.
+
+-- =============================================
+-- NOTIFICATION EVENTS
+-- =============================================
+
+ALTER TABLE notifications
+    ADD COLUMN bounced_at TIMESTAMPTZ,
+    ADD COLUMN bounce_type VARCHAR(10) CHECK (bounce_type IN ('hard', 'soft')),
+    ADD COLUMN complained_at TIMESTAMPTZ,
+    ADD COLUMN opened_at TIMESTAMPTZ, -- first open
+    ADD COLUMN clicked_at TIMESTAMPTZ; -- first click
+
+-- Provider events find their notification by the provider's message ID
+CREATE INDEX idx_notifications_external_message_id ON notifications(external_message_id)
+    WHERE external_message_id IS NOT NULL;
+
+-- =============================================
+-- SUPPRESSIONS
+-- =============================================
+
+CREATE TABLE email_suppressions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    email_address VARCHAR(255) NOT NULL UNIQUE, -- lower case
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('hard_bounce', 'complaint')),
+    provider VARCHAR(20),
+    notification_id UUID REFERENCES notifications(id) ON DELETE SET NULL, -- the email that bounced or was complained about
+    details TEXT, -- bounce diagnostic or complaint feedback type
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TRIGGER update_email_suppressions_updated_at BEFORE UPDATE ON email_suppressions
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE email_suppressions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Internal users can manage email suppressions" ON email_suppressions
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,15 @@
+-- Migration: Medical certificate reminder progress
+-- Created: 2026-10-19
+-- Purpose: Record which recipients and channels a medical certificate reminder has reached, so that
+--          a reminder that partly failed is retried only for the ones it missed.
+
+-- =============================================
+-- REMINDERS SENT
+-- =============================================
+
+ALTER TABLE medical_cert_reminders_sent
+    ADD COLUMN notified JSONB NOT NULL DEFAULT '[]', -- recipient and channel keys notified so far
+    ADD COLUMN completed_at TIMESTAMPTZ; -- every recipient notified
+
+-- Reminders recorded before progress was kept were only recorded once fully sent
+UPDATE medical_cert_reminders_sent SET completed_at = sent_at;
@@ -0,0 +1,29 @@
+-- Migration: Document content deletions
+-- Created: 2026-10-19
+-- Purpose: Queue the stored content of purged documents for deletion once the purge has committed,
+--          so content is never removed for rows a rolled back purge leaves in place, and content
+--          that fails to delete is retried by the next purge.
+
+-- =============================================
+-- CONTENT DELETIONS
+-- =============================================
+
+CREATE TABLE document_content_deletions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    document_id UUID NOT NULL, -- the purged version; no foreign key, the row is gone
+    storage_path TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_document_content_deletions_created_at ON document_content_deletions(created_at);
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE document_content_deletions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Internal users can manage document content deletions" ON document_content_deletions
+    FOR ALL USING (is_internal_user());
@@ -0,0 +1,46 @@
+-- Migration: Notification quiet hours
+-- Created: 2026-10-19
+-- Purpose: Keep each user's quiet hours and time zone apart from their channel preferences. Quiet
+--          hours were kept on the 'all' preference row, so setting them created that row with the
+--          channel defaults, and its sms_enabled = false turned text messages off for every
+--          category, critical alerts included.
+
+-- =============================================
+-- QUIET HOURS
+-- =============================================
+
+CREATE TABLE notification_quiet_hours (
+    user_id UUID PRIMARY KEY REFERENCES user_profiles(id) ON DELETE CASCADE,
+    quiet_hours_start TIME,
+    quiet_hours_end TIME,
+    timezone VARCHAR(64), -- IANA name, e.g. 'America/Chicago'
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+
+    CONSTRAINT notification_quiet_hours_check
+        CHECK ((quiet_hours_start IS NULL) = (quiet_hours_end IS NULL))
+);
+
+CREATE TRIGGER update_notification_quiet_hours_updated_at BEFORE UPDATE ON notification_quiet_hours
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO notification_quiet_hours (user_id, quiet_hours_start, quiet_hours_end, timezone)
+SELECT user_id, quiet_hours_start, quiet_hours_end, timezone
+FROM notification_preferences
+WHERE category = 'all'
+  AND (quiet_hours_start IS NOT NULL OR timezone IS NOT NULL);
+
+ALTER TABLE notification_preferences
+    DROP CONSTRAINT notification_preferences_quiet_hours_check,
+    DROP COLUMN quiet_hours_start,
+    DROP COLUMN quiet_hours_end,
+    DROP COLUMN timezone;
+
+-- =============================================
+-- ROW LEVEL SECURITY
+-- =============================================
+
+ALTER TABLE notification_quiet_hours ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY "Users can manage their own quiet hours" ON notification_quiet_hours
+    FOR ALL USING (user_id = auth.uid() OR is_internal_user());
@@ -0,0 +1,15 @@
+-- Migration: Bulk recipient notifications
+-- Created: 2026-10-19
+-- Purpose: Link the notifications of a bulk send to the recipient they were sent to, at most one per
+--          channel, so a retried chunk does not notify a recipient again when their notifications
+--          were recorded but their outcome was not.
+
+-- =============================================
+-- NOTIFICATIONS
+-- =============================================
+
+ALTER TABLE notifications
+    ADD COLUMN bulk_recipient_id UUID REFERENCES notification_bulk_recipients(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX idx_notifications_bulk_recipient ON notifications(bulk_recipient_id, notification_type)
+    WHERE bulk_recipient_id IS NOT NULL;
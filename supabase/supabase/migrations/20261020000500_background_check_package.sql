@@ -0,0 +1,53 @@
+-- Migration: Background check package
+-- Created: 2026-10-19
+-- Purpose: Record the catalog package a background check was ordered under, so analytics cost
+--          each check at that package's price and report cost per package.
+
+-- =============================================
+-- PACKAGE LOOKUP
+-- =============================================
+
+-- The package a check of the type from the provider is ordered under on the date: the
+-- organization's negotiated package over the list price, and the latest one in effect
+CREATE OR REPLACE FUNCTION background_check_package_for(
+    p_organization_id UUID, p_provider_name VARCHAR, p_check_type background_check_type, p_ordered_at TIMESTAMPTZ
+) RETURNS UUID AS $$
+    SELECT p.id FROM background_check_packages p
+     WHERE lower(p.provider_name) = lower(p_provider_name)
+       AND p.check_type = p_check_type
+       AND (p.organization_id IS NULL OR p.organization_id = p_organization_id)
+       AND p.effective_from <= p_ordered_at::date
+       AND (p.effective_to IS NULL OR p.effective_to > p_ordered_at::date)
+     ORDER BY p.organization_id IS NULL, p.effective_from DESC
+     LIMIT 1;
+$$ LANGUAGE sql STABLE SECURITY DEFINER SET search_path = public;
+
+-- =============================================
+-- BACKGROUND CHECKS
+-- =============================================
+
+ALTER TABLE background_checks
+    ADD COLUMN package_id UUID REFERENCES background_check_packages(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_background_checks_package ON background_checks(package_id) WHERE package_id IS NOT NULL;
+
+-- Checks ordered before packages were recorded get the package they would have been ordered under
+UPDATE background_checks
+   SET package_id = background_check_package_for(organization_id, provider_name, check_type, COALESCE(ordered_date, created_at))
+ WHERE package_id IS NULL
+   AND provider_name IS NOT NULL;
+
+-- Records the package of a check ordered without naming one, whatever orders it
+CREATE OR REPLACE FUNCTION set_background_check_package()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.package_id IS NULL AND NEW.provider_name IS NOT NULL THEN
+        NEW.package_id := background_check_package_for(
+            NEW.organization_id, NEW.provider_name, NEW.check_type, COALESCE(NEW.ordered_date, NOW()));
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER set_background_check_package BEFORE INSERT ON background_checks
+    FOR EACH ROW EXECUTE FUNCTION set_background_check_package();